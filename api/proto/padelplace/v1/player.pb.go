@@ -0,0 +1,742 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: padelplace/v1/player.proto
+
+package padelplacev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Player registered in the player-couple module, SSNs are masked unless the caller is an admin.
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email                string  `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	SocialSecurityNumber *string `protobuf:"bytes,3,opt,name=social_security_number,json=socialSecurityNumber,proto3,oneof" json:"social_security_number,omitempty"`
+	FirstName            string  `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName             string  `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// Derived from birth_date, ignored in requests.
+	Age *int32 `protobuf:"varint,6,opt,name=age,proto3,oneof" json:"age,omitempty"`
+	// Formatted as YYYY-MM-DD.
+	BirthDate *string `protobuf:"bytes,7,opt,name=birth_date,json=birthDate,proto3,oneof" json:"birth_date,omitempty"`
+	// male or female, empty when unknown like the other profile fields.
+	Gender string `protobuf:"bytes,8,opt,name=gender,proto3" json:"gender,omitempty"`
+	// Dominant hand: right or left.
+	Handedness string `protobuf:"bytes,9,opt,name=handedness,proto3" json:"handedness,omitempty"`
+	// Preferred side: drive, reves or both.
+	Side string `protobuf:"bytes,10,opt,name=side,proto3" json:"side,omitempty"`
+	// 1 to 8, 0 when unknown.
+	Level int32  `protobuf:"varint,11,opt,name=level,proto3" json:"level,omitempty"`
+	Club  string `protobuf:"bytes,12,opt,name=club,proto3" json:"club,omitempty"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	mi := &file_padelplace_v1_player_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Player) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Player) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Player) GetSocialSecurityNumber() string {
+	if x != nil && x.SocialSecurityNumber != nil {
+		return *x.SocialSecurityNumber
+	}
+	return ""
+}
+
+func (x *Player) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Player) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Player) GetAge() int32 {
+	if x != nil && x.Age != nil {
+		return *x.Age
+	}
+	return 0
+}
+
+func (x *Player) GetBirthDate() string {
+	if x != nil && x.BirthDate != nil {
+		return *x.BirthDate
+	}
+	return ""
+}
+
+func (x *Player) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *Player) GetHandedness() string {
+	if x != nil {
+		return x.Handedness
+	}
+	return ""
+}
+
+func (x *Player) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *Player) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Player) GetClub() string {
+	if x != nil {
+		return x.Club
+	}
+	return ""
+}
+
+type RegisterPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *RegisterPlayerRequest) Reset() {
+	*x = RegisterPlayerRequest{}
+	mi := &file_padelplace_v1_player_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPlayerRequest) ProtoMessage() {}
+
+func (x *RegisterPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPlayerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterPlayerRequest) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type RegisterPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// True when the player didn't exist before.
+	Created bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *RegisterPlayerResponse) Reset() {
+	*x = RegisterPlayerResponse{}
+	mi := &file_padelplace_v1_player_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPlayerResponse) ProtoMessage() {}
+
+func (x *RegisterPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPlayerResponse.ProtoReflect.Descriptor instead.
+func (*RegisterPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegisterPlayerResponse) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *RegisterPlayerResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type FindPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FindPlayerRequest) Reset() {
+	*x = FindPlayerRequest{}
+	mi := &file_padelplace_v1_player_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayerRequest) ProtoMessage() {}
+
+func (x *FindPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayerRequest.ProtoReflect.Descriptor instead.
+func (*FindPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FindPlayerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FindPlayerByEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *FindPlayerByEmailRequest) Reset() {
+	*x = FindPlayerByEmailRequest{}
+	mi := &file_padelplace_v1_player_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlayerByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayerByEmailRequest) ProtoMessage() {}
+
+func (x *FindPlayerByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayerByEmailRequest.ProtoReflect.Descriptor instead.
+func (*FindPlayerByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindPlayerByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type FindPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *FindPlayerResponse) Reset() {
+	*x = FindPlayerResponse{}
+	mi := &file_padelplace_v1_player_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayerResponse) ProtoMessage() {}
+
+func (x *FindPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayerResponse.ProtoReflect.Descriptor instead.
+func (*FindPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindPlayerResponse) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type FindPlayersByLastNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastName string `protobuf:"bytes,1,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+}
+
+func (x *FindPlayersByLastNameRequest) Reset() {
+	*x = FindPlayersByLastNameRequest{}
+	mi := &file_padelplace_v1_player_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlayersByLastNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayersByLastNameRequest) ProtoMessage() {}
+
+func (x *FindPlayersByLastNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayersByLastNameRequest.ProtoReflect.Descriptor instead.
+func (*FindPlayersByLastNameRequest) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FindPlayersByLastNameRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type FindPlayersByLastNameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *FindPlayersByLastNameResponse) Reset() {
+	*x = FindPlayersByLastNameResponse{}
+	mi := &file_padelplace_v1_player_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlayersByLastNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayersByLastNameResponse) ProtoMessage() {}
+
+func (x *FindPlayersByLastNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayersByLastNameResponse.ProtoReflect.Descriptor instead.
+func (*FindPlayersByLastNameResponse) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FindPlayersByLastNameResponse) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type UnregisterPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UnregisterPlayerRequest) Reset() {
+	*x = UnregisterPlayerRequest{}
+	mi := &file_padelplace_v1_player_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterPlayerRequest) ProtoMessage() {}
+
+func (x *UnregisterPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterPlayerRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnregisterPlayerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnregisterPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnregisterPlayerResponse) Reset() {
+	*x = UnregisterPlayerResponse{}
+	mi := &file_padelplace_v1_player_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterPlayerResponse) ProtoMessage() {}
+
+func (x *UnregisterPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterPlayerResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_proto_rawDescGZIP(), []int{9}
+}
+
+var File_padelplace_v1_player_proto protoreflect.FileDescriptor
+
+var file_padelplace_v1_player_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x70, 0x61,
+	0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x88, 0x03, 0x0a, 0x06,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x39, 0x0a, 0x16,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x14,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x01, 0x52, 0x03, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x62, 0x69,
+	0x72, 0x74, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x65, 0x64,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x61, 0x6e, 0x64,
+	0x65, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6c, 0x75, 0x62, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6c, 0x75, 0x62, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x69, 0x72, 0x74,
+	0x68, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x46, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x61,
+	0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0x23, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x43, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x3b, 0x0a,
+	0x1c, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x42, 0x79, 0x4c, 0x61,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x1d, 0x46, 0x69,
+	0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a, 0x17,
+	0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x6e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xfb, 0x03, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x20, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x2e, 0x70,
+	0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2b, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x42, 0x79, 0x4c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x10,
+	0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x26, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x61, 0x67, 0x75, 0x65, 0x72, 0x72, 0x65, 0x33, 0x2f, 0x67, 0x6f, 0x64, 0x64, 0x64, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_padelplace_v1_player_proto_rawDescOnce sync.Once
+	file_padelplace_v1_player_proto_rawDescData = file_padelplace_v1_player_proto_rawDesc
+)
+
+func file_padelplace_v1_player_proto_rawDescGZIP() []byte {
+	file_padelplace_v1_player_proto_rawDescOnce.Do(func() {
+		file_padelplace_v1_player_proto_rawDescData = protoimpl.X.CompressGZIP(file_padelplace_v1_player_proto_rawDescData)
+	})
+	return file_padelplace_v1_player_proto_rawDescData
+}
+
+var file_padelplace_v1_player_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_padelplace_v1_player_proto_goTypes = []any{
+	(*Player)(nil),                        // 0: padelplace.v1.Player
+	(*RegisterPlayerRequest)(nil),         // 1: padelplace.v1.RegisterPlayerRequest
+	(*RegisterPlayerResponse)(nil),        // 2: padelplace.v1.RegisterPlayerResponse
+	(*FindPlayerRequest)(nil),             // 3: padelplace.v1.FindPlayerRequest
+	(*FindPlayerByEmailRequest)(nil),      // 4: padelplace.v1.FindPlayerByEmailRequest
+	(*FindPlayerResponse)(nil),            // 5: padelplace.v1.FindPlayerResponse
+	(*FindPlayersByLastNameRequest)(nil),  // 6: padelplace.v1.FindPlayersByLastNameRequest
+	(*FindPlayersByLastNameResponse)(nil), // 7: padelplace.v1.FindPlayersByLastNameResponse
+	(*UnregisterPlayerRequest)(nil),       // 8: padelplace.v1.UnregisterPlayerRequest
+	(*UnregisterPlayerResponse)(nil),      // 9: padelplace.v1.UnregisterPlayerResponse
+}
+var file_padelplace_v1_player_proto_depIdxs = []int32{
+	0, // 0: padelplace.v1.RegisterPlayerRequest.player:type_name -> padelplace.v1.Player
+	0, // 1: padelplace.v1.RegisterPlayerResponse.player:type_name -> padelplace.v1.Player
+	0, // 2: padelplace.v1.FindPlayerResponse.player:type_name -> padelplace.v1.Player
+	0, // 3: padelplace.v1.FindPlayersByLastNameResponse.players:type_name -> padelplace.v1.Player
+	1, // 4: padelplace.v1.PlayerService.RegisterPlayer:input_type -> padelplace.v1.RegisterPlayerRequest
+	3, // 5: padelplace.v1.PlayerService.FindPlayer:input_type -> padelplace.v1.FindPlayerRequest
+	4, // 6: padelplace.v1.PlayerService.FindPlayerByEmail:input_type -> padelplace.v1.FindPlayerByEmailRequest
+	6, // 7: padelplace.v1.PlayerService.FindPlayersByLastName:input_type -> padelplace.v1.FindPlayersByLastNameRequest
+	8, // 8: padelplace.v1.PlayerService.UnregisterPlayer:input_type -> padelplace.v1.UnregisterPlayerRequest
+	2, // 9: padelplace.v1.PlayerService.RegisterPlayer:output_type -> padelplace.v1.RegisterPlayerResponse
+	5, // 10: padelplace.v1.PlayerService.FindPlayer:output_type -> padelplace.v1.FindPlayerResponse
+	5, // 11: padelplace.v1.PlayerService.FindPlayerByEmail:output_type -> padelplace.v1.FindPlayerResponse
+	7, // 12: padelplace.v1.PlayerService.FindPlayersByLastName:output_type -> padelplace.v1.FindPlayersByLastNameResponse
+	9, // 13: padelplace.v1.PlayerService.UnregisterPlayer:output_type -> padelplace.v1.UnregisterPlayerResponse
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_padelplace_v1_player_proto_init() }
+func file_padelplace_v1_player_proto_init() {
+	if File_padelplace_v1_player_proto != nil {
+		return
+	}
+	file_padelplace_v1_player_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_padelplace_v1_player_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_padelplace_v1_player_proto_goTypes,
+		DependencyIndexes: file_padelplace_v1_player_proto_depIdxs,
+		MessageInfos:      file_padelplace_v1_player_proto_msgTypes,
+	}.Build()
+	File_padelplace_v1_player_proto = out.File
+	file_padelplace_v1_player_proto_rawDesc = nil
+	file_padelplace_v1_player_proto_goTypes = nil
+	file_padelplace_v1_player_proto_depIdxs = nil
+}
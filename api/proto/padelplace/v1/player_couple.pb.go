@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: padelplace/v1/player_couple.proto
+
+package padelplacev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlayerCouple struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Player1 *Player `protobuf:"bytes,2,opt,name=player1,proto3" json:"player1,omitempty"`
+	Player2 *Player `protobuf:"bytes,3,opt,name=player2,proto3" json:"player2,omitempty"`
+	Ranking *int32  `protobuf:"varint,4,opt,name=ranking,proto3,oneof" json:"ranking,omitempty"`
+}
+
+func (x *PlayerCouple) Reset() {
+	*x = PlayerCouple{}
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerCouple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerCouple) ProtoMessage() {}
+
+func (x *PlayerCouple) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerCouple.ProtoReflect.Descriptor instead.
+func (*PlayerCouple) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_couple_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlayerCouple) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlayerCouple) GetPlayer1() *Player {
+	if x != nil {
+		return x.Player1
+	}
+	return nil
+}
+
+func (x *PlayerCouple) GetPlayer2() *Player {
+	if x != nil {
+		return x.Player2
+	}
+	return nil
+}
+
+func (x *PlayerCouple) GetRanking() int32 {
+	if x != nil && x.Ranking != nil {
+		return *x.Ranking
+	}
+	return 0
+}
+
+type RegisterPlayerCoupleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player1Id string `protobuf:"bytes,1,opt,name=player1_id,json=player1Id,proto3" json:"player1_id,omitempty"`
+	Player2Id string `protobuf:"bytes,2,opt,name=player2_id,json=player2Id,proto3" json:"player2_id,omitempty"`
+	Ranking   *int32 `protobuf:"varint,3,opt,name=ranking,proto3,oneof" json:"ranking,omitempty"`
+}
+
+func (x *RegisterPlayerCoupleRequest) Reset() {
+	*x = RegisterPlayerCoupleRequest{}
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPlayerCoupleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPlayerCoupleRequest) ProtoMessage() {}
+
+func (x *RegisterPlayerCoupleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPlayerCoupleRequest.ProtoReflect.Descriptor instead.
+func (*RegisterPlayerCoupleRequest) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_couple_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterPlayerCoupleRequest) GetPlayer1Id() string {
+	if x != nil {
+		return x.Player1Id
+	}
+	return ""
+}
+
+func (x *RegisterPlayerCoupleRequest) GetPlayer2Id() string {
+	if x != nil {
+		return x.Player2Id
+	}
+	return ""
+}
+
+func (x *RegisterPlayerCoupleRequest) GetRanking() int32 {
+	if x != nil && x.Ranking != nil {
+		return *x.Ranking
+	}
+	return 0
+}
+
+type RegisterPlayerCoupleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerCouple *PlayerCouple `protobuf:"bytes,1,opt,name=player_couple,json=playerCouple,proto3" json:"player_couple,omitempty"`
+	// True when the couple didn't exist before.
+	Created bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *RegisterPlayerCoupleResponse) Reset() {
+	*x = RegisterPlayerCoupleResponse{}
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPlayerCoupleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPlayerCoupleResponse) ProtoMessage() {}
+
+func (x *RegisterPlayerCoupleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPlayerCoupleResponse.ProtoReflect.Descriptor instead.
+func (*RegisterPlayerCoupleResponse) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_couple_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegisterPlayerCoupleResponse) GetPlayerCouple() *PlayerCouple {
+	if x != nil {
+		return x.PlayerCouple
+	}
+	return nil
+}
+
+func (x *RegisterPlayerCoupleResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type FindPlayerCoupleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FindPlayerCoupleRequest) Reset() {
+	*x = FindPlayerCoupleRequest{}
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlayerCoupleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayerCoupleRequest) ProtoMessage() {}
+
+func (x *FindPlayerCoupleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayerCoupleRequest.ProtoReflect.Descriptor instead.
+func (*FindPlayerCoupleRequest) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_couple_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FindPlayerCoupleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FindPlayerCoupleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerCouple *PlayerCouple `protobuf:"bytes,1,opt,name=player_couple,json=playerCouple,proto3" json:"player_couple,omitempty"`
+}
+
+func (x *FindPlayerCoupleResponse) Reset() {
+	*x = FindPlayerCoupleResponse{}
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlayerCoupleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayerCoupleResponse) ProtoMessage() {}
+
+func (x *FindPlayerCoupleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayerCoupleResponse.ProtoReflect.Descriptor instead.
+func (*FindPlayerCoupleResponse) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_couple_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindPlayerCoupleResponse) GetPlayerCouple() *PlayerCouple {
+	if x != nil {
+		return x.PlayerCouple
+	}
+	return nil
+}
+
+type FindPlayerCouplesByPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *FindPlayerCouplesByPlayerRequest) Reset() {
+	*x = FindPlayerCouplesByPlayerRequest{}
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlayerCouplesByPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayerCouplesByPlayerRequest) ProtoMessage() {}
+
+func (x *FindPlayerCouplesByPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayerCouplesByPlayerRequest.ProtoReflect.Descriptor instead.
+func (*FindPlayerCouplesByPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_couple_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindPlayerCouplesByPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type FindPlayerCouplesByPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerCouples []*PlayerCouple `protobuf:"bytes,1,rep,name=player_couples,json=playerCouples,proto3" json:"player_couples,omitempty"`
+}
+
+func (x *FindPlayerCouplesByPlayerResponse) Reset() {
+	*x = FindPlayerCouplesByPlayerResponse{}
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlayerCouplesByPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayerCouplesByPlayerResponse) ProtoMessage() {}
+
+func (x *FindPlayerCouplesByPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_player_couple_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayerCouplesByPlayerResponse.ProtoReflect.Descriptor instead.
+func (*FindPlayerCouplesByPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_player_couple_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FindPlayerCouplesByPlayerResponse) GetPlayerCouples() []*PlayerCouple {
+	if x != nil {
+		return x.PlayerCouples
+	}
+	return nil
+}
+
+var File_padelplace_v1_player_couple_proto protoreflect.FileDescriptor
+
+var file_padelplace_v1_player_couple_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x1a, 0x1a, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab,
+	0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2f, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31,
+	0x12, 0x2f, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x32, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x86, 0x01, 0x0a,
+	0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x31, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x72,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x7a, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0x29, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5c, 0x0a, 0x18,
+	0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x0c, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x22, 0x3f, 0x0a, 0x20, 0x46, 0x69,
+	0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x42,
+	0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x21, 0x46,
+	0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x73,
+	0x42, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x70, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x70, 0x6c, 0x65, 0x73, 0x32, 0xeb, 0x02, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6f, 0x0a, 0x14,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x70, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a,
+	0x10, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c,
+	0x65, 0x12, 0x26, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x61, 0x64, 0x65,
+	0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7e, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x2f, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65,
+	0x73, 0x42, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c,
+	0x65, 0x73, 0x42, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x61, 0x67, 0x75, 0x65, 0x72, 0x72, 0x65, 0x33, 0x2f, 0x67, 0x6f, 0x64, 0x64, 0x64,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x61, 0x64, 0x65, 0x6c,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_padelplace_v1_player_couple_proto_rawDescOnce sync.Once
+	file_padelplace_v1_player_couple_proto_rawDescData = file_padelplace_v1_player_couple_proto_rawDesc
+)
+
+func file_padelplace_v1_player_couple_proto_rawDescGZIP() []byte {
+	file_padelplace_v1_player_couple_proto_rawDescOnce.Do(func() {
+		file_padelplace_v1_player_couple_proto_rawDescData = protoimpl.X.CompressGZIP(file_padelplace_v1_player_couple_proto_rawDescData)
+	})
+	return file_padelplace_v1_player_couple_proto_rawDescData
+}
+
+var file_padelplace_v1_player_couple_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_padelplace_v1_player_couple_proto_goTypes = []any{
+	(*PlayerCouple)(nil),                      // 0: padelplace.v1.PlayerCouple
+	(*RegisterPlayerCoupleRequest)(nil),       // 1: padelplace.v1.RegisterPlayerCoupleRequest
+	(*RegisterPlayerCoupleResponse)(nil),      // 2: padelplace.v1.RegisterPlayerCoupleResponse
+	(*FindPlayerCoupleRequest)(nil),           // 3: padelplace.v1.FindPlayerCoupleRequest
+	(*FindPlayerCoupleResponse)(nil),          // 4: padelplace.v1.FindPlayerCoupleResponse
+	(*FindPlayerCouplesByPlayerRequest)(nil),  // 5: padelplace.v1.FindPlayerCouplesByPlayerRequest
+	(*FindPlayerCouplesByPlayerResponse)(nil), // 6: padelplace.v1.FindPlayerCouplesByPlayerResponse
+	(*Player)(nil),                            // 7: padelplace.v1.Player
+}
+var file_padelplace_v1_player_couple_proto_depIdxs = []int32{
+	7, // 0: padelplace.v1.PlayerCouple.player1:type_name -> padelplace.v1.Player
+	7, // 1: padelplace.v1.PlayerCouple.player2:type_name -> padelplace.v1.Player
+	0, // 2: padelplace.v1.RegisterPlayerCoupleResponse.player_couple:type_name -> padelplace.v1.PlayerCouple
+	0, // 3: padelplace.v1.FindPlayerCoupleResponse.player_couple:type_name -> padelplace.v1.PlayerCouple
+	0, // 4: padelplace.v1.FindPlayerCouplesByPlayerResponse.player_couples:type_name -> padelplace.v1.PlayerCouple
+	1, // 5: padelplace.v1.PlayerCoupleService.RegisterPlayerCouple:input_type -> padelplace.v1.RegisterPlayerCoupleRequest
+	3, // 6: padelplace.v1.PlayerCoupleService.FindPlayerCouple:input_type -> padelplace.v1.FindPlayerCoupleRequest
+	5, // 7: padelplace.v1.PlayerCoupleService.FindPlayerCouplesByPlayer:input_type -> padelplace.v1.FindPlayerCouplesByPlayerRequest
+	2, // 8: padelplace.v1.PlayerCoupleService.RegisterPlayerCouple:output_type -> padelplace.v1.RegisterPlayerCoupleResponse
+	4, // 9: padelplace.v1.PlayerCoupleService.FindPlayerCouple:output_type -> padelplace.v1.FindPlayerCoupleResponse
+	6, // 10: padelplace.v1.PlayerCoupleService.FindPlayerCouplesByPlayer:output_type -> padelplace.v1.FindPlayerCouplesByPlayerResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_padelplace_v1_player_couple_proto_init() }
+func file_padelplace_v1_player_couple_proto_init() {
+	if File_padelplace_v1_player_couple_proto != nil {
+		return
+	}
+	file_padelplace_v1_player_proto_init()
+	file_padelplace_v1_player_couple_proto_msgTypes[0].OneofWrappers = []any{}
+	file_padelplace_v1_player_couple_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_padelplace_v1_player_couple_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_padelplace_v1_player_couple_proto_goTypes,
+		DependencyIndexes: file_padelplace_v1_player_couple_proto_depIdxs,
+		MessageInfos:      file_padelplace_v1_player_couple_proto_msgTypes,
+	}.Build()
+	File_padelplace_v1_player_couple_proto = out.File
+	file_padelplace_v1_player_couple_proto_rawDesc = nil
+	file_padelplace_v1_player_couple_proto_goTypes = nil
+	file_padelplace_v1_player_couple_proto_depIdxs = nil
+}
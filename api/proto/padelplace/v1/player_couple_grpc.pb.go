@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: padelplace/v1/player_couple.proto
+
+package padelplacev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PlayerCoupleService_RegisterPlayerCouple_FullMethodName      = "/padelplace.v1.PlayerCoupleService/RegisterPlayerCouple"
+	PlayerCoupleService_FindPlayerCouple_FullMethodName          = "/padelplace.v1.PlayerCoupleService/FindPlayerCouple"
+	PlayerCoupleService_FindPlayerCouplesByPlayer_FullMethodName = "/padelplace.v1.PlayerCoupleService/FindPlayerCouplesByPlayer"
+)
+
+// PlayerCoupleServiceClient is the client API for PlayerCoupleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PlayerCoupleService registers couples of already registered players.
+type PlayerCoupleServiceClient interface {
+	// RegisterPlayerCouple registers the couple or updates its ranking if both players already play together.
+	RegisterPlayerCouple(ctx context.Context, in *RegisterPlayerCoupleRequest, opts ...grpc.CallOption) (*RegisterPlayerCoupleResponse, error)
+	FindPlayerCouple(ctx context.Context, in *FindPlayerCoupleRequest, opts ...grpc.CallOption) (*FindPlayerCoupleResponse, error)
+	FindPlayerCouplesByPlayer(ctx context.Context, in *FindPlayerCouplesByPlayerRequest, opts ...grpc.CallOption) (*FindPlayerCouplesByPlayerResponse, error)
+}
+
+type playerCoupleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlayerCoupleServiceClient(cc grpc.ClientConnInterface) PlayerCoupleServiceClient {
+	return &playerCoupleServiceClient{cc}
+}
+
+func (c *playerCoupleServiceClient) RegisterPlayerCouple(ctx context.Context, in *RegisterPlayerCoupleRequest, opts ...grpc.CallOption) (*RegisterPlayerCoupleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterPlayerCoupleResponse)
+	err := c.cc.Invoke(ctx, PlayerCoupleService_RegisterPlayerCouple_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerCoupleServiceClient) FindPlayerCouple(ctx context.Context, in *FindPlayerCoupleRequest, opts ...grpc.CallOption) (*FindPlayerCoupleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPlayerCoupleResponse)
+	err := c.cc.Invoke(ctx, PlayerCoupleService_FindPlayerCouple_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerCoupleServiceClient) FindPlayerCouplesByPlayer(ctx context.Context, in *FindPlayerCouplesByPlayerRequest, opts ...grpc.CallOption) (*FindPlayerCouplesByPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPlayerCouplesByPlayerResponse)
+	err := c.cc.Invoke(ctx, PlayerCoupleService_FindPlayerCouplesByPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlayerCoupleServiceServer is the server API for PlayerCoupleService service.
+// All implementations must embed UnimplementedPlayerCoupleServiceServer
+// for forward compatibility.
+//
+// PlayerCoupleService registers couples of already registered players.
+type PlayerCoupleServiceServer interface {
+	// RegisterPlayerCouple registers the couple or updates its ranking if both players already play together.
+	RegisterPlayerCouple(context.Context, *RegisterPlayerCoupleRequest) (*RegisterPlayerCoupleResponse, error)
+	FindPlayerCouple(context.Context, *FindPlayerCoupleRequest) (*FindPlayerCoupleResponse, error)
+	FindPlayerCouplesByPlayer(context.Context, *FindPlayerCouplesByPlayerRequest) (*FindPlayerCouplesByPlayerResponse, error)
+	mustEmbedUnimplementedPlayerCoupleServiceServer()
+}
+
+// UnimplementedPlayerCoupleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlayerCoupleServiceServer struct{}
+
+func (UnimplementedPlayerCoupleServiceServer) RegisterPlayerCouple(context.Context, *RegisterPlayerCoupleRequest) (*RegisterPlayerCoupleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPlayerCouple not implemented")
+}
+func (UnimplementedPlayerCoupleServiceServer) FindPlayerCouple(context.Context, *FindPlayerCoupleRequest) (*FindPlayerCoupleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPlayerCouple not implemented")
+}
+func (UnimplementedPlayerCoupleServiceServer) FindPlayerCouplesByPlayer(context.Context, *FindPlayerCouplesByPlayerRequest) (*FindPlayerCouplesByPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPlayerCouplesByPlayer not implemented")
+}
+func (UnimplementedPlayerCoupleServiceServer) mustEmbedUnimplementedPlayerCoupleServiceServer() {}
+func (UnimplementedPlayerCoupleServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafePlayerCoupleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlayerCoupleServiceServer will
+// result in compilation errors.
+type UnsafePlayerCoupleServiceServer interface {
+	mustEmbedUnimplementedPlayerCoupleServiceServer()
+}
+
+func RegisterPlayerCoupleServiceServer(s grpc.ServiceRegistrar, srv PlayerCoupleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPlayerCoupleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlayerCoupleService_ServiceDesc, srv)
+}
+
+func _PlayerCoupleService_RegisterPlayerCouple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPlayerCoupleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerCoupleServiceServer).RegisterPlayerCouple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerCoupleService_RegisterPlayerCouple_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerCoupleServiceServer).RegisterPlayerCouple(ctx, req.(*RegisterPlayerCoupleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerCoupleService_FindPlayerCouple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPlayerCoupleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerCoupleServiceServer).FindPlayerCouple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerCoupleService_FindPlayerCouple_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerCoupleServiceServer).FindPlayerCouple(ctx, req.(*FindPlayerCoupleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerCoupleService_FindPlayerCouplesByPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPlayerCouplesByPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerCoupleServiceServer).FindPlayerCouplesByPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerCoupleService_FindPlayerCouplesByPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerCoupleServiceServer).FindPlayerCouplesByPlayer(ctx, req.(*FindPlayerCouplesByPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlayerCoupleService_ServiceDesc is the grpc.ServiceDesc for PlayerCoupleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlayerCoupleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "padelplace.v1.PlayerCoupleService",
+	HandlerType: (*PlayerCoupleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterPlayerCouple",
+			Handler:    _PlayerCoupleService_RegisterPlayerCouple_Handler,
+		},
+		{
+			MethodName: "FindPlayerCouple",
+			Handler:    _PlayerCoupleService_FindPlayerCouple_Handler,
+		},
+		{
+			MethodName: "FindPlayerCouplesByPlayer",
+			Handler:    _PlayerCoupleService_FindPlayerCouplesByPlayer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "padelplace/v1/player_couple.proto",
+}
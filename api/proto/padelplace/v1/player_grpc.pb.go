@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: padelplace/v1/player.proto
+
+package padelplacev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PlayerService_RegisterPlayer_FullMethodName        = "/padelplace.v1.PlayerService/RegisterPlayer"
+	PlayerService_FindPlayer_FullMethodName            = "/padelplace.v1.PlayerService/FindPlayer"
+	PlayerService_FindPlayerByEmail_FullMethodName     = "/padelplace.v1.PlayerService/FindPlayerByEmail"
+	PlayerService_FindPlayersByLastName_FullMethodName = "/padelplace.v1.PlayerService/FindPlayersByLastName"
+	PlayerService_UnregisterPlayer_FullMethodName      = "/padelplace.v1.PlayerService/UnregisterPlayer"
+)
+
+// PlayerServiceClient is the client API for PlayerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PlayerService exposes the same use cases as the /v1/players routes.
+type PlayerServiceClient interface {
+	// RegisterPlayer registers a player or updates it if it already exists (by ID or email).
+	RegisterPlayer(ctx context.Context, in *RegisterPlayerRequest, opts ...grpc.CallOption) (*RegisterPlayerResponse, error)
+	FindPlayer(ctx context.Context, in *FindPlayerRequest, opts ...grpc.CallOption) (*FindPlayerResponse, error)
+	FindPlayerByEmail(ctx context.Context, in *FindPlayerByEmailRequest, opts ...grpc.CallOption) (*FindPlayerResponse, error)
+	FindPlayersByLastName(ctx context.Context, in *FindPlayersByLastNameRequest, opts ...grpc.CallOption) (*FindPlayersByLastNameResponse, error)
+	UnregisterPlayer(ctx context.Context, in *UnregisterPlayerRequest, opts ...grpc.CallOption) (*UnregisterPlayerResponse, error)
+}
+
+type playerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlayerServiceClient(cc grpc.ClientConnInterface) PlayerServiceClient {
+	return &playerServiceClient{cc}
+}
+
+func (c *playerServiceClient) RegisterPlayer(ctx context.Context, in *RegisterPlayerRequest, opts ...grpc.CallOption) (*RegisterPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterPlayerResponse)
+	err := c.cc.Invoke(ctx, PlayerService_RegisterPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) FindPlayer(ctx context.Context, in *FindPlayerRequest, opts ...grpc.CallOption) (*FindPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPlayerResponse)
+	err := c.cc.Invoke(ctx, PlayerService_FindPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) FindPlayerByEmail(ctx context.Context, in *FindPlayerByEmailRequest, opts ...grpc.CallOption) (*FindPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPlayerResponse)
+	err := c.cc.Invoke(ctx, PlayerService_FindPlayerByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) FindPlayersByLastName(ctx context.Context, in *FindPlayersByLastNameRequest, opts ...grpc.CallOption) (*FindPlayersByLastNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPlayersByLastNameResponse)
+	err := c.cc.Invoke(ctx, PlayerService_FindPlayersByLastName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) UnregisterPlayer(ctx context.Context, in *UnregisterPlayerRequest, opts ...grpc.CallOption) (*UnregisterPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnregisterPlayerResponse)
+	err := c.cc.Invoke(ctx, PlayerService_UnregisterPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlayerServiceServer is the server API for PlayerService service.
+// All implementations must embed UnimplementedPlayerServiceServer
+// for forward compatibility.
+//
+// PlayerService exposes the same use cases as the /v1/players routes.
+type PlayerServiceServer interface {
+	// RegisterPlayer registers a player or updates it if it already exists (by ID or email).
+	RegisterPlayer(context.Context, *RegisterPlayerRequest) (*RegisterPlayerResponse, error)
+	FindPlayer(context.Context, *FindPlayerRequest) (*FindPlayerResponse, error)
+	FindPlayerByEmail(context.Context, *FindPlayerByEmailRequest) (*FindPlayerResponse, error)
+	FindPlayersByLastName(context.Context, *FindPlayersByLastNameRequest) (*FindPlayersByLastNameResponse, error)
+	UnregisterPlayer(context.Context, *UnregisterPlayerRequest) (*UnregisterPlayerResponse, error)
+	mustEmbedUnimplementedPlayerServiceServer()
+}
+
+// UnimplementedPlayerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlayerServiceServer struct{}
+
+func (UnimplementedPlayerServiceServer) RegisterPlayer(context.Context, *RegisterPlayerRequest) (*RegisterPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPlayer not implemented")
+}
+func (UnimplementedPlayerServiceServer) FindPlayer(context.Context, *FindPlayerRequest) (*FindPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPlayer not implemented")
+}
+func (UnimplementedPlayerServiceServer) FindPlayerByEmail(context.Context, *FindPlayerByEmailRequest) (*FindPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPlayerByEmail not implemented")
+}
+func (UnimplementedPlayerServiceServer) FindPlayersByLastName(context.Context, *FindPlayersByLastNameRequest) (*FindPlayersByLastNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPlayersByLastName not implemented")
+}
+func (UnimplementedPlayerServiceServer) UnregisterPlayer(context.Context, *UnregisterPlayerRequest) (*UnregisterPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterPlayer not implemented")
+}
+func (UnimplementedPlayerServiceServer) mustEmbedUnimplementedPlayerServiceServer() {}
+func (UnimplementedPlayerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafePlayerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlayerServiceServer will
+// result in compilation errors.
+type UnsafePlayerServiceServer interface {
+	mustEmbedUnimplementedPlayerServiceServer()
+}
+
+func RegisterPlayerServiceServer(s grpc.ServiceRegistrar, srv PlayerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPlayerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlayerService_ServiceDesc, srv)
+}
+
+func _PlayerService_RegisterPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).RegisterPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_RegisterPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).RegisterPlayer(ctx, req.(*RegisterPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_FindPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).FindPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_FindPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).FindPlayer(ctx, req.(*FindPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_FindPlayerByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPlayerByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).FindPlayerByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_FindPlayerByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).FindPlayerByEmail(ctx, req.(*FindPlayerByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_FindPlayersByLastName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPlayersByLastNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).FindPlayersByLastName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_FindPlayersByLastName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).FindPlayersByLastName(ctx, req.(*FindPlayersByLastNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_UnregisterPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).UnregisterPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_UnregisterPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).UnregisterPlayer(ctx, req.(*UnregisterPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlayerService_ServiceDesc is the grpc.ServiceDesc for PlayerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlayerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "padelplace.v1.PlayerService",
+	HandlerType: (*PlayerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterPlayer",
+			Handler:    _PlayerService_RegisterPlayer_Handler,
+		},
+		{
+			MethodName: "FindPlayer",
+			Handler:    _PlayerService_FindPlayer_Handler,
+		},
+		{
+			MethodName: "FindPlayerByEmail",
+			Handler:    _PlayerService_FindPlayerByEmail_Handler,
+		},
+		{
+			MethodName: "FindPlayersByLastName",
+			Handler:    _PlayerService_FindPlayersByLastName_Handler,
+		},
+		{
+			MethodName: "UnregisterPlayer",
+			Handler:    _PlayerService_UnregisterPlayer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "padelplace/v1/player.proto",
+}
@@ -0,0 +1,780 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: padelplace/v1/tournament.proto
+
+package padelplacev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Tournament struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PlayerCouples []*PlayerCouple        `protobuf:"bytes,4,rep,name=player_couples,json=playerCouples,proto3" json:"player_couples,omitempty"`
+	Rounds        []*Round               `protobuf:"bytes,5,rep,name=rounds,proto3" json:"rounds,omitempty"`
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_tournament_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tournament) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tournament) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Tournament) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Tournament) GetPlayerCouples() []*PlayerCouple {
+	if x != nil {
+		return x.PlayerCouples
+	}
+	return nil
+}
+
+func (x *Tournament) GetRounds() []*Round {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+type Round struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number  int32    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Matches []*Match `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *Round) Reset() {
+	*x = Round{}
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Round) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Round) ProtoMessage() {}
+
+func (x *Round) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Round.ProtoReflect.Descriptor instead.
+func (*Round) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_tournament_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Round) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Round) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Couple1   *PlayerCouple          `protobuf:"bytes,3,opt,name=couple1,proto3" json:"couple1,omitempty"`
+	Couple2   *PlayerCouple          `protobuf:"bytes,4,opt,name=couple2,proto3" json:"couple2,omitempty"`
+	Score     *Score                 `protobuf:"bytes,5,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_tournament_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Match) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Match) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Match) GetCouple1() *PlayerCouple {
+	if x != nil {
+		return x.Couple1
+	}
+	return nil
+}
+
+func (x *Match) GetCouple2() *PlayerCouple {
+	if x != nil {
+		return x.Couple2
+	}
+	return nil
+}
+
+func (x *Match) GetScore() *Score {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+type Score struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Set1 *GameSet `protobuf:"bytes,1,opt,name=set1,proto3" json:"set1,omitempty"`
+	Set2 *GameSet `protobuf:"bytes,2,opt,name=set2,proto3" json:"set2,omitempty"`
+	Set3 *GameSet `protobuf:"bytes,3,opt,name=set3,proto3" json:"set3,omitempty"`
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_tournament_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Score) GetSet1() *GameSet {
+	if x != nil {
+		return x.Set1
+	}
+	return nil
+}
+
+func (x *Score) GetSet2() *GameSet {
+	if x != nil {
+		return x.Set2
+	}
+	return nil
+}
+
+func (x *Score) GetSet3() *GameSet {
+	if x != nil {
+		return x.Set3
+	}
+	return nil
+}
+
+type GameSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GamesCouple1 int32     `protobuf:"varint,1,opt,name=games_couple1,json=gamesCouple1,proto3" json:"games_couple1,omitempty"`
+	GamesCouple2 int32     `protobuf:"varint,2,opt,name=games_couple2,json=gamesCouple2,proto3" json:"games_couple2,omitempty"`
+	Tiebreak     *Tiebreak `protobuf:"bytes,3,opt,name=tiebreak,proto3" json:"tiebreak,omitempty"`
+}
+
+func (x *GameSet) Reset() {
+	*x = GameSet{}
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSet) ProtoMessage() {}
+
+func (x *GameSet) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSet.ProtoReflect.Descriptor instead.
+func (*GameSet) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_tournament_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GameSet) GetGamesCouple1() int32 {
+	if x != nil {
+		return x.GamesCouple1
+	}
+	return 0
+}
+
+func (x *GameSet) GetGamesCouple2() int32 {
+	if x != nil {
+		return x.GamesCouple2
+	}
+	return 0
+}
+
+func (x *GameSet) GetTiebreak() *Tiebreak {
+	if x != nil {
+		return x.Tiebreak
+	}
+	return nil
+}
+
+type Tiebreak struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PointsCouple1 int32 `protobuf:"varint,1,opt,name=points_couple1,json=pointsCouple1,proto3" json:"points_couple1,omitempty"`
+	PointsCouple2 int32 `protobuf:"varint,2,opt,name=points_couple2,json=pointsCouple2,proto3" json:"points_couple2,omitempty"`
+}
+
+func (x *Tiebreak) Reset() {
+	*x = Tiebreak{}
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tiebreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tiebreak) ProtoMessage() {}
+
+func (x *Tiebreak) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tiebreak.ProtoReflect.Descriptor instead.
+func (*Tiebreak) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_tournament_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Tiebreak) GetPointsCouple1() int32 {
+	if x != nil {
+		return x.PointsCouple1
+	}
+	return 0
+}
+
+func (x *Tiebreak) GetPointsCouple2() int32 {
+	if x != nil {
+		return x.PointsCouple2
+	}
+	return 0
+}
+
+type CreateTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title     string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_tournament_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateTournamentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CreateTournamentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tournament *Tournament `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+}
+
+func (x *CreateTournamentResponse) Reset() {
+	*x = CreateTournamentResponse{}
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentResponse) ProtoMessage() {}
+
+func (x *CreateTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_tournament_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateTournamentResponse) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type FindTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FindTournamentRequest) Reset() {
+	*x = FindTournamentRequest{}
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTournamentRequest) ProtoMessage() {}
+
+func (x *FindTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTournamentRequest.ProtoReflect.Descriptor instead.
+func (*FindTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_tournament_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FindTournamentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FindTournamentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tournament *Tournament `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+}
+
+func (x *FindTournamentResponse) Reset() {
+	*x = FindTournamentResponse{}
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTournamentResponse) ProtoMessage() {}
+
+func (x *FindTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_padelplace_v1_tournament_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTournamentResponse.ProtoReflect.Descriptor instead.
+func (*FindTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_padelplace_v1_tournament_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FindTournamentResponse) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+var File_padelplace_v1_tournament_proto protoreflect.FileDescriptor
+
+var file_padelplace_v1_tournament_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0d, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x21, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xde, 0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x42, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75,
+	0x70, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x64,
+	0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x70, 0x6c, 0x65, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x64,
+	0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x31,
+	0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x32, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a,
+	0x04, 0x73, 0x65, 0x74, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61,
+	0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x65, 0x74, 0x52, 0x04, 0x73, 0x65, 0x74, 0x31, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x65, 0x74,
+	0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x52,
+	0x04, 0x73, 0x65, 0x74, 0x32, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x33, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x52, 0x04, 0x73, 0x65, 0x74,
+	0x33, 0x22, 0x88, 0x01, 0x0a, 0x07, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x31, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x70, 0x6c,
+	0x65, 0x31, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x70,
+	0x6c, 0x65, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x32, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x69, 0x65, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x64, 0x65,
+	0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x65, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x52, 0x08, 0x74, 0x69, 0x65, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x22, 0x58, 0x0a, 0x08,
+	0x54, 0x69, 0x65, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x31, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65,
+	0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x43,
+	0x6f, 0x75, 0x70, 0x6c, 0x65, 0x32, 0x22, 0x69, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x55, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x53, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0xd7, 0x01, 0x0a, 0x11, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x10,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x26, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x61, 0x64, 0x65,
+	0x6c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x61, 0x67, 0x75, 0x65, 0x72, 0x72, 0x65, 0x33, 0x2f, 0x67, 0x6f, 0x64, 0x64, 0x64, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x61, 0x64, 0x65, 0x6c, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_padelplace_v1_tournament_proto_rawDescOnce sync.Once
+	file_padelplace_v1_tournament_proto_rawDescData = file_padelplace_v1_tournament_proto_rawDesc
+)
+
+func file_padelplace_v1_tournament_proto_rawDescGZIP() []byte {
+	file_padelplace_v1_tournament_proto_rawDescOnce.Do(func() {
+		file_padelplace_v1_tournament_proto_rawDescData = protoimpl.X.CompressGZIP(file_padelplace_v1_tournament_proto_rawDescData)
+	})
+	return file_padelplace_v1_tournament_proto_rawDescData
+}
+
+var file_padelplace_v1_tournament_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_padelplace_v1_tournament_proto_goTypes = []any{
+	(*Tournament)(nil),               // 0: padelplace.v1.Tournament
+	(*Round)(nil),                    // 1: padelplace.v1.Round
+	(*Match)(nil),                    // 2: padelplace.v1.Match
+	(*Score)(nil),                    // 3: padelplace.v1.Score
+	(*GameSet)(nil),                  // 4: padelplace.v1.GameSet
+	(*Tiebreak)(nil),                 // 5: padelplace.v1.Tiebreak
+	(*CreateTournamentRequest)(nil),  // 6: padelplace.v1.CreateTournamentRequest
+	(*CreateTournamentResponse)(nil), // 7: padelplace.v1.CreateTournamentResponse
+	(*FindTournamentRequest)(nil),    // 8: padelplace.v1.FindTournamentRequest
+	(*FindTournamentResponse)(nil),   // 9: padelplace.v1.FindTournamentResponse
+	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+	(*PlayerCouple)(nil),             // 11: padelplace.v1.PlayerCouple
+}
+var file_padelplace_v1_tournament_proto_depIdxs = []int32{
+	10, // 0: padelplace.v1.Tournament.timestamp:type_name -> google.protobuf.Timestamp
+	11, // 1: padelplace.v1.Tournament.player_couples:type_name -> padelplace.v1.PlayerCouple
+	1,  // 2: padelplace.v1.Tournament.rounds:type_name -> padelplace.v1.Round
+	2,  // 3: padelplace.v1.Round.matches:type_name -> padelplace.v1.Match
+	10, // 4: padelplace.v1.Match.timestamp:type_name -> google.protobuf.Timestamp
+	11, // 5: padelplace.v1.Match.couple1:type_name -> padelplace.v1.PlayerCouple
+	11, // 6: padelplace.v1.Match.couple2:type_name -> padelplace.v1.PlayerCouple
+	3,  // 7: padelplace.v1.Match.score:type_name -> padelplace.v1.Score
+	4,  // 8: padelplace.v1.Score.set1:type_name -> padelplace.v1.GameSet
+	4,  // 9: padelplace.v1.Score.set2:type_name -> padelplace.v1.GameSet
+	4,  // 10: padelplace.v1.Score.set3:type_name -> padelplace.v1.GameSet
+	5,  // 11: padelplace.v1.GameSet.tiebreak:type_name -> padelplace.v1.Tiebreak
+	10, // 12: padelplace.v1.CreateTournamentRequest.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 13: padelplace.v1.CreateTournamentResponse.tournament:type_name -> padelplace.v1.Tournament
+	0,  // 14: padelplace.v1.FindTournamentResponse.tournament:type_name -> padelplace.v1.Tournament
+	6,  // 15: padelplace.v1.TournamentService.CreateTournament:input_type -> padelplace.v1.CreateTournamentRequest
+	8,  // 16: padelplace.v1.TournamentService.FindTournament:input_type -> padelplace.v1.FindTournamentRequest
+	7,  // 17: padelplace.v1.TournamentService.CreateTournament:output_type -> padelplace.v1.CreateTournamentResponse
+	9,  // 18: padelplace.v1.TournamentService.FindTournament:output_type -> padelplace.v1.FindTournamentResponse
+	17, // [17:19] is the sub-list for method output_type
+	15, // [15:17] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_padelplace_v1_tournament_proto_init() }
+func file_padelplace_v1_tournament_proto_init() {
+	if File_padelplace_v1_tournament_proto != nil {
+		return
+	}
+	file_padelplace_v1_player_couple_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_padelplace_v1_tournament_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_padelplace_v1_tournament_proto_goTypes,
+		DependencyIndexes: file_padelplace_v1_tournament_proto_depIdxs,
+		MessageInfos:      file_padelplace_v1_tournament_proto_msgTypes,
+	}.Build()
+	File_padelplace_v1_tournament_proto = out.File
+	file_padelplace_v1_tournament_proto_rawDesc = nil
+	file_padelplace_v1_tournament_proto_goTypes = nil
+	file_padelplace_v1_tournament_proto_depIdxs = nil
+}
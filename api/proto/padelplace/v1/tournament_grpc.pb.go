@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: padelplace/v1/tournament.proto
+
+package padelplacev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TournamentService_CreateTournament_FullMethodName = "/padelplace.v1.TournamentService/CreateTournament"
+	TournamentService_FindTournament_FullMethodName   = "/padelplace.v1.TournamentService/FindTournament"
+)
+
+// TournamentServiceClient is the client API for TournamentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TournamentService creates and finds tournaments.
+type TournamentServiceClient interface {
+	CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*CreateTournamentResponse, error)
+	FindTournament(ctx context.Context, in *FindTournamentRequest, opts ...grpc.CallOption) (*FindTournamentResponse, error)
+}
+
+type tournamentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTournamentServiceClient(cc grpc.ClientConnInterface) TournamentServiceClient {
+	return &tournamentServiceClient{cc}
+}
+
+func (c *tournamentServiceClient) CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*CreateTournamentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTournamentResponse)
+	err := c.cc.Invoke(ctx, TournamentService_CreateTournament_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) FindTournament(ctx context.Context, in *FindTournamentRequest, opts ...grpc.CallOption) (*FindTournamentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindTournamentResponse)
+	err := c.cc.Invoke(ctx, TournamentService_FindTournament_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TournamentServiceServer is the server API for TournamentService service.
+// All implementations must embed UnimplementedTournamentServiceServer
+// for forward compatibility.
+//
+// TournamentService creates and finds tournaments.
+type TournamentServiceServer interface {
+	CreateTournament(context.Context, *CreateTournamentRequest) (*CreateTournamentResponse, error)
+	FindTournament(context.Context, *FindTournamentRequest) (*FindTournamentResponse, error)
+	mustEmbedUnimplementedTournamentServiceServer()
+}
+
+// UnimplementedTournamentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTournamentServiceServer struct{}
+
+func (UnimplementedTournamentServiceServer) CreateTournament(context.Context, *CreateTournamentRequest) (*CreateTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournament not implemented")
+}
+func (UnimplementedTournamentServiceServer) FindTournament(context.Context, *FindTournamentRequest) (*FindTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTournament not implemented")
+}
+func (UnimplementedTournamentServiceServer) mustEmbedUnimplementedTournamentServiceServer() {}
+func (UnimplementedTournamentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeTournamentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TournamentServiceServer will
+// result in compilation errors.
+type UnsafeTournamentServiceServer interface {
+	mustEmbedUnimplementedTournamentServiceServer()
+}
+
+func RegisterTournamentServiceServer(s grpc.ServiceRegistrar, srv TournamentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTournamentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TournamentService_ServiceDesc, srv)
+}
+
+func _TournamentService_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).CreateTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_CreateTournament_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).CreateTournament(ctx, req.(*CreateTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_FindTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).FindTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentService_FindTournament_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).FindTournament(ctx, req.(*FindTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TournamentService_ServiceDesc is the grpc.ServiceDesc for TournamentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TournamentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "padelplace.v1.TournamentService",
+	HandlerType: (*TournamentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTournament",
+			Handler:    _TournamentService_CreateTournament_Handler,
+		},
+		{
+			MethodName: "FindTournament",
+			Handler:    _TournamentService_FindTournament_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "padelplace/v1/tournament.proto",
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/migration"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	tournament_domain "github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+var (
+	errNotFound = errors.New("not found")
+	// errStoreOnly is returned by commands the REST API doesn't expose, e.g. migrations.
+	errStoreOnly = errors.New("only available against the store, unset -api")
+)
+
+// exportQuery is the filter of exports as typed by users, i.e. parsed by the store or by the server.
+type exportQuery struct {
+	From, To, TournamentID, CategoryID string
+}
+
+// backend runs the commands, either through the application use cases (storeBackend) or through the REST API
+// (restBackend). Missing players, couples, tournaments or matches are errNotFound.
+type backend interface {
+	RegisterPlayer(ctx context.Context, player domain.Player) (domain.Player, error)
+	FindPlayerByID(ctx context.Context, playerId string) (domain.Player, error)
+	FindPlayerByEmail(ctx context.Context, email string) (domain.Player, error)
+	FindPlayersByLastName(ctx context.Context, lastName string) ([]domain.Player, error)
+	UnregisterPlayer(ctx context.Context, playerId string) error
+	ImportPlayers(ctx context.Context, fileName string, dryRun bool) (domain.PlayerImportReport, error)
+
+	RegisterPlayerCouple(ctx context.Context, player1Id, player2Id string, ranking *int) (domain.PlayerCouple, error)
+	FindPlayerCouple(ctx context.Context, coupleId string) (domain.PlayerCouple, error)
+	FindPlayerCouplesByPlayers(ctx context.Context, player1Id, player2Id string) ([]domain.PlayerCouple, error)
+	// FindPlayerCouplesByLastNames finds the couples with any partner when lastName2 is empty.
+	FindPlayerCouplesByLastNames(ctx context.Context, lastName1, lastName2 string) ([]domain.PlayerCouple, error)
+
+	CreateTournament(ctx context.Context, title string, timestamp time.Time) (tournament_domain.Tournament, error)
+	FindTournament(ctx context.Context, tournamentId string) (tournament_domain.Tournament, error)
+	// TransitionTournament changes the status, e.g. TransitionPublishDraw generates the draw.
+	TransitionTournament(ctx context.Context, tournamentId string, transition tournament_domain.Transition) (tournament_domain.Tournament, error)
+	RecordMatchResult(ctx context.Context, tournamentId, matchId string, result tournament_domain.Result, score *tournament_domain.Score) (tournament_domain.Match, error)
+
+	// Export writes the players, couples or tournaments export to w.
+	Export(ctx context.Context, kind string, format spreadsheet.Format, query exportQuery, w io.Writer) error
+
+	// MigrationStatus lists the schema migrations, applied or pending.
+	MigrationStatus(ctx context.Context) ([]migration.Status, error)
+	// Migrate applies the pending migrations up to the target version (all when 0), or reverts the ones above it.
+	Migrate(ctx context.Context, up bool, target int, dryRun bool) ([]migration.Status, error)
+	// RotateSSNKeys re-encrypts the SSNs stored with retired keys, returning the number of documents updated.
+	RotateSSNKeys(ctx context.Context) (int, error)
+}
+
+// Export kinds, named after the routes of the REST API.
+var exportKinds = []string{"players", "couples", "tournaments"}
+
+func validateExportKind(kind string) error {
+	if !slices.Contains(exportKinds, kind) {
+		return fmt.Errorf("unknown export %q, expected one of %v", kind, exportKinds)
+	}
+	return nil
+}
+
+// checkStatus returns the error of use cases, a status other than the expected ones being an error as well.
+func checkStatus[S interface {
+	comparable
+	fmt.Stringer
+}](status S, err error, expected ...S) error {
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(expected, status) {
+		return fmt.Errorf("unexpected status %s", status)
+	}
+	return nil
+}
+
+// notFound returns errNotFound with the status telling what wasn't found.
+func notFound(status fmt.Stringer) error {
+	return fmt.Errorf("%w: %s", errNotFound, status)
+}
@@ -0,0 +1,604 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	tournament_domain "github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// command runs an action of a resource, e.g. "players register", args being its flags.
+type command struct {
+	usage string
+	run   func(ctx context.Context, c *cli, args []string) error
+}
+
+var commands = map[string]map[string]command{
+	"players": {
+		"register":   {"-email e -first-name n -last-name n [-id id] [-ssn ssn] [-birth-date 2006-01-02] [-gender male|female] [-hand right|left] [-side drive|reves|both] [-level 1-8] [-club name]", registerPlayer},
+		"find":       {"-id id | -email e | -last-name n", findPlayer},
+		"unregister": {"-id id", unregisterPlayer},
+		"import":     {"-file players.csv|players.xlsx [-dry-run]", importPlayers},
+		"export":     {exportUsage, exportCommand("players")},
+	},
+	"couples": {
+		"form":   {"-player1 id -player2 id [-ranking n]", formCouple},
+		"find":   {"-id id | -player1 id -player2 id | -last-name n [-partner-last-name n]", findCouple},
+		"export": {exportUsage, exportCommand("couples")},
+	},
+	"tournaments": {
+		"create":     {"-title t -at 2006-01-02T15:04", createTournament},
+		"find":       {"-id id", findTournament},
+		"draw":       {"-id id (publishes the seeded draw)", drawTournament},
+		"transition": {"-id id -to " + strings.Join(transitionNames(), "|"), transitionTournament},
+		"export":     {exportUsage, exportCommand("tournaments")},
+	},
+	"scores": {
+		"record": {"-tournament id -match id [-sets \"6-4 6-7(5-7) 10-8\"] [-outcome completed] [-winner 1|2] [-reason r]", recordScore},
+	},
+	"migrate": {
+		"status":   {"(lists the schema migrations, store only)", migrationStatus},
+		"up":       {"[-to version] [-dry-run] (applies the pending migrations, store only)", migrateUp},
+		"down":     {"-to version [-dry-run] (reverts the migrations above the version, store only)", migrateDown},
+		"ssn-keys": {"(re-encrypts SSNs stored with retired keys, store only)", migrateSSNKeys},
+	},
+}
+
+const exportUsage = "[-format csv|jsonl|xlsx] [-from date] [-to date] [-tournament id] [-category id] [-out file]"
+
+// cli holds the options shared by commands, the backend being connected on first use.
+type cli struct {
+	api     string
+	token   string
+	printer printer
+	stdout  io.Writer
+	stderr  io.Writer
+	backend backend
+	// connect returns the backend of the options, and the function closing it.
+	connect func() (backend, func() error, error)
+	close   func() error
+}
+
+func (c *cli) execute(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		return errors.New(usage())
+	}
+	actions, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q\n%s", args[0], usage())
+	}
+	action, ok := actions[args[1]]
+	if !ok {
+		return fmt.Errorf("unknown %s command %q\n%s", args[0], args[1], usage())
+	}
+	return action.run(ctx, c, args[2:])
+}
+
+// store returns the backend, connecting to the store or the API the first time.
+func (c *cli) store() (backend, error) {
+	if c.backend != nil {
+		return c.backend, nil
+	}
+	backend, closeBackend, err := c.connect()
+	if err != nil {
+		return nil, err
+	}
+	c.backend, c.close = backend, closeBackend
+	return backend, nil
+}
+
+func (c *cli) flags(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	return flags
+}
+
+// usage lists the commands in alphabetical order.
+func usage() string {
+	var lines []string
+	for resource, actions := range commands {
+		for action, command := range actions {
+			lines = append(lines, fmt.Sprintf("  padelctl [-api url -token t | -tenant id] [-output table|json] %s %s %s", resource, action, command.usage))
+		}
+	}
+	sort.Strings(lines)
+	return "usage:\n" + strings.Join(lines, "\n")
+}
+
+// required returns an error naming the first missing flag.
+func required(flags map[string]string) error {
+	names := make([]string, 0, len(flags))
+	for name := range flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if len(flags[name]) == 0 {
+			return fmt.Errorf("missing -%s", name)
+		}
+	}
+	return nil
+}
+
+// optionalInt parses optional numeric flags, empty being nil.
+func optionalInt(name, value string) (*int, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -%s: %s", name, value)
+	}
+	return &number, nil
+}
+
+func registerPlayer(ctx context.Context, c *cli, args []string) error {
+	flags := c.flags("players register")
+	id := flags.String("id", "", "ID of the player to update")
+	email := flags.String("email", "", "email")
+	firstName := flags.String("first-name", "", "first name")
+	lastName := flags.String("last-name", "", "last name")
+	ssn := flags.String("ssn", "", "social security number")
+	birthDate := flags.String("birth-date", "", "birth date, e.g. 1990-05-17")
+	gender := flags.String("gender", "", "male or female")
+	hand := flags.String("hand", "", "dominant hand, right or left")
+	side := flags.String("side", "", "preferred side, drive, reves or both")
+	levelText := flags.String("level", "", "level, 1 to 8")
+	club := flags.String("club", "", "club the player is a member of")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := required(map[string]string{"email": *email, "first-name": *firstName, "last-name": *lastName}); err != nil {
+		return err
+	}
+	level, err := optionalInt("level", *levelText)
+	if err != nil {
+		return err
+	}
+	player := domain.Player{ID: *id, Email: *email, FirstName: *firstName, LastName: *lastName, Profile: domain.Profile{
+		BirthDate: domain.BirthDate(*birthDate), Gender: domain.Gender(*gender), Handedness: domain.Handedness(*hand), Side: domain.Side(*side), Club: domain.Club(*club)}}
+	if level != nil {
+		player.Level = domain.Level(*level)
+	}
+	if len(*ssn) > 0 {
+		player.SocialSecurityNumber = ssn
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	if player, err = backend.RegisterPlayer(ctx, player); err != nil {
+		return err
+	}
+	return c.printer.players(player)
+}
+
+func findPlayer(ctx context.Context, c *cli, args []string) error {
+	flags := c.flags("players find")
+	id := flags.String("id", "", "ID")
+	email := flags.String("email", "", "email")
+	lastName := flags.String("last-name", "", "last name")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	var players []domain.Player
+	switch {
+	case len(*id) > 0:
+		player, err := backend.FindPlayerByID(ctx, *id)
+		if err != nil {
+			return err
+		}
+		players = append(players, player)
+	case len(*email) > 0:
+		player, err := backend.FindPlayerByEmail(ctx, *email)
+		if err != nil {
+			return err
+		}
+		players = append(players, player)
+	case len(*lastName) > 0:
+		if players, err = backend.FindPlayersByLastName(ctx, *lastName); err != nil {
+			return err
+		}
+		// Lists are printed as such even with a single player.
+		if len(players) == 1 && c.printer.json {
+			return c.printer.print(players, "", nil)
+		}
+	default:
+		return errors.New("missing -id, -email or -last-name")
+	}
+	return c.printer.players(players...)
+}
+
+func unregisterPlayer(ctx context.Context, c *cli, args []string) error {
+	flags := c.flags("players unregister")
+	id := flags.String("id", "", "ID")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := required(map[string]string{"id": *id}); err != nil {
+		return err
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	if err := backend.UnregisterPlayer(ctx, *id); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, "Player %s unregistered\n", *id)
+	return nil
+}
+
+func importPlayers(ctx context.Context, c *cli, args []string) error {
+	flags := c.flags("players import")
+	file := flags.String("file", "", "CSV or XLSX file, the first row being the header")
+	dryRun := flags.Bool("dry-run", false, "only validate the rows")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := required(map[string]string{"file": *file}); err != nil {
+		return err
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	report, err := backend.ImportPlayers(ctx, *file, *dryRun)
+	if err != nil {
+		return err
+	}
+	return c.printer.importReport(report)
+}
+
+func formCouple(ctx context.Context, c *cli, args []string) error {
+	flags := c.flags("couples form")
+	player1 := flags.String("player1", "", "ID of the first player")
+	player2 := flags.String("player2", "", "ID of the second player")
+	rankingText := flags.String("ranking", "", "ranking")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := required(map[string]string{"player1": *player1, "player2": *player2}); err != nil {
+		return err
+	}
+	ranking, err := optionalInt("ranking", *rankingText)
+	if err != nil {
+		return err
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	playerCouple, err := backend.RegisterPlayerCouple(ctx, *player1, *player2, ranking)
+	if err != nil {
+		return err
+	}
+	return c.printer.playerCouples(playerCouple)
+}
+
+func findCouple(ctx context.Context, c *cli, args []string) error {
+	flags := c.flags("couples find")
+	id := flags.String("id", "", "ID")
+	player1 := flags.String("player1", "", "player ID")
+	player2 := flags.String("player2", "", "partner ID")
+	lastName := flags.String("last-name", "", "last name of a player")
+	partnerLastName := flags.String("partner-last-name", "", "last name of the partner, any partner when missing")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	var playerCouples []domain.PlayerCouple
+	switch {
+	case len(*id) > 0:
+		playerCouple, err := backend.FindPlayerCouple(ctx, *id)
+		if err != nil {
+			return err
+		}
+		return c.printer.playerCouples(playerCouple)
+	case len(*player1) > 0 || len(*player2) > 0:
+		if err := required(map[string]string{"player1": *player1, "player2": *player2}); err != nil {
+			return err
+		}
+		playerCouples, err = backend.FindPlayerCouplesByPlayers(ctx, *player1, *player2)
+	case len(*lastName) > 0:
+		playerCouples, err = backend.FindPlayerCouplesByLastNames(ctx, *lastName, *partnerLastName)
+	default:
+		return errors.New("missing -id, -player1 and -player2, or -last-name")
+	}
+	if err != nil {
+		return err
+	}
+	// Lists are printed as such even with a single couple.
+	if len(playerCouples) == 1 && c.printer.json {
+		return c.printer.print(playerCouples, "", nil)
+	}
+	return c.printer.playerCouples(playerCouples...)
+}
+
+func createTournament(ctx context.Context, c *cli, args []string) error {
+	flags := c.flags("tournaments create")
+	title := flags.String("title", "", "title")
+	at := flags.String("at", "", "start, e.g. 2026-11-07T09:00 (UTC) or RFC 3339")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := required(map[string]string{"title": *title, "at": *at}); err != nil {
+		return err
+	}
+	timestamp, err := time.Parse("2006-01-02T15:04", *at)
+	if err != nil {
+		if timestamp, err = time.Parse(time.RFC3339, *at); err != nil {
+			return fmt.Errorf("invalid -at: %s", *at)
+		}
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	tournament, err := backend.CreateTournament(ctx, *title, timestamp)
+	if err != nil {
+		return err
+	}
+	return c.printer.tournament(tournament)
+}
+
+func findTournament(ctx context.Context, c *cli, args []string) error {
+	flags := c.flags("tournaments find")
+	id := flags.String("id", "", "ID")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := required(map[string]string{"id": *id}); err != nil {
+		return err
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	tournament, err := backend.FindTournament(ctx, *id)
+	if err != nil {
+		return err
+	}
+	return c.printer.tournament(tournament)
+}
+
+func drawTournament(ctx context.Context, c *cli, args []string) error {
+	return transition(ctx, c, "tournaments draw", args, tournament_domain.TransitionPublishDraw)
+}
+
+func transitionTournament(ctx context.Context, c *cli, args []string) error {
+	return transition(ctx, c, "tournaments transition", args, "")
+}
+
+// transition applies the -to flag unless the transition is set, e.g. by the draw command.
+func transition(ctx context.Context, c *cli, name string, args []string, to tournament_domain.Transition) error {
+	flags := c.flags(name)
+	id := flags.String("id", "", "ID")
+	if len(to) == 0 {
+		flags.Func("to", strings.Join(transitionNames(), ", "), func(value string) error {
+			to = tournament_domain.Transition(value)
+			return nil
+		})
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := required(map[string]string{"id": *id, "to": string(to)}); err != nil {
+		return err
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	tournament, err := backend.TransitionTournament(ctx, *id, to)
+	if err != nil {
+		return err
+	}
+	return c.printer.tournament(tournament)
+}
+
+func transitionNames() []string {
+	names := make([]string, 0, len(tournament_domain.Transitions))
+	for _, transition := range tournament_domain.Transitions {
+		names = append(names, string(transition))
+	}
+	return names
+}
+
+func recordScore(ctx context.Context, c *cli, args []string) error {
+	flags := c.flags("scores record")
+	tournamentId := flags.String("tournament", "", "tournament ID")
+	matchId := flags.String("match", "", "match ID")
+	sets := flags.String("sets", "", "games of each set, e.g. \"6-4 6-7(5-7) 10-8\"")
+	outcome := flags.String("outcome", string(tournament_domain.OutcomeCompleted), "completed, retired, walkover, disqualified or cancelled")
+	winner := flags.Int("winner", 0, "1 or 2, derived from the sets of completed matches")
+	reason := flags.String("reason", "", "reason of matches not completed")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := required(map[string]string{"tournament": *tournamentId, "match": *matchId}); err != nil {
+		return err
+	}
+	score, err := parseSets(*sets)
+	if err != nil {
+		return err
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	result := tournament_domain.Result{Outcome: tournament_domain.Outcome(*outcome), Winner: *winner, Reason: *reason}
+	match, err := backend.RecordMatchResult(ctx, *tournamentId, *matchId, result, score)
+	if err != nil {
+		return err
+	}
+	return c.printer.match(match)
+}
+
+// parseSets parses the sets formatted by formatScore, e.g. "6-4 7-6(7-5)", empty being no score.
+func parseSets(text string) (*tournament_domain.Score, error) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	if len(fields) > 3 {
+		return nil, fmt.Errorf("invalid -sets: %s, at most 3 sets", text)
+	}
+	sets := make([]tournament_domain.GameSet, len(fields))
+	for i, field := range fields {
+		games, tiebreak, hasTiebreak := strings.Cut(strings.TrimSuffix(field, ")"), "(")
+		if hasTiebreak != strings.HasSuffix(field, ")") {
+			return nil, fmt.Errorf("invalid set: %s", field)
+		}
+		var err error
+		if sets[i].GamesCouple1, sets[i].GamesCouple2, err = parsePair(games); err != nil {
+			return nil, fmt.Errorf("invalid set: %s", field)
+		}
+		if hasTiebreak {
+			sets[i].Tiebreak = &tournament_domain.Tiebreak{}
+			if sets[i].Tiebreak.PointsCouple1, sets[i].Tiebreak.PointsCouple2, err = parsePair(tiebreak); err != nil {
+				return nil, fmt.Errorf("invalid set: %s", field)
+			}
+		}
+	}
+	score := &tournament_domain.Score{Set1: sets[0]}
+	if len(sets) > 1 {
+		score.Set2 = sets[1]
+	}
+	if len(sets) > 2 {
+		score.Set3 = &sets[2]
+	}
+	return score, nil
+}
+
+// parsePair parses "6-4" as 6 and 4.
+func parsePair(text string) (int, int, error) {
+	first, second, ok := strings.Cut(text, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid pair: %s", text)
+	}
+	a, err := strconv.Atoi(first)
+	if err != nil {
+		return 0, 0, err
+	}
+	b, err := strconv.Atoi(second)
+	return a, b, err
+}
+
+// exportCommand writes the export to stdout unless -out is set, a failed export leaving a truncated file.
+func exportCommand(kind string) func(ctx context.Context, c *cli, args []string) error {
+	return func(ctx context.Context, c *cli, args []string) error {
+		flags := c.flags(kind + " export")
+		formatName := flags.String("format", string(spreadsheet.FormatCSV), "csv, jsonl or xlsx")
+		var query exportQuery
+		flags.StringVar(&query.From, "from", "", "tournaments held since this date (2006-01-02) or RFC 3339 time")
+		flags.StringVar(&query.To, "to", "", "tournaments held until this date (included) or before this RFC 3339 time")
+		flags.StringVar(&query.TournamentID, "tournament", "", "tournament ID")
+		flags.StringVar(&query.CategoryID, "category", "", "category ID")
+		out := flags.String("out", "", "output file, stdout when empty")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		format, err := spreadsheet.ParseFormat(*formatName)
+		if err != nil {
+			return err
+		}
+		backend, err := c.store()
+		if err != nil {
+			return err
+		}
+		w := c.stdout
+		if len(*out) > 0 {
+			file, err := os.Create(*out)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			w = file
+		}
+		return backend.Export(ctx, kind, format, query, w)
+	}
+}
+
+func migrationStatus(ctx context.Context, c *cli, args []string) error {
+	if err := c.flags("migrate status").Parse(args); err != nil {
+		return err
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	statuses, err := backend.MigrationStatus(ctx)
+	if err != nil {
+		return err
+	}
+	return c.printer.migrations(statuses)
+}
+
+func migrateUp(ctx context.Context, c *cli, args []string) error {
+	return migrate(ctx, c, "migrate up", true, args)
+}
+
+func migrateDown(ctx context.Context, c *cli, args []string) error {
+	return migrate(ctx, c, "migrate down", false, args)
+}
+
+// migrate requires the target version of down migrations, reverting every migration only when told so (-to 0).
+func migrate(ctx context.Context, c *cli, name string, up bool, args []string) error {
+	flags := c.flags(name)
+	target := flags.Int("to", -1, "target version, 0 being every migration")
+	dryRun := flags.Bool("dry-run", false, "only list the migrations that would run")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *target < 0 {
+		if !up {
+			return errors.New("missing -to")
+		}
+		*target = 0
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	statuses, err := backend.Migrate(ctx, up, *target, *dryRun)
+	if len(statuses) > 0 || err == nil {
+		if printErr := c.printer.migrations(statuses); printErr != nil {
+			return errors.Join(err, printErr)
+		}
+	}
+	return err
+}
+
+func migrateSSNKeys(ctx context.Context, c *cli, args []string) error {
+	if err := c.flags("migrate ssn-keys").Parse(args); err != nil {
+		return err
+	}
+	backend, err := c.store()
+	if err != nil {
+		return err
+	}
+	rotated, err := backend.RotateSSNKeys(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, "%d documents re-encrypted\n", rotated)
+	return nil
+}
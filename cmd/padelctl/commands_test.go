@@ -0,0 +1,300 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/migration"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	tournament_domain "github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockBackend struct {
+	mock.Mock
+}
+
+func (m *mockBackend) RegisterPlayer(ctx context.Context, player domain.Player) (domain.Player, error) {
+	args := m.Called(player)
+	return args.Get(0).(domain.Player), args.Error(1)
+}
+
+func (m *mockBackend) FindPlayerByID(ctx context.Context, playerId string) (domain.Player, error) {
+	args := m.Called(playerId)
+	return args.Get(0).(domain.Player), args.Error(1)
+}
+
+func (m *mockBackend) FindPlayerByEmail(ctx context.Context, email string) (domain.Player, error) {
+	args := m.Called(email)
+	return args.Get(0).(domain.Player), args.Error(1)
+}
+
+func (m *mockBackend) FindPlayersByLastName(ctx context.Context, lastName string) ([]domain.Player, error) {
+	args := m.Called(lastName)
+	return args.Get(0).([]domain.Player), args.Error(1)
+}
+
+func (m *mockBackend) UnregisterPlayer(ctx context.Context, playerId string) error {
+	return m.Called(playerId).Error(0)
+}
+
+func (m *mockBackend) ImportPlayers(ctx context.Context, fileName string, dryRun bool) (domain.PlayerImportReport, error) {
+	args := m.Called(fileName, dryRun)
+	return args.Get(0).(domain.PlayerImportReport), args.Error(1)
+}
+
+func (m *mockBackend) RegisterPlayerCouple(ctx context.Context, player1Id, player2Id string, ranking *int) (domain.PlayerCouple, error) {
+	args := m.Called(player1Id, player2Id, ranking)
+	return args.Get(0).(domain.PlayerCouple), args.Error(1)
+}
+
+func (m *mockBackend) FindPlayerCouple(ctx context.Context, coupleId string) (domain.PlayerCouple, error) {
+	args := m.Called(coupleId)
+	return args.Get(0).(domain.PlayerCouple), args.Error(1)
+}
+
+func (m *mockBackend) FindPlayerCouplesByPlayers(ctx context.Context, player1Id, player2Id string) ([]domain.PlayerCouple, error) {
+	args := m.Called(player1Id, player2Id)
+	return args.Get(0).([]domain.PlayerCouple), args.Error(1)
+}
+
+func (m *mockBackend) FindPlayerCouplesByLastNames(ctx context.Context, lastName1, lastName2 string) ([]domain.PlayerCouple, error) {
+	args := m.Called(lastName1, lastName2)
+	return args.Get(0).([]domain.PlayerCouple), args.Error(1)
+}
+
+func (m *mockBackend) CreateTournament(ctx context.Context, title string, timestamp time.Time) (tournament_domain.Tournament, error) {
+	args := m.Called(title, timestamp)
+	return args.Get(0).(tournament_domain.Tournament), args.Error(1)
+}
+
+func (m *mockBackend) FindTournament(ctx context.Context, tournamentId string) (tournament_domain.Tournament, error) {
+	args := m.Called(tournamentId)
+	return args.Get(0).(tournament_domain.Tournament), args.Error(1)
+}
+
+func (m *mockBackend) TransitionTournament(ctx context.Context, tournamentId string, transition tournament_domain.Transition) (tournament_domain.Tournament, error) {
+	args := m.Called(tournamentId, transition)
+	return args.Get(0).(tournament_domain.Tournament), args.Error(1)
+}
+
+func (m *mockBackend) RecordMatchResult(ctx context.Context, tournamentId, matchId string, result tournament_domain.Result,
+	score *tournament_domain.Score) (tournament_domain.Match, error) {
+	args := m.Called(tournamentId, matchId, result, score)
+	return args.Get(0).(tournament_domain.Match), args.Error(1)
+}
+
+func (m *mockBackend) Export(ctx context.Context, kind string, format spreadsheet.Format, query exportQuery, w io.Writer) error {
+	args := m.Called(kind, format, query)
+	_, _ = io.WriteString(w, "id,email\n")
+	return args.Error(0)
+}
+
+func (m *mockBackend) MigrationStatus(ctx context.Context) ([]migration.Status, error) {
+	args := m.Called()
+	return args.Get(0).([]migration.Status), args.Error(1)
+}
+
+func (m *mockBackend) Migrate(ctx context.Context, up bool, target int, dryRun bool) ([]migration.Status, error) {
+	args := m.Called(up, target, dryRun)
+	return args.Get(0).([]migration.Status), args.Error(1)
+}
+
+func (m *mockBackend) RotateSSNKeys(ctx context.Context) (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
+
+// execute runs the command against the backend, returning what it printed.
+func execute(t *testing.T, backend backend, output string, args ...string) (string, error) {
+	var stdout bytes.Buffer
+	printer, err := newPrinter(&stdout, output)
+	assert.NoError(t, err)
+	c := &cli{printer: printer, stdout: &stdout, stderr: io.Discard, backend: backend}
+	err = c.execute(context.Background(), args)
+	return stdout.String(), err
+}
+
+func TestPlayersRegister(t *testing.T) {
+	player := domain.Player{Email: "jane@padel.com", FirstName: "Jane", LastName: "Doe", Profile: domain.Profile{
+		BirthDate: "1990-05-17", Gender: domain.GenderFemale, Side: domain.SideDrive, Level: 5, Club: "Club Norte"}}
+	backend := &mockBackend{}
+	registered := player
+	registered.ID = "player-1"
+	backend.On("RegisterPlayer", player).Return(registered, nil)
+
+	out, err := execute(t, backend, outputTable, "players", "register", "-email", "jane@padel.com", "-first-name", "Jane",
+		"-last-name", "Doe", "-birth-date", "1990-05-17", "-gender", "female", "-side", "drive", "-level", "5", "-club", "Club Norte")
+	assert.NoError(t, err)
+	assert.Equal(t, "ID        EMAIL           FIRST NAME  LAST NAME  BIRTH DATE  LEVEL  SIDE   CLUB        SSN\n"+
+		"player-1  jane@padel.com  Jane        Doe        1990-05-17  5      drive  Club Norte  -\n", out)
+	backend.AssertExpectations(t)
+
+	_, err = execute(t, backend, outputTable, "players", "register", "-email", "jane@padel.com", "-last-name", "Doe")
+	assert.EqualError(t, err, "missing -first-name")
+	_, err = execute(t, backend, outputTable, "players", "register", "-email", "jane@padel.com", "-first-name", "Jane",
+		"-last-name", "Doe", "-level", "five")
+	assert.EqualError(t, err, "invalid -level: five")
+}
+
+func TestPlayersFind_JSON(t *testing.T) {
+	backend := &mockBackend{}
+	backend.On("FindPlayersByLastName", "Doe").Return([]domain.Player{{ID: "player-1", LastName: "Doe"}}, nil)
+	backend.On("FindPlayerByID", "player-2").Return(domain.Player{}, notFound(stringer("FindPlayerNotFound")))
+
+	out, err := execute(t, backend, outputJSON, "players", "find", "-last-name", "Doe")
+	assert.NoError(t, err)
+	var players []domain.Player
+	assert.NoError(t, json.Unmarshal([]byte(out), &players), "Expected a list even with a single player")
+	assert.Equal(t, []domain.Player{{ID: "player-1", LastName: "Doe"}}, players)
+
+	_, err = execute(t, backend, outputJSON, "players", "find", "-id", "player-2")
+	assert.ErrorIs(t, err, errNotFound)
+	_, err = execute(t, backend, outputJSON, "players", "find")
+	assert.EqualError(t, err, "missing -id, -email or -last-name")
+}
+
+func TestCouplesFind(t *testing.T) {
+	couple := domain.PlayerCouple{ID: "couple-1", Player1: domain.Player{ID: "player-1", FirstName: "Jane", LastName: "Doe"},
+		Player2: domain.Player{ID: "player-2", FirstName: "John", LastName: "Roe"}}
+	backend := &mockBackend{}
+	backend.On("FindPlayerCouplesByLastNames", "Roe", "Doe").Return([]domain.PlayerCouple{couple}, nil)
+	backend.On("FindPlayerCouplesByPlayers", "player-2", "player-9").Return([]domain.PlayerCouple(nil), notFound(stringer("FindPlayerCoupleNotFound")))
+
+	out, err := execute(t, backend, outputTable, "couples", "find", "-last-name", "Roe", "-partner-last-name", "Doe")
+	assert.NoError(t, err)
+	assert.Equal(t, "ID        RANKING  PLAYER 1             PLAYER 2\ncouple-1  -        Jane Doe (player-1)  John Roe (player-2)\n", out)
+
+	out, err = execute(t, backend, outputJSON, "couples", "find", "-last-name", "Roe", "-partner-last-name", "Doe")
+	assert.NoError(t, err)
+	var couples []domain.PlayerCouple
+	assert.NoError(t, json.Unmarshal([]byte(out), &couples), "Expected a list even with a single couple")
+
+	_, err = execute(t, backend, outputTable, "couples", "find", "-player1", "player-2", "-player2", "player-9")
+	assert.ErrorIs(t, err, errNotFound)
+	_, err = execute(t, backend, outputTable, "couples", "find", "-player1", "player-2")
+	assert.EqualError(t, err, "missing -player2")
+	_, err = execute(t, backend, outputTable, "couples", "find")
+	assert.EqualError(t, err, "missing -id, -player1 and -player2, or -last-name")
+}
+
+func TestTournamentsDraw(t *testing.T) {
+	backend := &mockBackend{}
+	tournament := tournament_domain.Tournament{ID: "tournament-1", Title: "Open",
+		Timestamp: time.Date(2026, time.November, 7, 9, 0, 0, 0, time.UTC),
+		Rounds: []tournament_domain.Round{{Number: 1, Matches: []tournament_domain.Match{{ID: "match-1",
+			Couple1: tournament_domain.PlayerCouple{ID: "couple-1"}, Couple2: tournament_domain.PlayerCouple{ID: "couple-2"}}}}}}
+	backend.On("TransitionTournament", "tournament-1", tournament_domain.TransitionPublishDraw).Return(tournament, nil)
+
+	out, err := execute(t, backend, outputTable, "tournaments", "draw", "-id", "tournament-1")
+	assert.NoError(t, err)
+	assert.Contains(t, out, `tournament-1 "Open" 2026-11-07 09:00`)
+	assert.Contains(t, out, "1      match-1  -         couple-1  couple-2  -      -\n")
+	backend.AssertExpectations(t)
+}
+
+func TestScoresRecord(t *testing.T) {
+	backend := &mockBackend{}
+	score := &tournament_domain.Score{Set1: tournament_domain.GameSet{GamesCouple1: 6, GamesCouple2: 4},
+		Set2: tournament_domain.GameSet{GamesCouple1: 7, GamesCouple2: 6,
+			Tiebreak: &tournament_domain.Tiebreak{PointsCouple1: 7, PointsCouple2: 5}}}
+	result := tournament_domain.Result{Outcome: tournament_domain.OutcomeCompleted}
+	backend.On("RecordMatchResult", "tournament-1", "match-1", result, score).
+		Return(tournament_domain.Match{ID: "match-1", Score: score}, nil)
+
+	out, err := execute(t, backend, outputTable, "scores", "record", "-tournament", "tournament-1", "-match", "match-1",
+		"-sets", "6-4 7-6(7-5)")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "6-4 7-6(7-5)  completed (couple 1)")
+	backend.AssertExpectations(t)
+}
+
+func TestParseSets(t *testing.T) {
+	score, err := parseSets("6-4 3-6 10-8")
+	assert.NoError(t, err)
+	assert.Equal(t, &tournament_domain.GameSet{GamesCouple1: 10, GamesCouple2: 8}, score.Set3)
+	assert.Equal(t, "6-4 3-6 10-8", formatScore(score))
+
+	score, err = parseSets("")
+	assert.NoError(t, err)
+	assert.Nil(t, score)
+
+	for _, sets := range []string{"6-4 7-6(7-5", "6:4", "6-4 6-4 6-4 6-4", "6-x"} {
+		_, err := parseSets(sets)
+		assert.Error(t, err, "Expected %q to be invalid", sets)
+	}
+}
+
+func TestExport(t *testing.T) {
+	backend := &mockBackend{}
+	backend.On("Export", "couples", spreadsheet.FormatJSONL, exportQuery{From: "2026-10-01", TournamentID: "tournament-1"}).Return(nil)
+
+	out, err := execute(t, backend, outputTable, "couples", "export", "-format", "jsonl", "-from", "2026-10-01", "-tournament", "tournament-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "id,email\n", out)
+	backend.AssertExpectations(t)
+
+	_, err = execute(t, backend, outputTable, "couples", "export", "-format", "pdf")
+	assert.Error(t, err)
+}
+
+func TestMigrate(t *testing.T) {
+	appliedAt := time.Date(2026, time.October, 19, 8, 0, 0, 0, time.UTC)
+	backend := &mockBackend{}
+	backend.On("MigrationStatus").Return([]migration.Status{{Version: 2026101901, Description: "Create players indexes", AppliedAt: &appliedAt},
+		{Version: 2026101902, Description: "Create player_couples indexes"}}, nil)
+	backend.On("Migrate", true, 0, true).Return([]migration.Status{{Version: 2026101902, Description: "Create player_couples indexes"}}, nil)
+	backend.On("Migrate", false, 2026101901, false).Return([]migration.Status{}, migration.ErrLocked)
+
+	out, err := execute(t, backend, outputTable, "migrate", "status")
+	assert.NoError(t, err)
+	assert.Equal(t, "VERSION     DESCRIPTION                    APPLIED AT\n"+
+		"2026101901  Create players indexes         2026-10-19T08:00:00Z\n"+
+		"2026101902  Create player_couples indexes  -\n", out)
+
+	out, err = execute(t, backend, outputTable, "migrate", "up", "-dry-run")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "2026101902  Create player_couples indexes  -")
+
+	_, err = execute(t, backend, outputTable, "migrate", "down", "-to", "2026101901")
+	assert.ErrorIs(t, err, migration.ErrLocked)
+	_, err = execute(t, backend, outputTable, "migrate", "down")
+	assert.EqualError(t, err, "missing -to")
+	backend.AssertExpectations(t)
+}
+
+func TestMigrateSSNKeys(t *testing.T) {
+	backend := &mockBackend{}
+	backend.On("RotateSSNKeys").Return(3, nil)
+
+	out, err := execute(t, backend, outputTable, "migrate", "ssn-keys")
+	assert.NoError(t, err)
+	assert.Equal(t, "3 documents re-encrypted\n", out)
+
+	_, err = execute(t, newRESTBackend("http://localhost:8080", ""), outputTable, "migrate", "ssn-keys")
+	assert.ErrorIs(t, err, errStoreOnly)
+}
+
+func TestUnknownCommand(t *testing.T) {
+	_, err := execute(t, &mockBackend{}, outputTable, "players", "delete")
+	assert.ErrorContains(t, err, `unknown players command "delete"`)
+	_, err = execute(t, &mockBackend{}, outputTable, "players")
+	assert.ErrorContains(t, err, "padelctl [-api url -token t | -tenant id] [-output table|json] players register")
+
+	err = run(context.Background(), []string{"-output", "yaml", "players", "find"}, io.Discard, io.Discard)
+	assert.EqualError(t, err, `unknown output "yaml", expected table or json`)
+	err = run(context.Background(), []string{"-tenant", "FEP", "players", "find"}, io.Discard, io.Discard)
+	assert.EqualError(t, err, `invalid tenant: "FEP"`)
+}
+
+type stringer string
+
+func (s stringer) String() string {
+	return string(s)
+}
@@ -0,0 +1,81 @@
+// padelctl is the admin CLI of padelplace. Commands run the application use cases directly against the store (Mongo,
+// configured as the server is) or, with -api, through the REST API of a running server.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/paguerre3/goddd/internal/modules/common/encryption"
+	"github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+)
+
+const (
+	apiEnv    = "PADELCTL_API"
+	tokenEnv  = "PADELCTL_TOKEN"
+	tenantEnv = "PADELCTL_TENANT"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := run(ctx, os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "padelctl:", err)
+		os.Exit(1)
+	}
+}
+
+// run parses the global flags before the command, e.g. padelctl -output json players find -id 1.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("padelctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	api := flags.String("api", os.Getenv(apiEnv), "base URL of the REST API (e.g. http://localhost:8080), the store being used when empty")
+	token := flags.String("token", os.Getenv(tokenEnv), "bearer token of the REST API")
+	tenantID := flags.String("tenant", os.Getenv(tenantEnv), "tenant of the store, e.g. to migrate its database, the default one when empty")
+	output := flags.String("output", outputTable, "table or json")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, usage())
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	printer, err := newPrinter(stdout, *output)
+	if err != nil {
+		return err
+	}
+	// Through the API, the tenant is the one of the host or the token.
+	if len(*tenantID) > 0 {
+		if err := tenant.ValidateID(*tenantID); err != nil {
+			return err
+		}
+		ctx = tenant.WithID(ctx, *tenantID)
+	}
+	c := &cli{api: *api, token: *token, printer: printer, stdout: stdout, stderr: stderr}
+	c.connect = func() (backend, func() error, error) {
+		if len(c.api) > 0 {
+			return newRESTBackend(c.api, c.token), func() error { return nil }, nil
+		}
+		keyRing, err := encryption.NewKeyRingFromEnv()
+		if err != nil {
+			return nil, nil, err
+		}
+		mongoClient := mongo.NewMongoClient()
+		backend, err := newStoreBackend(mongoClient, keyRing)
+		if err != nil {
+			return nil, nil, errors.Join(err, mongoClient.Close())
+		}
+		return backend, mongoClient.Close, nil
+	}
+	err = c.execute(ctx, flags.Args())
+	if c.close != nil {
+		err = errors.Join(err, c.close())
+	}
+	return err
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/migration"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	tournament_domain "github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// Output formats of the -output flag.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// printer writes results as aligned tables, or as the JSON of the REST API.
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+func newPrinter(w io.Writer, output string) (printer, error) {
+	switch output {
+	case outputTable:
+		return printer{w: w}, nil
+	case outputJSON:
+		return printer{w: w, json: true}, nil
+	}
+	return printer{}, fmt.Errorf("unknown output %q, expected %s or %s", output, outputTable, outputJSON)
+}
+
+// print writes value as JSON, or the rows of the table (tab separated cells) with a header.
+func (p printer) print(value any, header string, rows func(add func(cells ...any))) error {
+	if p.json {
+		encoder := json.NewEncoder(p.w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	}
+	table := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, header)
+	rows(func(cells ...any) {
+		texts := make([]string, len(cells))
+		for i, cell := range cells {
+			texts[i] = fmt.Sprint(cell)
+		}
+		fmt.Fprintln(table, strings.Join(texts, "\t"))
+	})
+	return table.Flush()
+}
+
+func (p printer) players(players ...domain.Player) error {
+	var value any = players
+	if len(players) == 1 {
+		value = players[0]
+	}
+	return p.print(value, "ID\tEMAIL\tFIRST NAME\tLAST NAME\tBIRTH DATE\tLEVEL\tSIDE\tCLUB\tSSN", func(add func(cells ...any)) {
+		for _, player := range players {
+			level := "-"
+			if player.Level != 0 {
+				level = strconv.Itoa(int(player.Level))
+			}
+			add(player.ID, player.Email, player.FirstName, player.LastName, or(string(player.BirthDate), "-"), level,
+				or(string(player.Side), "-"), or(string(player.Club), "-"), optional(player.SocialSecurityNumber))
+		}
+	})
+}
+
+func (p printer) playerCouples(playerCouples ...domain.PlayerCouple) error {
+	var value any = playerCouples
+	if len(playerCouples) == 1 {
+		value = playerCouples[0]
+	}
+	return p.print(value, "ID\tRANKING\tPLAYER 1\tPLAYER 2", func(add func(cells ...any)) {
+		for _, playerCouple := range playerCouples {
+			add(playerCouple.ID, optional(playerCouple.Ranking), playerName(playerCouple.Player1), playerName(playerCouple.Player2))
+		}
+	})
+}
+
+// tournament prints the matches of the tournament after its title and status.
+func (p printer) tournament(tournament tournament_domain.Tournament) error {
+	if !p.json {
+		fmt.Fprintf(p.w, "%s %q %s (%s), %d couples\n\n", tournament.ID, tournament.Title,
+			tournament.Timestamp.Format("2006-01-02 15:04"), tournament.CurrentStatus(), len(tournament.PlayerCouples))
+	}
+	return p.print(tournament, matchHeader, func(add func(cells ...any)) {
+		for _, round := range tournament.Rounds {
+			for _, match := range round.Matches {
+				add(matchCells(round.Number, match)...)
+			}
+		}
+	})
+}
+
+func (p printer) match(match tournament_domain.Match) error {
+	return p.print(match, matchHeader, func(add func(cells ...any)) {
+		add(matchCells(0, match)...)
+	})
+}
+
+const matchHeader = "ROUND\tMATCH\tCATEGORY\tCOUPLE 1\tCOUPLE 2\tSCORE\tRESULT"
+
+// matchCells returns the cells of matchHeader, round 0 being unknown.
+func matchCells(round int, match tournament_domain.Match) []any {
+	roundCell := "-"
+	if round > 0 {
+		roundCell = strconv.Itoa(round)
+	}
+	result := "-"
+	if decided, ok := match.Decided(); ok {
+		result = string(decided.Outcome)
+		if decided.Winner > 0 {
+			result += fmt.Sprintf(" (couple %d)", decided.Winner)
+		}
+	}
+	return []any{roundCell, match.ID, or(match.CategoryID, "-"), or(match.Couple1.ID, "-"), or(match.Couple2.ID, "-"),
+		formatScore(match.Score), result}
+}
+
+// importReport prints the invalid rows, or every row on dry runs, after the counts.
+func (p printer) importReport(report domain.PlayerImportReport) error {
+	if !p.json {
+		fmt.Fprintf(p.w, "%d created, %d updated, %d invalid", report.Created, report.Updated, report.Invalid)
+		if report.DryRun {
+			fmt.Fprint(p.w, " (dry run, nothing saved)")
+		}
+		fmt.Fprint(p.w, "\n\n")
+	}
+	return p.print(report, "ROW\tEMAIL\tSTATUS\tPLAYER\tREASON", func(add func(cells ...any)) {
+		for _, row := range report.Rows {
+			if report.DryRun || row.Status == domain.ImportRowInvalid {
+				add(row.Row, or(row.Email, "-"), row.Status, or(row.PlayerID, "-"), or(row.Reason, "-"))
+			}
+		}
+	})
+}
+
+// migrations prints the status of migrations, or the ones migrated (without AppliedAt on dry runs and reverts).
+func (p printer) migrations(statuses []migration.Status) error {
+	return p.print(statuses, "VERSION\tDESCRIPTION\tAPPLIED AT", func(add func(cells ...any)) {
+		for _, status := range statuses {
+			appliedAt := "-"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+			add(status.Version, status.Description, appliedAt)
+		}
+	})
+}
+
+// formatScore returns the sets of the score, e.g. "6-4 7-6(7-5)" (see parseSets).
+func formatScore(score *tournament_domain.Score) string {
+	if score == nil {
+		return "-"
+	}
+	sets := []*tournament_domain.GameSet{&score.Set1, &score.Set2, score.Set3}
+	texts := make([]string, 0, len(sets))
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+		text := fmt.Sprintf("%d-%d", set.GamesCouple1, set.GamesCouple2)
+		if set.Tiebreak != nil {
+			text += fmt.Sprintf("(%d-%d)", set.Tiebreak.PointsCouple1, set.Tiebreak.PointsCouple2)
+		}
+		texts = append(texts, text)
+	}
+	return strings.Join(texts, " ")
+}
+
+func playerName(player domain.Player) string {
+	return strings.TrimSpace(player.FirstName+" "+player.LastName) + " (" + player.ID + ")"
+}
+
+// optional returns the value of optional fields, "-" when missing.
+func optional[T any](pointer *T) any {
+	if pointer == nil {
+		return "-"
+	}
+	return *pointer
+}
+
+func or(value, fallback string) string {
+	if len(value) == 0 {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/migration"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	tournament_domain "github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+const apiVersion = "/v1"
+
+// restBackend runs the commands through the REST API of padelplace, authenticated with a bearer token (e.g. the
+// access token returned by POST /v1/accounts/login).
+type restBackend struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func newRESTBackend(baseURL, token string) *restBackend {
+	return &restBackend{baseURL: strings.TrimSuffix(baseURL, "/") + apiVersion, token: token, client: http.DefaultClient}
+}
+
+// errorBody is the body of error responses (web.ErrorBody), or the status of not found ones.
+type errorBody struct {
+	Error  string `json:"error"`
+	Status string `json:"status"`
+}
+
+// do sends body as JSON unless it's a reader (sent as contentType), and decodes JSON responses into out.
+func (b *restBackend) do(ctx context.Context, method, path string, body any, contentType string, out any) error {
+	response, err := b.send(ctx, method, path, body, contentType)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(response.Body).Decode(out)
+}
+
+// send returns successful responses, the other ones being errors (errNotFound for 404).
+func (b *restBackend) send(ctx context.Context, method, path string, body any, contentType string) (*http.Response, error) {
+	reader, ok := body.(io.Reader)
+	if !ok && body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader, contentType = bytes.NewReader(encoded), "application/json"
+	}
+	request, err := http.NewRequestWithContext(ctx, method, b.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+	if len(contentType) > 0 {
+		request.Header.Set("Content-Type", contentType)
+	}
+	if len(b.token) > 0 {
+		request.Header.Set("Authorization", "Bearer "+b.token)
+	}
+	response, err := b.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < http.StatusBadRequest {
+		return response, nil
+	}
+	defer response.Body.Close()
+	var errBody errorBody
+	_ = json.NewDecoder(response.Body).Decode(&errBody)
+	if response.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s %s", errNotFound, method, path)
+	}
+	message := errBody.Error
+	if len(message) == 0 {
+		message = errBody.Status
+	}
+	return nil, fmt.Errorf("%s %s: %s %s", method, path, response.Status, message)
+}
+
+func (b *restBackend) RegisterPlayer(ctx context.Context, player domain.Player) (domain.Player, error) {
+	var newPlayer domain.Player
+	return newPlayer, b.do(ctx, http.MethodPost, "/players", player, "", &newPlayer)
+}
+
+func (b *restBackend) FindPlayerByID(ctx context.Context, playerId string) (domain.Player, error) {
+	var player domain.Player
+	return player, b.do(ctx, http.MethodGet, "/players/"+url.PathEscape(playerId), nil, "", &player)
+}
+
+func (b *restBackend) FindPlayerByEmail(ctx context.Context, email string) (domain.Player, error) {
+	var player domain.Player
+	return player, b.do(ctx, http.MethodGet, "/players/email/"+url.PathEscape(email), nil, "", &player)
+}
+
+func (b *restBackend) FindPlayersByLastName(ctx context.Context, lastName string) ([]domain.Player, error) {
+	var players []domain.Player
+	return players, b.do(ctx, http.MethodGet, "/players/last-name/"+url.PathEscape(lastName), nil, "", &players)
+}
+
+func (b *restBackend) UnregisterPlayer(ctx context.Context, playerId string) error {
+	return b.do(ctx, http.MethodDelete, "/players/"+url.PathEscape(playerId), nil, "", nil)
+}
+
+// ImportPlayers streams the file as the request body, its Content-Type told by its extension.
+func (b *restBackend) ImportPlayers(ctx context.Context, fileName string, dryRun bool) (domain.PlayerImportReport, error) {
+	format, err := spreadsheet.FormatOf("", filepath.Base(fileName))
+	if err != nil {
+		return domain.PlayerImportReport{}, err
+	}
+	file, err := os.Open(fileName)
+	if err != nil {
+		return domain.PlayerImportReport{}, err
+	}
+	defer file.Close()
+	var report domain.PlayerImportReport
+	path := "/players/import?dryRun=" + strconv.FormatBool(dryRun)
+	return report, b.do(ctx, http.MethodPost, path, file, format.ContentType(), &report)
+}
+
+func (b *restBackend) RegisterPlayerCouple(ctx context.Context, player1Id, player2Id string, ranking *int) (domain.PlayerCouple, error) {
+	request := struct {
+		Player1ID string `json:"player1Id"`
+		Player2ID string `json:"player2Id"`
+		Ranking   *int   `json:"ranking,omitempty"`
+	}{player1Id, player2Id, ranking}
+	var playerCouple domain.PlayerCouple
+	return playerCouple, b.do(ctx, http.MethodPost, "/couples", request, "", &playerCouple)
+}
+
+func (b *restBackend) FindPlayerCouple(ctx context.Context, coupleId string) (domain.PlayerCouple, error) {
+	var playerCouple domain.PlayerCouple
+	return playerCouple, b.do(ctx, http.MethodGet, "/couples/"+url.PathEscape(coupleId), nil, "", &playerCouple)
+}
+
+func (b *restBackend) FindPlayerCouplesByPlayers(ctx context.Context, player1Id, player2Id string) ([]domain.PlayerCouple, error) {
+	return b.findPlayerCouples(ctx, url.Values{"player1Id": {player1Id}, "player2Id": {player2Id}})
+}
+
+func (b *restBackend) FindPlayerCouplesByLastNames(ctx context.Context, lastName1, lastName2 string) ([]domain.PlayerCouple, error) {
+	values := url.Values{"lastName1": {lastName1}}
+	if len(lastName2) > 0 {
+		values.Set("lastName2", lastName2)
+	}
+	return b.findPlayerCouples(ctx, values)
+}
+
+func (b *restBackend) findPlayerCouples(ctx context.Context, values url.Values) ([]domain.PlayerCouple, error) {
+	var playerCouples []domain.PlayerCouple
+	return playerCouples, b.do(ctx, http.MethodGet, "/couples?"+values.Encode(), nil, "", &playerCouples)
+}
+
+func (b *restBackend) CreateTournament(ctx context.Context, title string, timestamp time.Time) (tournament_domain.Tournament, error) {
+	request := struct {
+		Title     string    `json:"title"`
+		Timestamp time.Time `json:"timestamp"`
+	}{title, timestamp}
+	var tournament tournament_domain.Tournament
+	return tournament, b.do(ctx, http.MethodPost, "/tournaments", request, "", &tournament)
+}
+
+func (b *restBackend) FindTournament(ctx context.Context, tournamentId string) (tournament_domain.Tournament, error) {
+	var tournament tournament_domain.Tournament
+	return tournament, b.do(ctx, http.MethodGet, "/tournaments/"+url.PathEscape(tournamentId), nil, "", &tournament)
+}
+
+func (b *restBackend) TransitionTournament(ctx context.Context, tournamentId string, transition tournament_domain.Transition) (tournament_domain.Tournament, error) {
+	var tournament tournament_domain.Tournament
+	path := "/tournaments/" + url.PathEscape(tournamentId) + "/" + string(transition)
+	return tournament, b.do(ctx, http.MethodPost, path, nil, "", &tournament)
+}
+
+func (b *restBackend) RecordMatchResult(ctx context.Context, tournamentId, matchId string, result tournament_domain.Result,
+	score *tournament_domain.Score) (tournament_domain.Match, error) {
+	request := struct {
+		Outcome tournament_domain.Outcome `json:"outcome"`
+		Winner  int                       `json:"winner,omitempty"`
+		Reason  string                    `json:"reason,omitempty"`
+		Score   *tournament_domain.Score  `json:"score,omitempty"`
+	}{result.Outcome, result.Winner, result.Reason, score}
+	var match tournament_domain.Match
+	path := "/tournaments/" + url.PathEscape(tournamentId) + "/matches/" + url.PathEscape(matchId) + "/result"
+	return match, b.do(ctx, http.MethodPut, path, request, "", &match)
+}
+
+func (b *restBackend) Export(ctx context.Context, kind string, format spreadsheet.Format, query exportQuery, w io.Writer) error {
+	if err := validateExportKind(kind); err != nil {
+		return err
+	}
+	values := url.Values{"format": {string(format)}}
+	for name, value := range map[string]string{"from": query.From, "to": query.To, "tournamentId": query.TournamentID, "categoryId": query.CategoryID} {
+		if len(value) > 0 {
+			values.Set(name, value)
+		}
+	}
+	response, err := b.send(ctx, http.MethodGet, "/exports/"+kind+"?"+values.Encode(), nil, "")
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, err = io.Copy(w, response.Body)
+	return err
+}
+
+func (b *restBackend) MigrationStatus(context.Context) ([]migration.Status, error) {
+	return nil, errStoreOnly
+}
+
+func (b *restBackend) Migrate(context.Context, bool, int, bool) ([]migration.Status, error) {
+	return nil, errStoreOnly
+}
+
+func (b *restBackend) RotateSSNKeys(context.Context) (int, error) {
+	return 0, errStoreOnly
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	tournament_domain "github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRESTBackend(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/v1/couples":
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(`[{"id":"couple-1"}]`))
+				return
+			}
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]any{"player1Id": "player-1", "player2Id": "player-2"}, body)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"couple-1","player1":{"id":"player-1"},"player2":{"id":"player-2"}}`))
+		case "/v1/tournaments/tournament-1":
+			_, _ = w.Write([]byte(`{"id":"tournament-1","title":"Open","timestamp":"2026-11-07T09:00"}`))
+		case "/v1/players/player-3":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{}`))
+		case "/v1/tournaments/tournament-1/publish-draw":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":"registration is open"}`))
+		case "/v1/exports/players":
+			w.Header().Set("Content-Type", spreadsheet.FormatCSV.ContentType())
+			_, _ = w.Write([]byte("id,email\n"))
+		default:
+			w.WriteHeader(http.StatusTeapot)
+		}
+	}))
+	defer server.Close()
+	backend := newRESTBackend(server.URL+"/", "token")
+	ctx := context.Background()
+
+	playerCouple, err := backend.RegisterPlayerCouple(ctx, "player-1", "player-2", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PlayerCouple{ID: "couple-1", Player1: domain.Player{ID: "player-1"}, Player2: domain.Player{ID: "player-2"}}, playerCouple)
+
+	playerCouples, err := backend.FindPlayerCouplesByLastNames(ctx, "Roe", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PlayerCouple{{ID: "couple-1"}}, playerCouples)
+
+	tournament, err := backend.FindTournament(ctx, "tournament-1")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, time.November, 7, 9, 0, 0, 0, time.UTC), tournament.Timestamp)
+
+	_, err = backend.FindPlayerByID(ctx, "player-3")
+	assert.ErrorIs(t, err, errNotFound)
+
+	_, err = backend.TransitionTournament(ctx, "tournament-1", tournament_domain.TransitionPublishDraw)
+	assert.EqualError(t, err, "POST /tournaments/tournament-1/publish-draw: 409 Conflict registration is open")
+
+	var export bytes.Buffer
+	assert.NoError(t, backend.Export(ctx, "players", spreadsheet.FormatCSV, exportQuery{From: "2026-10-01"}, &export))
+	assert.Equal(t, "id,email\n", export.String())
+	assert.Error(t, backend.Export(ctx, "matches", spreadsheet.FormatCSV, exportQuery{}, io.Discard))
+
+	assert.Equal(t, []string{"POST /v1/couples", "GET /v1/couples?lastName1=Roe", "GET /v1/tournaments/tournament-1", "GET /v1/players/player-3",
+		"POST /v1/tournaments/tournament-1/publish-draw", "GET /v1/exports/players?format=csv&from=2026-10-01"}, requests)
+}
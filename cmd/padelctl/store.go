@@ -0,0 +1,255 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/migrations"
+	"github.com/paguerre3/goddd/internal/modules/common/encryption"
+	"github.com/paguerre3/goddd/internal/modules/common/migration"
+	"github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/api"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	player_couple_infrastructure "github.com/paguerre3/goddd/internal/modules/player-couple/infrastructure/mongo"
+	tournament_api "github.com/paguerre3/goddd/internal/modules/tournament/api"
+	tournament_application "github.com/paguerre3/goddd/internal/modules/tournament/application"
+	tournament_domain "github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	tournament_infrastructure "github.com/paguerre3/goddd/internal/modules/tournament/infrastructure/mongo"
+)
+
+// storeBackend runs the use cases of padelplace against Mongo (MONGO_ADDR), SSNs being encrypted with the same keys
+// (see encryption.NewKeyRingFromEnv). Events and score updates are published to in memory brokers, i.e. replicas
+// of the server aren't notified.
+type storeBackend struct {
+	registerPlayerUseCase       application.RegisterPlayerUseCase
+	unregisterPlayerUseCase     application.UnregisterPlayerUseCase
+	findPlayerUseCase           application.FindPlayerUseCase
+	importPlayersUseCase        application.ImportPlayersUseCase
+	registerPlayerCoupleUseCase application.RegisterPlayerCoupleUseCase
+	findPlayerCoupleUseCase     application.FindPlayerCoupleUseCase
+	exportUseCase               application.ExportUseCase
+
+	createTournamentUseCase     tournament_application.CreateTournamentUseCase
+	findTournamentUseCase       tournament_application.FindTournamentUseCase
+	transitionTournamentUseCase tournament_application.TransitionTournamentUseCase
+	resultUseCase               tournament_application.ResultUseCase
+	exportTournamentsUseCase    tournament_application.ExportUseCase
+
+	migrator       *migration.Migrator
+	ssnKeyRotation *player_couple_infrastructure.SSNKeyRotation
+}
+
+func newStoreBackend(mongoClient mongo.MongoClient, keyRing *encryption.KeyRing) (*storeBackend, error) {
+	idGen, err := utils.NewIDGeneratorFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	migrator, err := migration.NewMigrator(mongoClient, migrations.All(idGen)...)
+	if err != nil {
+		return nil, err
+	}
+	playerRepo := player_couple_infrastructure.NewMongoPlayerRepository(idGen, mongoClient, keyRing)
+	playerCoupleRepo := player_couple_infrastructure.NewMongoPlayerCoupleRepository(idGen, mongoClient, keyRing)
+	tournamentHistory := player_couple_infrastructure.NewMongoTournamentHistory(mongoClient)
+	tournamentRepo := tournament_infrastructure.NewMongoTournamentRepository(idGen, mongoClient)
+	return &storeBackend{
+		registerPlayerUseCase:       application.NewRegisterPlayerUseCase(playerRepo),
+		unregisterPlayerUseCase:     application.NewUnregisterPlayerUseCase(playerRepo),
+		findPlayerUseCase:           application.NewFindPlayerUseCase(playerRepo),
+		importPlayersUseCase:        application.NewImportPlayersUseCase(playerRepo),
+		registerPlayerCoupleUseCase: application.NewRegisterPlayerCoupleUseCase(playerRepo, playerCoupleRepo),
+		findPlayerCoupleUseCase:     application.NewFindPlayerCoupleUseCase(playerRepo, playerCoupleRepo),
+		exportUseCase:               application.NewExportUseCase(playerRepo, playerCoupleRepo, tournamentHistory),
+
+		createTournamentUseCase: tournament_application.NewCreateTournamentUseCase(tournamentRepo),
+		findTournamentUseCase:   tournament_application.NewFindTournamentUseCase(tournamentRepo),
+		transitionTournamentUseCase: tournament_application.NewTransitionTournamentUseCase(tournamentRepo,
+			pubsub.NewMemoryBroker[tournament_domain.TournamentEvent]()),
+		resultUseCase:            tournament_application.NewResultUseCase(tournamentRepo, pubsub.NewMemoryBroker[tournament_domain.ScoreUpdate]()),
+		exportTournamentsUseCase: tournament_application.NewExportUseCase(tournamentRepo),
+
+		migrator:       migrator,
+		ssnKeyRotation: player_couple_infrastructure.NewSSNKeyRotation(mongoClient, keyRing),
+	}, nil
+}
+
+func (b *storeBackend) RegisterPlayer(ctx context.Context, player domain.Player) (domain.Player, error) {
+	newPlayer, status, err := b.registerPlayerUseCase.RegisterPlayerUseCase(ctx, player)
+	return newPlayer, checkStatus(status, err, application.RegisterPlayerCreated, application.RegisterPlayerUpdated)
+}
+
+func (b *storeBackend) FindPlayerByID(ctx context.Context, playerId string) (domain.Player, error) {
+	player, status, err := b.findPlayerUseCase.FindPlayerByIDUseCase(ctx, playerId)
+	return player, checkFindPlayer(status, err)
+}
+
+func (b *storeBackend) FindPlayerByEmail(ctx context.Context, email string) (domain.Player, error) {
+	player, status, err := b.findPlayerUseCase.FindPlayerByEmailUseCase(ctx, email)
+	return player, checkFindPlayer(status, err)
+}
+
+func (b *storeBackend) FindPlayersByLastName(ctx context.Context, lastName string) ([]domain.Player, error) {
+	players, status, err := b.findPlayerUseCase.FindPlayersByLastNameUseCase(ctx, lastName)
+	return players, checkFindPlayer(status, err)
+}
+
+func checkFindPlayer(status application.FindPlayerStatus, err error) error {
+	if err == nil && status == application.FindPlayerNotFound {
+		return notFound(status)
+	}
+	return checkStatus(status, err, application.FindPlayerFound)
+}
+
+func (b *storeBackend) UnregisterPlayer(ctx context.Context, playerId string) error {
+	status, err := b.unregisterPlayerUseCase.UnregisterPlayerUseCase(ctx, playerId)
+	if err == nil && status == application.UnregisterPlayerNotFound {
+		return notFound(status)
+	}
+	return checkStatus(status, err, application.UnregisterPlayerDeleted)
+}
+
+// ImportPlayers reads CSV or XLSX files, told apart by their extension.
+func (b *storeBackend) ImportPlayers(ctx context.Context, fileName string, dryRun bool) (domain.PlayerImportReport, error) {
+	format, err := spreadsheet.FormatOf("", filepath.Base(fileName))
+	if err != nil {
+		return domain.PlayerImportReport{}, err
+	}
+	file, err := os.Open(fileName)
+	if err != nil {
+		return domain.PlayerImportReport{}, err
+	}
+	defer file.Close()
+	rows := spreadsheet.NewCSVReader(file)
+	if format == spreadsheet.FormatXLSX {
+		info, err := file.Stat()
+		if err != nil {
+			return domain.PlayerImportReport{}, err
+		}
+		if rows, err = spreadsheet.NewXLSXReader(file, info.Size()); err != nil {
+			return domain.PlayerImportReport{}, err
+		}
+	}
+	report, status, err := b.importPlayersUseCase.ImportPlayersUseCase(ctx, rows, dryRun)
+	return report, checkStatus(status, err, application.ImportPlayersImported)
+}
+
+func (b *storeBackend) RegisterPlayerCouple(ctx context.Context, player1Id, player2Id string, ranking *int) (domain.PlayerCouple, error) {
+	playerCouple, status, err := b.registerPlayerCoupleUseCase.RegisterPlayerCoupleUseCase(ctx, player1Id, player2Id, ranking)
+	if err == nil && status == application.RegisterPlayerCouplePlayerNotFound {
+		return playerCouple, notFound(status)
+	}
+	return playerCouple, checkStatus(status, err, application.RegisterPlayerCoupleCreated, application.RegisterPlayerCoupleUpdated)
+}
+
+func (b *storeBackend) FindPlayerCouple(ctx context.Context, coupleId string) (domain.PlayerCouple, error) {
+	playerCouple, status, err := b.findPlayerCoupleUseCase.FindPlayerCoupleByIDUseCase(ctx, coupleId)
+	if err == nil && status == application.FindPlayerCoupleNotFound {
+		return playerCouple, notFound(status)
+	}
+	return playerCouple, checkStatus(status, err, application.FindPlayerCoupleFound)
+}
+
+func (b *storeBackend) FindPlayerCouplesByPlayers(ctx context.Context, player1Id, player2Id string) ([]domain.PlayerCouple, error) {
+	playerCouples, status, err := b.findPlayerCoupleUseCase.FindPlayerCouplesByPlayersUseCase(ctx, player1Id, player2Id)
+	return playerCouples, checkFindPlayerCouples(status, err)
+}
+
+func (b *storeBackend) FindPlayerCouplesByLastNames(ctx context.Context, lastName1, lastName2 string) ([]domain.PlayerCouple, error) {
+	playerCouples, status, err := b.findPlayerCoupleUseCase.FindPlayerCouplesByLastNamesUseCase(ctx, lastName1, lastName2)
+	return playerCouples, checkFindPlayerCouples(status, err)
+}
+
+func checkFindPlayerCouples(status application.FindPlayerCoupleStatus, err error) error {
+	if err == nil && status == application.FindPlayerCoupleNotFound {
+		return notFound(status)
+	}
+	return checkStatus(status, err, application.FindPlayerCoupleFound)
+}
+
+func (b *storeBackend) CreateTournament(ctx context.Context, title string, timestamp time.Time) (tournament_domain.Tournament, error) {
+	tournament, status, err := b.createTournamentUseCase.CreateTournamentUseCase(ctx, title, timestamp)
+	return tournament, checkStatus(status, err, tournament_application.CreateTournamentCreated)
+}
+
+func (b *storeBackend) FindTournament(ctx context.Context, tournamentId string) (tournament_domain.Tournament, error) {
+	tournament, status, err := b.findTournamentUseCase.FindTournamentByIDUseCase(ctx, tournamentId)
+	if err == nil && status == tournament_application.FindTournamentNotFound {
+		return tournament, notFound(status)
+	}
+	return tournament, checkStatus(status, err, tournament_application.FindTournamentFound)
+}
+
+func (b *storeBackend) TransitionTournament(ctx context.Context, tournamentId string, transition tournament_domain.Transition) (tournament_domain.Tournament, error) {
+	tournament, status, err := b.transitionTournamentUseCase.TransitionTournamentUseCase(ctx, tournamentId, transition)
+	if err == nil && status == tournament_application.TournamentLifecycleNotFound {
+		return tournament, notFound(status)
+	}
+	return tournament, checkStatus(status, err, tournament_application.TournamentLifecycleTransitioned)
+}
+
+func (b *storeBackend) RecordMatchResult(ctx context.Context, tournamentId, matchId string, result tournament_domain.Result,
+	score *tournament_domain.Score) (tournament_domain.Match, error) {
+	match, status, err := b.resultUseCase.RecordMatchResultUseCase(ctx, tournamentId, matchId, result, score)
+	if err == nil && status == tournament_application.ResultNotFound {
+		return match, notFound(status)
+	}
+	return match, checkStatus(status, err, tournament_application.ResultRecorded)
+}
+
+// Export parses the query as the export handlers do.
+func (b *storeBackend) Export(ctx context.Context, kind string, format spreadsheet.Format, query exportQuery, w io.Writer) error {
+	if err := validateExportKind(kind); err != nil {
+		return err
+	}
+	rows, err := spreadsheet.NewWriter(format, w)
+	if err != nil {
+		return err
+	}
+	if kind == "tournaments" {
+		filter, err := tournament_api.ParseTournamentFilter(query.From, query.To, query.TournamentID, query.CategoryID)
+		if err != nil {
+			return err
+		}
+		status, err := b.exportTournamentsUseCase.ExportTournamentsUseCase(ctx, filter, rows)
+		if err := checkStatus(status, err, tournament_application.ExportExported); err != nil {
+			return err
+		}
+		return rows.Close()
+	}
+	filter, err := api.ParseExportFilter(query.From, query.To, query.TournamentID, query.CategoryID)
+	if err != nil {
+		return err
+	}
+	var status application.ExportStatus
+	if kind == "players" {
+		status, err = b.exportUseCase.ExportPlayersUseCase(ctx, filter, rows)
+	} else {
+		status, err = b.exportUseCase.ExportPlayerCouplesUseCase(ctx, filter, rows)
+	}
+	if err := checkStatus(status, err, application.ExportExported); err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+func (b *storeBackend) MigrationStatus(ctx context.Context) ([]migration.Status, error) {
+	return b.migrator.Status(ctx)
+}
+
+func (b *storeBackend) Migrate(ctx context.Context, up bool, target int, dryRun bool) ([]migration.Status, error) {
+	if up {
+		return b.migrator.Up(ctx, target, dryRun)
+	}
+	return b.migrator.Down(ctx, target, dryRun)
+}
+
+func (b *storeBackend) RotateSSNKeys(ctx context.Context) (int, error) {
+	return b.ssnKeyRotation.Rotate(ctx)
+}
@@ -1,37 +1,249 @@
 package main
 
 import (
-	"github.com/gin-gonic/gin"
+	"context"
+	"log"
+	"time"
+
+	padelplacev1 "github.com/paguerre3/goddd/api/proto/padelplace/v1"
+	"github.com/paguerre3/goddd/internal/migrations"
+	account_api "github.com/paguerre3/goddd/internal/modules/account/api"
+	account_application "github.com/paguerre3/goddd/internal/modules/account/application"
+	account_domain "github.com/paguerre3/goddd/internal/modules/account/domain"
+	account_infrastructure "github.com/paguerre3/goddd/internal/modules/account/infrastructure/mongo"
+	"github.com/paguerre3/goddd/internal/modules/account/infrastructure/notification"
+	"github.com/paguerre3/goddd/internal/modules/account/infrastructure/security"
+	apikey_api "github.com/paguerre3/goddd/internal/modules/apikey/api"
+	apikey_application "github.com/paguerre3/goddd/internal/modules/apikey/application"
+	apikey_infrastructure "github.com/paguerre3/goddd/internal/modules/apikey/infrastructure/mongo"
+	club_api "github.com/paguerre3/goddd/internal/modules/club/api"
+	club_application "github.com/paguerre3/goddd/internal/modules/club/application"
+	club_infrastructure "github.com/paguerre3/goddd/internal/modules/club/infrastructure/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/encryption"
+	"github.com/paguerre3/goddd/internal/modules/common/migration"
 	"github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/common/ratelimit"
+	"github.com/paguerre3/goddd/internal/modules/common/rpc"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/paguerre3/goddd/internal/modules/common/tracing"
 	"github.com/paguerre3/goddd/internal/modules/common/utils"
 	"github.com/paguerre3/goddd/internal/modules/player-couple/api"
 	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
 	player_couple_infrastructure "github.com/paguerre3/goddd/internal/modules/player-couple/infrastructure/mongo"
+	tournament_api "github.com/paguerre3/goddd/internal/modules/tournament/api"
+	tournament_application "github.com/paguerre3/goddd/internal/modules/tournament/application"
+	tournament_domain "github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	tournament_infrastructure "github.com/paguerre3/goddd/internal/modules/tournament/infrastructure/mongo"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	serviceName = "padelplace"
+	// migrationTimeout fails the startup of replicas waiting for the migrations of another one for longer, e.g. a
+	// migration stuck while renewing its lock.
+	migrationTimeout = 30 * time.Minute
 )
 
 func main() {
+	// Tracing goes first so Mongo commands of the client are traced.
+	shutdownTracing, err := tracing.NewTracerProvider(context.Background(), serviceName)
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	tokenValidator, err := auth.NewTokenValidator()
+	if err != nil {
+		log.Fatalf("Failed to initialize authentication: %v", err)
+	}
+	tokenIssuer, err := auth.NewTokenIssuer()
+	if err != nil {
+		log.Fatalf("Failed to initialize token issuer: %v", err)
+	}
+	passwordResetNotifier, err := notification.NewPasswordResetNotifier()
+	if err != nil {
+		log.Fatalf("Failed to initialize password reset notifier: %v", err)
+	}
+
+	apiKeyRate, err := ratelimit.RateFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize rate limiting: %v", err)
+	}
+
+	tenantHosts, err := tenant.HostsFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize tenants: %v", err)
+	}
+
+	keyRing, err := encryption.NewKeyRingFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize field encryption: %v", err)
+	}
+
+	// IDs are random UUIDs unless ID_GENERATOR selects another generator.
+	idGen, err := utils.NewIDGeneratorFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize ID generation: %v", err)
+	}
+
+	// Databases per tenant are migrated (and their SSNs rotated) one by one, the shared one holds every tenant otherwise.
+	databasePerTenant, err := mongo.DatabasePerTenantFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize tenants: %v", err)
+	}
+	databaseTenants := []string{tenant.Default}
+	if databasePerTenant {
+		databaseTenants = tenantHosts.IDs()
+	}
+
 	mongoClient := mongo.NewMongoClient()
 	defer mongoClient.Close()
 
-	idGen := utils.NewUUIDGenerator()
+	// Migrations run before serving (see MIGRATE_ON_STARTUP).
+	migrateOnStartup, err := migration.RunOnStartup()
+	if err != nil {
+		log.Fatalf("Failed to initialize migrations: %v", err)
+	}
+	if migrateOnStartup {
+		migrator, err := migration.NewMigrator(mongoClient, migrations.All(idGen)...)
+		if err != nil {
+			log.Fatalf("Failed to initialize migrations: %v", err)
+		}
+		for _, id := range databaseTenants {
+			// Replicas starting meanwhile wait for the migrations run by the first one, so none serves before they're
+			// applied.
+			ctx, cancel := context.WithTimeout(tenant.WithID(context.Background(), id), migrationTimeout)
+			applied, err := migrator.UpWhenUnlocked(ctx)
+			cancel()
+			if err != nil {
+				log.Fatalf("Failed to migrate tenant %s: %v", id, err)
+			}
+			log.Printf("Migrations of tenant %s done, %d applied", id, len(applied))
+		}
+	}
+
+	// SSNs stored with retired keys (or before encryption) are re-encrypted in background.
+	go func() {
+		for _, id := range databaseTenants {
+			rotated, err := player_couple_infrastructure.NewSSNKeyRotation(mongoClient, keyRing).Rotate(tenant.WithID(context.Background(), id))
+			if err != nil {
+				log.Printf("SSN key rotation of tenant %s failed: %v", id, err)
+				continue
+			}
+			log.Printf("SSN key rotation of tenant %s done, %d documents re-encrypted", id, rotated)
+		}
+	}()
 
-	playerRepo := player_couple_infrastructure.NewMongoPlayerRepository(idGen, mongoClient)
+	playerRepo := player_couple_infrastructure.NewMongoPlayerRepository(idGen, mongoClient, keyRing)
+	playerCoupleRepo := player_couple_infrastructure.NewMongoPlayerCoupleRepository(idGen, mongoClient, keyRing)
+	tournamentHistory := player_couple_infrastructure.NewMongoTournamentHistory(mongoClient)
 
-	registerPlayerUseCase := application.NewRegisterPlayerUseCase(playerRepo)
-	unregisterPlayerUseCase := application.NewUnregisterPlayerUseCase(playerRepo)
-	findPlayerUseCase := application.NewFindPlayerUseCase(playerRepo)
+	registerPlayerUseCase := application.NewInstrumentedRegisterPlayerUseCase(application.NewRegisterPlayerUseCase(playerRepo))
+	unregisterPlayerUseCase := application.NewInstrumentedUnregisterPlayerUseCase(application.NewUnregisterPlayerUseCase(playerRepo))
+	findPlayerUseCase := application.NewInstrumentedFindPlayerUseCase(application.NewFindPlayerUseCase(playerRepo))
 
 	playerHandler := api.NewPlayerHandler(registerPlayerUseCase, unregisterPlayerUseCase, findPlayerUseCase)
+	playerImportHandler := api.NewPlayerImportHandler(application.NewInstrumentedImportPlayersUseCase(application.NewImportPlayersUseCase(playerRepo)))
+
+	exportHandler := api.NewExportHandler(application.NewInstrumentedExportUseCase(application.NewExportUseCase(playerRepo, playerCoupleRepo, tournamentHistory)))
+
+	registerPlayerCoupleUseCase := application.NewInstrumentedRegisterPlayerCoupleUseCase(application.NewRegisterPlayerCoupleUseCase(playerRepo, playerCoupleRepo))
+	findPlayerCoupleUseCase := application.NewInstrumentedFindPlayerCoupleUseCase(application.NewFindPlayerCoupleUseCase(playerRepo, playerCoupleRepo))
+
+	playerCoupleHandler := api.NewPlayerCoupleHandler(registerPlayerCoupleUseCase, findPlayerCoupleUseCase)
+
+	tournamentRepo := tournament_infrastructure.NewMongoTournamentRepository(idGen, mongoClient)
+	createTournamentUseCase := tournament_application.NewCreateTournamentUseCase(tournamentRepo)
+	findTournamentUseCase := tournament_application.NewFindTournamentUseCase(tournamentRepo)
+	tournamentHandler := tournament_api.NewTournamentHandler(createTournamentUseCase, findTournamentUseCase)
+	// In memory brokers, i.e. score subscribers are only notified of the scores recorded by their replica.
+	scoreBroker := pubsub.NewMemoryBroker[tournament_domain.ScoreUpdate]()
+
+	accountRepo := account_infrastructure.NewMongoAccountRepository(idGen, mongoClient)
+	refreshTokenRepo := account_infrastructure.NewMongoRefreshTokenRepository(mongoClient)
+
+	// Erasure also deletes the login account of the player.
+	playerDataUseCase := application.NewInstrumentedPlayerDataUseCase(application.NewPlayerDataUseCase(playerRepo, playerCoupleRepo,
+		tournamentHistory, account_application.NewAccountEraser(accountRepo, refreshTokenRepo)))
+	playerDataHandler := api.NewPlayerDataHandler(playerDataUseCase, findPlayerUseCase)
+	playerDirectory := account_infrastructure.NewMongoPlayerDirectory(mongoClient)
+	passwordHasher := security.NewBcryptHasher(bcrypt.DefaultCost)
+
+	accountHandler := account_api.NewAccountHandler(
+		account_application.NewSignUpUseCase(accountRepo, playerDirectory, passwordHasher),
+		account_application.NewLoginUseCase(accountRepo, refreshTokenRepo, passwordHasher, tokenIssuer, account_domain.DefaultLockoutPolicy()),
+		account_application.NewPasswordResetUseCase(accountRepo, refreshTokenRepo, passwordHasher, passwordResetNotifier))
+
+	apiKeyRepo := apikey_infrastructure.NewMongoAPIKeyRepository(idGen, mongoClient)
+	apiKeyHandler := apikey_api.NewAPIKeyHandler(apikey_application.NewManageAPIKeyUseCase(apiKeyRepo))
+	authenticateAPIKeyUseCase := apikey_application.NewAuthenticateAPIKeyUseCase(apiKeyRepo)
+	// In memory buckets are per replica, use ratelimit.NewRedisLimiter to share limits between replicas.
+	apiKeyLimiter := ratelimit.NewMemoryLimiter(apiKeyRate)
+
+	liveMatchHandler := tournament_api.NewLiveMatchHandler(tournament_application.NewLiveMatchUseCase(tournamentRepo,
+		tournament_infrastructure.NewMongoLiveMatchRepository(idGen, mongoClient), pubsub.NewMemoryBroker[tournament_domain.LiveScore](), scoreBroker))
+	scheduleHandler := tournament_api.NewScheduleHandler(tournament_application.NewScheduleUseCase(tournamentRepo))
+	tournamentPlayerReader := tournament_infrastructure.NewMongoPlayerReader(mongoClient)
+	tournamentPlayerCoupleReader := tournament_infrastructure.NewMongoPlayerCoupleReader(mongoClient)
+	categoryHandler := tournament_api.NewCategoryHandler(tournament_application.NewCategoryUseCase(tournamentRepo,
+		tournamentPlayerCoupleReader, tournamentPlayerReader))
+	// Tournament events are logged, other bounded contexts can subscribe to the same broker.
+	tournamentEventBroker := pubsub.NewMemoryBroker[tournament_domain.TournamentEvent]()
+	go func() {
+		for event := range tournament_application.SubscribeTournamentEvents(context.Background(), tournamentEventBroker) {
+			log.Printf("Tournament %s: %s (%s -> %s)", event.TournamentID, event.Type, event.From, event.To)
+		}
+	}()
+	resultHandler := tournament_api.NewResultHandler(tournament_application.NewResultUseCase(tournamentRepo, scoreBroker))
+	tournamentExportHandler := tournament_api.NewExportHandler(tournament_application.NewExportUseCase(tournamentRepo))
+	lifecycleHandler := tournament_api.NewLifecycleHandler(tournament_application.NewTransitionTournamentUseCase(tournamentRepo, tournamentEventBroker))
+	hostClubHandler := tournament_api.NewHostClubHandler(tournament_application.NewHostClubUseCase(tournamentRepo,
+		tournament_infrastructure.NewMongoClubReader(mongoClient)))
+	graphQLHandler := tournament_api.NewGraphQLHandler(tokenValidator, tournamentPlayerReader, tournamentPlayerCoupleReader,
+		findTournamentUseCase, tournament_application.NewRecordMatchScoreUseCase(tournamentRepo, scoreBroker),
+		tournament_application.NewSubscribeScoreUpdatesUseCase(tournamentRepo, scoreBroker))
+
+	clubRepo := club_infrastructure.NewMongoClubRepository(idGen, mongoClient)
+	clubHandler := club_api.NewClubHandler(club_application.NewClubUseCase(clubRepo), club_application.NewMembershipUseCase(clubRepo,
+		club_infrastructure.NewMongoMembershipRepository(idGen, mongoClient), club_infrastructure.NewMongoPlayerReader(mongoClient)))
 
-	// Initialize router
-	router := gin.Default()
+	router := newRouter(routerDeps{
+		playerHandler:             playerHandler,
+		playerDataHandler:         playerDataHandler,
+		playerImportHandler:       playerImportHandler,
+		playerCoupleHandler:       playerCoupleHandler,
+		exportHandler:             exportHandler,
+		accountHandler:            accountHandler,
+		apiKeyHandler:             apiKeyHandler,
+		tournamentHandler:         tournamentHandler,
+		graphQLHandler:            graphQLHandler,
+		liveMatchHandler:          liveMatchHandler,
+		scheduleHandler:           scheduleHandler,
+		categoryHandler:           categoryHandler,
+		lifecycleHandler:          lifecycleHandler,
+		resultHandler:             resultHandler,
+		tournamentExportHandler:   tournamentExportHandler,
+		hostClubHandler:           hostClubHandler,
+		clubHandler:               clubHandler,
+		tokenValidator:            tokenValidator,
+		authenticateAPIKeyUseCase: authenticateAPIKeyUseCase,
+		apiKeyLimiter:             apiKeyLimiter,
+		tenantHosts:               tenantHosts,
+	})
 
-	// Routes
-	router.POST("/players", playerHandler.RegisterPlayer)
-	router.DELETE("/players/:playerId", playerHandler.UnregisterPlayer)
-	router.GET("/players/:playerId", playerHandler.FindPlayerByID)
-	router.GET("/players/email/:email", playerHandler.FindPlayerByEmail)
-	router.GET("/players/last-name/:lastName", playerHandler.FindPlayersByLastName)
+	// gRPC exposes the same use cases on its own port (GRPC_PORT), bearer tokens only.
+	grpcServer := rpc.NewServer(tokenValidator)
+	padelplacev1.RegisterPlayerServiceServer(grpcServer, api.NewPlayerGRPCServer(registerPlayerUseCase, unregisterPlayerUseCase, findPlayerUseCase))
+	padelplacev1.RegisterPlayerCoupleServiceServer(grpcServer, api.NewPlayerCoupleGRPCServer(registerPlayerCoupleUseCase, findPlayerCoupleUseCase))
+	padelplacev1.RegisterTournamentServiceServer(grpcServer, tournament_api.NewTournamentGRPCServer(createTournamentUseCase, findTournamentUseCase))
+	go func() {
+		if err := grpcServer.ListenAndServe(rpc.ListenAddress()); err != nil {
+			log.Fatalf("Failed to serve gRPC: %v", err)
+		}
+	}()
+	defer grpcServer.GracefulStop()
 
 	// Start your HTTP server and handle routes
 	router.Run(":8080")
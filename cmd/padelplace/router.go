@@ -0,0 +1,207 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	account_api "github.com/paguerre3/goddd/internal/modules/account/api"
+	apikey_api "github.com/paguerre3/goddd/internal/modules/apikey/api"
+	apikey_application "github.com/paguerre3/goddd/internal/modules/apikey/application"
+	apikey_domain "github.com/paguerre3/goddd/internal/modules/apikey/domain"
+	club_api "github.com/paguerre3/goddd/internal/modules/club/api"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/metrics"
+	"github.com/paguerre3/goddd/internal/modules/common/openapi"
+	"github.com/paguerre3/goddd/internal/modules/common/ratelimit"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/paguerre3/goddd/internal/modules/common/tracing"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/api"
+	tournament_api "github.com/paguerre3/goddd/internal/modules/tournament/api"
+	tournament_domain "github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+const (
+	apiTitle   = "Padel Place API"
+	apiVersion = "1.0.0"
+	apiV1      = "/v1"
+	graphQL    = "/graphql"
+)
+
+// Unversioned (legacy) routes are deprecated in favor of apiV1 ones.
+var (
+	legacyDeprecatedAt = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	legacySunset       = time.Date(2027, time.April, 30, 0, 0, 0, 0, time.UTC)
+)
+
+// routerDeps are the handlers and middleware dependencies wired in main.
+type routerDeps struct {
+	playerHandler             *api.PlayerHandler
+	playerDataHandler         *api.PlayerDataHandler
+	playerImportHandler       *api.PlayerImportHandler
+	playerCoupleHandler       *api.PlayerCoupleHandler
+	exportHandler             *api.ExportHandler
+	accountHandler            *account_api.AccountHandler
+	apiKeyHandler             *apikey_api.APIKeyHandler
+	tournamentHandler         *tournament_api.TournamentHandler
+	graphQLHandler            *tournament_api.GraphQLHandler
+	liveMatchHandler          *tournament_api.LiveMatchHandler
+	scheduleHandler           *tournament_api.ScheduleHandler
+	categoryHandler           *tournament_api.CategoryHandler
+	lifecycleHandler          *tournament_api.LifecycleHandler
+	resultHandler             *tournament_api.ResultHandler
+	tournamentExportHandler   *tournament_api.ExportHandler
+	hostClubHandler           *tournament_api.HostClubHandler
+	clubHandler               *club_api.ClubHandler
+	tokenValidator            auth.TokenValidator
+	authenticateAPIKeyUseCase apikey_application.AuthenticateAPIKeyUseCase
+	apiKeyLimiter             ratelimit.Limiter
+	tenantHosts               tenant.Hosts
+}
+
+func newRouter(deps routerDeps) *gin.Engine {
+	// gin.Default() without its logger so trace IDs are logged.
+	router := gin.New()
+	router.Use(gin.LoggerWithFormatter(tracing.LogFormatter), gin.Recovery())
+	router.Use(tracing.GinMiddleware(serviceName)...)
+	router.Use(metrics.GinMiddleware())
+	// Documents are scoped to the tenant of the host, or else of the token (see auth.WithClaims).
+	router.Use(tenant.GinMiddleware(deps.tenantHosts))
+
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+	router.GET("/openapi.json", openapi.Handler(newOpenAPIDocument()))
+	router.GET("/docs", openapi.SwaggerUIHandler(apiTitle, "/openapi.json"))
+
+	// GraphQL evolves its schema instead of versioning routes, subscriptions authenticate themselves.
+	router.POST(graphQL, auth.Authenticate(deps.tokenValidator), deps.graphQLHandler.Query)
+	router.GET(graphQL, deps.graphQLHandler.Subscribe)
+
+	registerAPIRoutes(router.Group(apiV1), deps)
+	// Unversioned routes are kept for existing clients until the sunset date.
+	registerAPIRoutes(router.Group("", web.Deprecated(legacyDeprecatedAt, legacySunset, apiV1)), deps)
+
+	return router
+}
+
+// registerAPIRoutes registers the routes of the modules under the version group.
+func registerAPIRoutes(version *gin.RouterGroup, deps routerDeps) {
+	// Club integrations use X-API-Key (rate limited per key) while users use bearer tokens.
+	players := version.Group("/players",
+		apikey_api.Authenticate(deps.authenticateAPIKeyUseCase, auth.Authenticate(deps.tokenValidator)),
+		ratelimit.GinMiddleware(deps.apiKeyLimiter, apikey_api.RateLimitKey))
+	playersRead := auth.RequireScope(string(apikey_domain.ScopePlayersRead))
+	// Players only register or update their own profile (enforced by the handler).
+	players.POST("", auth.RequireRoles(auth.RoleAdmin, auth.RoleOrganizer, auth.RolePlayer, auth.RoleClub),
+		auth.RequireScope(string(apikey_domain.ScopePlayersWrite)), deps.playerHandler.RegisterPlayer)
+	players.DELETE("/:playerId", auth.RequireRoles(auth.RoleAdmin), deps.playerHandler.UnregisterPlayer)
+	players.GET("/:playerId", playersRead, deps.playerHandler.FindPlayerByID)
+	players.GET("/email/:email", playersRead, deps.playerHandler.FindPlayerByEmail)
+	players.GET("/last-name/:lastName", playersRead, deps.playerHandler.FindPlayersByLastName)
+	players.POST("/ssn-lookup", auth.RequireRoles(auth.RoleAdmin), deps.playerHandler.FindPlayerBySocialSecurityNumber)
+	players.POST("/import", auth.RequireRoles(auth.RoleAdmin, auth.RoleOrganizer, auth.RoleClub),
+		auth.RequireScope(string(apikey_domain.ScopePlayersWrite)), deps.playerImportHandler.ImportPlayers)
+	// Data subject rights (GDPR), players only act on their own data (enforced by the handler).
+	players.GET("/:playerId/export", auth.RequireRoles(auth.RoleAdmin, auth.RolePlayer), deps.playerDataHandler.ExportPlayerData)
+	players.DELETE("/:playerId/personal-data", auth.RequireRoles(auth.RoleAdmin, auth.RolePlayer), deps.playerDataHandler.ErasePlayerData)
+
+	couples := version.Group("/couples", auth.Authenticate(deps.tokenValidator))
+	couples.POST("", auth.RequireRoles(auth.RoleAdmin, auth.RoleOrganizer), deps.playerCoupleHandler.RegisterPlayerCouple)
+	couples.GET("", deps.playerCoupleHandler.FindPlayerCouples)
+	couples.GET("/:coupleId", deps.playerCoupleHandler.FindPlayerCoupleByID)
+
+	// Live scores, schedules and standings are public (no personal data), referees score the points and report overruns.
+	tournaments := version.Group("/tournaments")
+	const live = "/:id/matches/:matchId/live"
+	tournaments.GET(live, deps.liveMatchHandler.StreamLiveScore)
+	tournaments.GET("/:id/schedule", deps.scheduleHandler.FindSchedule)
+	referees := tournaments.Group("", auth.Authenticate(deps.tokenValidator), auth.RequireRoles(auth.RoleAdmin, auth.RoleOrganizer, auth.RoleReferee))
+	referees.POST(live, deps.liveMatchHandler.StartLiveMatch)
+	referees.POST(live+"/points", deps.liveMatchHandler.ScorePoint)
+	referees.DELETE(live+"/points/last", deps.liveMatchHandler.UndoPoint)
+	referees.PUT("/:id/matches/:matchId/end", deps.scheduleHandler.ReportMatchEnd)
+	referees.PUT("/:id/matches/:matchId/result", deps.resultHandler.RecordMatchResult)
+	organizers := tournaments.Group("", auth.Authenticate(deps.tokenValidator), auth.RequireRoles(auth.RoleAdmin, auth.RoleOrganizer))
+	organizers.POST("", deps.tournamentHandler.CreateTournament)
+	organizers.PUT("/:id/scheduling", deps.scheduleHandler.ConfigureScheduling)
+	organizers.POST("/:id/schedule", deps.scheduleHandler.ScheduleMatches)
+	organizers.PUT("/:id/matches/:matchId/schedule", deps.scheduleHandler.AssignMatch)
+	// Couples and category entries hold personal data of players.
+	tournaments.GET("/:id", auth.Authenticate(deps.tokenValidator), deps.tournamentHandler.FindTournamentByID)
+	tournaments.GET("/:id/categories", auth.Authenticate(deps.tokenValidator), deps.categoryHandler.FindCategories)
+	organizers.POST("/:id/categories", deps.categoryHandler.AddCategory)
+	organizers.POST("/:id/categories/:categoryId/entries", deps.categoryHandler.EnterCategory)
+	organizers.DELETE("/:id/categories/:categoryId/entries/:coupleId", deps.categoryHandler.WithdrawFromCategory)
+	tournaments.GET("/:id/standings", deps.resultHandler.FindStandings)
+	organizers.PUT("/:id/result-rules", deps.resultHandler.ConfigureResultRules)
+	organizers.POST("/:id/rounds", deps.resultHandler.AdvanceRound)
+	organizers.PUT("/:id/host-club", deps.hostClubHandler.AssignHostClub)
+	for _, transition := range tournament_domain.Transitions {
+		organizers.POST("/:id/"+string(transition), deps.lifecycleHandler.Transition(transition))
+	}
+
+	// Admins manage clubs and their venues, organizers the memberships, players of clubs hold personal data.
+	clubs := version.Group("/clubs", auth.Authenticate(deps.tokenValidator))
+	clubs.POST("", auth.RequireRoles(auth.RoleAdmin), deps.clubHandler.CreateClub)
+	clubs.GET("", deps.clubHandler.FindClubs)
+	clubs.GET("/:clubId", deps.clubHandler.FindClubByID)
+	clubs.POST("/:clubId/venues", auth.RequireRoles(auth.RoleAdmin), deps.clubHandler.AddVenue)
+	memberships := clubs.Group("/:clubId/memberships", auth.RequireRoles(auth.RoleAdmin, auth.RoleOrganizer))
+	memberships.POST("", deps.clubHandler.JoinClub)
+	memberships.GET("", deps.clubHandler.FindMemberships)
+	memberships.DELETE("/:membershipId", deps.clubHandler.EndMembership)
+	clubs.GET("/:clubId/players", deps.clubHandler.FindClubPlayers)
+
+	// Federation reports, files hold personal data of every player exported.
+	exports := version.Group("/exports", auth.Authenticate(deps.tokenValidator), auth.RequireRoles(auth.RoleAdmin, auth.RoleOrganizer))
+	exports.GET("/players", deps.exportHandler.ExportPlayers)
+	exports.GET("/couples", deps.exportHandler.ExportPlayerCouples)
+	exports.GET("/tournaments", deps.tournamentExportHandler.ExportTournaments)
+
+	apiKeys := version.Group("/api-keys", auth.Authenticate(deps.tokenValidator), auth.RequireRoles(auth.RoleAdmin))
+	apiKeys.POST("", deps.apiKeyHandler.CreateAPIKey)
+	apiKeys.GET("", deps.apiKeyHandler.FindAPIKeysByClub)
+	apiKeys.DELETE("/:apiKeyId", deps.apiKeyHandler.RevokeAPIKey)
+
+	// Accounts are anonymous, i.e. they are the way to get a token.
+	accounts := version.Group("/accounts")
+	accounts.POST("/sign-up", deps.accountHandler.SignUp)
+	accounts.POST("/login", deps.accountHandler.Login)
+	accounts.POST("/refresh", deps.accountHandler.RefreshTokens)
+	accounts.POST("/password-reset", deps.accountHandler.RequestPasswordReset)
+	accounts.POST("/password-reset/confirm", deps.accountHandler.ResetPassword)
+}
+
+// newOpenAPIDocument describes every route of newRouter, i.e. base paths must match the groups of registerAPIRoutes.
+func newOpenAPIDocument() *openapi.Document {
+	doc := openapi.New(apiTitle, apiVersion)
+	text := func(description string) map[string]*openapi.Response {
+		return map[string]*openapi.Response{"200": {Description: description}}
+	}
+	doc.Add(http.MethodGet, "/metrics", openapi.Operation{Summary: "Prometheus metrics", Tags: []string{"operations"}, Responses: text("Metrics in text exposition format")})
+	doc.Add(http.MethodGet, "/openapi.json", openapi.Operation{Summary: "This OpenAPI document", Tags: []string{"operations"}, Responses: text("OpenAPI 3 document")})
+	doc.Add(http.MethodGet, "/docs", openapi.Operation{Summary: "Swagger UI", Tags: []string{"operations"}, Responses: text("Swagger UI page")})
+
+	tournament_api.DescribeGraphQLRoutes(doc, graphQL)
+
+	for _, version := range []string{apiV1, ""} {
+		api.DescribePlayerRoutes(doc, version+"/players")
+		api.DescribePlayerCoupleRoutes(doc, version+"/couples")
+		apikey_api.DescribeAPIKeyRoutes(doc, version+"/api-keys")
+		account_api.DescribeAccountRoutes(doc, version+"/accounts")
+		tournament_api.DescribeTournamentRoutes(doc, version+"/tournaments")
+		tournament_api.DescribeLiveMatchRoutes(doc, version+"/tournaments")
+		tournament_api.DescribeScheduleRoutes(doc, version+"/tournaments")
+		tournament_api.DescribeCategoryRoutes(doc, version+"/tournaments")
+		tournament_api.DescribeLifecycleRoutes(doc, version+"/tournaments")
+		tournament_api.DescribeResultRoutes(doc, version+"/tournaments")
+		tournament_api.DescribeHostClubRoutes(doc, version+"/tournaments")
+		club_api.DescribeClubRoutes(doc, version+"/clubs")
+		api.DescribeExportRoutes(doc, version+"/exports")
+		tournament_api.DescribeExportRoutes(doc, version+"/exports")
+	}
+	for _, legacy := range []string{"/players", "/couples", "/api-keys", "/accounts", "/tournaments", "/clubs", "/exports"} {
+		doc.Deprecate(legacy)
+	}
+	return doc
+}
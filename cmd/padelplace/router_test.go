@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/openapi"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestOpenAPIDocument_DescribesEveryRoute fails when a route is registered without an OpenAPI entry (or the
+// other way around), i.e. document it in the Describe*Routes function of its module.
+func TestOpenAPIDocument_DescribesEveryRoute(t *testing.T) {
+	router := newRouter(routerDeps{})
+	doc := newOpenAPIDocument()
+
+	var registered []string
+	for _, route := range router.Routes() {
+		registered = append(registered, route.Method+" "+openapi.Path(route.Path))
+		assert.True(t, doc.Has(route.Method, route.Path), "Route %s %s has no OpenAPI entry", route.Method, route.Path)
+	}
+	sort.Strings(registered)
+	assert.Equal(t, registered, doc.Routes(), "OpenAPI document must describe exactly the registered routes")
+}
+
+func TestOpenAPIDocument_Responses(t *testing.T) {
+	doc := newOpenAPIDocument()
+	operation := func(method, path string) *openapi.Operation {
+		return (*doc.Paths[path])[method]
+	}
+
+	register := operation("post", "/v1/players")
+	assert.Contains(t, register.Responses, "200", "Expected update status")
+	assert.Contains(t, register.Responses, "201", "Expected register status")
+
+	find := operation("get", "/v1/players/{playerId}")
+	assert.Equal(t, "#/components/schemas/Player", find.Responses["404"].Content["application/json"].Schema.Ref, "Expected empty player on not found")
+	assert.Equal(t, "#/components/schemas/Player", find.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, []openapi.Parameter{{Name: "playerId", In: "path", Required: true, Schema: &openapi.Schema{Type: "string"}}}, find.Parameters)
+
+	for _, path := range []string{"/v1/accounts/login", "/v1/accounts/sign-up"} {
+		assert.Empty(t, operation("post", path).Security, "Expected anonymous %s", path)
+	}
+	assert.Equal(t, openapi.BearerOrAPIKey, find.Security)
+
+	assert.False(t, find.Deprecated)
+	assert.True(t, operation("get", "/players/{playerId}").Deprecated, "Expected legacy routes deprecated")
+	assert.True(t, operation("post", "/accounts/login").Deprecated, "Expected legacy routes deprecated")
+}
+
+func TestLegacyRoutes_Deprecated(t *testing.T) {
+	router := newRouter(routerDeps{})
+
+	// Anonymous requests are rejected by authentication, after the deprecation headers are set.
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api-keys?club=padel-club", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, fmt.Sprintf("@%d", legacyDeprecatedAt.Unix()), w.Header().Get("Deprecation"))
+	assert.Equal(t, legacySunset.Format(http.TimeFormat), w.Header().Get("Sunset"))
+	assert.Equal(t, `</v1/api-keys?club=padel-club>; rel="successor-version"`, w.Header().Get("Link"))
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/api-keys?club=padel-club", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Empty(t, w.Header().Get("Deprecation"))
+	assert.Empty(t, w.Header().Get("Sunset"))
+}
+
+// tenantTokenValidator accepts tokens named after their tenant, e.g. "fep".
+type tenantTokenValidator struct{}
+
+func (tenantTokenValidator) Validate(token string) (*auth.Claims, error) {
+	return &auth.Claims{Roles: []auth.Role{auth.RoleAdmin}, Tenant: token}, nil
+}
+
+func TestTenants_Forbidden(t *testing.T) {
+	router := newRouter(routerDeps{tokenValidator: tenantTokenValidator{}, tenantHosts: tenant.Hosts{"fep.padelplace.com": "fep"}})
+
+	// Tokens of another tenant are rejected by authentication, before reaching the handlers.
+	req := httptest.NewRequest(http.MethodGet, "/v1/clubs", nil)
+	req.Host = "fep.padelplace.com:443"
+	req.Header.Set("Authorization", "Bearer fip")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "token of another tenant")
+
+	// Tokens without tenant don't reach the tenant of the host either.
+	req = httptest.NewRequest(http.MethodGet, "/v1/clubs", nil)
+	req.Host = "fep.padelplace.com"
+	req.Header.Set("Authorization", "Bearer ")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestOpenAPIRoutes(t *testing.T) {
+	router := newRouter(routerDeps{})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var doc map[string]any
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal(t, openapi.Version, doc["openapi"])
+	assert.Contains(t, doc["paths"], "/v1/players/{playerId}")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "swagger-ui")
+}
@@ -0,0 +1,20 @@
+// Package migrations gathers the migrations of every module, the ones run by padelplace on startup and by padelctl.
+package migrations
+
+import (
+	"slices"
+
+	account_infrastructure "github.com/paguerre3/goddd/internal/modules/account/infrastructure/mongo"
+	apikey_infrastructure "github.com/paguerre3/goddd/internal/modules/apikey/infrastructure/mongo"
+	club_infrastructure "github.com/paguerre3/goddd/internal/modules/club/infrastructure/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/migration"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	player_couple_infrastructure "github.com/paguerre3/goddd/internal/modules/player-couple/infrastructure/mongo"
+	tournament_infrastructure "github.com/paguerre3/goddd/internal/modules/tournament/infrastructure/mongo"
+)
+
+// All returns the migrations of every module, unsorted (see migration.NewMigrator).
+func All(idGen utils.IDGenerator) []migration.Migration {
+	return slices.Concat(player_couple_infrastructure.Migrations(idGen), club_infrastructure.Migrations(),
+		tournament_infrastructure.Migrations(), account_infrastructure.Migrations(), apikey_infrastructure.Migrations())
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/account/application"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+)
+
+type AccountHandler struct {
+	signUpUseCase        application.SignUpUseCase
+	loginUseCase         application.LoginUseCase
+	passwordResetUseCase application.PasswordResetUseCase
+}
+
+type credentialsRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type refreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type passwordResetRequest struct {
+	Email string `json:"email"`
+}
+
+type passwordResetConfirmRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"newPassword"`
+}
+
+func NewAccountHandler(signUpUseCase application.SignUpUseCase, loginUseCase application.LoginUseCase,
+	passwordResetUseCase application.PasswordResetUseCase) *AccountHandler {
+	return &AccountHandler{
+		signUpUseCase:        signUpUseCase,
+		loginUseCase:         loginUseCase,
+		passwordResetUseCase: passwordResetUseCase,
+	}
+}
+
+func (h *AccountHandler) SignUp(c *gin.Context) {
+	var req credentialsRequest
+	if err := web.Bind(c, &req); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	account, status, err := h.signUpUseCase.SignUpUseCase(c.Request.Context(), req.Email, req.Password)
+	if err != nil {
+		if status == application.SignUpInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	switch status {
+	case application.SignUpCreated:
+		web.Respond(c, http.StatusCreated, account)
+	case application.SignUpAlreadyExists:
+		web.Respond(c, http.StatusConflict, gin.H{"status": status.String()})
+	case application.SignUpPlayerNotFound:
+		// Accounts are only linked to players already registered through POST /players.
+		web.Respond(c, http.StatusUnprocessableEntity, gin.H{"status": status.String()})
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
+
+func (h *AccountHandler) Login(c *gin.Context) {
+	var req credentialsRequest
+	if err := web.Bind(c, &req); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	tokens, status, err := h.loginUseCase.LoginUseCase(c.Request.Context(), req.Email, req.Password)
+	handleTokensResponse(c, tokens, status, err)
+}
+
+func (h *AccountHandler) RefreshTokens(c *gin.Context) {
+	var req refreshRequest
+	if err := web.Bind(c, &req); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	tokens, status, err := h.loginUseCase.RefreshTokensUseCase(c.Request.Context(), req.RefreshToken)
+	handleTokensResponse(c, tokens, status, err)
+}
+
+func handleTokensResponse(c *gin.Context, tokens application.Tokens, status application.LoginStatus, err error) {
+	var lockedErr *application.AccountLockedError
+	if errors.As(err, &lockedErr) {
+		retryAfter := math.Ceil(time.Until(lockedErr.Until).Seconds())
+		c.Header("Retry-After", strconv.Itoa(int(math.Max(retryAfter, 1))))
+		web.Respond(c, http.StatusLocked, web.ErrorBody(c, err))
+		return
+	}
+	if err != nil {
+		if status == application.LoginInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	switch status {
+	case application.LoginSucceeded:
+		web.Respond(c, http.StatusOK, tokens)
+	case application.LoginUnauthorized:
+		web.Respond(c, http.StatusUnauthorized, gin.H{"status": status.String()})
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
+
+func (h *AccountHandler) RequestPasswordReset(c *gin.Context) {
+	var req passwordResetRequest
+	if err := web.Bind(c, &req); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	status, err := h.passwordResetUseCase.RequestPasswordResetUseCase(c.Request.Context(), req.Email)
+	handlePasswordResetResponse(c, status, err)
+}
+
+func (h *AccountHandler) ResetPassword(c *gin.Context) {
+	var req passwordResetConfirmRequest
+	if err := web.Bind(c, &req); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	status, err := h.passwordResetUseCase.ResetPasswordUseCase(c.Request.Context(), req.Token, req.NewPassword)
+	handlePasswordResetResponse(c, status, err)
+}
+
+func handlePasswordResetResponse(c *gin.Context, status application.PasswordResetStatus, err error) {
+	if err != nil {
+		if status == application.PasswordResetInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	switch status {
+	case application.PasswordResetRequested:
+		web.Respond(c, http.StatusAccepted, gin.H{"status": status.String()})
+	case application.PasswordResetDone:
+		web.Respond(c, http.StatusOK, gin.H{"status": status.String()})
+	case application.PasswordResetTokenInvalid:
+		web.Respond(c, http.StatusBadRequest, gin.H{"status": status.String()})
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
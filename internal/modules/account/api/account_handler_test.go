@@ -0,0 +1,160 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/account/application"
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockSignUpUseCase struct {
+	mock.Mock
+}
+
+func (m *mockSignUpUseCase) SignUpUseCase(_ context.Context, email, password string) (domain.Account, application.SignUpStatus, error) {
+	args := m.Called(email, password)
+	return args.Get(0).(domain.Account), args.Get(1).(application.SignUpStatus), args.Error(2)
+}
+
+type mockLoginUseCase struct {
+	mock.Mock
+}
+
+func (m *mockLoginUseCase) LoginUseCase(_ context.Context, email, password string) (application.Tokens, application.LoginStatus, error) {
+	args := m.Called(email, password)
+	return args.Get(0).(application.Tokens), args.Get(1).(application.LoginStatus), args.Error(2)
+}
+
+func (m *mockLoginUseCase) RefreshTokensUseCase(_ context.Context, refreshToken string) (application.Tokens, application.LoginStatus, error) {
+	args := m.Called(refreshToken)
+	return args.Get(0).(application.Tokens), args.Get(1).(application.LoginStatus), args.Error(2)
+}
+
+type mockPasswordResetUseCase struct {
+	mock.Mock
+}
+
+func (m *mockPasswordResetUseCase) RequestPasswordResetUseCase(_ context.Context, email string) (application.PasswordResetStatus, error) {
+	args := m.Called(email)
+	return args.Get(0).(application.PasswordResetStatus), args.Error(1)
+}
+
+func (m *mockPasswordResetUseCase) ResetPasswordUseCase(_ context.Context, token, newPassword string) (application.PasswordResetStatus, error) {
+	args := m.Called(token, newPassword)
+	return args.Get(0).(application.PasswordResetStatus), args.Error(1)
+}
+
+func serve(handler gin.HandlerFunc, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/accounts", bytes.NewBufferString(body))
+	handler(c)
+	return w
+}
+
+func TestSignUp(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     application.SignUpStatus
+		err        error
+		statusCode int
+	}{
+		{"Created", application.SignUpCreated, nil, http.StatusCreated},
+		{"Already exists", application.SignUpAlreadyExists, nil, http.StatusConflict},
+		{"Player not registered", application.SignUpPlayerNotFound, nil, http.StatusUnprocessableEntity},
+		{"Invalid", application.SignUpInvalid, assert.AnError, http.StatusBadRequest},
+		{"Internal error", application.SignUpPending, assert.AnError, http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			signUp := &mockSignUpUseCase{}
+			signUp.On("SignUpUseCase", "a@b.com", "s3cret-password").Return(domain.Account{ID: "id"}, test.status, test.err)
+			h := NewAccountHandler(signUp, nil, nil)
+
+			w := serve(h.SignUp, `{"email": "a@b.com", "password": "s3cret-password"}`)
+			assert.Equal(t, test.statusCode, w.Code)
+			assert.NotContains(t, w.Body.String(), "passwordHash")
+		})
+	}
+
+	t.Run("Invalid JSON binding", func(t *testing.T) {
+		h := NewAccountHandler(&mockSignUpUseCase{}, nil, nil)
+		assert.Equal(t, http.StatusBadRequest, serve(h.SignUp, "invalid json").Code)
+	})
+}
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     application.LoginStatus
+		err        error
+		statusCode int
+	}{
+		{"Succeeded", application.LoginSucceeded, nil, http.StatusOK},
+		{"Unauthorized", application.LoginUnauthorized, nil, http.StatusUnauthorized},
+		{"Invalid", application.LoginInvalid, assert.AnError, http.StatusBadRequest},
+		{"Internal error", application.LoginPending, assert.AnError, http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			login := &mockLoginUseCase{}
+			login.On("LoginUseCase", "a@b.com", "s3cret-password").Return(application.Tokens{AccessToken: "token"}, test.status, test.err)
+			h := NewAccountHandler(nil, login, nil)
+
+			w := serve(h.Login, `{"email": "a@b.com", "password": "s3cret-password"}`)
+			assert.Equal(t, test.statusCode, w.Code)
+		})
+	}
+
+	t.Run("Locked account", func(t *testing.T) {
+		login := &mockLoginUseCase{}
+		lockedErr := &application.AccountLockedError{Until: time.Now().Add(90 * time.Second)}
+		login.On("LoginUseCase", "a@b.com", "s3cret-password").Return(application.Tokens{}, application.LoginLocked, lockedErr)
+		h := NewAccountHandler(nil, login, nil)
+
+		w := serve(h.Login, `{"email": "a@b.com", "password": "s3cret-password"}`)
+		assert.Equal(t, http.StatusLocked, w.Code)
+		assert.Equal(t, "90", w.Header().Get("Retry-After"))
+	})
+}
+
+func TestRefreshTokens(t *testing.T) {
+	login := &mockLoginUseCase{}
+	login.On("RefreshTokensUseCase", "refresh").Return(application.Tokens{AccessToken: "token"}, application.LoginSucceeded, nil)
+	h := NewAccountHandler(nil, login, nil)
+
+	w := serve(h.RefreshTokens, `{"refreshToken": "refresh"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"accessToken":"token"`)
+}
+
+func TestPasswordReset(t *testing.T) {
+	t.Run("Reset requested", func(t *testing.T) {
+		reset := &mockPasswordResetUseCase{}
+		reset.On("RequestPasswordResetUseCase", "a@b.com").Return(application.PasswordResetRequested, nil)
+		h := NewAccountHandler(nil, nil, reset)
+		assert.Equal(t, http.StatusAccepted, serve(h.RequestPasswordReset, `{"email": "a@b.com"}`).Code)
+	})
+
+	t.Run("Password reset", func(t *testing.T) {
+		reset := &mockPasswordResetUseCase{}
+		reset.On("ResetPasswordUseCase", "token", "new-password").Return(application.PasswordResetDone, nil)
+		h := NewAccountHandler(nil, nil, reset)
+		assert.Equal(t, http.StatusOK, serve(h.ResetPassword, `{"token": "token", "newPassword": "new-password"}`).Code)
+	})
+
+	t.Run("Invalid reset token", func(t *testing.T) {
+		reset := &mockPasswordResetUseCase{}
+		reset.On("ResetPasswordUseCase", "token", "new-password").Return(application.PasswordResetTokenInvalid, nil)
+		h := NewAccountHandler(nil, nil, reset)
+		assert.Equal(t, http.StatusBadRequest, serve(h.ResetPassword, `{"token": "token", "newPassword": "new-password"}`).Code)
+	})
+}
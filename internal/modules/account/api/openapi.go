@@ -0,0 +1,70 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/paguerre3/goddd/internal/modules/account/application"
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	"github.com/paguerre3/goddd/internal/modules/common/openapi"
+)
+
+const accountsTag = "accounts"
+
+// DescribeAccountRoutes documents the routes of AccountHandler registered under basePath (anonymous).
+func DescribeAccountRoutes(doc *openapi.Document, basePath string) {
+	add := func(method, path string, operation openapi.Operation) {
+		operation.Tags = []string{accountsTag}
+		doc.Add(method, basePath+path, operation)
+	}
+	tokensResponses := map[string]*openapi.Response{
+		"200": doc.Response("Tokens issued", application.Tokens{}),
+		"400": doc.ErrorResponse("Invalid input"),
+		"401": doc.StatusResponse("Invalid credentials"),
+		"423": {
+			Description: "Account locked after consecutive failed logins",
+			Headers:     map[string]*openapi.Header{"Retry-After": {Description: "Seconds to wait", Schema: &openapi.Schema{Type: "integer"}}},
+			Content:     doc.Content(openapi.ErrorBody{}),
+		},
+		"500": doc.ErrorResponse("Internal error"),
+	}
+
+	add(http.MethodPost, "/sign-up", openapi.Operation{
+		Summary:     "Create the account of a registered player",
+		RequestBody: doc.Body(credentialsRequest{}),
+		Responses: map[string]*openapi.Response{
+			"201": doc.Response("Account created", domain.Account{}),
+			"400": doc.ErrorResponse("Invalid email or password"),
+			"409": doc.StatusResponse("Account already exists"),
+			"422": doc.StatusResponse("Player not registered"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPost, "/login", openapi.Operation{
+		Summary:     "Log in with email and password",
+		RequestBody: doc.Body(credentialsRequest{}),
+		Responses:   tokensResponses,
+	})
+	add(http.MethodPost, "/refresh", openapi.Operation{
+		Summary:     "Rotate a refresh token",
+		RequestBody: doc.Body(refreshRequest{}),
+		Responses:   tokensResponses,
+	})
+	add(http.MethodPost, "/password-reset", openapi.Operation{
+		Summary:     "Request a password reset token",
+		RequestBody: doc.Body(passwordResetRequest{}),
+		Responses: map[string]*openapi.Response{
+			"202": doc.StatusResponse("Requested, even for unknown emails"),
+			"400": doc.ErrorResponse("Invalid input"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPost, "/password-reset/confirm", openapi.Operation{
+		Summary:     "Reset the password with a reset token",
+		RequestBody: doc.Body(passwordResetConfirmRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": doc.StatusResponse("Password reset"),
+			"400": doc.StatusResponse("Invalid or expired token, or invalid password"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+}
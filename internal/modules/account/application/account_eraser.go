@@ -0,0 +1,31 @@
+package application
+
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+)
+
+// AccountEraser deletes the account (and its sessions) of a player whose personal data is erased.
+type AccountEraser interface {
+	ErasePlayerData(ctx context.Context, playerID string) error
+}
+
+func NewAccountEraser(accountRepository domain.AccountRepository, refreshTokenRepository domain.RefreshTokenRepository) AccountEraser {
+	return &accountService{
+		accountRepo: accountRepository,
+		refreshRepo: refreshTokenRepository,
+	}
+}
+
+// ErasePlayerData is idempotent, i.e. players without account are skipped.
+func (s *accountService) ErasePlayerData(ctx context.Context, playerID string) error {
+	account, err := s.accountRepo.FindByPlayerID(ctx, playerID)
+	if err != nil || len(account.ID) == 0 {
+		return err
+	}
+	if err := s.refreshRepo.DeleteByAccountID(ctx, account.ID); err != nil {
+		return err
+	}
+	return s.accountRepo.Delete(ctx, account.ID)
+}
@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccountEraser(t *testing.T) {
+	t.Run("Account and sessions deleted", func(t *testing.T) {
+		repo, refreshRepo := &mockAccountRepository{}, &mockRefreshTokenRepository{}
+		repo.On("FindByPlayerID", "player-id").Return(domain.Account{ID: "account-id", PlayerID: "player-id"}, nil)
+		refreshRepo.On("DeleteByAccountID", "account-id").Return(nil)
+		repo.On("Delete", "account-id").Return(nil)
+
+		assert.NoError(t, NewAccountEraser(repo, refreshRepo).ErasePlayerData(context.Background(), "player-id"))
+		repo.AssertExpectations(t)
+		refreshRepo.AssertExpectations(t)
+	})
+
+	t.Run("Player without account", func(t *testing.T) {
+		repo := &mockAccountRepository{}
+		repo.On("FindByPlayerID", "player-id").Return(domain.Account{}, nil)
+
+		assert.NoError(t, NewAccountEraser(repo, &mockRefreshTokenRepository{}).ErasePlayerData(context.Background(), "player-id"))
+		repo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+
+	t.Run("Account kept when sessions fail to be deleted", func(t *testing.T) {
+		repo, refreshRepo := &mockAccountRepository{}, &mockRefreshTokenRepository{}
+		repo.On("FindByPlayerID", "player-id").Return(domain.Account{ID: "account-id"}, nil)
+		refreshRepo.On("DeleteByAccountID", "account-id").Return(errors.New("delete error"))
+
+		assert.Error(t, NewAccountEraser(repo, refreshRepo).ErasePlayerData(context.Background(), "player-id"))
+		repo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+}
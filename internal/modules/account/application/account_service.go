@@ -0,0 +1,77 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+)
+
+const secretTokenBytes = 32
+
+// PasswordResetNotifier delivers reset tokens to players (e-mail in production, log or file locally).
+type PasswordResetNotifier interface {
+	NotifyPasswordReset(ctx context.Context, email, token string) error
+}
+
+// Tokens returned on login and refresh.
+type Tokens struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	TokenType    string `json:"tokenType"`
+	ExpiresIn    int64  `json:"expiresIn"`
+}
+
+type accountService struct {
+	accountRepo     domain.AccountRepository
+	refreshRepo     domain.RefreshTokenRepository
+	playerDirectory domain.PlayerDirectory
+	hasher          domain.PasswordHasher
+	issuer          auth.TokenIssuer
+	notifier        PasswordResetNotifier
+	policy          domain.LockoutPolicy
+	now             func() time.Time
+}
+
+// issueTokens signs an access token for the linked player, valid in the tenant of the account only, and stores a new
+// refresh token.
+func (s *accountService) issueTokens(ctx context.Context, account domain.Account) (Tokens, error) {
+	accessToken, expiresIn, err := s.issuer.Issue(account.PlayerID, account.Email, []auth.Role{auth.RolePlayer}, tenant.ID(ctx))
+	if err != nil {
+		return Tokens{}, err
+	}
+	refreshToken, err := generateSecretToken()
+	if err != nil {
+		return Tokens{}, err
+	}
+	if err := s.refreshRepo.Save(ctx, domain.NewRefreshToken(hashSecretToken(refreshToken), account.ID, s.now())); err != nil {
+		return Tokens{}, err
+	}
+	return Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(expiresIn.Seconds()),
+	}, nil
+}
+
+// generateSecretToken returns an opaque random token (refresh and password reset ones).
+func generateSecretToken() (string, error) {
+	b := make([]byte, secretTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSecretToken is the stored form of secret tokens, i.e. random tokens don't need a slow hash like passwords.
+func hashSecretToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
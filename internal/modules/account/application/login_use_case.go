@@ -0,0 +1,135 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+)
+
+type LoginUseCase interface {
+	LoginUseCase(ctx context.Context, email, password string) (tokens Tokens, status LoginStatus, err error)
+	RefreshTokensUseCase(ctx context.Context, refreshToken string) (tokens Tokens, status LoginStatus, err error)
+}
+
+type LoginStatus uint8
+
+const (
+	LoginPending LoginStatus = iota
+	LoginInvalid
+	LoginUnauthorized
+	LoginLocked
+	LoginSucceeded
+)
+
+// Implement the Stringer interface.
+func (s LoginStatus) String() string {
+	return [...]string{"LoginPending", "LoginInvalid", "LoginUnauthorized", "LoginLocked", "LoginSucceeded"}[s]
+}
+
+// AccountLockedError is returned along with LoginLocked so callers know when to retry.
+type AccountLockedError struct {
+	Until time.Time
+}
+
+func (e *AccountLockedError) Error() string {
+	return fmt.Sprintf("account locked until %s", e.Until.Format(time.RFC3339))
+}
+
+func NewLoginUseCase(accountRepository domain.AccountRepository, refreshTokenRepository domain.RefreshTokenRepository,
+	hasher domain.PasswordHasher, issuer auth.TokenIssuer, policy domain.LockoutPolicy) LoginUseCase {
+	return &accountService{
+		accountRepo: accountRepository,
+		refreshRepo: refreshTokenRepository,
+		hasher:      hasher,
+		issuer:      issuer,
+		policy:      policy,
+		now:         time.Now,
+	}
+}
+
+// LoginUseCase returns access and refresh tokens for valid credentials. Consecutive failures lock
+// the account according to the lockout policy.
+func (s *accountService) LoginUseCase(ctx context.Context, email, password string) (tokens Tokens,
+	status LoginStatus, err error) {
+	if err = domain.ValidateEmail(email); err != nil {
+		return tokens, LoginInvalid, err
+	}
+	if len(password) == 0 {
+		return tokens, LoginInvalid, errors.New("password cannot be empty")
+	}
+
+	account, err := s.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return tokens, LoginPending, err
+	}
+	// Unknown account and wrong password aren't distinguished.
+	if len(account.ID) == 0 {
+		return tokens, LoginUnauthorized, nil
+	}
+	now := s.now()
+	if account.IsLocked(now) {
+		return tokens, LoginLocked, &AccountLockedError{Until: *account.LockedUntil}
+	}
+
+	if err = s.hasher.Compare(account.PasswordHash, password); err != nil {
+		account.RegisterFailedLogin(now, s.policy)
+		if err = s.accountRepo.Upsert(ctx, &account); err != nil {
+			return tokens, LoginPending, err
+		}
+		return tokens, LoginUnauthorized, nil
+	}
+
+	if account.FailedLogins > 0 || account.LockedUntil != nil {
+		account.RegisterSuccessfulLogin()
+		if err = s.accountRepo.Upsert(ctx, &account); err != nil {
+			return tokens, LoginPending, err
+		}
+	}
+	if tokens, err = s.issueTokens(ctx, account); err != nil {
+		return tokens, LoginPending, err
+	}
+	return tokens, LoginSucceeded, nil
+}
+
+// RefreshTokensUseCase exchanges a refresh token for new tokens. Refresh tokens are single use (rotated).
+func (s *accountService) RefreshTokensUseCase(ctx context.Context, refreshToken string) (tokens Tokens,
+	status LoginStatus, err error) {
+	if len(refreshToken) == 0 {
+		return tokens, LoginInvalid, errors.New("refresh token cannot be empty")
+	}
+
+	tokenHash := hashSecretToken(refreshToken)
+	stored, err := s.refreshRepo.FindByID(ctx, tokenHash)
+	if err != nil {
+		return tokens, LoginPending, err
+	}
+	if len(stored.ID) == 0 {
+		return tokens, LoginUnauthorized, nil
+	}
+	if err = s.refreshRepo.Delete(ctx, tokenHash); err != nil {
+		return tokens, LoginPending, err
+	}
+	now := s.now()
+	if stored.IsExpired(now) {
+		return tokens, LoginUnauthorized, nil
+	}
+
+	account, err := s.accountRepo.FindByID(ctx, stored.AccountID)
+	if err != nil {
+		return tokens, LoginPending, err
+	}
+	if len(account.ID) == 0 {
+		return tokens, LoginUnauthorized, nil
+	}
+	if account.IsLocked(now) {
+		return tokens, LoginLocked, &AccountLockedError{Until: *account.LockedUntil}
+	}
+	if tokens, err = s.issueTokens(ctx, account); err != nil {
+		return tokens, LoginPending, err
+	}
+	return tokens, LoginSucceeded, nil
+}
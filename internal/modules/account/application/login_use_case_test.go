@@ -0,0 +1,171 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newLoginService() (*accountService, *mockAccountRepository, *mockRefreshTokenRepository) {
+	repo := &mockAccountRepository{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	policy := domain.LockoutPolicy{MaxFailedLogins: 2, LockDuration: time.Minute}
+	service := NewLoginUseCase(repo, refreshRepo, &mockPasswordHasher{}, &mockTokenIssuer{}, policy).(*accountService)
+	service.now = func() time.Time { return mockNow }
+	return service, repo, refreshRepo
+}
+
+func TestLoginUseCase(t *testing.T) {
+	storedAccount := domain.Account{ID: mockId, PlayerID: mockPlayerId, Email: mockEmail, PasswordHash: mockHash}
+
+	t.Run("Login succeeded", func(t *testing.T) {
+		// Arrange
+		service, repo, refreshRepo := newLoginService()
+		repo.On("FindByEmail", mockEmail).Return(storedAccount, nil)
+		refreshRepo.On("Save", mock.MatchedBy(func(token *domain.RefreshToken) bool {
+			return token.AccountID == mockId && len(token.ID) == 64
+		})).Return(nil)
+
+		// Act
+		tokens, status, err := service.LoginUseCase(context.Background(), mockEmail, mockPassword)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, LoginSucceeded, status)
+		assert.Equal(t, "access:"+mockPlayerId+"@"+tenant.Default, tokens.AccessToken)
+		assert.Equal(t, "Bearer", tokens.TokenType)
+		assert.Equal(t, int64(60), tokens.ExpiresIn)
+		assert.NotEmpty(t, tokens.RefreshToken)
+		refreshRepo.AssertExpectations(t)
+	})
+
+	t.Run("Tokens are valid in the tenant of the account only", func(t *testing.T) {
+		service, repo, refreshRepo := newLoginService()
+		repo.On("FindByEmail", mockEmail).Return(storedAccount, nil)
+		refreshRepo.On("Save", mock.Anything).Return(nil)
+
+		tokens, status, err := service.LoginUseCase(tenant.WithID(context.Background(), "fep"), mockEmail, mockPassword)
+		assert.NoError(t, err)
+		assert.Equal(t, LoginSucceeded, status)
+		assert.Equal(t, "access:"+mockPlayerId+"@fep", tokens.AccessToken)
+	})
+
+	t.Run("Unknown account", func(t *testing.T) {
+		service, repo, _ := newLoginService()
+		repo.On("FindByEmail", mockEmail).Return(domain.Account{}, nil)
+		_, status, err := service.LoginUseCase(context.Background(), mockEmail, mockPassword)
+		assert.NoError(t, err)
+		assert.Equal(t, LoginUnauthorized, status)
+	})
+
+	t.Run("Wrong password locks the account after policy limit", func(t *testing.T) {
+		service, repo, _ := newLoginService()
+		account := storedAccount
+		account.FailedLogins = 1
+		repo.On("FindByEmail", mockEmail).Return(account, nil)
+		repo.On("Upsert", mock.MatchedBy(func(a *domain.Account) bool {
+			return a.IsLocked(mockNow)
+		})).Return(nil)
+
+		_, status, err := service.LoginUseCase(context.Background(), mockEmail, "wrong-password")
+		assert.NoError(t, err)
+		assert.Equal(t, LoginUnauthorized, status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Locked account", func(t *testing.T) {
+		service, repo, _ := newLoginService()
+		account := storedAccount
+		lockedUntil := mockNow.Add(time.Minute)
+		account.LockedUntil = &lockedUntil
+		repo.On("FindByEmail", mockEmail).Return(account, nil)
+
+		_, status, err := service.LoginUseCase(context.Background(), mockEmail, mockPassword)
+		var lockedErr *AccountLockedError
+		assert.ErrorAs(t, err, &lockedErr)
+		assert.Equal(t, lockedUntil, lockedErr.Until)
+		assert.Equal(t, LoginLocked, status)
+	})
+
+	t.Run("Successful login clears failures", func(t *testing.T) {
+		service, repo, refreshRepo := newLoginService()
+		account := storedAccount
+		account.FailedLogins = 1
+		repo.On("FindByEmail", mockEmail).Return(account, nil)
+		repo.On("Upsert", mock.MatchedBy(func(a *domain.Account) bool {
+			return a.FailedLogins == 0
+		})).Return(nil)
+		refreshRepo.On("Save", mock.Anything).Return(nil)
+
+		_, status, err := service.LoginUseCase(context.Background(), mockEmail, mockPassword)
+		assert.NoError(t, err)
+		assert.Equal(t, LoginSucceeded, status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		service, _, _ := newLoginService()
+		_, status, err := service.LoginUseCase(context.Background(), mockEmail, "")
+		assert.Error(t, err)
+		assert.Equal(t, LoginInvalid, status)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		service, repo, _ := newLoginService()
+		repo.On("FindByEmail", mockEmail).Return(domain.Account{}, errors.New("repo error"))
+		_, status, err := service.LoginUseCase(context.Background(), mockEmail, mockPassword)
+		assert.EqualError(t, err, "repo error")
+		assert.Equal(t, LoginPending, status)
+	})
+}
+
+func TestRefreshTokensUseCase(t *testing.T) {
+	refreshToken := "refresh-token"
+	tokenHash := hashSecretToken(refreshToken)
+	storedAccount := domain.Account{ID: mockId, PlayerID: mockPlayerId, Email: mockEmail, PasswordHash: mockHash}
+
+	t.Run("Tokens rotated", func(t *testing.T) {
+		service, repo, refreshRepo := newLoginService()
+		refreshRepo.On("FindByID", tokenHash).Return(*domain.NewRefreshToken(tokenHash, mockId, mockNow), nil)
+		refreshRepo.On("Delete", tokenHash).Return(nil)
+		refreshRepo.On("Save", mock.Anything).Return(nil)
+		repo.On("FindByID", mockId).Return(storedAccount, nil)
+
+		tokens, status, err := service.RefreshTokensUseCase(context.Background(), refreshToken)
+		assert.NoError(t, err)
+		assert.Equal(t, LoginSucceeded, status)
+		assert.NotEqual(t, refreshToken, tokens.RefreshToken)
+		refreshRepo.AssertExpectations(t)
+	})
+
+	t.Run("Unknown refresh token", func(t *testing.T) {
+		service, _, refreshRepo := newLoginService()
+		refreshRepo.On("FindByID", tokenHash).Return(domain.RefreshToken{}, nil)
+		_, status, err := service.RefreshTokensUseCase(context.Background(), refreshToken)
+		assert.NoError(t, err)
+		assert.Equal(t, LoginUnauthorized, status)
+	})
+
+	t.Run("Expired refresh token", func(t *testing.T) {
+		service, _, refreshRepo := newLoginService()
+		expired := domain.RefreshToken{ID: tokenHash, AccountID: mockId, ExpiresAt: mockNow}
+		refreshRepo.On("FindByID", tokenHash).Return(expired, nil)
+		refreshRepo.On("Delete", tokenHash).Return(nil)
+		_, status, err := service.RefreshTokensUseCase(context.Background(), refreshToken)
+		assert.NoError(t, err)
+		assert.Equal(t, LoginUnauthorized, status)
+	})
+
+	t.Run("Empty refresh token", func(t *testing.T) {
+		service, _, _ := newLoginService()
+		_, status, err := service.RefreshTokensUseCase(context.Background(), "")
+		assert.Error(t, err)
+		assert.Equal(t, LoginInvalid, status)
+	})
+}
@@ -0,0 +1,100 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+)
+
+type PasswordResetUseCase interface {
+	RequestPasswordResetUseCase(ctx context.Context, email string) (status PasswordResetStatus, err error)
+	ResetPasswordUseCase(ctx context.Context, token, newPassword string) (status PasswordResetStatus, err error)
+}
+
+type PasswordResetStatus uint8
+
+const (
+	PasswordResetPending PasswordResetStatus = iota
+	PasswordResetInvalid
+	PasswordResetTokenInvalid
+	PasswordResetRequested
+	PasswordResetDone
+)
+
+// Implement the Stringer interface.
+func (s PasswordResetStatus) String() string {
+	return [...]string{"PasswordResetPending", "PasswordResetInvalid", "PasswordResetTokenInvalid", "PasswordResetRequested", "PasswordResetDone"}[s]
+}
+
+func NewPasswordResetUseCase(accountRepository domain.AccountRepository, refreshTokenRepository domain.RefreshTokenRepository,
+	hasher domain.PasswordHasher, notifier PasswordResetNotifier) PasswordResetUseCase {
+	return &accountService{
+		accountRepo: accountRepository,
+		refreshRepo: refreshTokenRepository,
+		hasher:      hasher,
+		notifier:    notifier,
+		now:         time.Now,
+	}
+}
+
+// RequestPasswordResetUseCase delivers a reset token through the notifier. Unknown emails are reported as
+// requested too so accounts can't be discovered.
+func (s *accountService) RequestPasswordResetUseCase(ctx context.Context, email string) (status PasswordResetStatus, err error) {
+	if err = domain.ValidateEmail(email); err != nil {
+		return PasswordResetInvalid, err
+	}
+	account, err := s.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return PasswordResetPending, err
+	}
+	if len(account.ID) == 0 {
+		return PasswordResetRequested, nil
+	}
+
+	token, err := generateSecretToken()
+	if err != nil {
+		return PasswordResetPending, err
+	}
+	account.RequestPasswordReset(hashSecretToken(token), s.now())
+	if err = s.accountRepo.Upsert(ctx, &account); err != nil {
+		return PasswordResetPending, err
+	}
+	if err = s.notifier.NotifyPasswordReset(ctx, account.Email, token); err != nil {
+		return PasswordResetPending, err
+	}
+	return PasswordResetRequested, nil
+}
+
+// ResetPasswordUseCase replaces the password and revokes every refresh token of the account.
+func (s *accountService) ResetPasswordUseCase(ctx context.Context, token, newPassword string) (status PasswordResetStatus, err error) {
+	if len(token) == 0 {
+		return PasswordResetInvalid, errors.New("reset token cannot be empty")
+	}
+	if err = domain.ValidatePassword(newPassword); err != nil {
+		return PasswordResetInvalid, err
+	}
+
+	account, err := s.accountRepo.FindByResetTokenHash(ctx, hashSecretToken(token))
+	if err != nil {
+		return PasswordResetPending, err
+	}
+	if len(account.ID) == 0 {
+		return PasswordResetTokenInvalid, nil
+	}
+	passwordHash, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		return PasswordResetPending, err
+	}
+	if err = account.ResetPassword(passwordHash, s.now()); err != nil {
+		return PasswordResetTokenInvalid, nil
+	}
+	if err = s.accountRepo.Upsert(ctx, &account); err != nil {
+		return PasswordResetPending, err
+	}
+	if err = s.refreshRepo.DeleteByAccountID(ctx, account.ID); err != nil {
+		return PasswordResetPending, err
+	}
+	return PasswordResetDone, nil
+}
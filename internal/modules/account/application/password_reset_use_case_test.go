@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newPasswordResetService() (*accountService, *mockAccountRepository, *mockRefreshTokenRepository, *mockNotifier) {
+	repo := &mockAccountRepository{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	notifier := &mockNotifier{}
+	service := NewPasswordResetUseCase(repo, refreshRepo, &mockPasswordHasher{}, notifier).(*accountService)
+	service.now = func() time.Time { return mockNow }
+	return service, repo, refreshRepo, notifier
+}
+
+func TestRequestPasswordResetUseCase(t *testing.T) {
+	t.Run("Reset token delivered", func(t *testing.T) {
+		// Arrange
+		service, repo, _, notifier := newPasswordResetService()
+		repo.On("FindByEmail", mockEmail).Return(domain.Account{ID: mockId, Email: mockEmail}, nil)
+		var deliveredToken string
+		notifier.On("NotifyPasswordReset", mockEmail, mock.Anything).Run(func(args mock.Arguments) {
+			deliveredToken = args.String(1)
+		}).Return(nil)
+		var storedHash string
+		repo.On("Upsert", mock.Anything).Run(func(args mock.Arguments) {
+			storedHash = args.Get(0).(*domain.Account).PasswordReset.TokenHash
+		}).Return(nil)
+
+		// Act
+		status, err := service.RequestPasswordResetUseCase(context.Background(), mockEmail)
+
+		// Assert: only the hash of the delivered token is stored.
+		assert.NoError(t, err)
+		assert.Equal(t, PasswordResetRequested, status)
+		assert.Equal(t, hashSecretToken(deliveredToken), storedHash)
+	})
+
+	t.Run("Unknown email is reported as requested", func(t *testing.T) {
+		service, repo, _, notifier := newPasswordResetService()
+		repo.On("FindByEmail", mockEmail).Return(domain.Account{}, nil)
+		status, err := service.RequestPasswordResetUseCase(context.Background(), mockEmail)
+		assert.NoError(t, err)
+		assert.Equal(t, PasswordResetRequested, status)
+		notifier.AssertNotCalled(t, "NotifyPasswordReset", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Notifier error", func(t *testing.T) {
+		service, repo, _, notifier := newPasswordResetService()
+		repo.On("FindByEmail", mockEmail).Return(domain.Account{ID: mockId, Email: mockEmail}, nil)
+		repo.On("Upsert", mock.Anything).Return(nil)
+		notifier.On("NotifyPasswordReset", mockEmail, mock.Anything).Return(errors.New("smtp error"))
+		status, err := service.RequestPasswordResetUseCase(context.Background(), mockEmail)
+		assert.EqualError(t, err, "smtp error")
+		assert.Equal(t, PasswordResetPending, status)
+	})
+
+	t.Run("Invalid email", func(t *testing.T) {
+		service, _, _, _ := newPasswordResetService()
+		status, err := service.RequestPasswordResetUseCase(context.Background(), "invalid")
+		assert.Error(t, err)
+		assert.Equal(t, PasswordResetInvalid, status)
+	})
+}
+
+func TestResetPasswordUseCase(t *testing.T) {
+	token := "reset-token"
+	newPassword := "new-s3cret-password"
+
+	t.Run("Password reset", func(t *testing.T) {
+		// Arrange
+		service, repo, refreshRepo, _ := newPasswordResetService()
+		account := domain.Account{ID: mockId, Email: mockEmail, PasswordHash: mockHash}
+		account.RequestPasswordReset(hashSecretToken(token), mockNow)
+		repo.On("FindByResetTokenHash", hashSecretToken(token)).Return(account, nil)
+		repo.On("Upsert", mock.MatchedBy(func(a *domain.Account) bool {
+			return a.PasswordHash == "hash:"+newPassword && a.PasswordReset == nil
+		})).Return(nil)
+		refreshRepo.On("DeleteByAccountID", mockId).Return(nil)
+
+		// Act
+		status, err := service.ResetPasswordUseCase(context.Background(), token, newPassword)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, PasswordResetDone, status)
+		repo.AssertExpectations(t)
+		refreshRepo.AssertExpectations(t)
+	})
+
+	t.Run("Unknown token", func(t *testing.T) {
+		service, repo, _, _ := newPasswordResetService()
+		repo.On("FindByResetTokenHash", hashSecretToken(token)).Return(domain.Account{}, nil)
+		status, err := service.ResetPasswordUseCase(context.Background(), token, newPassword)
+		assert.NoError(t, err)
+		assert.Equal(t, PasswordResetTokenInvalid, status)
+	})
+
+	t.Run("Expired token", func(t *testing.T) {
+		service, repo, _, _ := newPasswordResetService()
+		account := domain.Account{ID: mockId, Email: mockEmail, PasswordHash: mockHash}
+		account.RequestPasswordReset(hashSecretToken(token), mockNow.Add(-2*time.Hour))
+		repo.On("FindByResetTokenHash", hashSecretToken(token)).Return(account, nil)
+		status, err := service.ResetPasswordUseCase(context.Background(), token, newPassword)
+		assert.NoError(t, err)
+		assert.Equal(t, PasswordResetTokenInvalid, status)
+	})
+
+	t.Run("Invalid new password", func(t *testing.T) {
+		service, _, _, _ := newPasswordResetService()
+		status, err := service.ResetPasswordUseCase(context.Background(), token, "short")
+		assert.Error(t, err)
+		assert.Equal(t, PasswordResetInvalid, status)
+	})
+}
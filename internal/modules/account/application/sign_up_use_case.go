@@ -0,0 +1,77 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+)
+
+type SignUpUseCase interface {
+	SignUpUseCase(ctx context.Context, email, password string) (account domain.Account, status SignUpStatus, err error)
+}
+
+type SignUpStatus uint8
+
+const (
+	SignUpPending SignUpStatus = iota
+	SignUpInvalid
+	SignUpPlayerNotFound
+	SignUpAlreadyExists
+	SignUpCreated
+)
+
+// Implement the Stringer interface.
+func (s SignUpStatus) String() string {
+	return [...]string{"SignUpPending", "SignUpInvalid", "SignUpPlayerNotFound", "SignUpAlreadyExists", "SignUpCreated"}[s]
+}
+
+func NewSignUpUseCase(accountRepository domain.AccountRepository, playerDirectory domain.PlayerDirectory,
+	hasher domain.PasswordHasher) SignUpUseCase {
+	return &accountService{
+		accountRepo:     accountRepository,
+		playerDirectory: playerDirectory,
+		hasher:          hasher,
+		now:             time.Now,
+	}
+}
+
+// SignUpUseCase creates the account of a player already registered through POST /players.
+func (s *accountService) SignUpUseCase(ctx context.Context, email, password string) (account domain.Account,
+	status SignUpStatus, err error) {
+	if err = domain.ValidateEmail(email); err != nil {
+		return account, SignUpInvalid, err
+	}
+	if err = domain.ValidatePassword(password); err != nil {
+		return account, SignUpInvalid, err
+	}
+
+	existing, err := s.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return account, SignUpPending, err
+	}
+	if len(existing.ID) > 0 {
+		return account, SignUpAlreadyExists, nil
+	}
+
+	playerID, err := s.playerDirectory.FindPlayerIDByEmail(ctx, email)
+	if err != nil {
+		return account, SignUpPending, err
+	}
+	if len(playerID) == 0 {
+		return account, SignUpPlayerNotFound, nil
+	}
+
+	passwordHash, err := s.hasher.Hash(password)
+	if err != nil {
+		return account, SignUpPending, err
+	}
+	newAccount, err := domain.NewAccount(playerID, email, passwordHash, s.now())
+	if err != nil {
+		return account, SignUpInvalid, err
+	}
+	if err = s.accountRepo.Upsert(ctx, newAccount); err != nil {
+		return account, SignUpPending, err
+	}
+	return *newAccount, SignUpCreated, nil
+}
@@ -0,0 +1,188 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockId       = "mock-id"
+	mockPlayerId = "mock-player-id"
+	mockEmail    = "agus.tapia@gmail.com"
+	mockPassword = "s3cret-password"
+	mockHash     = "hash:" + mockPassword
+)
+
+var mockNow = time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+type mockAccountRepository struct {
+	mock.Mock
+}
+
+func (m *mockAccountRepository) Upsert(_ context.Context, account *domain.Account) error {
+	args := m.Called(account)
+	if account.ID == "" {
+		account.ID = mockId
+	}
+	return args.Error(0)
+}
+
+func (m *mockAccountRepository) FindByID(_ context.Context, id string) (domain.Account, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Account), args.Error(1)
+}
+
+func (m *mockAccountRepository) FindByEmail(_ context.Context, email string) (domain.Account, error) {
+	args := m.Called(email)
+	return args.Get(0).(domain.Account), args.Error(1)
+}
+
+func (m *mockAccountRepository) FindByPlayerID(_ context.Context, playerID string) (domain.Account, error) {
+	args := m.Called(playerID)
+	return args.Get(0).(domain.Account), args.Error(1)
+}
+
+func (m *mockAccountRepository) Delete(_ context.Context, id string) error {
+	return m.Called(id).Error(0)
+}
+
+func (m *mockAccountRepository) FindByResetTokenHash(_ context.Context, tokenHash string) (domain.Account, error) {
+	args := m.Called(tokenHash)
+	return args.Get(0).(domain.Account), args.Error(1)
+}
+
+type mockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+func (m *mockRefreshTokenRepository) Save(_ context.Context, token *domain.RefreshToken) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *mockRefreshTokenRepository) FindByID(_ context.Context, tokenHash string) (domain.RefreshToken, error) {
+	args := m.Called(tokenHash)
+	return args.Get(0).(domain.RefreshToken), args.Error(1)
+}
+
+func (m *mockRefreshTokenRepository) Delete(_ context.Context, tokenHash string) error {
+	args := m.Called(tokenHash)
+	return args.Error(0)
+}
+
+func (m *mockRefreshTokenRepository) DeleteByAccountID(_ context.Context, accountID string) error {
+	args := m.Called(accountID)
+	return args.Error(0)
+}
+
+type mockPlayerDirectory struct {
+	mock.Mock
+}
+
+func (m *mockPlayerDirectory) FindPlayerIDByEmail(_ context.Context, email string) (string, error) {
+	args := m.Called(email)
+	return args.String(0), args.Error(1)
+}
+
+// Deterministic hasher, i.e. hash is the password with a prefix.
+type mockPasswordHasher struct{}
+
+func (m *mockPasswordHasher) Hash(password string) (string, error) {
+	return "hash:" + password, nil
+}
+
+func (m *mockPasswordHasher) Compare(passwordHash, password string) error {
+	if passwordHash != "hash:"+password {
+		return errors.New("password mismatch")
+	}
+	return nil
+}
+
+type mockTokenIssuer struct{}
+
+func (m *mockTokenIssuer) Issue(subject, email string, roles []auth.Role, tenantID string) (string, time.Duration, error) {
+	return "access:" + subject + "@" + tenantID, time.Minute, nil
+}
+
+type mockNotifier struct {
+	mock.Mock
+}
+
+func (m *mockNotifier) NotifyPasswordReset(_ context.Context, email, token string) error {
+	args := m.Called(email, token)
+	return args.Error(0)
+}
+
+func TestSignUpUseCase(t *testing.T) {
+	newService := func() (*accountService, *mockAccountRepository, *mockPlayerDirectory) {
+		repo := &mockAccountRepository{}
+		directory := &mockPlayerDirectory{}
+		service := NewSignUpUseCase(repo, directory, &mockPasswordHasher{}).(*accountService)
+		service.now = func() time.Time { return mockNow }
+		return service, repo, directory
+	}
+
+	t.Run("Account created", func(t *testing.T) {
+		// Arrange
+		service, repo, directory := newService()
+		repo.On("FindByEmail", mockEmail).Return(domain.Account{}, nil)
+		directory.On("FindPlayerIDByEmail", mockEmail).Return(mockPlayerId, nil)
+		repo.On("Upsert", mock.Anything).Return(nil)
+
+		// Act
+		account, status, err := service.SignUpUseCase(context.Background(), mockEmail, mockPassword)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, SignUpCreated, status)
+		assert.Equal(t, domain.Account{ID: mockId, PlayerID: mockPlayerId, Email: mockEmail, PasswordHash: mockHash, CreatedAt: mockNow}, account)
+	})
+
+	t.Run("Invalid password", func(t *testing.T) {
+		service, _, _ := newService()
+		_, status, err := service.SignUpUseCase(context.Background(), mockEmail, "short")
+		assert.Error(t, err)
+		assert.Equal(t, SignUpInvalid, status)
+	})
+
+	t.Run("Invalid email", func(t *testing.T) {
+		service, _, _ := newService()
+		_, status, err := service.SignUpUseCase(context.Background(), "invalid", mockPassword)
+		assert.EqualError(t, err, "invalid email: invalid")
+		assert.Equal(t, SignUpInvalid, status)
+	})
+
+	t.Run("Account already exists", func(t *testing.T) {
+		service, repo, _ := newService()
+		repo.On("FindByEmail", mockEmail).Return(domain.Account{ID: mockId}, nil)
+		_, status, err := service.SignUpUseCase(context.Background(), mockEmail, mockPassword)
+		assert.NoError(t, err)
+		assert.Equal(t, SignUpAlreadyExists, status)
+	})
+
+	t.Run("Player not registered", func(t *testing.T) {
+		service, repo, directory := newService()
+		repo.On("FindByEmail", mockEmail).Return(domain.Account{}, nil)
+		directory.On("FindPlayerIDByEmail", mockEmail).Return("", nil)
+		_, status, err := service.SignUpUseCase(context.Background(), mockEmail, mockPassword)
+		assert.NoError(t, err)
+		assert.Equal(t, SignUpPlayerNotFound, status)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		service, repo, directory := newService()
+		repo.On("FindByEmail", mockEmail).Return(domain.Account{}, nil)
+		directory.On("FindPlayerIDByEmail", mockEmail).Return(mockPlayerId, nil)
+		repo.On("Upsert", mock.Anything).Return(errors.New("repo error"))
+		_, status, err := service.SignUpUseCase(context.Background(), mockEmail, mockPassword)
+		assert.EqualError(t, err, "repo error")
+		assert.Equal(t, SignUpPending, status)
+	})
+}
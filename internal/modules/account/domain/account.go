@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"time"
+)
+
+const (
+	minPasswordDigits = 8
+	maxPasswordDigits = 72 // bcrypt ignores bytes after 72.
+	minIdDigits       = 3
+	maxFailedLogins   = 5
+	lockDuration      = 15 * time.Minute
+	resetTokenTTL     = time.Hour
+	refreshTokenTTL   = 30 * 24 * time.Hour
+)
+
+// Account of a player registered in the player-couple module (linked by PlayerID).
+// Secrets and lockout state are never exposed through JSON.
+type Account struct {
+	ID            string         `bson:"_id" json:"id"`
+	PlayerID      string         `bson:"playerId" json:"playerId"`
+	Email         string         `bson:"email" json:"email"`
+	PasswordHash  string         `bson:"passwordHash" json:"-"`
+	FailedLogins  int            `bson:"failedLogins" json:"-"`
+	LockedUntil   *time.Time     `bson:"lockedUntil,omitempty" json:"-"`
+	PasswordReset *PasswordReset `bson:"passwordReset,omitempty" json:"-"`
+	CreatedAt     time.Time      `bson:"createdAt" json:"createdAt"`
+}
+
+// PasswordReset keeps only the hash of the token delivered to the player.
+type PasswordReset struct {
+	TokenHash string    `bson:"tokenHash"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+// RefreshToken is stored by hash (used as ID) so a leaked collection can't be used to refresh sessions.
+type RefreshToken struct {
+	ID        string    `bson:"_id"`
+	AccountID string    `bson:"accountId"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+// LockoutPolicy locks an account for LockDuration once MaxFailedLogins consecutive failures are reached.
+type LockoutPolicy struct {
+	MaxFailedLogins int
+	LockDuration    time.Duration
+}
+
+func DefaultLockoutPolicy() LockoutPolicy {
+	return LockoutPolicy{MaxFailedLogins: maxFailedLogins, LockDuration: lockDuration}
+}
+
+func NewAccount(playerID, email, passwordHash string, now time.Time) (*Account, error) {
+	if len(playerID) < minIdDigits {
+		return nil, fmt.Errorf("invalid player id: %s", playerID)
+	}
+	if err := ValidateEmail(email); err != nil {
+		return nil, err
+	}
+	if len(passwordHash) == 0 {
+		return nil, fmt.Errorf("password hash cannot be empty")
+	}
+	return &Account{
+		//ID:       auto generated ID set in the repository.
+		PlayerID:     playerID,
+		Email:        email,
+		PasswordHash: passwordHash,
+		CreatedAt:    now,
+	}, nil
+}
+
+func NewRefreshToken(tokenHash, accountID string, now time.Time) *RefreshToken {
+	return &RefreshToken{
+		ID:        tokenHash,
+		AccountID: accountID,
+		ExpiresAt: now.Add(refreshTokenTTL),
+	}
+}
+
+func ValidateEmail(email string) error {
+	if _, err := mail.ParseAddress(email); err != nil {
+		return fmt.Errorf("invalid email: %s", email)
+	}
+	return nil
+}
+
+func ValidatePassword(password string) error {
+	if len(password) < minPasswordDigits || len(password) > maxPasswordDigits {
+		return fmt.Errorf("password must have between %d and %d characters", minPasswordDigits, maxPasswordDigits)
+	}
+	return nil
+}
+
+// IsLocked returns true while the lockout period isn't over.
+func (a *Account) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && now.Before(*a.LockedUntil)
+}
+
+// RegisterFailedLogin counts a failed login and locks the account when the policy limit is reached.
+func (a *Account) RegisterFailedLogin(now time.Time, policy LockoutPolicy) {
+	a.FailedLogins++
+	if a.FailedLogins >= policy.MaxFailedLogins {
+		lockedUntil := now.Add(policy.LockDuration)
+		a.LockedUntil = &lockedUntil
+		a.FailedLogins = 0
+	}
+}
+
+// RegisterSuccessfulLogin clears the lockout state.
+func (a *Account) RegisterSuccessfulLogin() {
+	a.FailedLogins = 0
+	a.LockedUntil = nil
+}
+
+// RequestPasswordReset keeps the hash of a new reset token, replacing any previous one.
+func (a *Account) RequestPasswordReset(tokenHash string, now time.Time) {
+	a.PasswordReset = &PasswordReset{TokenHash: tokenHash, ExpiresAt: now.Add(resetTokenTTL)}
+}
+
+// ResetPassword replaces the password hash if the reset token is still valid. A successful reset
+// also unlocks the account as the player proved the ownership of the email.
+func (a *Account) ResetPassword(passwordHash string, now time.Time) error {
+	if a.PasswordReset == nil || !now.Before(a.PasswordReset.ExpiresAt) {
+		return fmt.Errorf("password reset token expired")
+	}
+	a.PasswordHash = passwordHash
+	a.PasswordReset = nil
+	a.RegisterSuccessfulLogin()
+	return nil
+}
+
+// IsExpired returns true once the refresh token can't be used anymore.
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	mockPlayerId = "mock-player-id"
+	mockHash     = "mock-hash"
+)
+
+var now = time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+func TestNewAccount_Success(t *testing.T) {
+	account, err := NewAccount(mockPlayerId, "agus.tapia@gmail.com", mockHash, now)
+
+	assert.NoError(t, err, "Expected no error for valid inputs")
+	assert.Equal(t, "", account.ID, "Expected ID to be empty")
+	assert.Equal(t, mockPlayerId, account.PlayerID)
+	assert.Equal(t, "agus.tapia@gmail.com", account.Email)
+	assert.Equal(t, mockHash, account.PasswordHash)
+	assert.Equal(t, now, account.CreatedAt)
+}
+
+func TestNewAccount_Fail(t *testing.T) {
+	_, err := NewAccount("1", "agus.tapia@gmail.com", mockHash, now)
+	assert.EqualError(t, err, "invalid player id: 1")
+
+	_, err = NewAccount(mockPlayerId, "agustapia", mockHash, now)
+	assert.EqualError(t, err, "invalid email: agustapia")
+
+	_, err = NewAccount(mockPlayerId, "agus.tapia@gmail.com", "", now)
+	assert.EqualError(t, err, "password hash cannot be empty")
+}
+
+func TestValidatePassword(t *testing.T) {
+	assert.Error(t, ValidatePassword("short"), "Expected error for short password")
+	assert.Error(t, ValidatePassword(string(make([]byte, 73))), "Expected error for password longer than bcrypt limit")
+	assert.NoError(t, ValidatePassword("long-enough"))
+}
+
+func TestAccount_Lockout(t *testing.T) {
+	account, _ := NewAccount(mockPlayerId, "agus.tapia@gmail.com", mockHash, now)
+	policy := LockoutPolicy{MaxFailedLogins: 3, LockDuration: time.Minute}
+
+	account.RegisterFailedLogin(now, policy)
+	account.RegisterFailedLogin(now, policy)
+	assert.False(t, account.IsLocked(now), "Expected account unlocked before reaching the limit")
+
+	account.RegisterFailedLogin(now, policy)
+	assert.True(t, account.IsLocked(now), "Expected account locked once the limit is reached")
+	assert.False(t, account.IsLocked(now.Add(time.Minute)), "Expected account unlocked after lock duration")
+
+	account.RegisterSuccessfulLogin()
+	assert.False(t, account.IsLocked(now))
+	assert.Equal(t, 0, account.FailedLogins)
+}
+
+func TestAccount_ResetPassword(t *testing.T) {
+	account, _ := NewAccount(mockPlayerId, "agus.tapia@gmail.com", mockHash, now)
+
+	err := account.ResetPassword("new-hash", now)
+	assert.EqualError(t, err, "password reset token expired", "Expected error without reset request")
+
+	account.RegisterFailedLogin(now, LockoutPolicy{MaxFailedLogins: 1, LockDuration: time.Hour})
+	account.RequestPasswordReset("token-hash", now)
+	assert.Equal(t, "token-hash", account.PasswordReset.TokenHash)
+
+	err = account.ResetPassword("new-hash", now.Add(resetTokenTTL))
+	assert.Error(t, err, "Expected error for expired reset token")
+
+	err = account.ResetPassword("new-hash", now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, "new-hash", account.PasswordHash)
+	assert.Nil(t, account.PasswordReset)
+	assert.False(t, account.IsLocked(now), "Expected reset to unlock the account")
+}
+
+func TestRefreshToken_IsExpired(t *testing.T) {
+	token := NewRefreshToken("token-hash", "account-id", now)
+
+	assert.False(t, token.IsExpired(now))
+	assert.True(t, token.IsExpired(now.Add(refreshTokenTTL)))
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "context"
+
+// interfaces to be used by infrastructure layer:
+type AccountRepository interface {
+	Upsert(ctx context.Context, account *Account) error
+	FindByID(ctx context.Context, id string) (Account, error)
+	FindByEmail(ctx context.Context, email string) (Account, error)
+	FindByResetTokenHash(ctx context.Context, tokenHash string) (Account, error)
+	FindByPlayerID(ctx context.Context, playerID string) (Account, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type RefreshTokenRepository interface {
+	Save(ctx context.Context, token *RefreshToken) error
+	FindByID(ctx context.Context, tokenHash string) (RefreshToken, error)
+	Delete(ctx context.Context, tokenHash string) error
+	DeleteByAccountID(ctx context.Context, accountID string) error
+}
+
+// PlayerDirectory resolves players registered in the player-couple module (anti-corruption layer),
+// i.e. an account can only be created for an existing player.
+type PlayerDirectory interface {
+	FindPlayerIDByEmail(ctx context.Context, email string) (string, error)
+}
+
+// PasswordHasher hides the hashing algorithm (e.g. bcrypt) from the domain.
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Compare(passwordHash, password string) error
+}
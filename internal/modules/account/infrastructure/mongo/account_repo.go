@@ -0,0 +1,167 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	timeout              = 5 * time.Second
+	accountsColName      = "accounts"
+	refreshTokensColName = "refresh_tokens"
+	playersColName       = "players"
+)
+
+type mongoAccountRepository struct {
+	idGen      utils.IDGenerator
+	collection *common.TenantCollection
+}
+
+type mongoRefreshTokenRepository struct {
+	collection *common.TenantCollection
+}
+
+type mongoPlayerDirectory struct {
+	collection *common.TenantCollection
+}
+
+func NewMongoAccountRepository(idGen utils.IDGenerator, client common.MongoClient) domain.AccountRepository {
+	return &mongoAccountRepository{
+		idGen:      idGen,
+		collection: common.NewTenantCollection(client, accountsColName),
+	}
+}
+
+func (r *mongoAccountRepository) Upsert(ctx context.Context, account *domain.Account) error {
+	if account == nil {
+		return errors.New("account is nil")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// DDD repository principle.
+	if len(account.ID) > 0 {
+		// Replace instead of $set so cleared lockout and reset fields (omitempty) are removed.
+		_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": account.ID}, account)
+		return err
+	}
+	account.ID = r.idGen.GenerateID()
+	_, err := r.collection.InsertOne(ctx, account)
+	if err != nil {
+		account.ID = ""
+	}
+	return err
+}
+
+func (r *mongoAccountRepository) FindByID(ctx context.Context, id string) (domain.Account, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *mongoAccountRepository) FindByEmail(ctx context.Context, email string) (domain.Account, error) {
+	return r.findOne(ctx, bson.M{"email": email})
+}
+
+func (r *mongoAccountRepository) FindByResetTokenHash(ctx context.Context, tokenHash string) (domain.Account, error) {
+	return r.findOne(ctx, bson.M{"passwordReset.tokenHash": tokenHash})
+}
+
+func (r *mongoAccountRepository) FindByPlayerID(ctx context.Context, playerID string) (domain.Account, error) {
+	return r.findOne(ctx, bson.M{"playerId": playerID})
+}
+
+func (r *mongoAccountRepository) Delete(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *mongoAccountRepository) findOne(ctx context.Context, filter bson.M) (domain.Account, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var account domain.Account
+	err := r.collection.FindOne(ctx, filter).Decode(&account)
+	if mongo.ErrNoDocuments == err {
+		return account, nil
+	}
+	return account, err
+}
+
+func NewMongoRefreshTokenRepository(client common.MongoClient) domain.RefreshTokenRepository {
+	return &mongoRefreshTokenRepository{
+		collection: common.NewTenantCollection(client, refreshTokensColName),
+	}
+}
+
+func (r *mongoRefreshTokenRepository) Save(ctx context.Context, token *domain.RefreshToken) error {
+	if token == nil {
+		return errors.New("refresh token is nil")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The ID is the token hash, i.e. generated by the application layer.
+	_, err := r.collection.InsertOne(ctx, token)
+	return err
+}
+
+func (r *mongoRefreshTokenRepository) FindByID(ctx context.Context, tokenHash string) (domain.RefreshToken, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var token domain.RefreshToken
+	err := r.collection.FindOne(ctx, bson.M{"_id": tokenHash}).Decode(&token)
+	if mongo.ErrNoDocuments == err {
+		return token, nil
+	}
+	return token, err
+}
+
+func (r *mongoRefreshTokenRepository) Delete(ctx context.Context, tokenHash string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": tokenHash})
+	return err
+}
+
+func (r *mongoRefreshTokenRepository) DeleteByAccountID(ctx context.Context, accountID string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := r.collection.DeleteMany(ctx, bson.M{"accountId": accountID})
+	return err
+}
+
+// NewMongoPlayerDirectory reads the players collection owned by the player-couple module, only the ID is
+// projected so the account module doesn't depend on the player model.
+func NewMongoPlayerDirectory(client common.MongoClient) domain.PlayerDirectory {
+	return &mongoPlayerDirectory{
+		collection: common.NewTenantCollection(client, playersColName),
+	}
+}
+
+func (d *mongoPlayerDirectory) FindPlayerIDByEmail(ctx context.Context, email string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var player struct {
+		ID string `bson:"_id"`
+	}
+	err := d.collection.FindOne(ctx, bson.M{"email": email},
+		options.FindOne().SetProjection(bson.M{"_id": 1})).Decode(&player)
+	if mongo.ErrNoDocuments == err {
+		return "", nil
+	}
+	return player.ID, err
+}
@@ -0,0 +1,223 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+const (
+	testDbName          = "testdb"
+	testAccountsNs      = testDbName + "." + accountsColName
+	testRefreshTokensNs = testDbName + "." + refreshTokensColName
+	testPlayersNs       = testDbName + "." + playersColName
+	mockId              = "mock-id"
+	mockEmail           = "agus.tapia@gmail.com"
+)
+
+type idGenMock struct {
+}
+
+func (i *idGenMock) GenerateID() string {
+	return mockId
+}
+
+func (i *idGenMock) GenerateIDWithPrefixes(prefix1 string, prefix2 string) string {
+	return prefix1 + "-" + prefix2 + "-" + mockId
+}
+
+func newIdGenMock() utils.IDGenerator {
+	return &idGenMock{}
+}
+
+type mongoClientMock struct {
+	database *mongo.Database
+}
+
+func (m *mongoClientMock) GetCollection(_ context.Context, collectionName string) *mongo.Collection {
+	return m.database.Collection(collectionName)
+}
+
+func (m *mongoClientMock) Close() error {
+	return nil
+}
+
+func newMongoClientMock(client *mongo.Client) common.MongoClient {
+	return &mongoClientMock{database: client.Database(testDbName)}
+}
+
+func TestMongoAccountRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	mt.Run("Save account", func(mt *mtest.T) {
+		repo := NewMongoAccountRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		account, err := domain.NewAccount("player-id", mockEmail, "hash", now)
+		assert.NoError(t, err)
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		err = repo.Upsert(context.Background(), account)
+		assert.NoError(t, err)
+		assert.Equal(t, mockId, account.ID)
+	})
+
+	mt.Run("Update account", func(mt *mtest.T) {
+		repo := NewMongoAccountRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		account := &domain.Account{ID: mockId, Email: mockEmail}
+
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}})
+		assert.NoError(t, repo.Upsert(context.Background(), account))
+		assert.Equal(t, "update", mt.GetStartedEvent().CommandName)
+	})
+
+	mt.Run("Save nil account", func(mt *mtest.T) {
+		repo := NewMongoAccountRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		assert.EqualError(t, repo.Upsert(context.Background(), nil), "account is nil")
+	})
+
+	mt.Run("Find account by reset token hash", func(mt *mtest.T) {
+		repo := NewMongoAccountRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testAccountsNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockId},
+			{Key: "email", Value: mockEmail},
+			{Key: "passwordReset", Value: bson.D{{Key: "tokenHash", Value: "token-hash"}, {Key: "expiresAt", Value: now}}},
+		}))
+
+		account, err := repo.FindByResetTokenHash(context.Background(), "token-hash")
+		assert.NoError(t, err)
+		assert.Equal(t, mockId, account.ID)
+		assert.Equal(t, "token-hash", account.PasswordReset.TokenHash)
+	})
+
+	mt.Run("Find account by player ID", func(mt *mtest.T) {
+		repo := NewMongoAccountRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testAccountsNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockId},
+			{Key: "playerId", Value: "player-id"},
+		}))
+
+		account, err := repo.FindByPlayerID(context.Background(), "player-id")
+		assert.NoError(t, err)
+		assert.Equal(t, mockId, account.ID)
+	})
+
+	mt.Run("Delete account", func(mt *mtest.T) {
+		repo := NewMongoAccountRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		assert.NoError(t, repo.Delete(context.Background(), mockId))
+		assert.Equal(t, "delete", mt.GetStartedEvent().CommandName)
+	})
+
+	mt.Run("Account not found", func(mt *mtest.T) {
+		repo := NewMongoAccountRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testAccountsNs, mtest.FirstBatch))
+
+		account, err := repo.FindByEmail(context.Background(), mockEmail)
+		assert.NoError(t, err)
+		assert.Empty(t, account.ID)
+	})
+}
+
+func TestMongoRefreshTokenRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	mt.Run("Save and find refresh token", func(mt *mtest.T) {
+		repo := NewMongoRefreshTokenRepository(newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Save(context.Background(), domain.NewRefreshToken("token-hash", mockId, now)))
+
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testRefreshTokensNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "token-hash"},
+			{Key: "accountId", Value: mockId},
+			{Key: "expiresAt", Value: now},
+		}))
+		token, err := repo.FindByID(context.Background(), "token-hash")
+		assert.NoError(t, err)
+		assert.Equal(t, mockId, token.AccountID)
+	})
+
+	mt.Run("Delete refresh tokens of an account", func(mt *mtest.T) {
+		repo := NewMongoRefreshTokenRepository(newMongoClientMock(mt.Client))
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 2}})
+		assert.NoError(t, repo.DeleteByAccountID(context.Background(), mockId))
+		assert.Equal(t, "delete", mt.GetStartedEvent().CommandName)
+	})
+
+	mt.Run("Refresh tokens are of the tenant of the account", func(mt *mtest.T) {
+		repo := NewMongoRefreshTokenRepository(newMongoClientMock(mt.Client))
+		ctx := tenant.WithID(context.Background(), "fep")
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Save(ctx, domain.NewRefreshToken("token-hash", mockId, now)))
+		inserted := mt.GetStartedEvent().Command.Lookup("documents").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", inserted.Lookup(common.TenantField).StringValue())
+
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testRefreshTokensNs, mtest.FirstBatch))
+		token, err := repo.FindByID(ctx, "token-hash")
+		assert.NoError(t, err)
+		assert.Empty(t, token.ID)
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, "fep", filter.Lookup(common.TenantField).StringValue())
+	})
+}
+
+func TestMongoPlayerDirectory(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Player found", func(mt *mtest.T) {
+		directory := NewMongoPlayerDirectory(newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testPlayersNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "player-id"},
+		}))
+
+		playerID, err := directory.FindPlayerIDByEmail(context.Background(), mockEmail)
+		assert.NoError(t, err)
+		assert.Equal(t, "player-id", playerID)
+	})
+
+	mt.Run("Player not found", func(mt *mtest.T) {
+		directory := NewMongoPlayerDirectory(newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testPlayersNs, mtest.FirstBatch))
+
+		playerID, err := directory.FindPlayerIDByEmail(context.Background(), mockEmail)
+		assert.NoError(t, err)
+		assert.Empty(t, playerID)
+	})
+
+	mt.Run("Players of other tenants are never linked", func(mt *mtest.T) {
+		directory := NewMongoPlayerDirectory(newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testPlayersNs, mtest.FirstBatch))
+
+		_, err := directory.FindPlayerIDByEmail(tenant.WithID(context.Background(), "fep"), mockEmail)
+		assert.NoError(t, err)
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, "fep", filter.Lookup(common.TenantField).StringValue())
+	})
+}
+
+func TestMigrations(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Accounts and refresh tokens stored before tenants are of the default tenant", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+		assert.NoError(t, Migrations()[0].Up(context.Background(), newMongoClientMock(mt.Client)))
+
+		for _, colName := range []string{accountsColName, refreshTokensColName} {
+			event := mt.GetStartedEvent()
+			assert.Equal(t, colName, event.Command.Lookup("update").StringValue())
+			update := event.Command.Lookup("updates").Array().Index(0).Value().Document()
+			assert.Equal(t, tenant.Default, update.Lookup("u", "$set", common.TenantField).StringValue())
+		}
+	})
+}
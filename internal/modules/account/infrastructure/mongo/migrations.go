@@ -0,0 +1,14 @@
+package mongo
+
+import "github.com/paguerre3/goddd/internal/modules/common/migration"
+
+// Migrations of the accounts and refresh_tokens collections.
+func Migrations() []migration.Migration {
+	return []migration.Migration{
+		{
+			Version:     2026101910,
+			Description: "Assign accounts and refresh_tokens to the default tenant",
+			Up:          migration.AssignTenant(accountsColName, refreshTokensColName),
+		},
+	}
+}
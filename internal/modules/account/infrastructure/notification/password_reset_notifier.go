@@ -0,0 +1,83 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/account/application"
+)
+
+const (
+	notifierEnv     = "PASSWORD_RESET_NOTIFIER"
+	notifierFileEnv = "PASSWORD_RESET_FILE"
+	defaultFile     = "password_resets.jsonl"
+)
+
+// Mockable for testing.
+var getEnv = os.Getenv
+
+type logNotifier struct{}
+
+type fileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+type passwordResetRecord struct {
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NewPasswordResetNotifier selects the notifier using PASSWORD_RESET_NOTIFIER ("log" by default or "file").
+// E-mail delivery is expected to be plugged in as another implementation.
+func NewPasswordResetNotifier() (application.PasswordResetNotifier, error) {
+	switch kind := getEnv(notifierEnv); kind {
+	case "", "log":
+		return &logNotifier{}, nil
+	case "file":
+		path := getEnv(notifierFileEnv)
+		if len(path) == 0 {
+			path = defaultFile
+		}
+		return NewFileNotifier(path), nil
+	default:
+		return nil, fmt.Errorf("unsupported password reset notifier: %s", kind)
+	}
+}
+
+// NewLogNotifier logs reset tokens, only meant for local development.
+func NewLogNotifier() application.PasswordResetNotifier {
+	return &logNotifier{}
+}
+
+func (n *logNotifier) NotifyPasswordReset(_ context.Context, email, token string) error {
+	log.Printf("password reset requested for %s, token: %s", email, token)
+	return nil
+}
+
+// NewFileNotifier appends reset tokens as JSON lines to the given file (e.g. to be picked up by a mailer).
+func NewFileNotifier(path string) application.PasswordResetNotifier {
+	return &fileNotifier{path: path}
+}
+
+func (n *fileNotifier) NotifyPasswordReset(_ context.Context, email, token string) error {
+	line, err := json.Marshal(passwordResetRecord{Email: email, Token: token, CreatedAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,62 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mockEnv(t *testing.T, values map[string]string) {
+	original := getEnv
+	getEnv = func(key string) string { return values[key] }
+	t.Cleanup(func() { getEnv = original })
+}
+
+func TestNewPasswordResetNotifier(t *testing.T) {
+	t.Run("Log notifier by default", func(t *testing.T) {
+		mockEnv(t, map[string]string{})
+		notifier, err := NewPasswordResetNotifier()
+		assert.NoError(t, err)
+		assert.IsType(t, &logNotifier{}, notifier)
+	})
+
+	t.Run("File notifier", func(t *testing.T) {
+		mockEnv(t, map[string]string{notifierEnv: "file", notifierFileEnv: "resets.jsonl"})
+		notifier, err := NewPasswordResetNotifier()
+		assert.NoError(t, err)
+		assert.Equal(t, "resets.jsonl", notifier.(*fileNotifier).path)
+	})
+
+	t.Run("Unsupported notifier", func(t *testing.T) {
+		mockEnv(t, map[string]string{notifierEnv: "pigeon"})
+		_, err := NewPasswordResetNotifier()
+		assert.EqualError(t, err, "unsupported password reset notifier: pigeon")
+	})
+}
+
+func TestFileNotifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resets.jsonl")
+	notifier := NewFileNotifier(path)
+
+	assert.NoError(t, notifier.NotifyPasswordReset(context.Background(), "a@b.com", "token-1"))
+	assert.NoError(t, notifier.NotifyPasswordReset(context.Background(), "c@d.com", "token-2"))
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+	var records []passwordResetRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record passwordResetRecord
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	assert.Len(t, records, 2)
+	assert.Equal(t, "c@d.com", records[1].Email)
+	assert.Equal(t, "token-2", records[1].Token)
+}
@@ -0,0 +1,27 @@
+package security
+
+import (
+	"github.com/paguerre3/goddd/internal/modules/account/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type bcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher returns a domain.PasswordHasher, cost below bcrypt.MinCost falls back to bcrypt.DefaultCost.
+func NewBcryptHasher(cost int) domain.PasswordHasher {
+	if cost < bcrypt.MinCost {
+		cost = bcrypt.DefaultCost
+	}
+	return &bcryptHasher{cost: cost}
+}
+
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	return string(hash), err
+}
+
+func (h *bcryptHasher) Compare(passwordHash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password))
+}
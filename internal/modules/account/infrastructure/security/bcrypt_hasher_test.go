@@ -0,0 +1,18 @@
+package security
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestBcryptHasher(t *testing.T) {
+	hasher := NewBcryptHasher(bcrypt.MinCost)
+
+	hash, err := hasher.Hash("s3cret-password")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "s3cret-password", hash)
+	assert.NoError(t, hasher.Compare(hash, "s3cret-password"))
+	assert.Error(t, hasher.Compare(hash, "wrong-password"))
+}
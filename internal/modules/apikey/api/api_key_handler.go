@@ -0,0 +1,92 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/apikey/application"
+	"github.com/paguerre3/goddd/internal/modules/apikey/domain"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+)
+
+type APIKeyHandler struct {
+	manageAPIKeyUseCase application.ManageAPIKeyUseCase
+}
+
+type createAPIKeyRequest struct {
+	Club   string         `json:"club"`
+	Scopes []domain.Scope `json:"scopes"`
+}
+
+// createAPIKeyResponse is the only response holding the plain key.
+type createAPIKeyResponse struct {
+	domain.APIKey
+	Key string `json:"key"`
+}
+
+func NewAPIKeyHandler(manageAPIKeyUseCase application.ManageAPIKeyUseCase) *APIKeyHandler {
+	return &APIKeyHandler{manageAPIKeyUseCase: manageAPIKeyUseCase}
+}
+
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	var req createAPIKeyRequest
+	if err := web.Bind(c, &req); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	apiKey, key, status, err := h.manageAPIKeyUseCase.CreateAPIKeyUseCase(c.Request.Context(), req.Club, req.Scopes)
+	if err != nil {
+		if status == application.APIKeyInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	if status != application.APIKeyCreated {
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+		return
+	}
+	web.Respond(c, http.StatusCreated, createAPIKeyResponse{APIKey: apiKey, Key: key})
+}
+
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	status, err := h.manageAPIKeyUseCase.RevokeAPIKeyUseCase(c.Request.Context(), c.Param("apiKeyId"))
+	if err != nil {
+		if status == application.APIKeyInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	switch status {
+	case application.APIKeyRevoked:
+		web.Respond(c, http.StatusOK, gin.H{"status": status.String()})
+	case application.APIKeyNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
+
+func (h *APIKeyHandler) FindAPIKeysByClub(c *gin.Context) {
+	apiKeys, status, err := h.manageAPIKeyUseCase.FindAPIKeysByClubUseCase(c.Request.Context(), c.Query("club"))
+	if err != nil {
+		if status == application.APIKeyInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	switch status {
+	case application.APIKeyFound:
+		web.Respond(c, http.StatusOK, apiKeys)
+	case application.APIKeyNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
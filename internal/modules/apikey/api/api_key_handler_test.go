@@ -0,0 +1,109 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/apikey/application"
+	"github.com/paguerre3/goddd/internal/modules/apikey/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockManageAPIKeyUseCase struct {
+	mock.Mock
+}
+
+func (m *mockManageAPIKeyUseCase) CreateAPIKeyUseCase(_ context.Context, club string, scopes []domain.Scope) (domain.APIKey, string, application.APIKeyStatus, error) {
+	args := m.Called(club, scopes)
+	return args.Get(0).(domain.APIKey), args.String(1), args.Get(2).(application.APIKeyStatus), args.Error(3)
+}
+
+func (m *mockManageAPIKeyUseCase) RevokeAPIKeyUseCase(_ context.Context, id string) (application.APIKeyStatus, error) {
+	args := m.Called(id)
+	return args.Get(0).(application.APIKeyStatus), args.Error(1)
+}
+
+func (m *mockManageAPIKeyUseCase) FindAPIKeysByClubUseCase(_ context.Context, club string) ([]domain.APIKey, application.APIKeyStatus, error) {
+	args := m.Called(club)
+	return args.Get(0).([]domain.APIKey), args.Get(1).(application.APIKeyStatus), args.Error(2)
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	scopes := []domain.Scope{domain.ScopePlayersWrite}
+
+	t.Run("Created", func(t *testing.T) {
+		useCase := &mockManageAPIKeyUseCase{}
+		useCase.On("CreateAPIKeyUseCase", "Padel Club", scopes).
+			Return(domain.APIKey{ID: "id", KeyHash: "hash"}, "pp_secret", application.APIKeyCreated, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api-keys", bytes.NewBufferString(`{"club": "Padel Club", "scopes": ["players:write"]}`))
+		NewAPIKeyHandler(useCase).CreateAPIKey(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var body map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "pp_secret", body["key"])
+		assert.Equal(t, "id", body["id"])
+		assert.NotContains(t, body, "keyHash")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		useCase := &mockManageAPIKeyUseCase{}
+		useCase.On("CreateAPIKeyUseCase", "Padel Club", scopes).Return(domain.APIKey{}, "", application.APIKeyInvalid, assert.AnError)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api-keys", bytes.NewBufferString(`{"club": "Padel Club", "scopes": ["players:write"]}`))
+		NewAPIKeyHandler(useCase).CreateAPIKey(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     application.APIKeyStatus
+		err        error
+		statusCode int
+	}{
+		{"Revoked", application.APIKeyRevoked, nil, http.StatusOK},
+		{"Not found", application.APIKeyNotFound, nil, http.StatusNotFound},
+		{"Internal error", application.APIKeyPending, assert.AnError, http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			useCase := &mockManageAPIKeyUseCase{}
+			useCase.On("RevokeAPIKeyUseCase", "id").Return(test.status, test.err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodDelete, "/api-keys/id", nil)
+			c.Params = gin.Params{{Key: "apiKeyId", Value: "id"}}
+			NewAPIKeyHandler(useCase).RevokeAPIKey(c)
+
+			assert.Equal(t, test.statusCode, w.Code)
+		})
+	}
+}
+
+func TestFindAPIKeysByClub(t *testing.T) {
+	useCase := &mockManageAPIKeyUseCase{}
+	useCase.On("FindAPIKeysByClubUseCase", "Padel Club").Return([]domain.APIKey{{ID: "id"}}, application.APIKeyFound, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api-keys?club=Padel+Club", nil)
+	NewAPIKeyHandler(useCase).FindAPIKeysByClub(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"id":"id"`)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/paguerre3/goddd/internal/modules/apikey/application"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+)
+
+const (
+	APIKeyHeader       = "X-API-Key"
+	rateLimitKeyPrefix = "apikey:"
+)
+
+// Authenticate authenticates requests holding an X-API-Key header as the club role with the scopes of the key,
+// requests without it are delegated to the fallback (e.g. bearer token authentication).
+func Authenticate(useCase application.AuthenticateAPIKeyUseCase, fallback gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(APIKeyHeader)
+		if len(key) == 0 {
+			fallback(c)
+			return
+		}
+		apiKey, status, err := useCase.AuthenticateAPIKeyUseCase(c.Request.Context(), key)
+		if err != nil && status != application.APIKeyInvalid {
+			web.Abort(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+			return
+		}
+		if status != application.APIKeyAuthenticated {
+			web.Abort(c, http.StatusUnauthorized, web.ErrorBody(c, errors.New("invalid api key")))
+			return
+		}
+		auth.SetClaims(c, &auth.Claims{
+			Roles:            []auth.Role{auth.RoleClub},
+			Scopes:           apiKey.ScopeNames(),
+			RegisteredClaims: jwt.RegisteredClaims{Subject: apiKey.ID},
+		})
+		c.Next()
+	}
+}
+
+// RateLimitKey buckets requests per API key, other requests aren't rate limited.
+func RateLimitKey(c *gin.Context) string {
+	claims, ok := auth.ClaimsFrom(c)
+	if !ok || !claims.HasAnyRole(auth.RoleClub) {
+		return ""
+	}
+	return rateLimitKeyPrefix + claims.Subject
+}
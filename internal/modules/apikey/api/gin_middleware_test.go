@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/apikey/application"
+	"github.com/paguerre3/goddd/internal/modules/apikey/domain"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeAuthenticateUseCase map[string]application.APIKeyStatus
+
+func (f fakeAuthenticateUseCase) AuthenticateAPIKeyUseCase(_ context.Context, key string) (domain.APIKey, application.APIKeyStatus, error) {
+	status := f[key]
+	if status == application.APIKeyPending {
+		return domain.APIKey{}, status, assert.AnError
+	}
+	return domain.APIKey{ID: "key-id", Scopes: []domain.Scope{domain.ScopePlayersWrite}}, status, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	useCase := fakeAuthenticateUseCase{
+		"pp_valid":   application.APIKeyAuthenticated,
+		"pp_revoked": application.APIKeyRevoked,
+		"pp_unknown": application.APIKeyNotFound,
+	}
+	fallback := func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusTeapot)
+	}
+	router := gin.New()
+	router.POST("/players", Authenticate(useCase, fallback), auth.RequireScope(string(domain.ScopePlayersWrite)),
+		func(c *gin.Context) {
+			c.String(http.StatusOK, RateLimitKey(c))
+		})
+
+	tests := []struct {
+		name       string
+		key        string
+		statusCode int
+	}{
+		{"Valid key", "pp_valid", http.StatusOK},
+		{"Revoked key", "pp_revoked", http.StatusUnauthorized},
+		{"Unknown key", "pp_unknown", http.StatusUnauthorized},
+		{"Repository error", "pp_error", http.StatusInternalServerError},
+		{"Without key uses fallback", "", http.StatusTeapot},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/players", nil)
+			if len(test.key) > 0 {
+				req.Header.Set(APIKeyHeader, test.key)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, test.statusCode, w.Code)
+			if test.statusCode == http.StatusOK {
+				assert.Equal(t, "apikey:key-id", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestRateLimitKey_NotClub(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	assert.Empty(t, RateLimitKey(c))
+
+	auth.SetClaims(c, &auth.Claims{Roles: []auth.Role{auth.RoleAdmin}})
+	assert.Empty(t, RateLimitKey(c))
+}
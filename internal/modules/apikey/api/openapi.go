@@ -0,0 +1,47 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/paguerre3/goddd/internal/modules/apikey/domain"
+	"github.com/paguerre3/goddd/internal/modules/common/openapi"
+)
+
+const apiKeysTag = "api-keys"
+
+// DescribeAPIKeyRoutes documents the routes of APIKeyHandler registered under basePath (admins only).
+func DescribeAPIKeyRoutes(doc *openapi.Document, basePath string) {
+	add := func(method, path string, operation openapi.Operation) {
+		operation.Tags = []string{apiKeysTag}
+		doc.Add(method, basePath+path, doc.Authenticated(operation, openapi.Bearer))
+	}
+
+	add(http.MethodPost, "", openapi.Operation{
+		Summary:     "Create an API key for a club",
+		RequestBody: doc.Body(createAPIKeyRequest{}),
+		Responses: map[string]*openapi.Response{
+			"201": doc.Response("API key created, the key is only returned once", createAPIKeyResponse{}),
+			"400": doc.ErrorResponse("Invalid club or scopes"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodGet, "", openapi.Operation{
+		Summary:    "Find the API keys of a club",
+		Parameters: []openapi.Parameter{{Name: "club", In: "query", Required: true, Schema: &openapi.Schema{Type: "string"}}},
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("API keys found", []domain.APIKey{}),
+			"400": doc.ErrorResponse("Invalid club"),
+			"404": doc.StatusResponse("No API keys found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodDelete, "/:apiKeyId", openapi.Operation{
+		Summary: "Revoke an API key",
+		Responses: map[string]*openapi.Response{
+			"200": doc.StatusResponse("API key revoked"),
+			"400": doc.ErrorResponse("Invalid API key ID"),
+			"404": doc.StatusResponse("API key not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+}
@@ -0,0 +1,147 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/apikey/domain"
+)
+
+const (
+	keyPrefix    = "pp_"
+	keyBytes     = 32
+	prefixDigits = len(keyPrefix) + 8
+)
+
+type ManageAPIKeyUseCase interface {
+	// CreateAPIKeyUseCase returns the plain key, it's the only time it's available as only its hash is stored.
+	CreateAPIKeyUseCase(ctx context.Context, club string, scopes []domain.Scope) (apiKey domain.APIKey, key string, status APIKeyStatus, err error)
+	RevokeAPIKeyUseCase(ctx context.Context, id string) (status APIKeyStatus, err error)
+	FindAPIKeysByClubUseCase(ctx context.Context, club string) (apiKeys []domain.APIKey, status APIKeyStatus, err error)
+}
+
+type AuthenticateAPIKeyUseCase interface {
+	AuthenticateAPIKeyUseCase(ctx context.Context, key string) (apiKey domain.APIKey, status APIKeyStatus, err error)
+}
+
+type APIKeyStatus uint8
+
+const (
+	APIKeyPending APIKeyStatus = iota
+	APIKeyInvalid
+	APIKeyNotFound
+	APIKeyRevoked
+	APIKeyCreated
+	APIKeyFound
+	APIKeyAuthenticated
+)
+
+// Implement the Stringer interface.
+func (s APIKeyStatus) String() string {
+	return [...]string{"APIKeyPending", "APIKeyInvalid", "APIKeyNotFound", "APIKeyRevoked", "APIKeyCreated",
+		"APIKeyFound", "APIKeyAuthenticated"}[s]
+}
+
+type apiKeyService struct {
+	apiKeyRepo domain.APIKeyRepository
+	now        func() time.Time
+}
+
+func NewManageAPIKeyUseCase(apiKeyRepository domain.APIKeyRepository) ManageAPIKeyUseCase {
+	return &apiKeyService{apiKeyRepo: apiKeyRepository, now: time.Now}
+}
+
+func NewAuthenticateAPIKeyUseCase(apiKeyRepository domain.APIKeyRepository) AuthenticateAPIKeyUseCase {
+	return &apiKeyService{apiKeyRepo: apiKeyRepository, now: time.Now}
+}
+
+func (s *apiKeyService) CreateAPIKeyUseCase(ctx context.Context, club string, scopes []domain.Scope) (apiKey domain.APIKey,
+	key string, status APIKeyStatus, err error) {
+	if key, err = generateKey(); err != nil {
+		return apiKey, "", APIKeyPending, err
+	}
+	newAPIKey, err := domain.NewAPIKey(club, key[:prefixDigits], hashKey(key), scopes, s.now())
+	if err != nil {
+		return apiKey, "", APIKeyInvalid, err
+	}
+	if err = s.apiKeyRepo.Upsert(ctx, newAPIKey); err != nil {
+		return apiKey, "", APIKeyPending, err
+	}
+	return *newAPIKey, key, APIKeyCreated, nil
+}
+
+func (s *apiKeyService) RevokeAPIKeyUseCase(ctx context.Context, id string) (status APIKeyStatus, err error) {
+	if len(id) == 0 {
+		return APIKeyInvalid, errors.New("api key id cannot be empty")
+	}
+	apiKey, err := s.apiKeyRepo.FindByID(ctx, id)
+	if err != nil {
+		return APIKeyPending, err
+	}
+	if len(apiKey.ID) == 0 {
+		return APIKeyNotFound, nil
+	}
+	// Revoking an already revoked key is idempotent for callers.
+	if apiKey.IsRevoked() {
+		return APIKeyRevoked, nil
+	}
+	if err = apiKey.Revoke(s.now()); err != nil {
+		return APIKeyPending, err
+	}
+	if err = s.apiKeyRepo.Upsert(ctx, &apiKey); err != nil {
+		return APIKeyPending, err
+	}
+	return APIKeyRevoked, nil
+}
+
+func (s *apiKeyService) FindAPIKeysByClubUseCase(ctx context.Context, club string) (apiKeys []domain.APIKey,
+	status APIKeyStatus, err error) {
+	if len(club) == 0 {
+		return nil, APIKeyInvalid, errors.New("club cannot be empty")
+	}
+	if apiKeys, err = s.apiKeyRepo.FindByClub(ctx, club); err != nil {
+		return nil, APIKeyPending, err
+	}
+	if len(apiKeys) == 0 {
+		return nil, APIKeyNotFound, nil
+	}
+	return apiKeys, APIKeyFound, nil
+}
+
+// AuthenticateAPIKeyUseCase resolves the key by its hash, unknown and revoked keys aren't authenticated.
+func (s *apiKeyService) AuthenticateAPIKeyUseCase(ctx context.Context, key string) (apiKey domain.APIKey,
+	status APIKeyStatus, err error) {
+	if len(key) <= prefixDigits {
+		return apiKey, APIKeyInvalid, errors.New("invalid api key")
+	}
+	if apiKey, err = s.apiKeyRepo.FindByKeyHash(ctx, hashKey(key)); err != nil {
+		return apiKey, APIKeyPending, err
+	}
+	if len(apiKey.ID) == 0 {
+		return apiKey, APIKeyNotFound, nil
+	}
+	if apiKey.IsRevoked() {
+		return apiKey, APIKeyRevoked, nil
+	}
+	return apiKey, APIKeyAuthenticated, nil
+}
+
+// generateKey returns a random key with a recognizable prefix (e.g. for secret scanners).
+func generateKey() (string, error) {
+	b := make([]byte, keyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return keyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashKey is the stored form of keys, random keys don't need a slow hash like passwords.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,172 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/apikey/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockId   = "mock-id"
+	mockClub = "Padel Club"
+)
+
+var mockNow = time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+type mockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *mockAPIKeyRepository) Upsert(_ context.Context, apiKey *domain.APIKey) error {
+	args := m.Called(apiKey)
+	if apiKey.ID == "" {
+		apiKey.ID = mockId
+	}
+	return args.Error(0)
+}
+
+func (m *mockAPIKeyRepository) FindByID(_ context.Context, id string) (domain.APIKey, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepository) FindByKeyHash(_ context.Context, keyHash string) (domain.APIKey, error) {
+	args := m.Called(keyHash)
+	return args.Get(0).(domain.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepository) FindByClub(_ context.Context, club string) ([]domain.APIKey, error) {
+	args := m.Called(club)
+	return args.Get(0).([]domain.APIKey), args.Error(1)
+}
+
+func newService(repo domain.APIKeyRepository) *apiKeyService {
+	return &apiKeyService{apiKeyRepo: repo, now: func() time.Time { return mockNow }}
+}
+
+func TestCreateAPIKeyUseCase(t *testing.T) {
+	t.Run("Created with hashed storage", func(t *testing.T) {
+		// Arrange
+		repo := &mockAPIKeyRepository{}
+		repo.On("Upsert", mock.Anything).Return(nil)
+
+		// Act
+		apiKey, key, status, err := newService(repo).CreateAPIKeyUseCase(context.Background(), mockClub, []domain.Scope{domain.ScopePlayersWrite})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, APIKeyCreated, status)
+		assert.True(t, strings.HasPrefix(key, keyPrefix))
+		assert.Equal(t, mockId, apiKey.ID)
+		assert.Equal(t, key[:prefixDigits], apiKey.Prefix)
+		assert.Equal(t, hashKey(key), apiKey.KeyHash)
+		assert.NotContains(t, apiKey.KeyHash, key)
+	})
+
+	t.Run("Invalid scopes", func(t *testing.T) {
+		_, _, status, err := newService(&mockAPIKeyRepository{}).CreateAPIKeyUseCase(context.Background(), mockClub, []domain.Scope{"admin"})
+		assert.EqualError(t, err, "invalid scope: admin")
+		assert.Equal(t, APIKeyInvalid, status)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		repo.On("Upsert", mock.Anything).Return(errors.New("repo error"))
+		_, key, status, err := newService(repo).CreateAPIKeyUseCase(context.Background(), mockClub, []domain.Scope{domain.ScopePlayersRead})
+		assert.EqualError(t, err, "repo error")
+		assert.Empty(t, key)
+		assert.Equal(t, APIKeyPending, status)
+	})
+}
+
+func TestRevokeAPIKeyUseCase(t *testing.T) {
+	t.Run("Revoked", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		repo.On("FindByID", mockId).Return(domain.APIKey{ID: mockId}, nil)
+		repo.On("Upsert", mock.MatchedBy(func(apiKey *domain.APIKey) bool {
+			return apiKey.IsRevoked() && apiKey.RevokedAt.Equal(mockNow)
+		})).Return(nil)
+
+		status, err := newService(repo).RevokeAPIKeyUseCase(context.Background(), mockId)
+		assert.NoError(t, err)
+		assert.Equal(t, APIKeyRevoked, status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Already revoked", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		repo.On("FindByID", mockId).Return(domain.APIKey{ID: mockId, RevokedAt: &mockNow}, nil)
+		status, err := newService(repo).RevokeAPIKeyUseCase(context.Background(), mockId)
+		assert.NoError(t, err)
+		assert.Equal(t, APIKeyRevoked, status)
+		repo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		repo.On("FindByID", mockId).Return(domain.APIKey{}, nil)
+		status, err := newService(repo).RevokeAPIKeyUseCase(context.Background(), mockId)
+		assert.NoError(t, err)
+		assert.Equal(t, APIKeyNotFound, status)
+	})
+}
+
+func TestFindAPIKeysByClubUseCase(t *testing.T) {
+	repo := &mockAPIKeyRepository{}
+	repo.On("FindByClub", mockClub).Return([]domain.APIKey{{ID: mockId}}, nil)
+	repo.On("FindByClub", "Unknown").Return([]domain.APIKey{}, nil)
+
+	apiKeys, status, err := newService(repo).FindAPIKeysByClubUseCase(context.Background(), mockClub)
+	assert.NoError(t, err)
+	assert.Equal(t, APIKeyFound, status)
+	assert.Len(t, apiKeys, 1)
+
+	_, status, err = newService(repo).FindAPIKeysByClubUseCase(context.Background(), "Unknown")
+	assert.NoError(t, err)
+	assert.Equal(t, APIKeyNotFound, status)
+
+	_, status, err = newService(repo).FindAPIKeysByClubUseCase(context.Background(), "")
+	assert.Error(t, err)
+	assert.Equal(t, APIKeyInvalid, status)
+}
+
+func TestAuthenticateAPIKeyUseCase(t *testing.T) {
+	key := keyPrefix + "0123456789abcdef"
+
+	t.Run("Authenticated", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		repo.On("FindByKeyHash", hashKey(key)).Return(domain.APIKey{ID: mockId}, nil)
+		apiKey, status, err := newService(repo).AuthenticateAPIKeyUseCase(context.Background(), key)
+		assert.NoError(t, err)
+		assert.Equal(t, APIKeyAuthenticated, status)
+		assert.Equal(t, mockId, apiKey.ID)
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		repo.On("FindByKeyHash", hashKey(key)).Return(domain.APIKey{ID: mockId, RevokedAt: &mockNow}, nil)
+		_, status, err := newService(repo).AuthenticateAPIKeyUseCase(context.Background(), key)
+		assert.NoError(t, err)
+		assert.Equal(t, APIKeyRevoked, status)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		repo.On("FindByKeyHash", hashKey(key)).Return(domain.APIKey{}, nil)
+		_, status, err := newService(repo).AuthenticateAPIKeyUseCase(context.Background(), key)
+		assert.NoError(t, err)
+		assert.Equal(t, APIKeyNotFound, status)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		_, status, err := newService(&mockAPIKeyRepository{}).AuthenticateAPIKeyUseCase(context.Background(), "pp_")
+		assert.EqualError(t, err, "invalid api key")
+		assert.Equal(t, APIKeyInvalid, status)
+	})
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Scope string
+
+// Scopes granted to club integrations.
+const (
+	ScopePlayersRead  Scope = "players:read"
+	ScopePlayersWrite Scope = "players:write"
+)
+
+const minClubDigits = 2
+
+var validScopes = map[Scope]bool{
+	ScopePlayersRead:  true,
+	ScopePlayersWrite: true,
+}
+
+// APIKey of a partner club. Only the hash of the key is stored, the prefix identifies it in listings.
+type APIKey struct {
+	ID        string     `bson:"_id" json:"id"`
+	Club      string     `bson:"club" json:"club"`
+	Prefix    string     `bson:"prefix" json:"prefix"`
+	KeyHash   string     `bson:"keyHash" json:"-"`
+	Scopes    []Scope    `bson:"scopes" json:"scopes"`
+	CreatedAt time.Time  `bson:"createdAt" json:"createdAt"`
+	RevokedAt *time.Time `bson:"revokedAt,omitempty" json:"revokedAt,omitempty"`
+}
+
+func NewAPIKey(club, prefix, keyHash string, scopes []Scope, now time.Time) (*APIKey, error) {
+	club = strings.TrimSpace(club)
+	if len(club) < minClubDigits {
+		return nil, fmt.Errorf("invalid club: %s", club)
+	}
+	if len(keyHash) == 0 {
+		return nil, errors.New("key hash cannot be empty")
+	}
+	if err := ValidateScopes(scopes); err != nil {
+		return nil, err
+	}
+	return &APIKey{
+		//ID:       auto generated ID set in the repository.
+		Club:      club,
+		Prefix:    prefix,
+		KeyHash:   keyHash,
+		Scopes:    scopes,
+		CreatedAt: now,
+	}, nil
+}
+
+func ValidateScopes(scopes []Scope) error {
+	if len(scopes) == 0 {
+		return errors.New("at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !validScopes[scope] {
+			return fmt.Errorf("invalid scope: %s", scope)
+		}
+	}
+	return nil
+}
+
+// IsRevoked returns true once the key can't be used anymore.
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// Revoke disables the key, revoking twice is an error so callers can report it.
+func (k *APIKey) Revoke(now time.Time) error {
+	if k.IsRevoked() {
+		return fmt.Errorf("api key %s already revoked", k.ID)
+	}
+	k.RevokedAt = &now
+	return nil
+}
+
+// ScopeNames returns the scopes as plain strings (e.g. for auth claims).
+func (k *APIKey) ScopeNames() []string {
+	names := make([]string, 0, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		names = append(names, string(scope))
+	}
+	return names
+}
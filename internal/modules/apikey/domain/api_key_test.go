@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	apiKey, err := NewAPIKey(" Padel Club ", "pp_abc", "hash", []Scope{ScopePlayersWrite}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, &APIKey{Club: "Padel Club", Prefix: "pp_abc", KeyHash: "hash", Scopes: []Scope{ScopePlayersWrite}, CreatedAt: now}, apiKey)
+	assert.Equal(t, []string{"players:write"}, apiKey.ScopeNames())
+
+	_, err = NewAPIKey("P", "pp_abc", "hash", []Scope{ScopePlayersWrite}, now)
+	assert.EqualError(t, err, "invalid club: P")
+	_, err = NewAPIKey("Padel Club", "pp_abc", "", []Scope{ScopePlayersWrite}, now)
+	assert.EqualError(t, err, "key hash cannot be empty")
+	_, err = NewAPIKey("Padel Club", "pp_abc", "hash", nil, now)
+	assert.EqualError(t, err, "at least one scope is required")
+	_, err = NewAPIKey("Padel Club", "pp_abc", "hash", []Scope{"tournaments:delete"}, now)
+	assert.EqualError(t, err, "invalid scope: tournaments:delete")
+}
+
+func TestAPIKey_Revoke(t *testing.T) {
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+	apiKey := &APIKey{ID: "id"}
+
+	assert.False(t, apiKey.IsRevoked())
+	assert.NoError(t, apiKey.Revoke(now))
+	assert.True(t, apiKey.IsRevoked())
+	assert.Equal(t, now, *apiKey.RevokedAt)
+	assert.EqualError(t, apiKey.Revoke(now), "api key id already revoked")
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "context"
+
+// interfaces to be used by infrastructure layer:
+type APIKeyRepository interface {
+	Upsert(ctx context.Context, apiKey *APIKey) error
+	FindByID(ctx context.Context, id string) (APIKey, error)
+	FindByKeyHash(ctx context.Context, keyHash string) (APIKey, error)
+	FindByClub(ctx context.Context, club string) ([]APIKey, error)
+}
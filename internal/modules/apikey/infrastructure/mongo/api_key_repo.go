@@ -0,0 +1,91 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/apikey/domain"
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	timeout        = 5 * time.Second
+	apiKeysColName = "api_keys"
+)
+
+type mongoAPIKeyRepository struct {
+	idGen      utils.IDGenerator
+	collection *common.TenantCollection
+}
+
+func NewMongoAPIKeyRepository(idGen utils.IDGenerator, client common.MongoClient) domain.APIKeyRepository {
+	return &mongoAPIKeyRepository{
+		idGen:      idGen,
+		collection: common.NewTenantCollection(client, apiKeysColName),
+	}
+}
+
+func (r *mongoAPIKeyRepository) Upsert(ctx context.Context, apiKey *domain.APIKey) error {
+	if apiKey == nil {
+		return errors.New("api key is nil")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// DDD repository principle.
+	if len(apiKey.ID) > 0 {
+		_, err := r.collection.UpdateOne(ctx, bson.M{"_id": apiKey.ID}, bson.M{"$set": apiKey})
+		return err
+	}
+	apiKey.ID = r.idGen.GenerateID()
+	_, err := r.collection.InsertOne(ctx, apiKey)
+	if err != nil {
+		apiKey.ID = ""
+	}
+	return err
+}
+
+func (r *mongoAPIKeyRepository) FindByID(ctx context.Context, id string) (domain.APIKey, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *mongoAPIKeyRepository) FindByKeyHash(ctx context.Context, keyHash string) (domain.APIKey, error) {
+	return r.findOne(ctx, bson.M{"keyHash": keyHash})
+}
+
+func (r *mongoAPIKeyRepository) findOne(ctx context.Context, filter bson.M) (domain.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var apiKey domain.APIKey
+	err := r.collection.FindOne(ctx, filter).Decode(&apiKey)
+	if mongo.ErrNoDocuments == err {
+		return apiKey, nil
+	}
+	return apiKey, err
+}
+
+func (r *mongoAPIKeyRepository) FindByClub(ctx context.Context, club string) ([]domain.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cursor, err := r.collection.Find(ctx, bson.M{"club": club})
+	if err != nil && mongo.ErrNoDocuments != err {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var apiKeys []domain.APIKey
+	for cursor.Next(ctx) {
+		var apiKey domain.APIKey
+		if err := cursor.Decode(&apiKey); err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+	return apiKeys, nil
+}
@@ -0,0 +1,137 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/apikey/domain"
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+const (
+	testDbName    = "testdb"
+	testAPIKeysNs = testDbName + "." + apiKeysColName
+	mockId        = "mock-id"
+)
+
+type idGenMock struct {
+}
+
+func (i *idGenMock) GenerateID() string {
+	return mockId
+}
+
+func (i *idGenMock) GenerateIDWithPrefixes(prefix1 string, prefix2 string) string {
+	return prefix1 + "-" + prefix2 + "-" + mockId
+}
+
+func newIdGenMock() utils.IDGenerator {
+	return &idGenMock{}
+}
+
+type mongoClientMock struct {
+	database *mongo.Database
+}
+
+func (m *mongoClientMock) GetCollection(_ context.Context, collectionName string) *mongo.Collection {
+	return m.database.Collection(collectionName)
+}
+
+func (m *mongoClientMock) Close() error {
+	return nil
+}
+
+func newMongoClientMock(client *mongo.Client) common.MongoClient {
+	return &mongoClientMock{database: client.Database(testDbName)}
+}
+
+func TestMongoAPIKeyRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	mt.Run("Save api key", func(mt *mtest.T) {
+		repo := NewMongoAPIKeyRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		apiKey, err := domain.NewAPIKey("Padel Club", "pp_abc", "hash", []domain.Scope{domain.ScopePlayersWrite}, now)
+		assert.NoError(t, err)
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(context.Background(), apiKey))
+		assert.Equal(t, mockId, apiKey.ID)
+	})
+
+	mt.Run("Save nil api key", func(mt *mtest.T) {
+		repo := NewMongoAPIKeyRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		assert.EqualError(t, repo.Upsert(context.Background(), nil), "api key is nil")
+	})
+
+	mt.Run("Find api key by hash", func(mt *mtest.T) {
+		repo := NewMongoAPIKeyRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testAPIKeysNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockId},
+			{Key: "club", Value: "Padel Club"},
+			{Key: "keyHash", Value: "hash"},
+			{Key: "scopes", Value: bson.A{"players:write"}},
+		}))
+
+		apiKey, err := repo.FindByKeyHash(context.Background(), "hash")
+		assert.NoError(t, err)
+		assert.Equal(t, mockId, apiKey.ID)
+		assert.Equal(t, []domain.Scope{domain.ScopePlayersWrite}, apiKey.Scopes)
+	})
+
+	mt.Run("Api key not found", func(mt *mtest.T) {
+		repo := NewMongoAPIKeyRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testAPIKeysNs, mtest.FirstBatch))
+
+		apiKey, err := repo.FindByID(context.Background(), mockId)
+		assert.NoError(t, err)
+		assert.Empty(t, apiKey.ID)
+	})
+
+	mt.Run("Find api keys by club", func(mt *mtest.T) {
+		repo := NewMongoAPIKeyRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		first := mtest.CreateCursorResponse(1, testAPIKeysNs, mtest.FirstBatch, bson.D{{Key: "_id", Value: "1"}, {Key: "club", Value: "Padel Club"}})
+		next := mtest.CreateCursorResponse(1, testAPIKeysNs, mtest.NextBatch, bson.D{{Key: "_id", Value: "2"}, {Key: "club", Value: "Padel Club"}})
+		end := mtest.CreateCursorResponse(0, testAPIKeysNs, mtest.NextBatch)
+		mt.AddMockResponses(first, next, end)
+
+		apiKeys, err := repo.FindByClub(context.Background(), "Padel Club")
+		assert.NoError(t, err)
+		assert.Len(t, apiKeys, 2)
+	})
+
+	mt.Run("Api keys of other tenants never authenticate", func(mt *mtest.T) {
+		repo := NewMongoAPIKeyRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testAPIKeysNs, mtest.FirstBatch))
+
+		apiKey, err := repo.FindByKeyHash(tenant.WithID(context.Background(), "fep"), "hash")
+		assert.NoError(t, err)
+		assert.Empty(t, apiKey.ID)
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, "hash", filter.Lookup("keyHash").StringValue())
+		assert.Equal(t, "fep", filter.Lookup(common.TenantField).StringValue())
+	})
+}
+
+func TestMigrations(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Api keys stored before tenants are of the default tenant", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, Migrations()[0].Up(context.Background(), newMongoClientMock(mt.Client)))
+
+		for _, colName := range []string{apiKeysColName} {
+			event := mt.GetStartedEvent()
+			assert.Equal(t, colName, event.Command.Lookup("update").StringValue())
+			update := event.Command.Lookup("updates").Array().Index(0).Value().Document()
+			assert.Equal(t, tenant.Default, update.Lookup("u", "$set", common.TenantField).StringValue())
+		}
+	})
+}
@@ -0,0 +1,14 @@
+package mongo
+
+import "github.com/paguerre3/goddd/internal/modules/common/migration"
+
+// Migrations of the api_keys collection.
+func Migrations() []migration.Migration {
+	return []migration.Migration{
+		{
+			Version:     2026101911,
+			Description: "Assign api_keys to the default tenant",
+			Up:          migration.AssignTenant(apiKeysColName),
+		},
+	}
+}
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/club/application"
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+)
+
+// ClubHandler manages clubs, their venues and the memberships of players, memberships hold personal data so
+// listing them needs authentication.
+type ClubHandler struct {
+	clubUseCase       application.ClubUseCase
+	membershipUseCase application.MembershipUseCase
+}
+
+func NewClubHandler(clubUseCase application.ClubUseCase, membershipUseCase application.MembershipUseCase) *ClubHandler {
+	return &ClubHandler{clubUseCase: clubUseCase, membershipUseCase: membershipUseCase}
+}
+
+type createClubRequest struct {
+	Name string `json:"name" binding:"required"`
+	City string `json:"city" binding:"required"`
+}
+
+// joinClubRequest starts now without validFrom, and is open ended without validUntil.
+type joinClubRequest struct {
+	PlayerID   string      `json:"playerId" binding:"required"`
+	Role       domain.Role `json:"role" binding:"required"`
+	ValidFrom  *time.Time  `json:"validFrom,omitempty"`
+	ValidUntil *time.Time  `json:"validUntil,omitempty"`
+}
+
+func (h *ClubHandler) CreateClub(c *gin.Context) {
+	var request createClubRequest
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	club, status, err := h.clubUseCase.CreateClubUseCase(c.Request.Context(), request.Name, request.City)
+	if status == application.ClubCreated {
+		web.Respond(c, http.StatusCreated, club)
+		return
+	}
+	respondClubError(c, status, err)
+}
+
+func (h *ClubHandler) FindClubs(c *gin.Context) {
+	clubs, status, err := h.clubUseCase.FindClubsUseCase(c.Request.Context())
+	if status == application.ClubFound {
+		web.Respond(c, http.StatusOK, clubs)
+		return
+	}
+	respondClubError(c, status, err)
+}
+
+func (h *ClubHandler) FindClubByID(c *gin.Context) {
+	club, status, err := h.clubUseCase.FindClubByIDUseCase(c.Request.Context(), c.Param("clubId"))
+	if status == application.ClubFound {
+		web.Respond(c, http.StatusOK, club)
+		return
+	}
+	respondClubError(c, status, err)
+}
+
+func (h *ClubHandler) AddVenue(c *gin.Context) {
+	var request domain.Venue
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	venue, status, err := h.clubUseCase.AddVenueUseCase(c.Request.Context(), c.Param("clubId"), request)
+	if status == application.ClubUpdated {
+		web.Respond(c, http.StatusCreated, venue)
+		return
+	}
+	respondClubError(c, status, err)
+}
+
+func (h *ClubHandler) JoinClub(c *gin.Context) {
+	var request joinClubRequest
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	membership := domain.Membership{PlayerID: request.PlayerID, Role: request.Role, ValidUntil: request.ValidUntil}
+	if request.ValidFrom != nil {
+		membership.ValidFrom = *request.ValidFrom
+	}
+	membership, status, err := h.membershipUseCase.JoinClubUseCase(c.Request.Context(), c.Param("clubId"), membership)
+	if status == application.MembershipCreated {
+		web.Respond(c, http.StatusCreated, membership)
+		return
+	}
+	respondMembershipError(c, status, err)
+}
+
+// EndMembership ends the membership now, or at the time of the "at" query parameter.
+func (h *ClubHandler) EndMembership(c *gin.Context) {
+	at, ok := parseAt(c)
+	if !ok {
+		return
+	}
+	membership, status, err := h.membershipUseCase.EndMembershipUseCase(c.Request.Context(), c.Param("clubId"), c.Param("membershipId"), at)
+	if status == application.MembershipEnded {
+		web.Respond(c, http.StatusOK, membership)
+		return
+	}
+	respondMembershipError(c, status, err)
+}
+
+func (h *ClubHandler) FindMemberships(c *gin.Context) {
+	memberships, status, err := h.membershipUseCase.FindMembershipsUseCase(c.Request.Context(), c.Param("clubId"))
+	if status == application.MembershipFound {
+		web.Respond(c, http.StatusOK, memberships)
+		return
+	}
+	respondMembershipError(c, status, err)
+}
+
+// FindClubPlayers lists the players that are members now, or at the time of the "at" query parameter.
+func (h *ClubHandler) FindClubPlayers(c *gin.Context) {
+	at, ok := parseAt(c)
+	if !ok {
+		return
+	}
+	players, status, err := h.membershipUseCase.FindClubPlayersUseCase(c.Request.Context(), c.Param("clubId"), at)
+	if status == application.MembershipFound {
+		web.Respond(c, http.StatusOK, players)
+		return
+	}
+	respondMembershipError(c, status, err)
+}
+
+// parseAt returns the zero time (i.e. now) without "at", responding 400 when it's invalid.
+func parseAt(c *gin.Context) (time.Time, bool) {
+	at, err := web.ParseDate("at", c.Query("at"))
+	if err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return time.Time{}, false
+	}
+	if at == nil {
+		return time.Time{}, true
+	}
+	return *at, true
+}
+
+func respondClubError(c *gin.Context, status application.ClubStatus, err error) {
+	switch status {
+	case application.ClubInvalid:
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+	case application.ClubNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	case application.ClubConflict:
+		web.Respond(c, http.StatusConflict, web.ErrorBody(c, err))
+	default:
+		if err == nil {
+			err = fmt.Errorf("invalid status %d", status)
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+	}
+}
+
+func respondMembershipError(c *gin.Context, status application.MembershipStatus, err error) {
+	switch status {
+	case application.MembershipInvalid:
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+	case application.MembershipNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	case application.MembershipConflict:
+		web.Respond(c, http.StatusConflict, web.ErrorBody(c, err))
+	default:
+		if err == nil {
+			err = fmt.Errorf("invalid status %d", status)
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+	}
+}
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/club/application"
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockClubUseCase struct {
+	mock.Mock
+}
+
+func (m *mockClubUseCase) CreateClubUseCase(_ context.Context, name, city string) (domain.Club, application.ClubStatus, error) {
+	args := m.Called(name, city)
+	return args.Get(0).(domain.Club), args.Get(1).(application.ClubStatus), args.Error(2)
+}
+
+func (m *mockClubUseCase) FindClubByIDUseCase(_ context.Context, id string) (domain.Club, application.ClubStatus, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Club), args.Get(1).(application.ClubStatus), args.Error(2)
+}
+
+func (m *mockClubUseCase) FindClubsUseCase(_ context.Context) ([]domain.Club, application.ClubStatus, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.Club), args.Get(1).(application.ClubStatus), args.Error(2)
+}
+
+func (m *mockClubUseCase) AddVenueUseCase(_ context.Context, clubId string, venue domain.Venue) (domain.Venue, application.ClubStatus, error) {
+	args := m.Called(clubId, venue)
+	return args.Get(0).(domain.Venue), args.Get(1).(application.ClubStatus), args.Error(2)
+}
+
+type mockMembershipUseCase struct {
+	mock.Mock
+}
+
+func (m *mockMembershipUseCase) JoinClubUseCase(_ context.Context, clubId string, membership domain.Membership) (domain.Membership, application.MembershipStatus, error) {
+	args := m.Called(clubId, membership)
+	return args.Get(0).(domain.Membership), args.Get(1).(application.MembershipStatus), args.Error(2)
+}
+
+func (m *mockMembershipUseCase) EndMembershipUseCase(_ context.Context, clubId, membershipId string, at time.Time) (domain.Membership, application.MembershipStatus, error) {
+	args := m.Called(clubId, membershipId, at)
+	return args.Get(0).(domain.Membership), args.Get(1).(application.MembershipStatus), args.Error(2)
+}
+
+func (m *mockMembershipUseCase) FindMembershipsUseCase(_ context.Context, clubId string) ([]domain.Membership, application.MembershipStatus, error) {
+	args := m.Called(clubId)
+	return args.Get(0).([]domain.Membership), args.Get(1).(application.MembershipStatus), args.Error(2)
+}
+
+func (m *mockMembershipUseCase) FindClubPlayersUseCase(_ context.Context, clubId string, at time.Time) ([]domain.ClubPlayer, application.MembershipStatus, error) {
+	args := m.Called(clubId, at)
+	return args.Get(0).([]domain.ClubPlayer), args.Get(1).(application.MembershipStatus), args.Error(2)
+}
+
+func newClubRouter(clubUseCase *mockClubUseCase, membershipUseCase *mockMembershipUseCase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewClubHandler(clubUseCase, membershipUseCase)
+	router := gin.New()
+	router.POST("/clubs", handler.CreateClub)
+	router.GET("/clubs", handler.FindClubs)
+	router.GET("/clubs/:clubId", handler.FindClubByID)
+	router.POST("/clubs/:clubId/venues", handler.AddVenue)
+	router.POST("/clubs/:clubId/memberships", handler.JoinClub)
+	router.GET("/clubs/:clubId/memberships", handler.FindMemberships)
+	router.DELETE("/clubs/:clubId/memberships/:membershipId", handler.EndMembership)
+	router.GET("/clubs/:clubId/players", handler.FindClubPlayers)
+	return router
+}
+
+func serve(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestClubHandler_Clubs(t *testing.T) {
+	clubUseCase := &mockClubUseCase{}
+	clubUseCase.On("CreateClubUseCase", "Club Norte", "Buenos Aires").
+		Return(domain.Club{ID: "club-1", Name: "Club Norte", City: "Buenos Aires"}, application.ClubCreated, nil)
+	clubUseCase.On("FindClubByIDUseCase", "club-2").Return(domain.Club{}, application.ClubNotFound, nil)
+	venue := domain.Venue{ID: "north", Name: "North courts", Courts: 4}
+	clubUseCase.On("AddVenueUseCase", "club-1", venue).Return(domain.Venue{}, application.ClubConflict, domain.ErrVenueExists)
+	router := newClubRouter(clubUseCase, &mockMembershipUseCase{})
+
+	w := serve(router, http.MethodPost, "/clubs", `{"name":"Club Norte","city":"Buenos Aires"}`)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, `{"id":"club-1","name":"Club Norte","city":"Buenos Aires","venues":null,"createdAt":"0001-01-01T00:00:00Z"}`, w.Body.String())
+
+	w = serve(router, http.MethodPost, "/clubs", `{"name":"Club Norte"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "Expected the city required")
+
+	w = serve(router, http.MethodGet, "/clubs/club-2", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"status":"ClubNotFound"}`, w.Body.String())
+
+	w = serve(router, http.MethodPost, "/clubs/club-1/venues", `{"id":"north","name":"North courts","courts":4}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	clubUseCase.AssertExpectations(t)
+}
+
+func TestClubHandler_Memberships(t *testing.T) {
+	membershipUseCase := &mockMembershipUseCase{}
+	validFrom := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	membershipUseCase.On("JoinClubUseCase", "club-1", domain.Membership{PlayerID: "player-1", Role: domain.RoleCoach, ValidFrom: validFrom}).
+		Return(domain.Membership{ID: "membership-1", ClubID: "club-1", PlayerID: "player-1", Role: domain.RoleCoach, ValidFrom: validFrom},
+			application.MembershipCreated, nil)
+	membershipUseCase.On("JoinClubUseCase", "club-1", domain.Membership{PlayerID: "player-2", Role: domain.RoleMember}).
+		Return(domain.Membership{}, application.MembershipConflict, domain.ErrMembershipOverlap)
+	end := time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC)
+	membershipUseCase.On("EndMembershipUseCase", "club-1", "membership-1", end).
+		Return(domain.Membership{ID: "membership-1", ValidUntil: &end}, application.MembershipEnded, nil)
+	membershipUseCase.On("FindClubPlayersUseCase", "club-1", time.Time{}).
+		Return([]domain.ClubPlayer{{Player: domain.Player{ID: "player-1", LastName: "Tapia"}, Role: domain.RoleCoach, ValidFrom: validFrom}},
+			application.MembershipFound, nil)
+	router := newClubRouter(&mockClubUseCase{}, membershipUseCase)
+
+	w := serve(router, http.MethodPost, "/clubs/club-1/memberships", `{"playerId":"player-1","role":"coach","validFrom":"2026-01-01T00:00:00Z"}`)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	w = serve(router, http.MethodPost, "/clubs/club-1/memberships", `{"playerId":"player-2","role":"member"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = serve(router, http.MethodDelete, "/clubs/club-1/memberships/membership-1?at=2026-06-30", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serve(router, http.MethodDelete, "/clubs/club-1/memberships/membership-1?at=tomorrow", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = serve(router, http.MethodGet, "/clubs/club-1/players", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	var players []map[string]any
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &players))
+	assert.Len(t, players, 1)
+	assert.Equal(t, "coach", players[0]["role"])
+	membershipUseCase.AssertExpectations(t)
+}
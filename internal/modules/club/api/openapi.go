@@ -0,0 +1,108 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+	"github.com/paguerre3/goddd/internal/modules/common/openapi"
+)
+
+const clubsTag = "clubs"
+
+// DescribeClubRoutes documents the routes of ClubHandler registered under basePath.
+func DescribeClubRoutes(doc *openapi.Document, basePath string) {
+	add := func(method, path string, operation openapi.Operation) {
+		operation.Tags = []string{clubsTag}
+		doc.Add(method, basePath+path, doc.Authenticated(operation, openapi.Bearer))
+	}
+	at := func(description string) []openapi.Parameter {
+		return []openapi.Parameter{{Name: "at", In: "query", Description: description, Schema: &openapi.Schema{Type: "string"}}}
+	}
+
+	add(http.MethodPost, "", openapi.Operation{
+		Summary:     "Create a club",
+		Description: "Admins. The club starts without venues.",
+		RequestBody: doc.Body(createClubRequest{}),
+		Responses: map[string]*openapi.Response{
+			"201": doc.Response("Club created", domain.Club{}),
+			"400": doc.ErrorResponse("Invalid name or city"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodGet, "", openapi.Operation{
+		Summary:     "Find the clubs by name",
+		Description: "Authenticated.",
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Clubs", []domain.Club{}),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodGet, "/:clubId", openapi.Operation{
+		Summary:     "Find a club with its venues",
+		Description: "Authenticated.",
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Club found", domain.Club{}),
+			"400": doc.ErrorResponse("Invalid ID"),
+			"404": doc.StatusResponse("Club not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPost, "/:clubId/venues", openapi.Operation{
+		Summary:     "Add a venue to a club",
+		Description: "Admins. Venue IDs are unique within the club.",
+		RequestBody: doc.Body(domain.Venue{}),
+		Responses: map[string]*openapi.Response{
+			"201": doc.Response("Venue added", domain.Venue{}),
+			"400": doc.ErrorResponse("Invalid venue"),
+			"404": doc.StatusResponse("Club not found"),
+			"409": doc.ErrorResponse("Venue already exists"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPost, "/:clubId/memberships", openapi.Operation{
+		Summary: "Add a player to a club",
+		Description: "Organizers and admins. Roles are member, coach or manager. Memberships start now without validFrom and are " +
+			"open ended without validUntil (excluded), periods of the same player in the club cannot overlap.",
+		RequestBody: doc.Body(joinClubRequest{}),
+		Responses: map[string]*openapi.Response{
+			"201": doc.Response("Membership created", domain.Membership{}),
+			"400": doc.ErrorResponse("Invalid membership"),
+			"404": doc.StatusResponse("Club or player not found"),
+			"409": doc.ErrorResponse("Membership overlapping another one of the player in the club"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodGet, "/:clubId/memberships", openapi.Operation{
+		Summary:     "Find the memberships of a club, including ended and upcoming ones",
+		Description: "Organizers and admins.",
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Memberships", []domain.Membership{}),
+			"400": doc.ErrorResponse("Invalid ID"),
+			"404": doc.StatusResponse("Club not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodDelete, "/:clubId/memberships/:membershipId", openapi.Operation{
+		Summary:     "End a membership",
+		Description: "Organizers and admins. Memberships ending before they start end when they start.",
+		Parameters:  at("End of the membership, a date (2006-01-02) or RFC 3339 time, now by default"),
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Membership ended", domain.Membership{}),
+			"400": doc.ErrorResponse("Invalid ID or time"),
+			"404": doc.StatusResponse("Membership not found"),
+			"409": doc.ErrorResponse("Membership already ended"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodGet, "/:clubId/players", openapi.Operation{
+		Summary:     "Find the players of a club by last name, with their membership",
+		Description: "Authenticated, players hold personal data.",
+		Parameters:  at("Players members at this date (2006-01-02) or RFC 3339 time, now by default"),
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Players", []domain.ClubPlayer{}),
+			"400": doc.ErrorResponse("Invalid ID or time"),
+			"404": doc.StatusResponse("Club not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+}
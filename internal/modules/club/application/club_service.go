@@ -0,0 +1,20 @@
+package application
+
+import (
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+)
+
+type clubService struct {
+	clubRepo domain.ClubRepository
+	now      func() time.Time
+}
+
+// membershipService reads players through the anti-corruption layer, i.e. club listings show current profiles.
+type membershipService struct {
+	clubRepo       domain.ClubRepository
+	membershipRepo domain.MembershipRepository
+	playerReader   domain.PlayerReader
+	now            func() time.Time
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+)
+
+type ClubUseCase interface {
+	CreateClubUseCase(ctx context.Context, name, city string) (domain.Club, ClubStatus, error)
+	FindClubByIDUseCase(ctx context.Context, id string) (domain.Club, ClubStatus, error)
+	FindClubsUseCase(ctx context.Context) ([]domain.Club, ClubStatus, error)
+	AddVenueUseCase(ctx context.Context, clubId string, venue domain.Venue) (domain.Venue, ClubStatus, error)
+}
+
+type ClubStatus uint8
+
+const (
+	ClubPending ClubStatus = iota
+	ClubInvalid
+	ClubNotFound
+	ClubConflict
+	ClubCreated
+	ClubUpdated
+	ClubFound
+)
+
+// Implement the Stringer interface.
+func (s ClubStatus) String() string {
+	return [...]string{"ClubPending", "ClubInvalid", "ClubNotFound", "ClubConflict", "ClubCreated", "ClubUpdated",
+		"ClubFound"}[s]
+}
+
+func NewClubUseCase(clubRepository domain.ClubRepository) ClubUseCase {
+	return &clubService{clubRepo: clubRepository, now: time.Now}
+}
+
+func (s *clubService) CreateClubUseCase(ctx context.Context, name, city string) (domain.Club, ClubStatus, error) {
+	club, err := domain.NewClub(name, city, s.now().UTC())
+	if err != nil {
+		return domain.Club{}, ClubInvalid, err
+	}
+	if err = s.clubRepo.Upsert(ctx, club); err != nil {
+		return domain.Club{}, ClubPending, err
+	}
+	return *club, ClubCreated, nil
+}
+
+func (s *clubService) FindClubByIDUseCase(ctx context.Context, id string) (domain.Club, ClubStatus, error) {
+	return findClub(ctx, s.clubRepo, id)
+}
+
+func (s *clubService) FindClubsUseCase(ctx context.Context) ([]domain.Club, ClubStatus, error) {
+	clubs, err := s.clubRepo.FindAll(ctx)
+	if err != nil {
+		return nil, ClubPending, err
+	}
+	if clubs == nil {
+		clubs = []domain.Club{}
+	}
+	return clubs, ClubFound, nil
+}
+
+func (s *clubService) AddVenueUseCase(ctx context.Context, clubId string, venue domain.Venue) (domain.Venue, ClubStatus, error) {
+	newVenue, err := domain.NewVenue(venue.ID, venue.Name, venue.Address, venue.Courts)
+	if err != nil {
+		return domain.Venue{}, ClubInvalid, err
+	}
+	club, status, err := findClub(ctx, s.clubRepo, clubId)
+	if status != ClubFound {
+		return domain.Venue{}, status, err
+	}
+	if err := club.AddVenue(*newVenue); err != nil {
+		if errors.Is(err, domain.ErrVenueExists) {
+			return domain.Venue{}, ClubConflict, err
+		}
+		return domain.Venue{}, ClubInvalid, err
+	}
+	if err := s.clubRepo.Upsert(ctx, &club); err != nil {
+		return domain.Venue{}, ClubPending, err
+	}
+	return *newVenue, ClubUpdated, nil
+}
+
+func findClub(ctx context.Context, clubRepo domain.ClubRepository, id string) (domain.Club, ClubStatus, error) {
+	if err := domain.ValidateID(id); err != nil {
+		return domain.Club{}, ClubInvalid, err
+	}
+	club, err := clubRepo.FindByID(ctx, id)
+	if err != nil {
+		return domain.Club{}, ClubPending, err
+	}
+	if len(club.ID) == 0 {
+		return domain.Club{}, ClubNotFound, nil
+	}
+	return club, ClubFound, nil
+}
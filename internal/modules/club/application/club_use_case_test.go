@@ -0,0 +1,109 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const mockId = "mock-id"
+
+type mockClubRepository struct {
+	mock.Mock
+}
+
+func (m *mockClubRepository) Upsert(_ context.Context, club *domain.Club) error {
+	args := m.Called(club)
+	if args.Error(0) == nil && club.ID == "" {
+		club.ID = mockId
+	}
+	return args.Error(0)
+}
+
+func (m *mockClubRepository) FindByID(_ context.Context, id string) (domain.Club, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Club), args.Error(1)
+}
+
+func (m *mockClubRepository) FindAll(_ context.Context) ([]domain.Club, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.Club), args.Error(1)
+}
+
+var clubNow = time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+
+func newTestClubUseCase(clubRepo domain.ClubRepository) ClubUseCase {
+	return &clubService{clubRepo: clubRepo, now: func() time.Time { return clubNow }}
+}
+
+func TestCreateClubUseCase(t *testing.T) {
+	clubRepo := &mockClubRepository{}
+	clubRepo.On("Upsert", mock.Anything).Return(nil).Once()
+	clubRepo.On("Upsert", mock.Anything).Return(errors.New("db error"))
+	useCase := newTestClubUseCase(clubRepo)
+
+	club, status, err := useCase.CreateClubUseCase(context.Background(), "Club Norte", "Buenos Aires")
+	assert.NoError(t, err)
+	assert.Equal(t, ClubCreated, status)
+	assert.Equal(t, domain.Club{ID: mockId, Name: "Club Norte", City: "Buenos Aires", Venues: []domain.Venue{}, CreatedAt: clubNow}, club)
+
+	_, status, err = useCase.CreateClubUseCase(context.Background(), "CN", "Buenos Aires")
+	assert.Error(t, err)
+	assert.Equal(t, ClubInvalid, status)
+
+	_, status, err = useCase.CreateClubUseCase(context.Background(), "Club Norte", "Buenos Aires")
+	assert.EqualError(t, err, "db error")
+	assert.Equal(t, ClubPending, status)
+}
+
+func TestFindClubUseCases(t *testing.T) {
+	clubRepo := &mockClubRepository{}
+	clubRepo.On("FindByID", "club-1").Return(domain.Club{ID: "club-1", Name: "Club Norte"}, nil)
+	clubRepo.On("FindByID", "club-2").Return(domain.Club{}, nil)
+	clubRepo.On("FindAll").Return([]domain.Club(nil), nil)
+	useCase := newTestClubUseCase(clubRepo)
+
+	club, status, err := useCase.FindClubByIDUseCase(context.Background(), "club-1")
+	assert.NoError(t, err)
+	assert.Equal(t, ClubFound, status)
+	assert.Equal(t, "Club Norte", club.Name)
+
+	_, status, _ = useCase.FindClubByIDUseCase(context.Background(), "club-2")
+	assert.Equal(t, ClubNotFound, status)
+	_, status, _ = useCase.FindClubByIDUseCase(context.Background(), "c")
+	assert.Equal(t, ClubInvalid, status)
+
+	clubs, status, err := useCase.FindClubsUseCase(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, ClubFound, status)
+	assert.Equal(t, []domain.Club{}, clubs, "Expected an empty list without clubs")
+}
+
+func TestAddVenueUseCase(t *testing.T) {
+	venue := domain.Venue{ID: "north", Name: "North courts", Courts: 4}
+	clubRepo := &mockClubRepository{}
+	clubRepo.On("FindByID", "club-1").Return(domain.Club{ID: "club-1", Venues: []domain.Venue{}}, nil).Once()
+	clubRepo.On("FindByID", "club-1").Return(domain.Club{ID: "club-1", Venues: []domain.Venue{venue}}, nil)
+	clubRepo.On("Upsert", mock.MatchedBy(func(club *domain.Club) bool {
+		return len(club.Venues) == 1 && club.Venues[0] == venue
+	})).Return(nil)
+	useCase := newTestClubUseCase(clubRepo)
+
+	added, status, err := useCase.AddVenueUseCase(context.Background(), "club-1", venue)
+	assert.NoError(t, err)
+	assert.Equal(t, ClubUpdated, status)
+	assert.Equal(t, venue, added)
+
+	_, status, err = useCase.AddVenueUseCase(context.Background(), "club-1", venue)
+	assert.ErrorIs(t, err, domain.ErrVenueExists)
+	assert.Equal(t, ClubConflict, status)
+
+	_, status, _ = useCase.AddVenueUseCase(context.Background(), "club-1", domain.Venue{ID: "south", Name: "South courts"})
+	assert.Equal(t, ClubInvalid, status, "Expected venues with courts")
+	clubRepo.AssertNumberOfCalls(t, "Upsert", 1)
+}
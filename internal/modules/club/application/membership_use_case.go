@@ -0,0 +1,168 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+)
+
+// MembershipUseCase manages the memberships of players in clubs, a zero time meaning now.
+type MembershipUseCase interface {
+	JoinClubUseCase(ctx context.Context, clubId string, membership domain.Membership) (domain.Membership, MembershipStatus, error)
+	EndMembershipUseCase(ctx context.Context, clubId, membershipId string, at time.Time) (domain.Membership, MembershipStatus, error)
+	// FindMembershipsUseCase returns every membership of the club, including ended and upcoming ones.
+	FindMembershipsUseCase(ctx context.Context, clubId string) ([]domain.Membership, MembershipStatus, error)
+	// FindClubPlayersUseCase returns the players with a membership active at the time, by last and first name.
+	FindClubPlayersUseCase(ctx context.Context, clubId string, at time.Time) ([]domain.ClubPlayer, MembershipStatus, error)
+}
+
+type MembershipStatus uint8
+
+const (
+	MembershipPending MembershipStatus = iota
+	MembershipInvalid
+	MembershipNotFound
+	MembershipConflict
+	MembershipCreated
+	MembershipEnded
+	MembershipFound
+)
+
+// Implement the Stringer interface.
+func (s MembershipStatus) String() string {
+	return [...]string{"MembershipPending", "MembershipInvalid", "MembershipNotFound", "MembershipConflict",
+		"MembershipCreated", "MembershipEnded", "MembershipFound"}[s]
+}
+
+func NewMembershipUseCase(clubRepository domain.ClubRepository, membershipRepository domain.MembershipRepository,
+	playerReader domain.PlayerReader) MembershipUseCase {
+	return &membershipService{clubRepo: clubRepository, membershipRepo: membershipRepository, playerReader: playerReader, now: time.Now}
+}
+
+func (s *membershipService) JoinClubUseCase(ctx context.Context, clubId string, membership domain.Membership) (domain.Membership, MembershipStatus, error) {
+	newMembership, err := domain.NewMembership(clubId, membership.PlayerID, membership.Role, s.orNow(membership.ValidFrom), membership.ValidUntil)
+	if err != nil {
+		return domain.Membership{}, MembershipInvalid, err
+	}
+	if status, err := s.findClub(ctx, clubId); status != MembershipFound {
+		return domain.Membership{}, status, err
+	}
+	players, err := s.playerReader.FindByIDs(ctx, []string{newMembership.PlayerID})
+	if err != nil {
+		return domain.Membership{}, MembershipPending, err
+	}
+	if len(players) == 0 {
+		return domain.Membership{}, MembershipNotFound, nil
+	}
+	existing, err := s.membershipRepo.FindByClubAndPlayer(ctx, clubId, newMembership.PlayerID)
+	if err != nil {
+		return domain.Membership{}, MembershipPending, err
+	}
+	for _, other := range existing {
+		if newMembership.Overlaps(other) {
+			return domain.Membership{}, MembershipConflict, fmt.Errorf("%w: %s", domain.ErrMembershipOverlap, other.ID)
+		}
+	}
+	if err = s.membershipRepo.Upsert(ctx, newMembership); err != nil {
+		return domain.Membership{}, MembershipPending, err
+	}
+	return *newMembership, MembershipCreated, nil
+}
+
+func (s *membershipService) EndMembershipUseCase(ctx context.Context, clubId, membershipId string, at time.Time) (domain.Membership, MembershipStatus, error) {
+	if err := domain.ValidateID(membershipId); err != nil {
+		return domain.Membership{}, MembershipInvalid, err
+	}
+	if err := domain.ValidateID(clubId); err != nil {
+		return domain.Membership{}, MembershipInvalid, err
+	}
+	membership, err := s.membershipRepo.FindByID(ctx, membershipId)
+	if err != nil {
+		return domain.Membership{}, MembershipPending, err
+	}
+	if len(membership.ID) == 0 || membership.ClubID != clubId {
+		return domain.Membership{}, MembershipNotFound, nil
+	}
+	if err := membership.End(s.orNow(at)); err != nil {
+		return domain.Membership{}, MembershipConflict, err
+	}
+	if err := s.membershipRepo.Upsert(ctx, &membership); err != nil {
+		return domain.Membership{}, MembershipPending, err
+	}
+	return membership, MembershipEnded, nil
+}
+
+func (s *membershipService) FindMembershipsUseCase(ctx context.Context, clubId string) ([]domain.Membership, MembershipStatus, error) {
+	if status, err := s.findClub(ctx, clubId); status != MembershipFound {
+		return nil, status, err
+	}
+	memberships, err := s.membershipRepo.FindByClub(ctx, clubId)
+	if err != nil {
+		return nil, MembershipPending, err
+	}
+	if memberships == nil {
+		memberships = []domain.Membership{}
+	}
+	return memberships, MembershipFound, nil
+}
+
+// FindClubPlayersUseCase skips the players no longer registered, their memberships are kept as history.
+func (s *membershipService) FindClubPlayersUseCase(ctx context.Context, clubId string, at time.Time) ([]domain.ClubPlayer, MembershipStatus, error) {
+	memberships, status, err := s.FindMembershipsUseCase(ctx, clubId)
+	if status != MembershipFound {
+		return nil, status, err
+	}
+	at = s.orNow(at)
+	active := map[string]domain.Membership{}
+	var playerIds []string
+	for _, membership := range memberships {
+		if membership.ActiveOn(at) {
+			active[membership.PlayerID] = membership
+			playerIds = append(playerIds, membership.PlayerID)
+		}
+	}
+	clubPlayers := []domain.ClubPlayer{}
+	if len(playerIds) == 0 {
+		return clubPlayers, MembershipFound, nil
+	}
+	players, err := s.playerReader.FindByIDs(ctx, playerIds)
+	if err != nil {
+		return nil, MembershipPending, err
+	}
+	for _, player := range players {
+		membership := active[player.ID]
+		clubPlayers = append(clubPlayers, domain.ClubPlayer{Player: player, Role: membership.Role,
+			ValidFrom: membership.ValidFrom, ValidUntil: membership.ValidUntil})
+	}
+	sort.SliceStable(clubPlayers, func(i, j int) bool {
+		a, b := clubPlayers[i].Player, clubPlayers[j].Player
+		if a.LastName != b.LastName {
+			return a.LastName < b.LastName
+		}
+		return a.FirstName < b.FirstName
+	})
+	return clubPlayers, MembershipFound, nil
+}
+
+func (s *membershipService) findClub(ctx context.Context, clubId string) (MembershipStatus, error) {
+	_, status, err := findClub(ctx, s.clubRepo, clubId)
+	switch status {
+	case ClubFound:
+		return MembershipFound, nil
+	case ClubInvalid:
+		return MembershipInvalid, err
+	case ClubNotFound:
+		return MembershipNotFound, nil
+	}
+	return MembershipPending, err
+}
+
+func (s *membershipService) orNow(at time.Time) time.Time {
+	if at.IsZero() {
+		return s.now().UTC()
+	}
+	return at
+}
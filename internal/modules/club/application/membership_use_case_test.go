@@ -0,0 +1,155 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockMembershipRepository struct {
+	mock.Mock
+}
+
+func (m *mockMembershipRepository) Upsert(_ context.Context, membership *domain.Membership) error {
+	args := m.Called(membership)
+	if args.Error(0) == nil && membership.ID == "" {
+		membership.ID = mockId
+	}
+	return args.Error(0)
+}
+
+func (m *mockMembershipRepository) FindByID(_ context.Context, id string) (domain.Membership, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Membership), args.Error(1)
+}
+
+func (m *mockMembershipRepository) FindByClub(_ context.Context, clubID string) ([]domain.Membership, error) {
+	args := m.Called(clubID)
+	return args.Get(0).([]domain.Membership), args.Error(1)
+}
+
+func (m *mockMembershipRepository) FindByClubAndPlayer(_ context.Context, clubID, playerID string) ([]domain.Membership, error) {
+	args := m.Called(clubID, playerID)
+	return args.Get(0).([]domain.Membership), args.Error(1)
+}
+
+type mockPlayerReader struct {
+	mock.Mock
+}
+
+func (m *mockPlayerReader) FindByIDs(_ context.Context, ids []string) ([]domain.Player, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]domain.Player), args.Error(1)
+}
+
+// clubRepository finds club-1 while club-2 doesn't exist.
+func clubRepository() *mockClubRepository {
+	repo := &mockClubRepository{}
+	repo.On("FindByID", "club-1").Return(domain.Club{ID: "club-1", Name: "Club Norte"}, nil)
+	repo.On("FindByID", "club-2").Return(domain.Club{}, nil)
+	return repo
+}
+
+func TestJoinClubUseCase(t *testing.T) {
+	// Arrange
+	membershipRepo := &mockMembershipRepository{}
+	playerReader := &mockPlayerReader{}
+	playerReader.On("FindByIDs", []string{"player-1"}).Return([]domain.Player{{ID: "player-1"}}, nil)
+	playerReader.On("FindByIDs", []string{"player-2"}).Return([]domain.Player{}, nil)
+	membershipRepo.On("FindByClubAndPlayer", "club-1", "player-1").Return([]domain.Membership{{ID: "membership-0",
+		ClubID: "club-1", PlayerID: "player-1", ValidFrom: clubNow.AddDate(-1, 0, 0), ValidUntil: &clubNow}}, nil).Once()
+	membershipRepo.On("Upsert", mock.Anything).Return(nil).Once()
+	useCase := &membershipService{clubRepo: clubRepository(), membershipRepo: membershipRepo, playerReader: playerReader,
+		now: func() time.Time { return clubNow }}
+
+	// Act
+	membership, status, err := useCase.JoinClubUseCase(context.Background(), "club-1", domain.Membership{PlayerID: "player-1", Role: domain.RoleCoach})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, MembershipCreated, status)
+	assert.Equal(t, domain.Membership{ID: mockId, ClubID: "club-1", PlayerID: "player-1", Role: domain.RoleCoach, ValidFrom: clubNow}, membership,
+		"Expected a membership valid from now, after the ended one")
+
+	membershipRepo.On("FindByClubAndPlayer", "club-1", "player-1").Return([]domain.Membership{membership}, nil)
+	_, status, err = useCase.JoinClubUseCase(context.Background(), "club-1", domain.Membership{PlayerID: "player-1", Role: domain.RoleMember,
+		ValidFrom: clubNow.AddDate(0, 1, 0)})
+	assert.ErrorIs(t, err, domain.ErrMembershipOverlap)
+	assert.Equal(t, MembershipConflict, status)
+
+	_, status, _ = useCase.JoinClubUseCase(context.Background(), "club-1", domain.Membership{PlayerID: "player-2", Role: domain.RoleMember})
+	assert.Equal(t, MembershipNotFound, status, "Expected unknown players rejected")
+	_, status, _ = useCase.JoinClubUseCase(context.Background(), "club-2", domain.Membership{PlayerID: "player-1", Role: domain.RoleMember})
+	assert.Equal(t, MembershipNotFound, status, "Expected unknown clubs rejected")
+	_, status, _ = useCase.JoinClubUseCase(context.Background(), "club-1", domain.Membership{PlayerID: "player-1", Role: "owner"})
+	assert.Equal(t, MembershipInvalid, status)
+	membershipRepo.AssertNumberOfCalls(t, "Upsert", 1)
+}
+
+func TestEndMembershipUseCase(t *testing.T) {
+	// Arrange
+	membershipRepo := &mockMembershipRepository{}
+	membershipRepo.On("FindByID", "membership-1").Return(domain.Membership{ID: "membership-1", ClubID: "club-1",
+		ValidFrom: clubNow.AddDate(-1, 0, 0)}, nil)
+	membershipRepo.On("FindByID", "membership-2").Return(domain.Membership{ID: "membership-2", ClubID: "club-1",
+		ValidFrom: clubNow.AddDate(-1, 0, 0), ValidUntil: &clubNow}, nil)
+	membershipRepo.On("Upsert", mock.Anything).Return(nil)
+	useCase := &membershipService{clubRepo: clubRepository(), membershipRepo: membershipRepo, playerReader: &mockPlayerReader{},
+		now: func() time.Time { return clubNow }}
+
+	// Act
+	membership, status, err := useCase.EndMembershipUseCase(context.Background(), "club-1", "membership-1", time.Time{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, MembershipEnded, status)
+	assert.Equal(t, &clubNow, membership.ValidUntil)
+
+	_, status, err = useCase.EndMembershipUseCase(context.Background(), "club-1", "membership-2", time.Time{})
+	assert.ErrorIs(t, err, domain.ErrMembershipEnded)
+	assert.Equal(t, MembershipConflict, status)
+
+	_, status, _ = useCase.EndMembershipUseCase(context.Background(), "club-2", "membership-1", time.Time{})
+	assert.Equal(t, MembershipNotFound, status, "Expected memberships of other clubs not found")
+}
+
+func TestFindClubPlayersUseCase(t *testing.T) {
+	// Arrange
+	lastYear := clubNow.AddDate(-1, 0, 0)
+	membershipRepo := &mockMembershipRepository{}
+	membershipRepo.On("FindByClub", "club-1").Return([]domain.Membership{
+		{ID: "membership-1", ClubID: "club-1", PlayerID: "player-1", Role: domain.RoleMember, ValidFrom: lastYear},
+		{ID: "membership-2", ClubID: "club-1", PlayerID: "player-2", Role: domain.RoleCoach, ValidFrom: lastYear},
+		{ID: "membership-3", ClubID: "club-1", PlayerID: "player-3", Role: domain.RoleMember, ValidFrom: lastYear, ValidUntil: &lastYear},
+		{ID: "membership-4", ClubID: "club-1", PlayerID: "player-4", Role: domain.RoleMember, ValidFrom: lastYear},
+	}, nil)
+	// player-4 is no longer registered.
+	playerReader := &mockPlayerReader{}
+	playerReader.On("FindByIDs", []string{"player-1", "player-2", "player-4"}).Return([]domain.Player{
+		{ID: "player-1", FirstName: "Juan", LastName: "Lebron"},
+		{ID: "player-2", FirstName: "Ale", LastName: "Galan"},
+	}, nil)
+	useCase := &membershipService{clubRepo: clubRepository(), membershipRepo: membershipRepo, playerReader: playerReader,
+		now: func() time.Time { return clubNow }}
+
+	// Act
+	players, status, err := useCase.FindClubPlayersUseCase(context.Background(), "club-1", time.Time{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, MembershipFound, status)
+	assert.Equal(t, []domain.ClubPlayer{
+		{Player: domain.Player{ID: "player-2", FirstName: "Ale", LastName: "Galan"}, Role: domain.RoleCoach, ValidFrom: lastYear},
+		{Player: domain.Player{ID: "player-1", FirstName: "Juan", LastName: "Lebron"}, Role: domain.RoleMember, ValidFrom: lastYear},
+	}, players)
+
+	players, _, _ = useCase.FindClubPlayersUseCase(context.Background(), "club-1", lastYear.Add(-time.Hour))
+	assert.Equal(t, []domain.ClubPlayer{}, players, "Expected no players before the memberships started")
+
+	_, status, _ = useCase.FindClubPlayersUseCase(context.Background(), "club-2", time.Time{})
+	assert.Equal(t, MembershipNotFound, status)
+}
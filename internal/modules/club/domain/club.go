@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	minNameDigits = 3
+	maxNameDigits = 100
+	minIdDigits   = 3
+	minCourts     = 1
+	maxCourts     = 50
+)
+
+var ErrVenueExists = errors.New("venue already exists")
+
+// Club is the root aggregate of the club bounded context, players join it through memberships.
+type Club struct {
+	ID        string    `bson:"_id" json:"id"`
+	Name      string    `bson:"name" json:"name"`
+	City      string    `bson:"city" json:"city"`
+	Venues    []Venue   `bson:"venues" json:"venues"`
+	CreatedAt time.Time `bson:"createdAt" json:"createdAt"`
+}
+
+// Venue is a site of the club, tournaments hosted by the club configure their courts from it.
+type Venue struct {
+	ID      string `bson:"_id" json:"id"`
+	Name    string `bson:"name" json:"name"`
+	Address string `bson:"address,omitempty" json:"address,omitempty"`
+	Courts  int    `bson:"courts" json:"courts"`
+}
+
+func NewClub(name, city string, now time.Time) (*Club, error) {
+	if err := validateName("name", name); err != nil {
+		return nil, err
+	}
+	if err := validateName("city", city); err != nil {
+		return nil, err
+	}
+	return &Club{
+		//ID:       auto generated ID set in the repository.
+		Name:      name,
+		City:      city,
+		Venues:    []Venue{},
+		CreatedAt: now,
+	}, nil
+}
+
+func NewVenue(id, name, address string, courts int) (*Venue, error) {
+	if err := ValidateID(id); err != nil {
+		return nil, err
+	}
+	if err := validateName("name", name); err != nil {
+		return nil, err
+	}
+	if courts < minCourts || courts > maxCourts {
+		return nil, fmt.Errorf("invalid courts: %d", courts)
+	}
+	return &Venue{ID: id, Name: name, Address: strings.TrimSpace(address), Courts: courts}, nil
+}
+
+// AddVenue adds a venue validated by NewVenue, venue IDs are unique within the club.
+func (c *Club) AddVenue(venue Venue) error {
+	for _, existing := range c.Venues {
+		if existing.ID == venue.ID {
+			return fmt.Errorf("%w: %s", ErrVenueExists, venue.ID)
+		}
+	}
+	c.Venues = append(c.Venues, venue)
+	return nil
+}
+
+func ValidateID(id string) error {
+	if len(id) < minIdDigits {
+		return fmt.Errorf("invalid id: %s", id)
+	}
+	return nil
+}
+
+func validateName(field, value string) error {
+	if trimmed := strings.TrimSpace(value); len(trimmed) < minNameDigits || len(trimmed) > maxNameDigits || trimmed != value {
+		return fmt.Errorf("invalid %s: %q", field, value)
+	}
+	return nil
+}
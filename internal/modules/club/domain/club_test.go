@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClub(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	club, err := NewClub("Club Norte", "Buenos Aires", now)
+	assert.NoError(t, err)
+	assert.Equal(t, &Club{Name: "Club Norte", City: "Buenos Aires", Venues: []Venue{}, CreatedAt: now}, club)
+
+	_, err = NewClub("CN", "Buenos Aires", now)
+	assert.EqualError(t, err, `invalid name: "CN"`)
+	_, err = NewClub("Club Norte", " Buenos Aires", now)
+	assert.EqualError(t, err, `invalid city: " Buenos Aires"`)
+}
+
+func TestClub_AddVenue(t *testing.T) {
+	club, _ := NewClub("Club Norte", "Buenos Aires", time.Now())
+	venue, err := NewVenue("north", "North courts", " Av. Libertador 1000 ", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "Av. Libertador 1000", venue.Address)
+
+	assert.NoError(t, club.AddVenue(*venue))
+	assert.ErrorIs(t, club.AddVenue(*venue), ErrVenueExists)
+	assert.Len(t, club.Venues, 1)
+
+	_, err = NewVenue("north", "North courts", "", 0)
+	assert.EqualError(t, err, "invalid courts: 0")
+	_, err = NewVenue("n", "North courts", "", 4)
+	assert.EqualError(t, err, "invalid id: n")
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "context"
+
+// interfaces to be used by infrastructure layer:
+type ClubRepository interface {
+	Upsert(ctx context.Context, club *Club) error
+	FindByID(ctx context.Context, id string) (Club, error)
+	FindAll(ctx context.Context) ([]Club, error)
+}
+
+type MembershipRepository interface {
+	Upsert(ctx context.Context, membership *Membership) error
+	FindByID(ctx context.Context, id string) (Membership, error)
+	FindByClub(ctx context.Context, clubID string) ([]Membership, error)
+	FindByClubAndPlayer(ctx context.Context, clubID, playerID string) ([]Membership, error)
+}
+
+// PlayerReader reads the current profile of players owned by the player-couple module (anti-corruption layer).
+type PlayerReader interface {
+	FindByIDs(ctx context.Context, ids []string) ([]Player, error)
+}
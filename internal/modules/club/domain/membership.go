@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Role of the player within the club.
+type Role string
+
+const (
+	RoleMember  Role = "member"
+	RoleCoach   Role = "coach"
+	RoleManager Role = "manager"
+)
+
+var (
+	// ErrMembershipOverlap is returned when the player already is a member of the club during the period.
+	ErrMembershipOverlap = errors.New("membership overlaps another one of the player")
+	ErrMembershipEnded   = errors.New("membership already ended")
+)
+
+// Membership of a player in a club from ValidFrom until ValidUntil (excluded), open ended when nil. Players belong
+// to one or more clubs, with one membership per club at a time.
+type Membership struct {
+	ID         string     `bson:"_id" json:"id"`
+	ClubID     string     `bson:"clubId" json:"clubId"`
+	PlayerID   string     `bson:"playerId" json:"playerId"`
+	Role       Role       `bson:"role" json:"role"`
+	ValidFrom  time.Time  `bson:"validFrom" json:"validFrom"`
+	ValidUntil *time.Time `bson:"validUntil,omitempty" json:"validUntil,omitempty"`
+}
+
+// ClubPlayer is a player listed by a club, with the membership it holds on the date of the listing.
+type ClubPlayer struct {
+	Player     Player     `json:"player"`
+	Role       Role       `json:"role"`
+	ValidFrom  time.Time  `json:"validFrom"`
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+func NewMembership(clubID, playerID string, role Role, validFrom time.Time, validUntil *time.Time) (*Membership, error) {
+	if err := ValidateID(clubID); err != nil {
+		return nil, err
+	}
+	if err := ValidateID(playerID); err != nil {
+		return nil, err
+	}
+	if err := role.Validate(); err != nil {
+		return nil, err
+	}
+	if validFrom.IsZero() {
+		return nil, errors.New("validFrom cannot be empty")
+	}
+	if validUntil != nil && !validUntil.After(validFrom) {
+		return nil, errors.New("validUntil must be after validFrom")
+	}
+	return &Membership{
+		//ID:        auto generated ID set in the repository.
+		ClubID:     clubID,
+		PlayerID:   playerID,
+		Role:       role,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}, nil
+}
+
+func (r Role) Validate() error {
+	switch r {
+	case RoleMember, RoleCoach, RoleManager:
+		return nil
+	}
+	return fmt.Errorf("invalid role: %s, expected %s, %s or %s", r, RoleMember, RoleCoach, RoleManager)
+}
+
+// ActiveOn returns true when the membership is valid at the time.
+func (m Membership) ActiveOn(at time.Time) bool {
+	return !at.Before(m.ValidFrom) && (m.ValidUntil == nil || at.Before(*m.ValidUntil))
+}
+
+// Overlaps returns true when both memberships are of the same player in the same club with overlapping periods.
+func (m Membership) Overlaps(other Membership) bool {
+	if m.ClubID != other.ClubID || m.PlayerID != other.PlayerID {
+		return false
+	}
+	startsBeforeOtherEnds := other.ValidUntil == nil || m.ValidFrom.Before(*other.ValidUntil)
+	endsAfterOtherStarts := m.ValidUntil == nil || other.ValidFrom.Before(*m.ValidUntil)
+	return startsBeforeOtherEnds && endsAfterOtherStarts
+}
+
+// End ends the membership at the time, memberships not started yet are ended on their start (i.e. cancelled).
+func (m *Membership) End(at time.Time) error {
+	if m.ValidUntil != nil && !m.ValidUntil.After(at) {
+		return fmt.Errorf("%w: %s", ErrMembershipEnded, m.ID)
+	}
+	if at.Before(m.ValidFrom) {
+		at = m.ValidFrom
+	}
+	m.ValidUntil = &at
+	return nil
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(month time.Month, day int) time.Time {
+	return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func datePtr(month time.Month, day int) *time.Time {
+	d := date(month, day)
+	return &d
+}
+
+func TestNewMembership(t *testing.T) {
+	membership, err := NewMembership("club-1", "player-1", RoleCoach, date(time.January, 1), datePtr(time.July, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, RoleCoach, membership.Role)
+
+	_, err = NewMembership("club-1", "player-1", "owner", date(time.January, 1), nil)
+	assert.EqualError(t, err, "invalid role: owner, expected member, coach or manager")
+	_, err = NewMembership("club-1", "player-1", RoleMember, time.Time{}, nil)
+	assert.EqualError(t, err, "validFrom cannot be empty")
+	_, err = NewMembership("club-1", "player-1", RoleMember, date(time.July, 1), datePtr(time.July, 1))
+	assert.EqualError(t, err, "validUntil must be after validFrom")
+}
+
+func TestMembership_ActiveOn(t *testing.T) {
+	membership := Membership{ValidFrom: date(time.January, 1), ValidUntil: datePtr(time.July, 1)}
+
+	assert.False(t, membership.ActiveOn(date(time.January, 1).Add(-time.Second)))
+	assert.True(t, membership.ActiveOn(date(time.January, 1)))
+	assert.False(t, membership.ActiveOn(date(time.July, 1)), "Expected validUntil excluded")
+	assert.True(t, Membership{ValidFrom: date(time.January, 1)}.ActiveOn(date(time.December, 31)), "Expected open ended memberships")
+}
+
+func TestMembership_Overlaps(t *testing.T) {
+	first := Membership{ClubID: "club-1", PlayerID: "player-1", ValidFrom: date(time.January, 1), ValidUntil: datePtr(time.July, 1)}
+
+	assert.False(t, first.Overlaps(Membership{ClubID: "club-1", PlayerID: "player-1", ValidFrom: date(time.July, 1)}),
+		"Expected a membership starting when the other ends")
+	assert.True(t, first.Overlaps(Membership{ClubID: "club-1", PlayerID: "player-1", ValidFrom: date(time.June, 1)}))
+	assert.True(t, Membership{ClubID: "club-1", PlayerID: "player-1", ValidFrom: date(time.March, 1)}.Overlaps(first))
+	assert.False(t, first.Overlaps(Membership{ClubID: "club-2", PlayerID: "player-1", ValidFrom: date(time.June, 1)}),
+		"Expected players to belong to several clubs")
+}
+
+func TestMembership_End(t *testing.T) {
+	membership := Membership{ID: "membership-1", ValidFrom: date(time.January, 1)}
+	assert.NoError(t, membership.End(date(time.March, 1)))
+	assert.Equal(t, datePtr(time.March, 1), membership.ValidUntil)
+	assert.ErrorIs(t, membership.End(date(time.April, 1)), ErrMembershipEnded)
+	assert.NoError(t, membership.End(date(time.February, 1)), "Expected ending earlier")
+
+	upcoming := Membership{ValidFrom: date(time.May, 1)}
+	assert.NoError(t, upcoming.End(date(time.March, 1)))
+	assert.Equal(t, datePtr(time.May, 1), upcoming.ValidUntil, "Expected upcoming memberships cancelled")
+}
@@ -0,0 +1,13 @@
+package domain
+
+// Adapted copy of the player of the player-couple module (not imported as it's a different module), only holding
+// the profile clubs list their players with.
+type Player struct {
+	ID        string `bson:"_id" json:"id"`
+	Email     string `bson:"email" json:"email"`
+	FirstName string `bson:"firstName" json:"firstName"`
+	LastName  string `bson:"lastName" json:"lastName"`
+	// Level and Side are only known once set in the player profile.
+	Level int    `bson:"level,omitempty" json:"level,omitempty"`
+	Side  string `bson:"side,omitempty" json:"side,omitempty"`
+}
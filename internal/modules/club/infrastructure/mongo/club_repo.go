@@ -0,0 +1,116 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	timeout            = 5 * time.Second
+	clubsColName       = "clubs"
+	membershipsColName = "club_memberships"
+)
+
+type mongoClubRepository struct {
+	idGen      utils.IDGenerator
+	collection *common.TenantCollection
+}
+
+type mongoMembershipRepository struct {
+	idGen      utils.IDGenerator
+	collection *common.TenantCollection
+}
+
+func NewMongoClubRepository(idGen utils.IDGenerator, client common.MongoClient) domain.ClubRepository {
+	return &mongoClubRepository{idGen: idGen, collection: common.NewTenantCollection(client, clubsColName)}
+}
+
+func NewMongoMembershipRepository(idGen utils.IDGenerator, client common.MongoClient) domain.MembershipRepository {
+	return &mongoMembershipRepository{idGen: idGen, collection: common.NewTenantCollection(client, membershipsColName)}
+}
+
+func (r *mongoClubRepository) Upsert(ctx context.Context, club *domain.Club) error {
+	if club == nil {
+		return errors.New("club is nil")
+	}
+	return upsert(ctx, r.collection, r.idGen, &club.ID, club)
+}
+
+func (r *mongoClubRepository) FindByID(ctx context.Context, id string) (domain.Club, error) {
+	return findOne[domain.Club](ctx, r.collection, bson.M{"_id": id})
+}
+
+func (r *mongoClubRepository) FindAll(ctx context.Context) ([]domain.Club, error) {
+	return find[domain.Club](ctx, r.collection, bson.M{}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+}
+
+func (r *mongoMembershipRepository) Upsert(ctx context.Context, membership *domain.Membership) error {
+	if membership == nil {
+		return errors.New("membership is nil")
+	}
+	return upsert(ctx, r.collection, r.idGen, &membership.ID, membership)
+}
+
+func (r *mongoMembershipRepository) FindByID(ctx context.Context, id string) (domain.Membership, error) {
+	return findOne[domain.Membership](ctx, r.collection, bson.M{"_id": id})
+}
+
+func (r *mongoMembershipRepository) FindByClub(ctx context.Context, clubID string) ([]domain.Membership, error) {
+	return find[domain.Membership](ctx, r.collection, bson.M{"clubId": clubID}, options.Find().SetSort(bson.D{{Key: "validFrom", Value: 1}}))
+}
+
+func (r *mongoMembershipRepository) FindByClubAndPlayer(ctx context.Context, clubID, playerID string) ([]domain.Membership, error) {
+	return find[domain.Membership](ctx, r.collection, bson.D{{Key: "clubId", Value: clubID}, {Key: "playerId", Value: playerID}})
+}
+
+// upsert follows the DDD repository principle, documents without ID are inserted with a generated one.
+func upsert(ctx context.Context, collection *common.TenantCollection, idGen utils.IDGenerator, id *string, document any) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if len(*id) > 0 {
+		_, err := collection.UpdateOne(ctx, bson.M{"_id": *id}, bson.M{"$set": document})
+		return err
+	}
+	*id = idGen.GenerateID()
+	_, err := collection.InsertOne(ctx, document)
+	if err != nil {
+		*id = ""
+	}
+	return err
+}
+
+func findOne[T any](ctx context.Context, collection *common.TenantCollection, filter any) (T, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var document T
+	err := collection.FindOne(ctx, filter).Decode(&document)
+	if mongo.ErrNoDocuments == err {
+		return document, nil
+	}
+	return document, err
+}
+
+func find[T any](ctx context.Context, collection *common.TenantCollection, filter any, opts ...*options.FindOptions) ([]T, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cursor, err := collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var documents []T
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, err
+	}
+	return documents, nil
+}
@@ -0,0 +1,206 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+const (
+	testDbName        = "testdb"
+	testClubsNs       = testDbName + "." + clubsColName
+	testMembershipsNs = testDbName + "." + membershipsColName
+	testPlayersNs     = testDbName + "." + playersColName
+	mockId            = "mock-id"
+)
+
+type idGenMock struct {
+}
+
+func (i *idGenMock) GenerateID() string {
+	return mockId
+}
+
+func (i *idGenMock) GenerateIDWithPrefixes(prefix1 string, prefix2 string) string {
+	return prefix1 + "-" + prefix2 + "-" + mockId
+}
+
+func newIdGenMock() utils.IDGenerator {
+	return &idGenMock{}
+}
+
+type mongoClientMock struct {
+	database *mongo.Database
+}
+
+func (m *mongoClientMock) GetCollection(_ context.Context, collectionName string) *mongo.Collection {
+	return m.database.Collection(collectionName)
+}
+
+func (m *mongoClientMock) Close() error {
+	return nil
+}
+
+func newMongoClientMock(client *mongo.Client) common.MongoClient {
+	return &mongoClientMock{database: client.Database(testDbName)}
+}
+
+func TestMongoClubRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Save club", func(mt *mtest.T) {
+		repo := NewMongoClubRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		club, err := domain.NewClub("Club Norte", "Buenos Aires", time.Now())
+		assert.NoError(t, err)
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(context.Background(), club))
+		assert.Equal(t, mockId, club.ID)
+		assert.Equal(t, "insert", mt.GetStartedEvent().CommandName)
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(context.Background(), club))
+		assert.Equal(t, "update", mt.GetStartedEvent().CommandName, "Expected clubs with ID updated")
+
+		assert.EqualError(t, repo.Upsert(context.Background(), nil), "club is nil")
+	})
+
+	mt.Run("Fail to save club", func(mt *mtest.T) {
+		repo := NewMongoClubRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		club, _ := domain.NewClub("Club Norte", "Buenos Aires", time.Now())
+
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Code: 11000, Message: "duplicate key"}))
+		assert.Error(t, repo.Upsert(context.Background(), club))
+		assert.Empty(t, club.ID, "Expected the generated ID reset")
+	})
+
+	mt.Run("Find clubs", func(mt *mtest.T) {
+		repo := NewMongoClubRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testClubsNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "club-1"},
+			{Key: "name", Value: "Club Norte"},
+			{Key: "venues", Value: bson.A{bson.D{{Key: "_id", Value: "north"}, {Key: "name", Value: "North courts"}, {Key: "courts", Value: 4}}}},
+		}))
+
+		clubs, err := repo.FindAll(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Club{{ID: "club-1", Name: "Club Norte", Venues: []domain.Venue{{ID: "north", Name: "North courts", Courts: 4}}}}, clubs)
+
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testClubsNs, mtest.FirstBatch))
+		club, err := repo.FindByID(context.Background(), "club-2")
+		assert.NoError(t, err)
+		assert.Empty(t, club.ID, "Expected an empty club when not found")
+	})
+
+	mt.Run("Clubs of other tenants are never read nor changed", func(mt *mtest.T) {
+		repo := NewMongoClubRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		ctx := tenant.WithID(context.Background(), "fep")
+
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testClubsNs, mtest.FirstBatch))
+		club, err := repo.FindByID(ctx, "club-1")
+		assert.NoError(t, err)
+		assert.Empty(t, club.ID)
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, "fep", filter.Lookup(common.TenantField).StringValue())
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(ctx, &domain.Club{ID: "club-1", Name: "Club Norte"}))
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", update.Lookup("q", common.TenantField).StringValue())
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(ctx, &domain.Club{Name: "Club Sur"}))
+		inserted := mt.GetStartedEvent().Command.Lookup("documents").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", inserted.Lookup(common.TenantField).StringValue())
+	})
+}
+
+func TestMongoMembershipRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Find memberships of a player in a club", func(mt *mtest.T) {
+		repo := NewMongoMembershipRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		validFrom := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testMembershipsNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "membership-1"},
+			{Key: "clubId", Value: "club-1"},
+			{Key: "playerId", Value: "player-1"},
+			{Key: "role", Value: "coach"},
+			{Key: "validFrom", Value: validFrom},
+		}))
+
+		memberships, err := repo.FindByClubAndPlayer(context.Background(), "club-1", "player-1")
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Membership{{ID: "membership-1", ClubID: "club-1", PlayerID: "player-1", Role: domain.RoleCoach,
+			ValidFrom: validFrom}}, memberships)
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, "club-1", filter.Lookup("clubId").StringValue())
+		assert.Equal(t, "player-1", filter.Lookup("playerId").StringValue())
+	})
+
+	mt.Run("Fail to find memberships of a club", func(mt *mtest.T) {
+		repo := NewMongoMembershipRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "find error"}))
+
+		memberships, err := repo.FindByClub(context.Background(), "club-1")
+		assert.ErrorContains(t, err, "find error")
+		assert.Nil(t, memberships)
+	})
+}
+
+func TestMongoPlayerReader(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Only the listed profile is read", func(mt *mtest.T) {
+		reader := NewMongoPlayerReader(newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testPlayersNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "player-1"},
+			{Key: "lastName", Value: "Tapia"},
+			{Key: "level", Value: 8},
+		}))
+
+		players, err := reader.FindByIDs(context.Background(), []string{"player-1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Player{{ID: "player-1", LastName: "Tapia", Level: 8}}, players)
+		projection := mt.GetStartedEvent().Command.Lookup("projection").Document()
+		_, err = projection.LookupErr("socialSecurityNumber")
+		assert.Error(t, err, "Expected SSNs not read")
+		_, err = projection.LookupErr("birthDate")
+		assert.Error(t, err, "Expected birth dates not read")
+	})
+}
+
+func TestMigrations(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Memberships are indexed by club and player", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, Migrations()[0].Up(context.Background(), newMongoClientMock(mt.Client)))
+
+		event := mt.GetStartedEvent()
+		assert.Equal(t, membershipsColName, event.Command.Lookup("createIndexes").StringValue())
+		indexes, _ := event.Command.Lookup("indexes").Array().Values()
+		assert.Equal(t, "clubId_1_playerId_1", indexes[0].Document().Lookup("name").StringValue())
+	})
+
+	mt.Run("Clubs stored before tenants are of the default tenant", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+		assert.NoError(t, Migrations()[1].Up(context.Background(), newMongoClientMock(mt.Client)))
+
+		for _, colName := range []string{clubsColName, membershipsColName} {
+			event := mt.GetStartedEvent()
+			assert.Equal(t, colName, event.Command.Lookup("update").StringValue())
+			update := event.Command.Lookup("updates").Array().Index(0).Value().Document()
+			assert.Equal(t, tenant.Default, update.Lookup("u", "$set", common.TenantField).StringValue())
+		}
+	})
+}
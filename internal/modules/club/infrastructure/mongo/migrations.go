@@ -0,0 +1,30 @@
+package mongo
+
+import (
+	"github.com/paguerre3/goddd/internal/modules/common/migration"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Memberships are listed by club, and checked for overlaps by club and player (prefix of the same index).
+var membershipIndexes = []mongo.IndexModel{
+	{Keys: bson.D{{Key: "clubId", Value: 1}, {Key: "playerId", Value: 1}}, Options: options.Index().SetName("clubId_1_playerId_1")},
+}
+
+// Migrations of the clubs and club_memberships collections.
+func Migrations() []migration.Migration {
+	return []migration.Migration{
+		{
+			Version:     2026101905,
+			Description: "Create club_memberships indexes",
+			Up:          migration.CreateIndexes(membershipsColName, membershipIndexes...),
+			Down:        migration.DropIndexes(membershipsColName, membershipIndexes...),
+		},
+		{
+			Version:     2026101908,
+			Description: "Assign clubs and club_memberships to the default tenant",
+			Up:          migration.AssignTenant(clubsColName, membershipsColName),
+		},
+	}
+}
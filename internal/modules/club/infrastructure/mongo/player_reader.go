@@ -0,0 +1,28 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/club/domain"
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection owned by the player-couple module, only read here.
+const playersColName = "players"
+
+// Only the profile listed by clubs is read, i.e. SSNs and birth dates never reach clubs.
+var playerProjection = bson.M{"_id": 1, "email": 1, "firstName": 1, "lastName": 1, "level": 1, "side": 1}
+
+type mongoPlayerReader struct {
+	collection *common.TenantCollection
+}
+
+func NewMongoPlayerReader(client common.MongoClient) domain.PlayerReader {
+	return &mongoPlayerReader{collection: common.NewTenantCollection(client, playersColName)}
+}
+
+func (r *mongoPlayerReader) FindByIDs(ctx context.Context, ids []string) ([]domain.Player, error) {
+	return find[domain.Player](ctx, r.collection, bson.M{"_id": bson.M{"$in": ids}}, options.Find().SetProjection(playerProjection))
+}
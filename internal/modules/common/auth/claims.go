@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+)
+
+type Role string
+
+// Role policy:
+//   - admin: unregisters players.
+//   - organizer: manages tournaments and draws.
+//   - referee: reports match scores.
+//   - player: updates only its own profile (matched via Player.Email).
+//   - club: partner club integration authenticated by API key, limited by its scopes.
+const (
+	RoleAdmin     Role = "admin"
+	RoleOrganizer Role = "organizer"
+	RoleReferee   Role = "referee"
+	RolePlayer    Role = "player"
+	RoleClub      Role = "club"
+)
+
+// Claims expected in access tokens on top of the registered ones (sub, exp, iss, aud, ...).
+type Claims struct {
+	Email string `json:"email"`
+	Roles []Role `json:"roles"`
+	// Scopes restrict club integrations (API keys), user tokens rely on roles only.
+	Scopes []string `json:"scopes,omitempty"`
+	// Tenant (federation or club) whose documents the token accesses, the tenant of the host without it.
+	Tenant string `json:"tenant,omitempty"`
+	// GlobalAdmin tokens (platform operators) access every tenant without holding one, along with the admin role.
+	GlobalAdmin bool `json:"globalAdmin,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// HasAnyRole returns true if the claims hold at least one of the roles.
+func (c *Claims) HasAnyRole(roles ...Role) bool {
+	for _, owned := range c.Roles {
+		for _, role := range roles {
+			if owned == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// OwnsEmail returns true if the email belongs to the token owner (emails are case-insensitive).
+func (c *Claims) OwnsEmail(email string) bool {
+	return len(c.Email) > 0 && strings.EqualFold(c.Email, email)
+}
+
+// HasScope returns true if the claims hold the scope.
+func (c *Claims) HasScope(scope string) bool {
+	for _, owned := range c.Scopes {
+		if owned == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsTenant returns true if the token accesses the tenant of ctx (see tenant.GinMiddleware), i.e. ctx has none or
+// the token holds the same one. Tokens without tenant only access the tenant of ctx when of a global admin.
+func (c *Claims) AllowsTenant(ctx context.Context) bool {
+	id, ok := tenant.FromContext(ctx)
+	if !ok {
+		return true
+	}
+	if len(c.Tenant) == 0 {
+		return c.GlobalAdmin && c.HasAnyRole(RoleAdmin)
+	}
+	return c.Tenant == id
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+)
+
+const (
+	bearerPrefix = "Bearer "
+	claimsKey    = "authClaims"
+)
+
+type claimsCtxKey struct{}
+
+// Authenticate rejects requests without a valid bearer token and keeps its claims in the gin and request contexts.
+// Tokens of another tenant than the one of the host are forbidden.
+func Authenticate(validator TokenValidator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if !strings.HasPrefix(header, bearerPrefix) {
+			c.Header("WWW-Authenticate", "Bearer")
+			web.Abort(c, http.StatusUnauthorized, web.ErrorBody(c, errors.New("missing bearer token")))
+			return
+		}
+		claims, err := validator.Validate(strings.TrimPrefix(header, bearerPrefix))
+		if err != nil {
+			c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			web.Abort(c, http.StatusUnauthorized, web.ErrorBody(c, err))
+			return
+		}
+		if !claims.AllowsTenant(c.Request.Context()) {
+			web.Abort(c, http.StatusForbidden, web.ErrorBody(c, errors.New("token of another tenant")))
+			return
+		}
+		SetClaims(c, claims)
+		c.Next()
+	}
+}
+
+// RequireRoles rejects authenticated requests whose token doesn't hold any of the roles.
+func RequireRoles(roles ...Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := ClaimsFrom(c)
+		if !ok || !claims.HasAnyRole(roles...) {
+			web.Abort(c, http.StatusForbidden, web.ErrorBody(c, errors.New("insufficient role")))
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireScope rejects club integrations whose API key doesn't hold the scope, other roles aren't scoped.
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := ClaimsFrom(c)
+		if !ok || (claims.HasAnyRole(RoleClub) && !claims.HasScope(scope)) {
+			web.Abort(c, http.StatusForbidden, web.ErrorBody(c, errors.New("insufficient scope")))
+			return
+		}
+		c.Next()
+	}
+}
+
+// SetClaims keeps the claims of an authenticated request in the gin and request contexts.
+func SetClaims(c *gin.Context, claims *Claims) {
+	c.Set(claimsKey, claims)
+	c.Request = c.Request.WithContext(WithClaims(c.Request.Context(), claims))
+}
+
+// ClaimsFrom returns the claims of an authenticated request.
+func ClaimsFrom(c *gin.Context) (*Claims, bool) {
+	value, ok := c.Get(claimsKey)
+	if !ok {
+		return nil, false
+	}
+	claims, ok := value.(*Claims)
+	return claims, ok
+}
+
+// WithClaims returns a copy of the context holding the claims, used by layers without access to gin, and their
+// tenant if any.
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	if len(claims.Tenant) > 0 {
+		ctx = tenant.WithID(ctx, claims.Tenant)
+	}
+	return context.WithValue(ctx, claimsCtxKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims kept by WithClaims.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsCtxKey{}).(*Claims)
+	return claims, ok
+}
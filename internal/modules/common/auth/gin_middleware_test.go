@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRouter(t *testing.T) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Authenticate(NewHS256Validator([]byte(testSecret))))
+	router.DELETE("/players/:playerId", RequireRoles(RoleAdmin), func(c *gin.Context) {
+		claims, ok := ClaimsFromContext(c.Request.Context())
+		assert.True(t, ok)
+		c.JSON(http.StatusOK, gin.H{"email": claims.Email})
+	})
+	return router
+}
+
+func TestAuthenticateAndRequireRoles(t *testing.T) {
+	router := newTestRouter(t)
+
+	tests := []struct {
+		name       string
+		header     string
+		statusCode int
+	}{
+		{"missing token", "", http.StatusUnauthorized},
+		{"not a bearer token", "Basic dXNlcjpwYXNz", http.StatusUnauthorized},
+		{"invalid token", "Bearer invalid", http.StatusUnauthorized},
+		{"insufficient role", "Bearer " + signHS256(t, newTestClaims("agus.tapia@gmail.com", RolePlayer)), http.StatusForbidden},
+		{"admin", "Bearer " + signHS256(t, newTestClaims("admin@padelplace.com", RoleAdmin)), http.StatusOK},
+		{"any of the roles", "Bearer " + signHS256(t, newTestClaims("admin@padelplace.com", RolePlayer, RoleAdmin)), http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodDelete, "/players/some-id", nil)
+			if len(tt.header) > 0 {
+				req.Header.Set("Authorization", tt.header)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.statusCode, w.Code)
+			if tt.statusCode == http.StatusUnauthorized {
+				assert.Contains(t, w.Header().Get("WWW-Authenticate"), "Bearer")
+			}
+		})
+	}
+}
+
+func TestRequireRoles_WithoutAuthentication(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	RequireRoles(RoleAdmin)(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.True(t, c.IsAborted())
+}
+
+func TestRequireScope(t *testing.T) {
+	tests := []struct {
+		name       string
+		claims     *Claims
+		statusCode int
+	}{
+		{"unauthenticated", nil, http.StatusForbidden},
+		{"club without scope", &Claims{Roles: []Role{RoleClub}, Scopes: []string{"players:read"}}, http.StatusForbidden},
+		{"club with scope", &Claims{Roles: []Role{RoleClub}, Scopes: []string{"players:write"}}, http.StatusOK},
+		{"unscoped role", newTestClaims("admin@padelplace.com", RoleAdmin), http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/players", nil)
+			if tt.claims != nil {
+				SetClaims(c, tt.claims)
+			}
+
+			RequireScope("players:write")(c)
+
+			assert.Equal(t, tt.statusCode == http.StatusForbidden, c.IsAborted())
+			assert.Equal(t, tt.statusCode, w.Code)
+		})
+	}
+}
+
+func TestClaims(t *testing.T) {
+	claims := newTestClaims("Agus.Tapia@gmail.com", RolePlayer)
+
+	assert.True(t, claims.OwnsEmail("agus.tapia@gmail.com"))
+	assert.False(t, claims.OwnsEmail("ale.galan@gmail.com"))
+	assert.False(t, (&Claims{}).OwnsEmail(""))
+	assert.True(t, claims.HasAnyRole(RoleAdmin, RolePlayer))
+	assert.False(t, claims.HasAnyRole(RoleAdmin))
+	assert.True(t, (&Claims{Scopes: []string{"players:write"}}).HasScope("players:write"))
+	assert.False(t, claims.HasScope("players:write"))
+}
+
+func TestAuthenticate_Tenant(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(tenant.GinMiddleware(tenant.Hosts{"fep.padelplace.com": "fep"}), Authenticate(NewHS256Validator([]byte(testSecret))))
+	router.GET("/tenant", func(c *gin.Context) {
+		c.String(http.StatusOK, tenant.ID(c.Request.Context()))
+	})
+	tokenOf := func(tenantID string) string {
+		claims := newTestClaims("admin@padelplace.com", RoleAdmin)
+		claims.Tenant = tenantID
+		return "Bearer " + signHS256(t, claims)
+	}
+	globalAdminToken := func(roles ...Role) string {
+		claims := newTestClaims("ops@padelplace.com", roles...)
+		claims.GlobalAdmin = true
+		return "Bearer " + signHS256(t, claims)
+	}
+
+	tests := []struct {
+		name       string
+		host       string
+		token      string
+		statusCode int
+		tenant     string
+	}{
+		{"tenant of the token", "padelplace.com", tokenOf("fip"), http.StatusOK, "fip"},
+		{"token without tenant", "fep.padelplace.com", tokenOf(""), http.StatusForbidden, ""},
+		{"global admin", "fep.padelplace.com", globalAdminToken(RoleAdmin), http.StatusOK, "fep"},
+		{"global admin claim without admin role", "fep.padelplace.com", globalAdminToken(RoleOrganizer), http.StatusForbidden, ""},
+		{"same tenant", "fep.padelplace.com", tokenOf("fep"), http.StatusOK, "fep"},
+		{"token of another tenant", "fep.padelplace.com", tokenOf("fip"), http.StatusForbidden, ""},
+		{"default tenant", "padelplace.com", tokenOf(""), http.StatusOK, tenant.Default},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/tenant", nil)
+			req.Host = tt.host
+			req.Header.Set("Authorization", tt.token)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.statusCode, w.Code)
+			if tt.statusCode == http.StatusOK {
+				assert.Equal(t, tt.tenant, w.Body.String())
+			}
+		})
+	}
+}
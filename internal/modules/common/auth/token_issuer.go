@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	signingKeyFileEnv = "JWT_SIGNING_KEY_FILE"
+	signingKidEnv     = "JWT_SIGNING_KID"
+	accessTokenTTL    = 15 * time.Minute
+)
+
+// TokenIssuer signs access tokens for accounts managed by padelplace itself (external identity providers
+// issue their own tokens, e.g. for admins).
+type TokenIssuer interface {
+	// Issue signs a token of the tenant, without tenant claim when empty.
+	Issue(subject, email string, roles []Role, tenantID string) (token string, expiresIn time.Duration, err error)
+}
+
+type jwtIssuer struct {
+	method jwt.SigningMethod
+	key    interface{}
+	kid    string
+	issuer string
+	// audience is set in every token when not empty, as enforced by NewTokenValidator with JWT_AUDIENCE.
+	audience string
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+// NewHS256Issuer signs tokens with the same shared secret used by the HS256 validator.
+func NewHS256Issuer(secret []byte, issuer, audience string) TokenIssuer {
+	return &jwtIssuer{method: jwt.SigningMethodHS256, key: secret, issuer: issuer, audience: audience, ttl: accessTokenTTL, now: time.Now}
+}
+
+// NewRS256Issuer signs tokens with a private key whose public part is published in the JWKS under kid.
+func NewRS256Issuer(key *rsa.PrivateKey, kid, issuer, audience string) TokenIssuer {
+	return &jwtIssuer{method: jwt.SigningMethodRS256, key: key, kid: kid, issuer: issuer, audience: audience, ttl: accessTokenTTL, now: time.Now}
+}
+
+// NewTokenIssuer resolves the issuer from the environment, i.e. RS256 with the PEM private key of
+// JWT_SIGNING_KEY_FILE (and JWT_SIGNING_KID) when JWT_JWKS_FILE is set, otherwise HS256 using JWT_HS256_SECRET.
+// Tokens hold JWT_ISSUER and JWT_AUDIENCE so they pass NewTokenValidator.
+func NewTokenIssuer() (TokenIssuer, error) {
+	issuer, audience := getEnv(issuerEnv), getEnv(audienceEnv)
+	if len(getEnv(jwksFileEnv)) > 0 {
+		path := getEnv(signingKeyFileEnv)
+		if len(path) == 0 {
+			return nil, fmt.Errorf("%s must be set to issue RS256 tokens", signingKeyFileEnv)
+		}
+		pem, err := readFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read signing key: %w", err)
+		}
+		key, err := jwt.ParseRSAPrivateKeyFromPEM(pem)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing key: %w", err)
+		}
+		return NewRS256Issuer(key, getEnv(signingKidEnv), issuer, audience), nil
+	}
+	if secret := getEnv(hs256SecretEnv); len(secret) > 0 {
+		return NewHS256Issuer([]byte(secret), issuer, audience), nil
+	}
+	return nil, fmt.Errorf("either %s or %s must be set", jwksFileEnv, hs256SecretEnv)
+}
+
+func (i *jwtIssuer) Issue(subject, email string, roles []Role, tenantID string) (string, time.Duration, error) {
+	now := i.now()
+	claims := &Claims{
+		Email:  email,
+		Roles:  roles,
+		Tenant: tenantID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    i.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(i.ttl)),
+		},
+	}
+	if len(i.audience) > 0 {
+		claims.Audience = jwt.ClaimStrings{i.audience}
+	}
+	token := jwt.NewWithClaims(i.method, claims)
+	if len(i.kid) > 0 {
+		token.Header["kid"] = i.kid
+	}
+	signed, err := token.SignedString(i.key)
+	if err != nil {
+		return "", 0, err
+	}
+	return signed, i.ttl, nil
+}
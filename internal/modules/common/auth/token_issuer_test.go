@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHS256Issuer(t *testing.T) {
+	issuer := NewHS256Issuer([]byte(testSecret), "padelplace", "")
+
+	token, expiresIn, err := issuer.Issue("player-id", "agus.tapia@gmail.com", []Role{RolePlayer}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, accessTokenTTL, expiresIn)
+
+	claims, err := NewHS256Validator([]byte(testSecret)).Validate(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "player-id", claims.Subject)
+	assert.Equal(t, "agus.tapia@gmail.com", claims.Email)
+	assert.Equal(t, "padelplace", claims.Issuer)
+	assert.Equal(t, []Role{RolePlayer}, claims.Roles)
+	assert.Empty(t, claims.Tenant)
+
+	token, _, err = issuer.Issue("player-id", "agus.tapia@gmail.com", []Role{RolePlayer}, "fep")
+	assert.NoError(t, err)
+	claims, _ = NewHS256Validator([]byte(testSecret)).Validate(token)
+	assert.Equal(t, "fep", claims.Tenant)
+}
+
+func TestRS256Issuer(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keys, err := parseJWKS(jwksOf(&key.PublicKey, "kid-1"))
+	assert.NoError(t, err)
+
+	token, _, err := NewRS256Issuer(key, "kid-1", "", "").Issue("player-id", "ale.galan@gmail.com", []Role{RolePlayer}, "")
+	assert.NoError(t, err)
+
+	claims, err := NewRS256Validator(keys).Validate(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "player-id", claims.Subject)
+}
+
+func TestIssuer_Expired(t *testing.T) {
+	issuer := NewHS256Issuer([]byte(testSecret), "", "").(*jwtIssuer)
+	issuer.now = func() time.Time { return time.Now().Add(-time.Hour) }
+
+	token, _, err := issuer.Issue("player-id", "agus.tapia@gmail.com", []Role{RolePlayer}, "")
+	assert.NoError(t, err)
+
+	_, err = NewHS256Validator([]byte(testSecret)).Validate(token)
+	assert.Error(t, err)
+}
+
+func TestNewTokenIssuer(t *testing.T) {
+	originalGetEnv := getEnv
+	defer func() { getEnv = originalGetEnv }()
+	originalReadFile := readFile
+	defer func() { readFile = originalReadFile }()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	readFile = func(path string) ([]byte, error) {
+		return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), nil
+	}
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected string
+	}{
+		{"missing configuration", map[string]string{}, "either JWT_JWKS_FILE or JWT_HS256_SECRET must be set"},
+		{"JWKS without signing key", map[string]string{jwksFileEnv: "jwks.json"}, "JWT_SIGNING_KEY_FILE must be set to issue RS256 tokens"},
+		{"RS256", map[string]string{jwksFileEnv: "jwks.json", signingKeyFileEnv: "key.pem", signingKidEnv: "kid-1"}, ""},
+		{"HS256", map[string]string{hs256SecretEnv: testSecret}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getEnv = func(key string) string {
+				return tt.env[key]
+			}
+
+			issuer, err := NewTokenIssuer()
+			if len(tt.expected) > 0 {
+				assert.EqualError(t, err, tt.expected)
+				return
+			}
+			assert.NoError(t, err)
+			_, _, err = issuer.Issue("player-id", "agus.tapia@gmail.com", []Role{RolePlayer}, "")
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewTokenIssuer_Audience(t *testing.T) {
+	originalGetEnv := getEnv
+	defer func() { getEnv = originalGetEnv }()
+	env := map[string]string{hs256SecretEnv: testSecret, issuerEnv: "padelplace", audienceEnv: "padelplace-api"}
+	getEnv = func(key string) string {
+		return env[key]
+	}
+
+	issuer, err := NewTokenIssuer()
+	assert.NoError(t, err)
+	token, _, err := issuer.Issue("player-id", "agus.tapia@gmail.com", []Role{RolePlayer}, "fep")
+	assert.NoError(t, err)
+	validator, err := NewTokenValidator()
+	assert.NoError(t, err)
+
+	claims, err := validator.Validate(token)
+	assert.NoError(t, err, "Expected issued tokens to pass the audience check")
+	assert.Equal(t, jwt.ClaimStrings{"padelplace-api"}, claims.Audience)
+
+	env[audienceEnv] = "other-api"
+	validator, err = NewTokenValidator()
+	assert.NoError(t, err)
+	_, err = validator.Validate(token)
+	assert.Error(t, err, "Expected tokens of another audience rejected")
+}
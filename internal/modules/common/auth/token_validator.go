@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+)
+
+const (
+	hs256SecretEnv = "JWT_HS256_SECRET"
+	jwksFileEnv    = "JWT_JWKS_FILE"
+	issuerEnv      = "JWT_ISSUER"
+	audienceEnv    = "JWT_AUDIENCE"
+)
+
+var getEnv = os.Getenv
+
+type TokenValidator interface {
+	Validate(token string) (*Claims, error)
+}
+
+type jwtValidator struct {
+	keyFunc jwt.Keyfunc
+	parser  *jwt.Parser
+}
+
+// NewHS256Validator validates tokens signed with a shared secret.
+func NewHS256Validator(secret []byte, opts ...jwt.ParserOption) TokenValidator {
+	return &jwtValidator{
+		keyFunc: func(*jwt.Token) (interface{}, error) {
+			return secret, nil
+		},
+		parser: newParser(jwt.SigningMethodHS256, opts...),
+	}
+}
+
+// NewRS256Validator validates tokens signed with one of the RSA keys, chosen by the token "kid" header.
+func NewRS256Validator(keys map[string]*rsa.PublicKey, opts ...jwt.ParserOption) TokenValidator {
+	return &jwtValidator{
+		keyFunc: func(token *jwt.Token) (interface{}, error) {
+			kid, _ := token.Header["kid"].(string)
+			key, ok := keys[kid]
+			if !ok {
+				return nil, fmt.Errorf("unknown kid: %s", kid)
+			}
+			return key, nil
+		},
+		parser: newParser(jwt.SigningMethodRS256, opts...),
+	}
+}
+
+// NewTokenValidator resolves the validator from the environment, i.e. RS256 when JWT_JWKS_FILE is set
+// otherwise HS256 using JWT_HS256_SECRET. JWT_ISSUER and JWT_AUDIENCE are optionally enforced.
+func NewTokenValidator() (TokenValidator, error) {
+	var opts []jwt.ParserOption
+	if issuer := getEnv(issuerEnv); len(issuer) > 0 {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	if audience := getEnv(audienceEnv); len(audience) > 0 {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+
+	if path := getEnv(jwksFileEnv); len(path) > 0 {
+		keys, err := loadJWKS(path)
+		if err != nil {
+			return nil, err
+		}
+		return NewRS256Validator(keys, opts...), nil
+	}
+	if secret := getEnv(hs256SecretEnv); len(secret) > 0 {
+		return NewHS256Validator([]byte(secret), opts...), nil
+	}
+	return nil, fmt.Errorf("either %s or %s must be set", jwksFileEnv, hs256SecretEnv)
+}
+
+func newParser(method jwt.SigningMethod, opts ...jwt.ParserOption) *jwt.Parser {
+	// Algorithm is pinned so a token can't downgrade it, e.g. "none" or HS256 signed with the public key.
+	opts = append([]jwt.ParserOption{jwt.WithValidMethods([]string{method.Alg()}), jwt.WithExpirationRequired()}, opts...)
+	return jwt.NewParser(opts...)
+}
+
+func (v *jwtValidator) Validate(token string) (*Claims, error) {
+	claims := &Claims{}
+	if _, err := v.parser.ParseWithClaims(token, claims, v.keyFunc); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	if len(claims.Roles) == 0 {
+		return nil, errors.New("invalid token: no roles")
+	}
+	if len(claims.Tenant) > 0 {
+		if err := tenant.ValidateID(claims.Tenant); err != nil {
+			return nil, fmt.Errorf("invalid token: %w", err)
+		}
+	}
+	return claims, nil
+}
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSecret = "test-secret"
+
+func newTestClaims(email string, roles ...Role) *Claims {
+	return &Claims{
+		Email: email,
+		Roles: roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func signHS256(t *testing.T, claims *Claims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	assert.NoError(t, err)
+	return token
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims *Claims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+	return signed
+}
+
+func jwksOf(key *rsa.PublicKey, kid string) []byte {
+	return []byte(fmt.Sprintf(`{"keys":[{"kid":%q,"kty":"RSA","alg":"RS256","use":"sig","n":%q,"e":%q}]}`,
+		kid,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())))
+}
+
+func TestHS256Validator(t *testing.T) {
+	validator := NewHS256Validator([]byte(testSecret))
+
+	t.Run("valid token", func(t *testing.T) {
+		claims, err := validator.Validate(signHS256(t, newTestClaims("agus.tapia@gmail.com", RolePlayer)))
+		assert.NoError(t, err)
+		assert.Equal(t, "agus.tapia@gmail.com", claims.Email)
+		assert.Equal(t, []Role{RolePlayer}, claims.Roles)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		claims := newTestClaims("agus.tapia@gmail.com", RolePlayer)
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+		_, err := validator.Validate(signHS256(t, claims))
+		assert.ErrorIs(t, err, jwt.ErrTokenExpired)
+	})
+
+	t.Run("token without expiration", func(t *testing.T) {
+		claims := newTestClaims("agus.tapia@gmail.com", RolePlayer)
+		claims.ExpiresAt = nil
+		_, err := validator.Validate(signHS256(t, claims))
+		assert.Error(t, err)
+	})
+
+	t.Run("token without roles", func(t *testing.T) {
+		_, err := validator.Validate(signHS256(t, newTestClaims("agus.tapia@gmail.com")))
+		assert.EqualError(t, err, "invalid token: no roles")
+	})
+
+	t.Run("token of an invalid tenant", func(t *testing.T) {
+		claims := newTestClaims("agus.tapia@gmail.com", RolePlayer)
+		claims.Tenant = "../admin"
+		_, err := validator.Validate(signHS256(t, claims))
+		assert.EqualError(t, err, `invalid token: invalid tenant: "../admin"`)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		_, err := NewHS256Validator([]byte("other")).Validate(signHS256(t, newTestClaims("a@b.com", RoleAdmin)))
+		assert.ErrorIs(t, err, jwt.ErrSignatureInvalid)
+	})
+
+	t.Run("unsigned token", func(t *testing.T) {
+		token, _ := jwt.NewWithClaims(jwt.SigningMethodNone, newTestClaims("a@b.com", RoleAdmin)).
+			SignedString(jwt.UnsafeAllowNoneSignatureType)
+		_, err := validator.Validate(token)
+		assert.Error(t, err)
+	})
+}
+
+func TestRS256Validator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keys, err := parseJWKS(jwksOf(&key.PublicKey, "kid-1"))
+	assert.NoError(t, err)
+	validator := NewRS256Validator(keys)
+
+	t.Run("valid token", func(t *testing.T) {
+		claims, err := validator.Validate(signRS256(t, key, "kid-1", newTestClaims("ale.galan@gmail.com", RoleReferee)))
+		assert.NoError(t, err)
+		assert.True(t, claims.HasAnyRole(RoleReferee))
+	})
+
+	t.Run("unknown kid", func(t *testing.T) {
+		_, err := validator.Validate(signRS256(t, key, "kid-2", newTestClaims("ale.galan@gmail.com", RoleReferee)))
+		assert.Error(t, err)
+	})
+
+	t.Run("HS256 token is rejected", func(t *testing.T) {
+		_, err := validator.Validate(signHS256(t, newTestClaims("ale.galan@gmail.com", RoleAdmin)))
+		assert.Error(t, err)
+	})
+}
+
+func TestParseJWKS_Fail(t *testing.T) {
+	_, err := parseJWKS([]byte(`not json`))
+	assert.Error(t, err)
+
+	_, err = parseJWKS([]byte(`{"keys":[{"kid":"enc","kty":"RSA","use":"enc","n":"AQAB","e":"AQAB"}]}`))
+	assert.EqualError(t, err, "JWKS without RSA signature keys")
+
+	_, err = parseJWKS([]byte(`{"keys":[{"kid":"bad","kty":"RSA","n":"***","e":"AQAB"}]}`))
+	assert.Error(t, err)
+}
+
+func TestNewTokenValidator(t *testing.T) {
+	originalGetEnv := getEnv
+	defer func() { getEnv = originalGetEnv }()
+	originalReadFile := readFile
+	defer func() { readFile = originalReadFile }()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	readFile = func(path string) ([]byte, error) {
+		return jwksOf(&key.PublicKey, "kid-1"), nil
+	}
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		token    string
+		expected string
+	}{
+		{"missing configuration", map[string]string{}, "", "either JWT_JWKS_FILE or JWT_HS256_SECRET must be set"},
+		{"HS256", map[string]string{hs256SecretEnv: testSecret}, signHS256(t, newTestClaims("a@b.com", RoleAdmin)), ""},
+		{"JWKS has priority", map[string]string{hs256SecretEnv: testSecret, jwksFileEnv: "jwks.json"},
+			signRS256(t, key, "kid-1", newTestClaims("a@b.com", RoleAdmin)), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getEnv = func(key string) string {
+				return tt.env[key]
+			}
+
+			validator, err := NewTokenValidator()
+			if len(tt.expected) > 0 {
+				assert.EqualError(t, err, tt.expected)
+				return
+			}
+			assert.NoError(t, err)
+			_, err = validator.Validate(tt.token)
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("issuer is enforced", func(t *testing.T) {
+		getEnv = func(key string) string {
+			return map[string]string{hs256SecretEnv: testSecret, issuerEnv: "padelplace"}[key]
+		}
+		validator, err := NewTokenValidator()
+		assert.NoError(t, err)
+		_, err = validator.Validate(signHS256(t, newTestClaims("a@b.com", RoleAdmin)))
+		assert.ErrorIs(t, err, jwt.ErrTokenRequiredClaimMissing, "Expected issuer to be required")
+	})
+}
@@ -0,0 +1,165 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWait     = 2 * time.Millisecond
+	defaultMaxBatch = 100
+)
+
+// BatchFunc returns the values of the keys found, missing keys are simply absent from the map.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader batches the keys loaded within a short wait window (e.g. by resolvers running concurrently) into a
+// single BatchFunc call and caches the results, i.e. it's meant to live as long as a single request.
+type Loader[K comparable, V any] struct {
+	batchFunc BatchFunc[K, V]
+	wait      time.Duration
+	maxBatch  int
+
+	mu      sync.Mutex
+	cache   map[K]*result[V]
+	pending *batch[K, V]
+}
+
+type result[V any] struct {
+	value V
+	found bool
+	err   error
+	done  chan struct{}
+}
+
+type batch[K comparable, V any] struct {
+	keys    []K
+	results map[K]*result[V]
+}
+
+type Option func(*options)
+
+type options struct {
+	wait     time.Duration
+	maxBatch int
+}
+
+// WithWait sets how long keys are collected before the batch is dispatched.
+func WithWait(wait time.Duration) Option {
+	return func(o *options) { o.wait = wait }
+}
+
+// WithMaxBatch dispatches the batch as soon as it holds the number of keys.
+func WithMaxBatch(maxBatch int) Option {
+	return func(o *options) { o.maxBatch = maxBatch }
+}
+
+func New[K comparable, V any](batchFunc BatchFunc[K, V], opts ...Option) *Loader[K, V] {
+	o := options{wait: defaultWait, maxBatch: defaultMaxBatch}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Loader[K, V]{
+		batchFunc: batchFunc,
+		wait:      o.wait,
+		maxBatch:  o.maxBatch,
+		cache:     make(map[K]*result[V]),
+	}
+}
+
+// Load returns the value of the key and whether it was found.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, bool, error) {
+	l.mu.Lock()
+	res, ok := l.cache[key]
+	if !ok {
+		res = &result[V]{done: make(chan struct{})}
+		l.cache[key] = res
+		l.enqueue(ctx, key, res)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-res.done:
+		return res.value, res.found, res.err
+	case <-ctx.Done():
+		var zero V
+		return zero, false, ctx.Err()
+	}
+}
+
+// LoadMany returns the values of the keys found keeping the order of the keys.
+func (l *Loader[K, V]) LoadMany(ctx context.Context, keys []K) ([]V, error) {
+	values := make([]V, 0, len(keys))
+	for _, key := range keys {
+		value, found, err := l.Load(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
+// Prime caches a value already known, e.g. one loaded by a parent resolver.
+func (l *Loader[K, V]) Prime(key K, value V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.cache[key]; !ok {
+		done := make(chan struct{})
+		close(done)
+		l.cache[key] = &result[V]{value: value, found: true, done: done}
+	}
+}
+
+// enqueue must be called holding the lock.
+func (l *Loader[K, V]) enqueue(ctx context.Context, key K, res *result[V]) {
+	if l.pending == nil {
+		pending := &batch[K, V]{results: make(map[K]*result[V])}
+		l.pending = pending
+		time.AfterFunc(l.wait, func() { l.dispatch(ctx, pending) })
+	}
+	l.pending.keys = append(l.pending.keys, key)
+	l.pending.results[key] = res
+	if len(l.pending.keys) >= l.maxBatch {
+		pending := l.pending
+		l.pending = nil
+		go l.run(ctx, pending)
+	}
+}
+
+func (l *Loader[K, V]) dispatch(ctx context.Context, pending *batch[K, V]) {
+	l.mu.Lock()
+	if l.pending != pending {
+		// Already dispatched once full.
+		l.mu.Unlock()
+		return
+	}
+	l.pending = nil
+	l.mu.Unlock()
+	l.run(ctx, pending)
+}
+
+func (l *Loader[K, V]) run(ctx context.Context, pending *batch[K, V]) {
+	values, err := l.batchFunc(ctx, pending.keys)
+	for key, res := range pending.results {
+		if err != nil {
+			res.err = err
+		} else {
+			res.value, res.found = values[key]
+		}
+		close(res.done)
+	}
+	if err != nil {
+		// Failed keys aren't cached so a later request can retry them.
+		l.mu.Lock()
+		for key, res := range pending.results {
+			if l.cache[key] == res {
+				delete(l.cache, key)
+			}
+		}
+		l.mu.Unlock()
+	}
+}
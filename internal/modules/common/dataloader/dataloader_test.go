@@ -0,0 +1,132 @@
+package dataloader
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingBatch struct {
+	mu      sync.Mutex
+	batches [][]string
+	err     error
+}
+
+func (r *recordingBatch) load(_ context.Context, keys []string) (map[string]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+	r.batches = append(r.batches, sorted)
+	if r.err != nil {
+		return nil, r.err
+	}
+	values := make(map[string]string)
+	for _, key := range keys {
+		if key != "missing" {
+			values[key] = "value-" + key
+		}
+	}
+	return values, nil
+}
+
+func TestLoader_BatchesConcurrentLoads(t *testing.T) {
+	recorder := &recordingBatch{}
+	loader := New(recorder.load, WithWait(10*time.Millisecond))
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "b", "a", "missing"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			value, found, err := loader.Load(context.Background(), key)
+			assert.NoError(t, err)
+			assert.Equal(t, key != "missing", found)
+			if found {
+				assert.Equal(t, "value-"+key, value)
+			}
+		}(key)
+	}
+	wg.Wait()
+
+	assert.Equal(t, [][]string{{"a", "b", "missing"}}, recorder.batches)
+
+	// Cached afterwards.
+	value, found, err := loader.Load(context.Background(), "b")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "value-b", value)
+	assert.Len(t, recorder.batches, 1)
+}
+
+func TestLoader_MaxBatch(t *testing.T) {
+	recorder := &recordingBatch{}
+	// Only a full batch is dispatched before the wait.
+	loader := New(recorder.load, WithWait(time.Hour), WithMaxBatch(2))
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "b"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			_, found, err := loader.Load(context.Background(), key)
+			assert.NoError(t, err)
+			assert.True(t, found)
+		}(key)
+	}
+	wg.Wait()
+
+	assert.Equal(t, [][]string{{"a", "b"}}, recorder.batches)
+}
+
+func TestLoader_ContextDone(t *testing.T) {
+	loader := New((&recordingBatch{}).load, WithWait(time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := loader.Load(ctx, "a")
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestLoader_LoadMany(t *testing.T) {
+	recorder := &recordingBatch{}
+	loader := New(recorder.load)
+
+	values, err := loader.LoadMany(context.Background(), []string{"a", "missing", "b"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"value-a", "value-b"}, values)
+}
+
+func TestLoader_Prime(t *testing.T) {
+	recorder := &recordingBatch{}
+	loader := New(recorder.load)
+	loader.Prime("a", "primed")
+
+	value, found, err := loader.Load(context.Background(), "a")
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "primed", value)
+	assert.Empty(t, recorder.batches)
+}
+
+func TestLoader_ErrorsAreNotCached(t *testing.T) {
+	recorder := &recordingBatch{err: errors.New("batch error")}
+	loader := New(recorder.load)
+
+	_, _, err := loader.Load(context.Background(), "a")
+	assert.Error(t, err)
+
+	recorder.err = nil
+	value, found, err := loader.Load(context.Background(), "a")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "value-a", value)
+}
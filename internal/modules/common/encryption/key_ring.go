@@ -0,0 +1,164 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	keysEnv          = "FIELD_ENCRYPTION_KEYS"
+	activeKeyEnv     = "FIELD_ENCRYPTION_ACTIVE_KEY"
+	blindIndexKeyEnv = "FIELD_BLIND_INDEX_KEY"
+	keySize          = 32 // AES-256.
+	minBlindKeySize  = 32
+	// Encrypted values look like "enc:v1:<key id>:<base64(nonce|ciphertext)>".
+	ciphertextPrefix = "enc:v1:"
+)
+
+// Mockable for testing.
+var getEnv = os.Getenv
+
+// KeyRing encrypts fields with AES-GCM using the active key and decrypts them with the key recorded in
+// the ciphertext, so keys can be rotated by adding a new active key and keeping the old ones for reads.
+// It also computes keyed blind indexes (HMAC-SHA256) to look up encrypted values by equality.
+type KeyRing struct {
+	aeads         map[string]cipher.AEAD
+	activeKeyID   string
+	blindIndexKey []byte
+}
+
+func NewKeyRing(keys map[string][]byte, activeKeyID string, blindIndexKey []byte) (*KeyRing, error) {
+	if _, ok := keys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active key %q not found in key ring", activeKeyID)
+	}
+	if len(blindIndexKey) < minBlindKeySize {
+		return nil, fmt.Errorf("blind index key must have at least %d bytes", minBlindKeySize)
+	}
+	aeads := make(map[string]cipher.AEAD, len(keys))
+	for id, key := range keys {
+		if len(id) == 0 || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("invalid key id: %q", id)
+		}
+		if len(key) != keySize {
+			return nil, fmt.Errorf("key %q must have %d bytes", id, keySize)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		aeads[id] = aead
+	}
+	return &KeyRing{aeads: aeads, activeKeyID: activeKeyID, blindIndexKey: blindIndexKey}, nil
+}
+
+// NewKeyRingFromEnv reads FIELD_ENCRYPTION_KEYS ("id1:base64key1,id2:base64key2"), FIELD_ENCRYPTION_ACTIVE_KEY
+// (last key by default) and FIELD_BLIND_INDEX_KEY (base64). The blind index key can't be rotated without
+// recomputing every index, so it's kept apart from the encryption keys.
+func NewKeyRingFromEnv() (*KeyRing, error) {
+	keys := make(map[string][]byte)
+	var lastKeyID string
+	for _, entry := range strings.Split(getEnv(keysEnv), ",") {
+		if len(strings.TrimSpace(entry)) == 0 {
+			continue
+		}
+		id, encoded, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid %s entry, expected <id>:<base64 key>", keysEnv)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", id, err)
+		}
+		keys[id] = key
+		lastKeyID = id
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%s must be set", keysEnv)
+	}
+	activeKeyID := getEnv(activeKeyEnv)
+	if len(activeKeyID) == 0 {
+		activeKeyID = lastKeyID
+	}
+	blindIndexKey, err := base64.StdEncoding.DecodeString(getEnv(blindIndexKeyEnv))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", blindIndexKeyEnv, err)
+	}
+	return NewKeyRing(keys, activeKeyID, blindIndexKey)
+}
+
+// ActiveKeyID returns the key used to encrypt new values.
+func (k *KeyRing) ActiveKeyID() string {
+	return k.activeKeyID
+}
+
+// ActivePrefix is the prefix of values encrypted with the active key, e.g. to find values to rotate.
+func (k *KeyRing) ActivePrefix() string {
+	return ciphertextPrefix + k.activeKeyID + ":"
+}
+
+// Encrypt returns the ciphertext of the value using the active key.
+func (k *KeyRing) Encrypt(plaintext string) (string, error) {
+	aead := k.aeads[k.activeKeyID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return k.ActivePrefix() + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the plaintext of a value encrypted by any key of the ring. Values without the
+// ciphertext prefix are returned as is, i.e. plaintext stored before encryption was enabled.
+func (k *KeyRing) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	id, encoded, ok := strings.Cut(strings.TrimPrefix(value, ciphertextPrefix), ":")
+	if !ok {
+		return "", errors.New("malformed ciphertext")
+	}
+	aead, ok := k.aeads[id]
+	if !ok {
+		return "", fmt.Errorf("unknown encryption key %q", id)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errors.New("malformed ciphertext")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt with key %q: %w", id, err)
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation returns true for plaintext values and values encrypted with a non active key.
+func (k *KeyRing) NeedsRotation(value string) bool {
+	return !strings.HasPrefix(value, k.ActivePrefix())
+}
+
+// BlindIndex returns a deterministic keyed hash of the value so it can be looked up without decrypting.
+func (k *KeyRing) BlindIndex(value string) string {
+	mac := hmac.New(sha256.New, k.blindIndexKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsEncrypted returns true if the value has the ciphertext prefix.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, ciphertextPrefix)
+}
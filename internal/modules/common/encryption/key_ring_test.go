@@ -0,0 +1,122 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testKey1       = bytes.Repeat([]byte{1}, keySize)
+	testKey2       = bytes.Repeat([]byte{2}, keySize)
+	testBlindIndex = bytes.Repeat([]byte{9}, minBlindKeySize)
+)
+
+func TestKeyRing_EncryptDecrypt(t *testing.T) {
+	keyRing, err := NewKeyRing(map[string][]byte{"k1": testKey1}, "k1", testBlindIndex)
+	assert.NoError(t, err)
+
+	ciphertext, err := keyRing.Encrypt("20123456789")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, "enc:v1:k1:"))
+	assert.NotContains(t, ciphertext, "20123456789")
+
+	// Random nonces, i.e. the same value doesn't produce the same ciphertext.
+	other, _ := keyRing.Encrypt("20123456789")
+	assert.NotEqual(t, ciphertext, other)
+
+	plaintext, err := keyRing.Decrypt(ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "20123456789", plaintext)
+
+	// Legacy plaintext values are returned as is.
+	plaintext, err = keyRing.Decrypt("20123456789")
+	assert.NoError(t, err)
+	assert.Equal(t, "20123456789", plaintext)
+}
+
+func TestKeyRing_Rotation(t *testing.T) {
+	oldRing, _ := NewKeyRing(map[string][]byte{"k1": testKey1}, "k1", testBlindIndex)
+	ciphertext, _ := oldRing.Encrypt("20123456789")
+
+	rotatedRing, err := NewKeyRing(map[string][]byte{"k1": testKey1, "k2": testKey2}, "k2", testBlindIndex)
+	assert.NoError(t, err)
+	assert.True(t, rotatedRing.NeedsRotation(ciphertext))
+	assert.True(t, rotatedRing.NeedsRotation("20123456789"))
+
+	plaintext, err := rotatedRing.Decrypt(ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "20123456789", plaintext)
+
+	rotated, _ := rotatedRing.Encrypt(plaintext)
+	assert.False(t, rotatedRing.NeedsRotation(rotated))
+	assert.Equal(t, oldRing.BlindIndex("20123456789"), rotatedRing.BlindIndex("20123456789"))
+
+	// Removed keys can't decrypt anymore.
+	newRing, _ := NewKeyRing(map[string][]byte{"k2": testKey2}, "k2", testBlindIndex)
+	_, err = newRing.Decrypt(ciphertext)
+	assert.EqualError(t, err, `unknown encryption key "k1"`)
+}
+
+func TestKeyRing_DecryptFail(t *testing.T) {
+	keyRing, _ := NewKeyRing(map[string][]byte{"k1": testKey1}, "k1", testBlindIndex)
+	ciphertext, _ := keyRing.Encrypt("20123456789")
+
+	_, err := keyRing.Decrypt("enc:v1:k1")
+	assert.EqualError(t, err, "malformed ciphertext")
+	_, err = keyRing.Decrypt("enc:v1:k1:@@@")
+	assert.EqualError(t, err, "malformed ciphertext")
+
+	tampered := ciphertext[:len(ciphertext)-4] + "AAAA"
+	_, err = keyRing.Decrypt(tampered)
+	assert.Error(t, err)
+}
+
+func TestKeyRing_BlindIndex(t *testing.T) {
+	keyRing, _ := NewKeyRing(map[string][]byte{"k1": testKey1}, "k1", testBlindIndex)
+	otherRing, _ := NewKeyRing(map[string][]byte{"k1": testKey1}, "k1", bytes.Repeat([]byte{8}, minBlindKeySize))
+
+	assert.Equal(t, keyRing.BlindIndex("20123456789"), keyRing.BlindIndex("20123456789"))
+	assert.NotEqual(t, keyRing.BlindIndex("20123456789"), keyRing.BlindIndex("20123456788"))
+	assert.NotEqual(t, keyRing.BlindIndex("20123456789"), otherRing.BlindIndex("20123456789"))
+	assert.Len(t, keyRing.BlindIndex("20123456789"), 64)
+}
+
+func TestNewKeyRing_Invalid(t *testing.T) {
+	_, err := NewKeyRing(map[string][]byte{"k1": testKey1}, "k2", testBlindIndex)
+	assert.EqualError(t, err, `active key "k2" not found in key ring`)
+	_, err = NewKeyRing(map[string][]byte{"k1": testKey1[:16]}, "k1", testBlindIndex)
+	assert.EqualError(t, err, `key "k1" must have 32 bytes`)
+	_, err = NewKeyRing(map[string][]byte{"k1": testKey1}, "k1", nil)
+	assert.EqualError(t, err, "blind index key must have at least 32 bytes")
+}
+
+func TestNewKeyRingFromEnv(t *testing.T) {
+	original := getEnv
+	defer func() { getEnv = original }()
+	env := map[string]string{
+		keysEnv:          "k1:" + base64.StdEncoding.EncodeToString(testKey1) + ", k2:" + base64.StdEncoding.EncodeToString(testKey2),
+		blindIndexKeyEnv: base64.StdEncoding.EncodeToString(testBlindIndex),
+	}
+	getEnv = func(key string) string { return env[key] }
+
+	keyRing, err := NewKeyRingFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, "k2", keyRing.ActiveKeyID())
+
+	env[activeKeyEnv] = "k1"
+	keyRing, err = NewKeyRingFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, "k1", keyRing.ActiveKeyID())
+
+	env[keysEnv] = ""
+	_, err = NewKeyRingFromEnv()
+	assert.EqualError(t, err, "FIELD_ENCRYPTION_KEYS must be set")
+
+	env[keysEnv] = "no-separator"
+	_, err = NewKeyRingFromEnv()
+	assert.Error(t, err)
+}
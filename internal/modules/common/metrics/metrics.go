@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "padelplace"
+
+// Own registry instead of the global default one so tests and modules don't collide with
+// third party collectors registered elsewhere.
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency per gin route, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	useCaseOutcomes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "use_case",
+		Name:      "outcomes_total",
+		Help:      "Use case executions per resulting status.",
+	}, []string{"use_case", "outcome"})
+
+	useCaseDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "use_case",
+		Name:      "duration_seconds",
+		Help:      "Use case latency.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"use_case"})
+
+	mongoOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "mongo",
+		Name:      "operation_duration_seconds",
+		Help:      "Mongo command latency per collection and command.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"collection", "command"})
+
+	mongoOperationErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mongo",
+		Name:      "operation_errors_total",
+		Help:      "Failed Mongo commands per collection and command.",
+	}, []string{"collection", "command"})
+
+	mongoPoolConnections = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "mongo_pool",
+		Name:      "connections",
+		Help:      "Mongo pool connections per state (open or in_use).",
+	}, []string{"state"})
+
+	mongoPoolEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mongo_pool",
+		Name:      "events_total",
+		Help:      "Mongo pool events as reported by the driver pool monitor.",
+	}, []string{"event"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestDuration,
+		useCaseOutcomes,
+		useCaseDuration,
+		mongoOperationDuration,
+		mongoOperationErrors,
+		mongoPoolConnections,
+		mongoPoolEvents,
+	)
+}
+
+// Handler exposes the registered metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// ObserveUseCase records the outcome (normally the String() of the use case status) and latency of a use case.
+func ObserveUseCase(useCase, outcome string, start time.Time) {
+	useCaseOutcomes.WithLabelValues(useCase, outcome).Inc()
+	useCaseDuration.WithLabelValues(useCase).Observe(time.Since(start).Seconds())
+}
+
+// ObserveMongoOperation records the latency of a Mongo command and counts it as error when it failed.
+func ObserveMongoOperation(collection, command string, duration time.Duration, failed bool) {
+	mongoOperationDuration.WithLabelValues(collection, command).Observe(duration.Seconds())
+	if failed {
+		mongoOperationErrors.WithLabelValues(collection, command).Inc()
+	}
+}
+
+// AddMongoPoolConnections adjusts the gauge of pool connections for the given state, e.g. "open" or "in_use".
+func AddMongoPoolConnections(state string, delta float64) {
+	mongoPoolConnections.WithLabelValues(state).Add(delta)
+}
+
+// IncMongoPoolEvent counts a pool monitor event by its driver type, e.g. "ConnectionCreated".
+func IncMongoPoolEvent(event string) {
+	mongoPoolEvents.WithLabelValues(event).Inc()
+}
@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGinMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(GinMiddleware())
+	router.GET("/players/:playerId", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	t.Run("route template is used as label", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/players/some-id", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, uint64(1), sampleCount(t, httpRequestDuration.WithLabelValues(http.MethodGet, "/players/:playerId", "404")))
+	})
+
+	t.Run("unmatched route", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/unknown/path", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, uint64(1), sampleCount(t, httpRequestDuration.WithLabelValues(http.MethodGet, unmatchedRoute, "404")))
+	})
+}
+
+func sampleCount(t *testing.T, observer prometheus.Observer) uint64 {
+	var m dto.Metric
+	assert.NoError(t, observer.(prometheus.Metric).Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestObserveUseCase(t *testing.T) {
+	ObserveUseCase("TestUseCase", "TestOutcome", time.Now())
+	ObserveUseCase("TestUseCase", "TestOutcome", time.Now())
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(useCaseOutcomes.WithLabelValues("TestUseCase", "TestOutcome")))
+}
+
+func TestObserveMongoOperation(t *testing.T) {
+	ObserveMongoOperation("test_col", "find", time.Millisecond, false)
+	ObserveMongoOperation("test_col", "find", time.Millisecond, true)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(mongoOperationErrors.WithLabelValues("test_col", "find")))
+}
+
+func TestMongoPool(t *testing.T) {
+	AddMongoPoolConnections("test_state", 2)
+	AddMongoPoolConnections("test_state", -1)
+	IncMongoPoolEvent("TestEvent")
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(mongoPoolConnections.WithLabelValues("test_state")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(mongoPoolEvents.WithLabelValues("TestEvent")))
+}
+
+func TestHandler(t *testing.T) {
+	ObserveUseCase("HandlerUseCase", "HandlerOutcome", time.Now())
+
+	server := httptest.NewServer(Handler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), `padelplace_use_case_outcomes_total{outcome="HandlerOutcome",use_case="HandlerUseCase"} 1`)
+}
@@ -0,0 +1,57 @@
+package migration
+
+import (
+	"context"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CreateIndexes is the Up of migrations adding indexes to the collection. Indexes must be named, so DropIndexes
+// can revert them.
+func CreateIndexes(colName string, indexes ...mongo.IndexModel) func(ctx context.Context, client common.MongoClient) error {
+	return func(ctx context.Context, client common.MongoClient) error {
+		_, err := client.GetCollection(ctx, colName).Indexes().CreateMany(ctx, indexes)
+		return err
+	}
+}
+
+// DropIndexes is the Down of CreateIndexes.
+func DropIndexes(colName string, indexes ...mongo.IndexModel) func(ctx context.Context, client common.MongoClient) error {
+	return func(ctx context.Context, client common.MongoClient) error {
+		for _, index := range indexes {
+			if _, err := client.GetCollection(ctx, colName).Indexes().DropOne(ctx, *index.Options.Name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// ReplaceIndex is the Up of migrations replacing an index of the collection, its Down replacing the indexes back.
+// The new index is created before dropping the old one, so queries (and unique constraints) are never left without.
+func ReplaceIndex(colName string, old, new mongo.IndexModel) func(ctx context.Context, client common.MongoClient) error {
+	return func(ctx context.Context, client common.MongoClient) error {
+		if err := CreateIndexes(colName, new)(ctx, client); err != nil {
+			return err
+		}
+		return DropIndexes(colName, old)(ctx, client)
+	}
+}
+
+// AssignTenant is the Up of migrations scoping the documents of collections to tenants (see mongo.TenantCollection),
+// documents stored before being assigned to the tenant of ctx, i.e. tenant.Default unless migrating the database of
+// a tenant. Documents can't be unassigned, as they could be of several tenants by then.
+func AssignTenant(colNames ...string) func(ctx context.Context, client common.MongoClient) error {
+	return func(ctx context.Context, client common.MongoClient) error {
+		for _, colName := range colNames {
+			if _, err := client.GetCollection(ctx, colName).UpdateMany(ctx, bson.M{common.TenantField: bson.M{"$exists": false}},
+				bson.M{"$set": bson.M{common.TenantField: tenant.ID(ctx)}}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
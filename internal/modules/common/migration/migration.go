@@ -0,0 +1,337 @@
+package migration
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	migrationsColName = "_migrations"
+	lockColName       = "_migrations_lock"
+	lockID            = "migrations"
+	// lockTTL releases the lock of processes that crashed while migrating, the ones migrating renew it meanwhile.
+	lockTTL      = 10 * time.Minute
+	onStartupEnv = "MIGRATE_ON_STARTUP"
+)
+
+var (
+	// ErrLocked is returned while another process (e.g. another replica starting) runs the migrations.
+	ErrLocked = errors.New("migrations are locked by another process")
+	// ErrLockLost is returned when the lock expired while migrating (e.g. the process was paused for longer than
+	// lockTTL), the migrations are stopped as another process may run them meanwhile.
+	ErrLockLost = errors.New("migrations lock lost")
+)
+
+// Mockable for testing.
+var (
+	getEnv = os.Getenv
+	now    = time.Now
+	// lockRenewal is the period the lock is renewed at while migrating, several renewals fit in lockTTL so a failed
+	// one (e.g. on a network error) is retried before it expires.
+	lockRenewal = lockTTL / 4
+	// lockRetry is the wait of UpWhenUnlocked between attempts while the lock is held.
+	lockRetry = 5 * time.Second
+)
+
+// Migration changes the shape of documents, or their indexes. Down reverts Up, it's nil for migrations that can't
+// be reverted.
+type Migration struct {
+	// Version orders the migrations. It's a date with a sequence number (YYYYMMDDNN) so the ones of different modules
+	// don't collide.
+	Version     int
+	Description string
+	Up          func(ctx context.Context, client common.MongoClient) error
+	Down        func(ctx context.Context, client common.MongoClient) error
+}
+
+// Status of a migration, AppliedAt being nil for pending ones.
+type Status struct {
+	Version     int        `json:"version"`
+	Description string     `json:"description"`
+	AppliedAt   *time.Time `json:"appliedAt,omitempty"`
+}
+
+// record is the document of applied migrations in _migrations.
+type record struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"appliedAt"`
+}
+
+// Migrator applies migrations in version order and records them in _migrations. Up and Down hold a lock (in
+// _migrations_lock), so only one process migrates at a time.
+type Migrator struct {
+	client     common.MongoClient
+	migrations []Migration
+	owner      string
+}
+
+// NewMigrator sorts the migrations, returning an error for duplicated versions or migrations without Up.
+func NewMigrator(client common.MongoClient, migrations ...Migration) (*Migrator, error) {
+	sorted := slices.Clone(migrations)
+	slices.SortFunc(sorted, func(a, b Migration) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+	for i, migration := range sorted {
+		if migration.Version <= 0 || migration.Up == nil {
+			return nil, fmt.Errorf("invalid migration %d (%s), expected a positive version and Up", migration.Version, migration.Description)
+		}
+		if i > 0 && sorted[i-1].Version == migration.Version {
+			return nil, fmt.Errorf("duplicated migration version %d", migration.Version)
+		}
+	}
+	hostname, _ := os.Hostname()
+	return &Migrator{
+		client:     client,
+		migrations: sorted,
+		owner:      fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), now().UnixNano()),
+	}, nil
+}
+
+// RunOnStartup reads MIGRATE_ON_STARTUP, true by default. Disable it to migrate through padelctl instead.
+func RunOnStartup() (bool, error) {
+	value := getEnv(onStartupEnv)
+	if len(value) == 0 {
+		return true, nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %s", onStartupEnv, value)
+	}
+	return enabled, nil
+}
+
+// Status returns every migration in version order, including the applied ones unknown to this version of the code.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Description: migration.Description}
+		if record, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &record.AppliedAt
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, record := range applied {
+		statuses = append(statuses, Status{Version: record.Version, Description: record.Description, AppliedAt: &record.AppliedAt})
+	}
+	slices.SortFunc(statuses, func(a, b Status) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+	return statuses, nil
+}
+
+// Up applies the pending migrations up to the target version (all of them when 0), returning them. On dry runs
+// nothing is applied (nor locked), the pending migrations are returned without AppliedAt.
+func (m *Migrator) Up(ctx context.Context, target int, dryRun bool) ([]Status, error) {
+	return m.migrate(ctx, dryRun, func(ctx context.Context, applied map[int]record) ([]Status, error) {
+		var pending []Migration
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; !ok && (target == 0 || migration.Version <= target) {
+				pending = append(pending, migration)
+			}
+		}
+		if dryRun {
+			return statusesOf(pending), nil
+		}
+		var done []Status
+		for _, migration := range pending {
+			if err := migration.Up(ctx, m.client); err != nil {
+				return done, fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Description, err)
+			}
+			record := record{Version: migration.Version, Description: migration.Description, AppliedAt: now().UTC()}
+			if _, err := m.records(ctx).InsertOne(ctx, record); err != nil {
+				return done, err
+			}
+			done = append(done, Status{Version: record.Version, Description: record.Description, AppliedAt: &record.AppliedAt})
+		}
+		return done, nil
+	})
+}
+
+// UpWhenUnlocked applies all the pending migrations, waiting while another process holds the lock until it releases
+// it, i.e. until the migrations it ran are recorded. It returns ErrLocked once ctx is done (e.g. its deadline passed)
+// while still waiting.
+func (m *Migrator) UpWhenUnlocked(ctx context.Context) ([]Status, error) {
+	for {
+		applied, err := m.Up(ctx, 0, false)
+		if !errors.Is(err, ErrLocked) {
+			return applied, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %w", err, context.Cause(ctx))
+		case <-time.After(lockRetry):
+		}
+	}
+}
+
+// Down reverts the applied migrations above the target version, latest first, returning them. Nothing is reverted
+// when one of them can't be, i.e. it has no Down or it's unknown to this version of the code.
+func (m *Migrator) Down(ctx context.Context, target int, dryRun bool) ([]Status, error) {
+	return m.migrate(ctx, dryRun, func(ctx context.Context, applied map[int]record) ([]Status, error) {
+		var reverted []Migration
+		for version := range applied {
+			if version <= target {
+				continue
+			}
+			index, ok := slices.BinarySearchFunc(m.migrations, version, func(migration Migration, version int) int {
+				return cmp.Compare(migration.Version, version)
+			})
+			if !ok || m.migrations[index].Down == nil {
+				return nil, fmt.Errorf("migration %d (%s) can't be reverted", version, applied[version].Description)
+			}
+			reverted = append(reverted, m.migrations[index])
+		}
+		slices.SortFunc(reverted, func(a, b Migration) int {
+			return cmp.Compare(b.Version, a.Version)
+		})
+		var done []Status
+		for _, migration := range reverted {
+			if dryRun {
+				done = append(done, Status{Version: migration.Version, Description: migration.Description})
+				continue
+			}
+			if err := migration.Down(ctx, m.client); err != nil {
+				return done, fmt.Errorf("migration %d (%s) revert failed: %w", migration.Version, migration.Description, err)
+			}
+			if _, err := m.records(ctx).DeleteOne(ctx, bson.M{"_id": migration.Version}); err != nil {
+				return done, err
+			}
+			done = append(done, Status{Version: migration.Version, Description: migration.Description})
+		}
+		return done, nil
+	})
+}
+
+// migrate runs the plan while holding the lock, reading the applied migrations once locked. The plan is given a
+// context canceled when the lock is lost.
+func (m *Migrator) migrate(ctx context.Context, dryRun bool, plan func(ctx context.Context, applied map[int]record) ([]Status, error)) (statuses []Status, err error) {
+	if dryRun {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return plan(ctx, applied)
+	}
+	if err := m.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		// A lost lock isn't released, it may be another process's already.
+		if !errors.Is(err, ErrLockLost) {
+			err = errors.Join(err, m.release(context.WithoutCancel(ctx)))
+		}
+	}()
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	locked, unlock := m.keepLocked(ctx)
+	statuses, err = plan(locked, applied)
+	unlock()
+	if errors.Is(context.Cause(locked), ErrLockLost) {
+		err = errors.Join(err, ErrLockLost)
+	}
+	return statuses, err
+}
+
+// records and lock are resolved on every call, as the database may be the one of the tenant of ctx (see
+// mongo.MongoClient), i.e. migrations are recorded per database.
+func (m *Migrator) records(ctx context.Context) *mongo.Collection {
+	return m.client.GetCollection(ctx, migrationsColName)
+}
+
+func (m *Migrator) lock(ctx context.Context) *mongo.Collection {
+	return m.client.GetCollection(ctx, lockColName)
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int]record, error) {
+	cursor, err := m.records(ctx).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	var records []record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+	applied := make(map[int]record, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// acquire upserts the lock unless another process holds it, i.e. the upsert fails on the existing _id.
+func (m *Migrator) acquire(ctx context.Context) error {
+	at := now()
+	_, err := m.lock(ctx).UpdateOne(ctx,
+		bson.M{"_id": lockID, "expiresAt": bson.M{"$lt": at}},
+		bson.M{"$set": bson.M{"owner": m.owner, "expiresAt": at.Add(lockTTL)}},
+		options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrLocked
+	}
+	return err
+}
+
+// keepLocked renews the lock every lockRenewal until unlock is called, so migrations may take longer than lockTTL. The
+// context returned is canceled with ErrLockLost when the lock isn't this process's anymore.
+func (m *Migrator) keepLocked(ctx context.Context) (locked context.Context, unlock func()) {
+	locked, cancel := context.WithCancelCause(ctx)
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(lockRenewal)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-locked.Done():
+				return
+			case <-ticker.C:
+				result, err := m.lock(ctx).UpdateOne(locked, bson.M{"_id": lockID, "owner": m.owner},
+					bson.M{"$set": bson.M{"expiresAt": now().Add(lockTTL)}})
+				// Failed renewals are retried on the next tick, the lock being valid for several of them.
+				if err == nil && result.MatchedCount == 0 {
+					cancel(ErrLockLost)
+					return
+				}
+			}
+		}
+	}()
+	return locked, func() {
+		close(done)
+		<-stopped
+		cancel(nil)
+	}
+}
+
+func (m *Migrator) release(ctx context.Context) error {
+	_, err := m.lock(ctx).DeleteOne(ctx, bson.M{"_id": lockID, "owner": m.owner})
+	return err
+}
+
+func statusesOf(migrations []Migration) []Status {
+	statuses := make([]Status, len(migrations))
+	for i, migration := range migrations {
+		statuses[i] = Status{Version: migration.Version, Description: migration.Description}
+	}
+	return statuses
+}
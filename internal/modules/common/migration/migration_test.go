@@ -0,0 +1,259 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+const (
+	testDbName       = "testdb"
+	testMigrationsNs = testDbName + "." + migrationsColName
+)
+
+type mongoClientMock struct {
+	database *mongo.Database
+}
+
+func (m *mongoClientMock) GetCollection(_ context.Context, collectionName string) *mongo.Collection {
+	return m.database.Collection(collectionName)
+}
+
+func (m *mongoClientMock) Close() error {
+	return nil
+}
+
+func newMongoClientMock(client *mongo.Client) common.MongoClient {
+	return &mongoClientMock{database: client.Database(testDbName)}
+}
+
+var appliedAt = time.Date(2026, time.October, 19, 8, 0, 0, 0, time.UTC)
+
+// testMigrations appends the versions run by Up and Down to calls.
+func testMigrations(calls *[]int) []Migration {
+	migration := func(version int, reversible bool) Migration {
+		m := Migration{Version: version, Description: "migration", Up: func(context.Context, common.MongoClient) error {
+			*calls = append(*calls, version)
+			return nil
+		}}
+		if reversible {
+			m.Down = func(context.Context, common.MongoClient) error {
+				*calls = append(*calls, -version)
+				return nil
+			}
+		}
+		return m
+	}
+	return []Migration{migration(3, true), migration(1, false), migration(2, true)}
+}
+
+func appliedResponse(versions ...int) []bson.D {
+	records := make([]bson.D, len(versions))
+	for i, version := range versions {
+		records[i] = bson.D{{Key: "_id", Value: version}, {Key: "description", Value: "migration"}, {Key: "appliedAt", Value: appliedAt}}
+	}
+	return []bson.D{
+		mtest.CreateCursorResponse(1, testMigrationsNs, mtest.FirstBatch, records...),
+		mtest.CreateCursorResponse(0, testMigrationsNs, mtest.NextBatch),
+	}
+}
+
+func TestNewMigrator_Invalid(t *testing.T) {
+	up := func(context.Context, common.MongoClient) error { return nil }
+	_, err := NewMigrator(nil, Migration{Version: 1, Up: up}, Migration{Version: 1, Up: up})
+	assert.EqualError(t, err, "duplicated migration version 1")
+	_, err = NewMigrator(nil, Migration{Version: 1, Description: "no up"})
+	assert.EqualError(t, err, "invalid migration 1 (no up), expected a positive version and Up")
+}
+
+func TestMigrator_Up(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Applies pending migrations in order", func(mt *mtest.T) {
+		var calls []int
+		migrator, err := NewMigrator(newMongoClientMock(mt.Client), testMigrations(&calls)...)
+		assert.NoError(t, err)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+		mt.AddMockResponses(appliedResponse(1)...)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+
+		applied, err := migrator.Up(context.Background(), 0, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 3}, calls)
+		assert.Len(t, applied, 2)
+		assert.Equal(t, 2, applied[0].Version)
+		assert.NotNil(t, applied[0].AppliedAt)
+		var commands []string
+		for event := mt.GetStartedEvent(); event != nil; event = mt.GetStartedEvent() {
+			commands = append(commands, event.CommandName)
+		}
+		assert.Equal(t, []string{"update", "find", "getMore", "insert", "insert", "delete"}, commands, "Expected the lock released")
+	})
+
+	mt.Run("Dry run up to the target", func(mt *mtest.T) {
+		var calls []int
+		migrator, _ := NewMigrator(newMongoClientMock(mt.Client), testMigrations(&calls)...)
+		mt.AddMockResponses(appliedResponse()...)
+
+		pending, err := migrator.Up(context.Background(), 2, true)
+		assert.NoError(t, err)
+		assert.Empty(t, calls)
+		assert.Equal(t, []Status{{Version: 1, Description: "migration"}, {Version: 2, Description: "migration"}}, pending)
+	})
+
+	mt.Run("Locked by another process", func(mt *mtest.T) {
+		var calls []int
+		migrator, _ := NewMigrator(newMongoClientMock(mt.Client), testMigrations(&calls)...)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Code: 11000, Message: "duplicate key"}))
+
+		_, err := migrator.Up(context.Background(), 0, false)
+		assert.ErrorIs(t, err, ErrLocked)
+		assert.Empty(t, calls)
+	})
+
+	mt.Run("Failed migration", func(mt *mtest.T) {
+		migrator, _ := NewMigrator(newMongoClientMock(mt.Client), Migration{Version: 1, Description: "failing",
+			Up: func(context.Context, common.MongoClient) error { return errors.New("boom") }})
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+		mt.AddMockResponses(appliedResponse()...)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		_, err := migrator.Up(context.Background(), 0, false)
+		assert.EqualError(t, err, "migration 1 (failing) failed: boom")
+	})
+}
+
+func TestMigrator_LockRenewal(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	original := lockRenewal
+	defer func() { lockRenewal = original }()
+	lockRenewal = time.Millisecond
+
+	mt.Run("Migrations stopped once the lock is lost", func(mt *mtest.T) {
+		// Runs until the lock is lost, i.e. longer than it's valid without renewals.
+		migrator, _ := NewMigrator(newMongoClientMock(mt.Client), Migration{Version: 1, Description: "long",
+			Up: func(ctx context.Context, _ common.MongoClient) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}})
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+		mt.AddMockResponses(appliedResponse()...)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}), mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}))
+
+		applied, err := migrator.Up(context.Background(), 0, false)
+		assert.ErrorIs(t, err, ErrLockLost)
+		assert.Empty(t, applied)
+		var commands []string
+		for event := mt.GetStartedEvent(); event != nil; event = mt.GetStartedEvent() {
+			commands = append(commands, event.CommandName)
+			if len(commands) == 4 {
+				update := event.Command.Lookup("updates").Array().Index(0).Value().Document()
+				assert.Equal(t, migrator.owner, update.Lookup("q", "owner").StringValue(), "Expected only the own lock renewed")
+				assert.Equal(t, bson.TypeDateTime, update.Lookup("u", "$set", "expiresAt").Type)
+			}
+		}
+		assert.Equal(t, []string{"update", "find", "killCursors", "update", "update"}, commands, "Expected the lost lock not released")
+	})
+}
+
+func TestMigrator_UpWhenUnlocked(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	original := lockRetry
+	defer func() { lockRetry = original }()
+	lockRetry = time.Millisecond
+	locked := mtest.CreateWriteErrorsResponse(mtest.WriteError{Code: 11000, Message: "duplicate key"})
+
+	mt.Run("Waits until the migrations of another process are recorded", func(mt *mtest.T) {
+		var calls []int
+		migrator, _ := NewMigrator(newMongoClientMock(mt.Client), testMigrations(&calls)...)
+		mt.AddMockResponses(locked, locked, mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+		mt.AddMockResponses(appliedResponse(1, 2, 3)...)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		applied, err := migrator.UpWhenUnlocked(context.Background())
+		assert.NoError(t, err)
+		assert.Empty(t, applied)
+		assert.Empty(t, calls, "Expected the migrations applied by the other process skipped")
+	})
+
+	mt.Run("Gives up once the context is done", func(mt *mtest.T) {
+		lockRetry = time.Hour
+		var calls []int
+		migrator, _ := NewMigrator(newMongoClientMock(mt.Client), testMigrations(&calls)...)
+		mt.AddMockResponses(locked)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := migrator.UpWhenUnlocked(ctx)
+		assert.ErrorIs(t, err, ErrLocked)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Empty(t, calls)
+	})
+}
+
+func TestMigrator_Down(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Reverts latest first", func(mt *mtest.T) {
+		var calls []int
+		migrator, _ := NewMigrator(newMongoClientMock(mt.Client), testMigrations(&calls)...)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+		mt.AddMockResponses(appliedResponse(1, 2, 3)...)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+
+		reverted, err := migrator.Down(context.Background(), 1, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{-3, -2}, calls)
+		assert.Equal(t, []Status{{Version: 3, Description: "migration"}, {Version: 2, Description: "migration"}}, reverted)
+	})
+
+	mt.Run("Irreversible migration", func(mt *mtest.T) {
+		var calls []int
+		migrator, _ := NewMigrator(newMongoClientMock(mt.Client), testMigrations(&calls)...)
+		mt.AddMockResponses(appliedResponse(1, 2)...)
+
+		_, err := migrator.Down(context.Background(), 0, true)
+		assert.EqualError(t, err, "migration 1 (migration) can't be reverted")
+		assert.Empty(t, calls)
+	})
+}
+
+func TestMigrator_Status(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Includes unknown applied migrations", func(mt *mtest.T) {
+		var calls []int
+		migrator, _ := NewMigrator(newMongoClientMock(mt.Client), testMigrations(&calls)...)
+		mt.AddMockResponses(appliedResponse(1, 4)...)
+
+		statuses, err := migrator.Status(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []Status{
+			{Version: 1, Description: "migration", AppliedAt: &appliedAt},
+			{Version: 2, Description: "migration"},
+			{Version: 3, Description: "migration"},
+			{Version: 4, Description: "migration", AppliedAt: &appliedAt},
+		}, statuses)
+	})
+}
+
+func TestRunOnStartup(t *testing.T) {
+	original := getEnv
+	defer func() { getEnv = original }()
+	for value, expected := range map[string]bool{"": true, "true": true, "false": false, "0": false} {
+		getEnv = func(string) string { return value }
+		enabled, err := RunOnStartup()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, enabled, "MIGRATE_ON_STARTUP=%s", value)
+	}
+	getEnv = func(string) string { return "never" }
+	_, err := RunOnStartup()
+	assert.EqualError(t, err, "invalid MIGRATE_ON_STARTUP: never")
+}
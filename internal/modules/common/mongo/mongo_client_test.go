@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
@@ -33,7 +34,7 @@ func TestNewMongoClient(t *testing.T) {
 
 		mongoClient := NewMongoClient()
 		assert.NotNil(t, mongoClient)
-		tc := mongoClient.GetCollection("testCol")
+		tc := mongoClient.GetCollection(context.Background(), "testCol")
 		assert.NotNil(t, tc)
 		// 1.13.0 the Close() method for mtest package is removed, this method is not necessary.
 		// Debugging the following code will succeed but the test at the end fails as it will call
@@ -96,3 +97,35 @@ func TestResolveMongoUri(t *testing.T) {
 		})
 	}
 }
+
+func TestMongoClient_GetCollection(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	mt.Run("database per tenant", func(mt *mtest.T) {
+		client := &mongoClient{client: mt.Client, database: mt.Client.Database(dbName), databasePerTenant: true}
+
+		assert.Equal(t, "padeldb-fep", client.GetCollection(tenant.WithID(context.Background(), "fep"), "players").Database().Name())
+		assert.Equal(t, dbName, client.GetCollection(tenant.WithID(context.Background(), tenant.Default), "players").Database().Name())
+		assert.Equal(t, dbName, client.GetCollection(context.Background(), "players").Database().Name())
+	})
+
+	mt.Run("shared database", func(mt *mtest.T) {
+		client := &mongoClient{client: mt.Client, database: mt.Client.Database(dbName)}
+
+		assert.Equal(t, dbName, client.GetCollection(tenant.WithID(context.Background(), "fep"), "players").Database().Name())
+	})
+}
+
+func TestResolveDatabasePerTenant(t *testing.T) {
+	originalGetEnv := getEnv
+	defer func() { getEnv = originalGetEnv }()
+
+	for value, expected := range map[string]bool{"": false, "true": true, "false": false} {
+		getEnv = func(string) string { return value }
+		enabled, err := DatabasePerTenantFromEnv()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, enabled, value)
+	}
+	getEnv = func(string) string { return "yes please" }
+	_, err := DatabasePerTenantFromEnv()
+	assert.EqualError(t, err, "invalid MONGO_DATABASE_PER_TENANT: yes please")
+}
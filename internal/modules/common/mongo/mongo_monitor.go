@@ -0,0 +1,107 @@
+package mongo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/paguerre3/goddd/internal/modules/common/metrics"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// Label used for commands that aren't bound to a collection, e.g. ping or endSessions.
+const noCollection = "none"
+
+// commandMetricsMonitor keeps the collection of every started command so it can be used as label
+// once the command succeeds or fails (the driver only reports the request ID in those events).
+type commandMetricsMonitor struct {
+	collections sync.Map
+}
+
+// newCommandMonitor returns a driver command monitor that records latency and errors per collection.
+func newCommandMonitor() *event.CommandMonitor {
+	m := &commandMetricsMonitor{}
+	return &event.CommandMonitor{
+		Started:   m.started,
+		Succeeded: m.succeeded,
+		Failed:    m.failed,
+	}
+}
+
+func (m *commandMetricsMonitor) started(_ context.Context, evt *event.CommandStartedEvent) {
+	m.collections.Store(evt.RequestID, commandCollection(evt))
+}
+
+func (m *commandMetricsMonitor) succeeded(_ context.Context, evt *event.CommandSucceededEvent) {
+	metrics.ObserveMongoOperation(m.collection(evt.RequestID), evt.CommandName, evt.Duration, false)
+}
+
+func (m *commandMetricsMonitor) failed(_ context.Context, evt *event.CommandFailedEvent) {
+	metrics.ObserveMongoOperation(m.collection(evt.RequestID), evt.CommandName, evt.Duration, true)
+}
+
+func (m *commandMetricsMonitor) collection(requestID int64) string {
+	if col, ok := m.collections.LoadAndDelete(requestID); ok {
+		return col.(string)
+	}
+	return noCollection
+}
+
+// commandCollection resolves the collection of a command, i.e. CRUD commands hold the collection name
+// as value of their first element, e.g. {"find": "players", "filter": {...}}.
+func commandCollection(evt *event.CommandStartedEvent) string {
+	elem, err := evt.Command.IndexErr(0)
+	if err != nil {
+		return noCollection
+	}
+	if col, ok := elem.Value().StringValueOK(); ok && len(col) > 0 {
+		return col
+	}
+	return noCollection
+}
+
+// newPoolMonitor returns a driver pool monitor that keeps the pool statistics up to date.
+func newPoolMonitor() *event.PoolMonitor {
+	return &event.PoolMonitor{
+		Event: func(evt *event.PoolEvent) {
+			metrics.IncMongoPoolEvent(evt.Type)
+			switch evt.Type {
+			case event.ConnectionCreated:
+				metrics.AddMongoPoolConnections("open", 1)
+			case event.ConnectionClosed:
+				metrics.AddMongoPoolConnections("open", -1)
+			case event.GetSucceeded:
+				metrics.AddMongoPoolConnections("in_use", 1)
+			case event.ConnectionReturned:
+				metrics.AddMongoPoolConnections("in_use", -1)
+			}
+		},
+	}
+}
+
+// combineCommandMonitors fans out command events to all monitors, i.e. the driver accepts only one
+// command monitor and it's shared by metrics and tracing.
+func combineCommandMonitors(monitors ...*event.CommandMonitor) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			for _, m := range monitors {
+				if m.Started != nil {
+					m.Started(ctx, evt)
+				}
+			}
+		},
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			for _, m := range monitors {
+				if m.Succeeded != nil {
+					m.Succeeded(ctx, evt)
+				}
+			}
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			for _, m := range monitors {
+				if m.Failed != nil {
+					m.Failed(ctx, evt)
+				}
+			}
+		},
+	}
+}
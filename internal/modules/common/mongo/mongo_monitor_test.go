@@ -0,0 +1,85 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+func startedEvent(t *testing.T, requestID int64, command bson.D) *event.CommandStartedEvent {
+	raw, err := bson.Marshal(command)
+	assert.NoError(t, err)
+	return &event.CommandStartedEvent{
+		Command:     raw,
+		CommandName: command[0].Key,
+		RequestID:   requestID,
+	}
+}
+
+func TestCommandCollection(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  bson.D
+		expected string
+	}{
+		{"find", bson.D{{Key: "find", Value: "players"}, {Key: "filter", Value: bson.D{}}}, "players"},
+		{"insert", bson.D{{Key: "insert", Value: "player_couples"}}, "player_couples"},
+		{"ping", bson.D{{Key: "ping", Value: 1}}, noCollection},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, commandCollection(startedEvent(t, 1, tt.command)))
+		})
+	}
+}
+
+func TestCommandMetricsMonitor(t *testing.T) {
+	m := &commandMetricsMonitor{}
+
+	t.Run("collection is released once command finishes", func(t *testing.T) {
+		m.started(context.Background(), startedEvent(t, 1, bson.D{{Key: "find", Value: "players"}}))
+		m.succeeded(context.Background(), &event.CommandSucceededEvent{
+			CommandFinishedEvent: event.CommandFinishedEvent{RequestID: 1, CommandName: "find", Duration: time.Millisecond},
+		})
+
+		_, ok := m.collections.Load(int64(1))
+		assert.False(t, ok)
+	})
+
+	t.Run("unknown request", func(t *testing.T) {
+		assert.Equal(t, noCollection, m.collection(42))
+	})
+
+	t.Run("failed command", func(t *testing.T) {
+		m.started(context.Background(), startedEvent(t, 2, bson.D{{Key: "delete", Value: "players"}}))
+		assert.Equal(t, "players", m.collection(2))
+		m.failed(context.Background(), &event.CommandFailedEvent{
+			CommandFinishedEvent: event.CommandFinishedEvent{RequestID: 2, CommandName: "delete", Duration: time.Millisecond},
+		})
+	})
+}
+
+func TestCombineCommandMonitors(t *testing.T) {
+	var calls []string
+	first := &event.CommandMonitor{
+		Started:   func(context.Context, *event.CommandStartedEvent) { calls = append(calls, "first started") },
+		Succeeded: func(context.Context, *event.CommandSucceededEvent) { calls = append(calls, "first succeeded") },
+		Failed:    func(context.Context, *event.CommandFailedEvent) { calls = append(calls, "first failed") },
+	}
+	// Monitor without callbacks must be skipped:
+	second := &event.CommandMonitor{
+		Started: func(context.Context, *event.CommandStartedEvent) { calls = append(calls, "second started") },
+	}
+
+	combined := combineCommandMonitors(first, second)
+	combined.Started(context.Background(), &event.CommandStartedEvent{})
+	combined.Succeeded(context.Background(), &event.CommandSucceededEvent{})
+	combined.Failed(context.Background(), &event.CommandFailedEvent{})
+
+	assert.Equal(t, []string{"first started", "second started", "first succeeded", "first failed"}, calls)
+}
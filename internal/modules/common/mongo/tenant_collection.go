@@ -0,0 +1,107 @@
+package mongo
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TenantField holds the tenant of the documents of tenant collections.
+const TenantField = "tenantId"
+
+// TenantCollection scopes a collection to the tenant of the context (see tenant.ID): filters only select the
+// documents of the tenant and inserted documents get its TenantField, so repositories can't read nor change the
+// documents of other tenants. Jobs spanning tenants (e.g. migrations) use MongoClient.GetCollection instead.
+type TenantCollection struct {
+	client MongoClient
+	name   string
+}
+
+func NewTenantCollection(client MongoClient, collectionName string) *TenantCollection {
+	return &TenantCollection{client: client, name: collectionName}
+}
+
+func (c *TenantCollection) FindOne(ctx context.Context, filter any, opts ...*options.FindOneOptions) *mongo.SingleResult {
+	return c.collection(ctx).FindOne(ctx, scopeFilter(ctx, filter), opts...)
+}
+
+func (c *TenantCollection) Find(ctx context.Context, filter any, opts ...*options.FindOptions) (*mongo.Cursor, error) {
+	return c.collection(ctx).Find(ctx, scopeFilter(ctx, filter), opts...)
+}
+
+func (c *TenantCollection) InsertOne(ctx context.Context, document any, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	scoped, err := scopeDocument(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+	return c.collection(ctx).InsertOne(ctx, scoped, opts...)
+}
+
+// ReplaceOne keeps the TenantField of the replaced document, which upserts insert.
+func (c *TenantCollection) ReplaceOne(ctx context.Context, filter, replacement any, opts ...*options.ReplaceOptions) (*mongo.UpdateResult, error) {
+	scoped, err := scopeDocument(ctx, replacement)
+	if err != nil {
+		return nil, err
+	}
+	return c.collection(ctx).ReplaceOne(ctx, scopeFilter(ctx, filter), scoped, opts...)
+}
+
+// UpdateOne upserts documents with the TenantField, Mongo copying the equality conditions of the filter.
+func (c *TenantCollection) UpdateOne(ctx context.Context, filter, update any, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return c.collection(ctx).UpdateOne(ctx, scopeFilter(ctx, filter), update, opts...)
+}
+
+func (c *TenantCollection) UpdateMany(ctx context.Context, filter, update any, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return c.collection(ctx).UpdateMany(ctx, scopeFilter(ctx, filter), update, opts...)
+}
+
+func (c *TenantCollection) DeleteOne(ctx context.Context, filter any, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	return c.collection(ctx).DeleteOne(ctx, scopeFilter(ctx, filter), opts...)
+}
+
+func (c *TenantCollection) DeleteMany(ctx context.Context, filter any, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	return c.collection(ctx).DeleteMany(ctx, scopeFilter(ctx, filter), opts...)
+}
+
+// collection is resolved on every call, as the database may be the one of the tenant.
+func (c *TenantCollection) collection(ctx context.Context) *mongo.Collection {
+	return c.client.GetCollection(ctx, c.name)
+}
+
+// scopeFilter adds the tenant to the filter, replacing any TenantField condition of the caller.
+func scopeFilter(ctx context.Context, filter any) any {
+	id := tenant.ID(ctx)
+	switch f := filter.(type) {
+	case bson.M:
+		scoped := maps.Clone(f)
+		if scoped == nil {
+			scoped = bson.M{}
+		}
+		scoped[TenantField] = id
+		return scoped
+	case bson.D:
+		scoped := slices.DeleteFunc(slices.Clone(f), func(e bson.E) bool { return e.Key == TenantField })
+		return append(scoped, bson.E{Key: TenantField, Value: id})
+	default:
+		return bson.D{{Key: "$and", Value: bson.A{filter, bson.M{TenantField: id}}}}
+	}
+}
+
+// scopeDocument returns the document with the TenantField of the tenant, documents being marshalled as Mongo does.
+func scopeDocument(ctx context.Context, document any) (bson.D, error) {
+	raw, err := bson.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	var scoped bson.D
+	if err := bson.Unmarshal(raw, &scoped); err != nil {
+		return nil, err
+	}
+	scoped = slices.DeleteFunc(scoped, func(e bson.E) bool { return e.Key == TenantField })
+	return append(scoped, bson.E{Key: TenantField, Value: tenant.ID(ctx)}), nil
+}
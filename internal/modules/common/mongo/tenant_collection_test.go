@@ -0,0 +1,85 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type sharedClientMock struct {
+	database *mongo.Database
+}
+
+func (m *sharedClientMock) GetCollection(_ context.Context, collectionName string) *mongo.Collection {
+	return m.database.Collection(collectionName)
+}
+
+func (m *sharedClientMock) Close() error {
+	return nil
+}
+
+type testDocument struct {
+	ID       string `bson:"_id"`
+	Name     string `bson:"name"`
+	TenantID string `bson:"tenantId,omitempty"`
+}
+
+func TestTenantCollection(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	fep := tenant.WithID(context.Background(), "fep")
+	tenantOf := func(mt *mtest.T, key string) string {
+		return mt.GetStartedEvent().Command.Lookup(key).Document().Lookup(TenantField).StringValue()
+	}
+
+	mt.Run("Filters select the documents of the tenant only", func(mt *mtest.T) {
+		collection := NewTenantCollection(&sharedClientMock{database: mt.Client.Database("testdb")}, "players")
+
+		// Conditions of the caller on the tenant are replaced, whatever the type of the filter.
+		for _, filter := range []any{bson.M{"_id": "p1", TenantField: "fip"}, bson.D{{Key: TenantField, Value: "fip"}, {Key: "_id", Value: "p1"}}} {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "testdb.players", mtest.FirstBatch))
+			err := collection.FindOne(fep, filter).Err()
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Equal(t, "fep", tenantOf(mt, "filter"))
+		}
+
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "testdb.players", mtest.FirstBatch))
+		_, err := collection.Find(context.Background(), bson.M{})
+		assert.NoError(t, err)
+		assert.Equal(t, tenant.Default, tenantOf(mt, "filter"), "Expected the default tenant without one")
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		_, err = collection.UpdateMany(fep, bson.M{"lastName": "Tapia"}, bson.M{"$set": bson.M{"level": 8}})
+		assert.NoError(t, err)
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", update.Lookup("q", TenantField).StringValue())
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		_, err = collection.DeleteOne(fep, bson.M{"_id": "p1"})
+		assert.NoError(t, err)
+		deletion := mt.GetStartedEvent().Command.Lookup("deletes").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", deletion.Lookup("q", TenantField).StringValue())
+	})
+
+	mt.Run("Documents are written with the tenant", func(mt *mtest.T) {
+		collection := NewTenantCollection(&sharedClientMock{database: mt.Client.Database("testdb")}, "players")
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		_, err := collection.InsertOne(fep, testDocument{ID: "p1", Name: "Tapia", TenantID: "fip"})
+		assert.NoError(t, err)
+		inserted := mt.GetStartedEvent().Command.Lookup("documents").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", inserted.Lookup(TenantField).StringValue(), "Expected the tenant of the context")
+		assert.Equal(t, "Tapia", inserted.Lookup("name").StringValue())
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		_, err = collection.ReplaceOne(fep, bson.M{"_id": "p1"}, testDocument{ID: "p1", Name: "Coello"})
+		assert.NoError(t, err)
+		replacement := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", replacement.Lookup("q", TenantField).StringValue())
+		assert.Equal(t, "fep", replacement.Lookup("u", TenantField).StringValue())
+	})
+}
@@ -0,0 +1,45 @@
+package openapi
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// swaggerUIVersion of swagger-ui-dist loaded from the CDN, i.e. no assets are bundled in the binary.
+const swaggerUIVersion = "5.17.14"
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8" />
+  <title>%[1]s</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@%[2]s/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@%[2]s/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: %[3]q, dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>`
+
+// Handler serves the document as JSON.
+func Handler(doc *Document) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, doc)
+	}
+}
+
+// SwaggerUIHandler serves Swagger UI rendering the document served at specURL.
+func SwaggerUIHandler(title, specURL string) gin.HandlerFunc {
+	page := fmt.Sprintf(swaggerUIPage, html.EscapeString(title), swaggerUIVersion, specURL)
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(page))
+	}
+}
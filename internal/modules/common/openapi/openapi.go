@@ -0,0 +1,236 @@
+package openapi
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+)
+
+// Minimal OpenAPI 3 model, i.e. only what is needed to describe the gin routes of the modules.
+
+const Version = "3.0.3"
+
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+	schemas    *schemaRegistry
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// PathItem maps lower case HTTP methods to operations.
+type PathItem map[string]*Operation
+
+type Operation struct {
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+	Deprecated  bool                  `json:"deprecated,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Headers     map[string]*Header    `json:"headers,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Security schemes of the API.
+const (
+	BearerAuth = "bearerAuth"
+	APIKeyAuth = "apiKeyAuth"
+)
+
+func New(title, version string) *Document {
+	return &Document{
+		OpenAPI: Version,
+		Info:    Info{Title: title, Version: version},
+		Paths:   map[string]*PathItem{},
+		Components: Components{
+			Schemas: map[string]*Schema{},
+			SecuritySchemes: map[string]*SecurityScheme{
+				BearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+				APIKeyAuth: {Type: "apiKey", In: "header", Name: "X-API-Key"},
+			},
+		},
+		schemas: newSchemaRegistry(),
+	}
+}
+
+var ginParam = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+
+// Path converts a gin path (e.g. "/players/:playerId") to an OpenAPI one (e.g. "/players/{playerId}").
+func Path(ginPath string) string {
+	return ginParam.ReplaceAllString(ginPath, "{$1}")
+}
+
+// Add documents the route registered in gin with method and ginPath, path parameters are added when
+// not described by the operation.
+func (d *Document) Add(method, ginPath string, operation Operation) {
+	path := Path(ginPath)
+	for _, match := range ginParam.FindAllStringSubmatch(ginPath, -1) {
+		if !operation.hasParameter(match[1], "path") {
+			operation.Parameters = append(operation.Parameters, Parameter{Name: match[1], In: "path", Required: true, Schema: &Schema{Type: "string"}})
+		}
+	}
+	item, ok := d.Paths[path]
+	if !ok {
+		item = &PathItem{}
+		d.Paths[path] = item
+	}
+	(*item)[strings.ToLower(method)] = &operation
+}
+
+func (o Operation) hasParameter(name, in string) bool {
+	for _, parameter := range o.Parameters {
+		if parameter.Name == name && parameter.In == in {
+			return true
+		}
+	}
+	return false
+}
+
+// Deprecate flags the operations under the gin basePath as deprecated.
+func (d *Document) Deprecate(basePath string) {
+	prefix := Path(basePath)
+	for path, item := range d.Paths {
+		if path != prefix && !strings.HasPrefix(path, prefix+"/") {
+			continue
+		}
+		for _, operation := range *item {
+			operation.Deprecated = true
+		}
+	}
+}
+
+// Has returns true if the route registered in gin with method and ginPath is documented.
+func (d *Document) Has(method, ginPath string) bool {
+	item, ok := d.Paths[Path(ginPath)]
+	if !ok {
+		return false
+	}
+	_, ok = (*item)[strings.ToLower(method)]
+	return ok
+}
+
+// Routes returns the documented routes as "METHOD /path" (OpenAPI paths), sorted.
+func (d *Document) Routes() []string {
+	var routes []string
+	for path, item := range d.Paths {
+		for method := range *item {
+			routes = append(routes, strings.ToUpper(method)+" "+path)
+		}
+	}
+	sort.Strings(routes)
+	return routes
+}
+
+// Content returns a request body or response content for the value type in every negotiable media type
+// (see web.Respond and web.Bind).
+func (d *Document) Content(value any) map[string]*MediaType {
+	schema := d.Schema(value)
+	return map[string]*MediaType{
+		web.MIMEJSON:    {Schema: schema},
+		web.MIMEMsgPack: {Schema: schema},
+		web.MIMECBOR:    {Schema: schema},
+	}
+}
+
+// Body returns a required JSON request body for the value type.
+func (d *Document) Body(value any) *RequestBody {
+	return &RequestBody{Required: true, Content: d.Content(value)}
+}
+
+// Response returns a JSON response, a nil value means no body.
+func (d *Document) Response(description string, value any) *Response {
+	response := &Response{Description: description}
+	if value != nil {
+		response.Content = d.Content(value)
+	}
+	return response
+}
+
+// ErrorResponse returns the response of web.ErrorBody.
+func (d *Document) ErrorResponse(description string) *Response {
+	return d.Response(description, ErrorBody{})
+}
+
+// StatusResponse returns the response of use case statuses, i.e. {"status": "<UseCaseStatus>"}.
+func (d *Document) StatusResponse(description string) *Response {
+	return d.Response(description, StatusBody{})
+}
+
+// ErrorBody documents web.ErrorBody.
+type ErrorBody struct {
+	Error   string `json:"error"`
+	TraceID string `json:"traceId,omitempty"`
+}
+
+// StatusBody documents responses holding only the status of the use case.
+type StatusBody struct {
+	Status string `json:"status"`
+}
+
+// Security requirements of operations.
+var (
+	Bearer         = []map[string][]string{{BearerAuth: {}}}
+	BearerOrAPIKey = []map[string][]string{{BearerAuth: {}}, {APIKeyAuth: {}}}
+)
+
+// Authenticated sets the security requirement of the operation and the responses of the auth middlewares.
+func (d *Document) Authenticated(operation Operation, security []map[string][]string) Operation {
+	operation.Security = security
+	if operation.Responses == nil {
+		operation.Responses = map[string]*Response{}
+	}
+	operation.Responses["401"] = d.ErrorResponse("Missing or invalid credentials")
+	if _, ok := operation.Responses["403"]; !ok {
+		operation.Responses["403"] = d.ErrorResponse("Role or scope not allowed")
+	}
+	return operation
+}
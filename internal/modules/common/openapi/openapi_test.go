@@ -0,0 +1,134 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type testAddress struct {
+	Street string `json:"street"`
+}
+
+type testPlayer struct {
+	ID        string         `json:"id"`
+	Age       *int           `json:"age,omitempty"`
+	Secret    string         `json:"-"`
+	Tags      []string       `json:"tags"`
+	Address   *testAddress   `json:"address,omitempty"`
+	Ratings   map[string]int `json:"ratings,omitempty"`
+	CreatedAt time.Time      `json:"createdAt"`
+	hidden    string
+	Nested    struct{ N int }   `json:"nested"`
+	Labels    map[string]string `json:"-"`
+}
+
+type testEmbedding struct {
+	testAddress
+	Key string `json:"key"`
+}
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, "/players/{playerId}", Path("/players/:playerId"))
+	assert.Equal(t, "/players/{playerId}/export", Path("/players/:playerId/export"))
+	assert.Equal(t, "/files/{path}", Path("/files/*path"))
+	assert.Equal(t, "/players", Path("/players"))
+}
+
+func TestDocument_Add(t *testing.T) {
+	doc := New("test", "1.0.0")
+	doc.Add(http.MethodGet, "/players/:playerId", Operation{Summary: "Find player"})
+
+	assert.True(t, doc.Has(http.MethodGet, "/players/:playerId"))
+	assert.False(t, doc.Has(http.MethodDelete, "/players/:playerId"))
+	assert.False(t, doc.Has(http.MethodGet, "/players"))
+	assert.Equal(t, []string{"GET /players/{playerId}"}, doc.Routes())
+
+	operation := (*doc.Paths["/players/{playerId}"])["get"]
+	assert.Equal(t, []Parameter{{Name: "playerId", In: "path", Required: true, Schema: &Schema{Type: "string"}}}, operation.Parameters)
+}
+
+func TestDocument_Schema(t *testing.T) {
+	doc := New("test", "1.0.0")
+
+	schema := doc.Schema(testPlayer{})
+	assert.Equal(t, "#/components/schemas/testPlayer", schema.Ref)
+
+	player := doc.Components.Schemas["testPlayer"]
+	assert.Equal(t, "object", player.Type)
+	assert.ElementsMatch(t, []string{"id", "tags", "createdAt", "nested"}, player.Required)
+	assert.Equal(t, &Schema{Type: "integer", Format: "int32", Nullable: true}, player.Properties["age"])
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Type: "string"}}, player.Properties["tags"])
+	assert.Equal(t, "#/components/schemas/testAddress", player.Properties["address"].Ref)
+	assert.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "integer", Format: "int32"}}, player.Properties["ratings"])
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time"}, player.Properties["createdAt"])
+	assert.Equal(t, "object", player.Properties["nested"].Type)
+	assert.NotContains(t, player.Properties, "Secret")
+	assert.NotContains(t, player.Properties, "hidden")
+	assert.Contains(t, doc.Components.Schemas, "testAddress")
+
+	// Slices of named structs reference the component.
+	assert.Equal(t, "#/components/schemas/testPlayer", doc.Schema([]testPlayer{}).Items.Ref)
+}
+
+func TestDocument_Schema_Embedded(t *testing.T) {
+	doc := New("test", "1.0.0")
+	doc.Schema(testEmbedding{})
+
+	embedding := doc.Components.Schemas["testEmbedding"]
+	assert.Contains(t, embedding.Properties, "street")
+	assert.Contains(t, embedding.Properties, "key")
+}
+
+func TestHandlers(t *testing.T) {
+	doc := New("test", "1.0.0")
+	doc.Add(http.MethodGet, "/players", Operation{Responses: map[string]*Response{"200": doc.Response("Players found", []testPlayer{})}})
+	router := gin.New()
+	router.GET("/openapi.json", Handler(doc))
+	router.GET("/docs", SwaggerUIHandler("test", "/openapi.json"))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var served map[string]any
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &served))
+	assert.Equal(t, Version, served["openapi"])
+	assert.Contains(t, served["components"].(map[string]any)["schemas"], "testPlayer")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `url: "/openapi.json"`)
+}
+
+func TestDocument_Deprecate(t *testing.T) {
+	doc := New("test", "1.0.0")
+	doc.Add(http.MethodGet, "/players/:playerId", Operation{})
+	doc.Add(http.MethodGet, "/players-stats", Operation{})
+	doc.Add(http.MethodGet, "/v1/players/:playerId", Operation{})
+
+	doc.Deprecate("/players")
+
+	assert.True(t, (*doc.Paths["/players/{playerId}"])["get"].Deprecated)
+	assert.False(t, (*doc.Paths["/players-stats"])["get"].Deprecated)
+	assert.False(t, (*doc.Paths["/v1/players/{playerId}"])["get"].Deprecated)
+}
+
+func TestDocument_Content(t *testing.T) {
+	doc := New("test", "1.0.0")
+	content := doc.Content(testAddress{})
+	assert.ElementsMatch(t, []string{"application/json", "application/x-msgpack", "application/cbor"}, keys(content))
+}
+
+func keys(content map[string]*MediaType) []string {
+	var mediaTypes []string
+	for mediaType := range content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	return mediaTypes
+}
@@ -0,0 +1,170 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Schema is the subset of JSON schema used by OpenAPI 3.0.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// schemaRegistry keeps named struct types as components so they're described once and referenced.
+type schemaRegistry struct {
+	names map[reflect.Type]string
+	taken map[string]reflect.Type
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{names: map[reflect.Type]string{}, taken: map[string]reflect.Type{}}
+}
+
+// Schema generates the schema of the value type from its JSON encoding (json tags), named structs are
+// added to the components and referenced.
+func (d *Document) Schema(value any) *Schema {
+	return d.schemaOf(reflect.TypeOf(value))
+}
+
+func (d *Document) schemaOf(t reflect.Type) *Schema {
+	if t.Kind() == reflect.Pointer {
+		schema := d.schemaOf(t.Elem())
+		if len(schema.Ref) == 0 {
+			schema.Nullable = true
+		}
+		return schema
+	}
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Kind() == reflect.Struct && t.Implements(jsonMarshalerType):
+		// Custom encodings can't be described by reflection.
+		return &Schema{Type: "object"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: d.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: d.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if len(t.Name()) == 0 {
+			return d.objectSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + d.component(t)}
+	}
+	return &Schema{}
+}
+
+func (d *Document) component(t reflect.Type) string {
+	if name, ok := d.schemas.names[t]; ok {
+		return name
+	}
+	name := t.Name()
+	if other, taken := d.schemas.taken[name]; taken && other != t {
+		// Same type name in different modules, e.g. Player copies.
+		name = pkgName(t) + name
+	}
+	d.schemas.names[t] = name
+	d.schemas.taken[name] = t
+	// Registered before describing the fields so recursive types end.
+	d.Components.Schemas[name] = &Schema{}
+	*d.Components.Schemas[name] = *d.objectSchema(t)
+	return name
+}
+
+func pkgName(t reflect.Type) string {
+	path := t.PkgPath()
+	parts := strings.Split(path, "/")
+	for i := len(parts) - 1; i >= 0; i-- {
+		// Modules are named by the parent of their layer, e.g. "player-couple/domain".
+		if parts[i] != "domain" && parts[i] != "api" && parts[i] != "application" {
+			var name strings.Builder
+			for _, word := range strings.Split(parts[i], "-") {
+				if len(word) > 0 {
+					name.WriteString(strings.ToUpper(word[:1]) + word[1:])
+				}
+			}
+			return name.String()
+		}
+	}
+	return ""
+}
+
+func (d *Document) objectSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, omitEmpty, skip := jsonField(field)
+		if skip {
+			continue
+		}
+		if field.Anonymous && len(name) == 0 {
+			// Embedded structs are inlined by encoding/json.
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				inlined := d.objectSchema(embedded)
+				for property, propertySchema := range inlined.Properties {
+					schema.Properties[property] = propertySchema
+				}
+				schema.Required = append(schema.Required, inlined.Required...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+		schema.Properties[name] = d.schemaOf(field.Type)
+		if !omitEmpty && field.Type.Kind() != reflect.Pointer {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+func jsonField(field reflect.StructField) (name string, omitEmpty, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return parts[0], omitEmpty, false
+}
@@ -0,0 +1,59 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+const subscriberBuffer = 16
+
+// Broker fans out messages published on a topic to its current subscribers.
+type Broker[T any] interface {
+	Publish(topic string, message T)
+	// Subscribe returns the messages published on the topic until the context is done, then the channel is closed.
+	Subscribe(ctx context.Context, topic string) <-chan T
+}
+
+type memoryBroker[T any] struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan T]struct{}
+}
+
+// NewMemoryBroker returns a broker of the current process, i.e. subscribers of other replicas aren't notified.
+// Slow subscribers miss messages instead of blocking publishers.
+func NewMemoryBroker[T any]() Broker[T] {
+	return &memoryBroker[T]{subscribers: make(map[string]map[chan T]struct{})}
+}
+
+func (b *memoryBroker[T]) Publish(topic string, message T) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for subscriber := range b.subscribers[topic] {
+		select {
+		case subscriber <- message:
+		default:
+		}
+	}
+}
+
+func (b *memoryBroker[T]) Subscribe(ctx context.Context, topic string) <-chan T {
+	subscriber := make(chan T, subscriberBuffer)
+	b.mu.Lock()
+	if b.subscribers[topic] == nil {
+		b.subscribers[topic] = make(map[chan T]struct{})
+	}
+	b.subscribers[topic][subscriber] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers[topic], subscriber)
+		if len(b.subscribers[topic]) == 0 {
+			delete(b.subscribers, topic)
+		}
+		b.mu.Unlock()
+		close(subscriber)
+	}()
+	return subscriber
+}
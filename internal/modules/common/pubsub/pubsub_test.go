@@ -0,0 +1,47 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryBroker(t *testing.T) {
+	broker := NewMemoryBroker[string]()
+	ctx, cancel := context.WithCancel(context.Background())
+	first := broker.Subscribe(ctx, "t1")
+	second := broker.Subscribe(context.Background(), "t1")
+	other := broker.Subscribe(context.Background(), "t2")
+
+	broker.Publish("t1", "6-4")
+
+	assert.Equal(t, "6-4", <-first)
+	assert.Equal(t, "6-4", <-second)
+	assert.Empty(t, other)
+
+	cancel()
+	_, open := <-first
+	assert.False(t, open, "Expected the channel to be closed once the context is done")
+}
+
+func TestMemoryBroker_SlowSubscriber(t *testing.T) {
+	broker := NewMemoryBroker[int]()
+	subscriber := broker.Subscribe(context.Background(), "t1")
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < subscriberBuffer*2; i++ {
+			broker.Publish("t1", i)
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected publishers not to block on slow subscribers")
+	}
+	assert.Len(t, subscriber, subscriberBuffer)
+}
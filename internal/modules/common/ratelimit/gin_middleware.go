@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+)
+
+// KeyFunc returns the bucket of the request, an empty key skips rate limiting.
+type KeyFunc func(c *gin.Context) string
+
+// GinMiddleware rejects requests with 429 and Retry-After (seconds) once the bucket of their key is empty.
+// Limiter failures don't reject requests, i.e. rate limiting fails open.
+func GinMiddleware(limiter Limiter, keyFunc KeyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := keyFunc(c)
+		if len(key) == 0 {
+			c.Next()
+			return
+		}
+		decision, err := limiter.Allow(c.Request.Context(), key)
+		if err != nil {
+			_ = c.Error(err)
+			c.Next()
+			return
+		}
+		c.Header("X-RateLimit-Limit", strconv.Itoa(limiter.Rate().Burst))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+		if !decision.Allowed {
+			retryAfter := int(math.Max(math.Ceil(decision.RetryAfter.Seconds()), 1))
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			web.Abort(c, http.StatusTooManyRequests, web.ErrorBody(c, errors.New("rate limit exceeded")))
+			return
+		}
+		c.Next()
+	}
+}
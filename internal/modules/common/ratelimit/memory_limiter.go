@@ -0,0 +1,74 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Idle buckets are swept every sweepInterval calls once they're full again (same as a new bucket).
+const sweepInterval = 1024
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type memoryLimiter struct {
+	mu      sync.Mutex
+	rate    Rate
+	buckets map[string]*bucket
+	calls   int
+	now     func() time.Time
+}
+
+// NewMemoryLimiter returns a token bucket limiter local to the process, i.e. limits aren't shared between replicas.
+func NewMemoryLimiter(rate Rate) Limiter {
+	return &memoryLimiter{
+		rate:    rate,
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (l *memoryLimiter) Rate() Rate {
+	return l.rate
+}
+
+func (l *memoryLimiter) Allow(_ context.Context, key string) (Decision, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.rate.Burst), last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.last = now
+	if b.tokens < 1 {
+		return Decision{Allowed: false, RetryAfter: l.rate.retryAfter(b.tokens)}, nil
+	}
+	b.tokens--
+	return Decision{Allowed: true, Remaining: int(b.tokens)}, nil
+}
+
+func (l *memoryLimiter) refill(b *bucket, now time.Time) float64 {
+	elapsed := now.Sub(b.last).Seconds()
+	return math.Min(float64(l.rate.Burst), b.tokens+elapsed*l.rate.Limit)
+}
+
+func (l *memoryLimiter) sweep(now time.Time) {
+	l.calls++
+	if l.calls%sweepInterval != 0 {
+		return
+	}
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= float64(l.rate.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	rateEnv      = "API_KEY_RATE_LIMIT"
+	burstEnv     = "API_KEY_RATE_BURST"
+	defaultRate  = 10
+	defaultBurst = 20
+)
+
+// Mockable for testing.
+var getEnv = os.Getenv
+
+// Rate of a token bucket, i.e. Limit tokens refilled per second up to Burst tokens.
+type Rate struct {
+	Limit float64
+	Burst int
+}
+
+// Decision of a limiter for a single request.
+type Decision struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// Limiter takes one token of the bucket identified by key.
+type Limiter interface {
+	Allow(ctx context.Context, key string) (Decision, error)
+	Rate() Rate
+}
+
+// RateFromEnv reads API_KEY_RATE_LIMIT (requests per second) and API_KEY_RATE_BURST, using 10 and 20 by default.
+func RateFromEnv() (Rate, error) {
+	rate := Rate{Limit: defaultRate, Burst: defaultBurst}
+	if value := getEnv(rateEnv); len(value) > 0 {
+		limit, err := strconv.ParseFloat(value, 64)
+		if err != nil || limit <= 0 {
+			return rate, fmt.Errorf("invalid %s: %s", rateEnv, value)
+		}
+		rate.Limit = limit
+	}
+	if value := getEnv(burstEnv); len(value) > 0 {
+		burst, err := strconv.Atoi(value)
+		if err != nil || burst <= 0 {
+			return rate, fmt.Errorf("invalid %s: %s", burstEnv, value)
+		}
+		rate.Burst = burst
+	}
+	return rate, nil
+}
+
+// retryAfter is the time needed to refill the missing fraction of a token.
+func (r Rate) retryAfter(tokens float64) time.Duration {
+	return time.Duration((1 - tokens) / r.Limit * float64(time.Second))
+}
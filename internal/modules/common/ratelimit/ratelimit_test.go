@@ -0,0 +1,157 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateFromEnv(t *testing.T) {
+	original := getEnv
+	defer func() { getEnv = original }()
+
+	getEnv = func(string) string { return "" }
+	rate, err := RateFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, Rate{Limit: defaultRate, Burst: defaultBurst}, rate)
+
+	getEnv = func(key string) string { return map[string]string{rateEnv: "0.5", burstEnv: "3"}[key] }
+	rate, err = RateFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, Rate{Limit: 0.5, Burst: 3}, rate)
+
+	getEnv = func(key string) string { return map[string]string{rateEnv: "-1"}[key] }
+	_, err = RateFromEnv()
+	assert.EqualError(t, err, "invalid API_KEY_RATE_LIMIT: -1")
+}
+
+func TestMemoryLimiter(t *testing.T) {
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewMemoryLimiter(Rate{Limit: 2, Burst: 2}).(*memoryLimiter)
+	limiter.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// Burst is consumed, then the bucket is empty.
+	for remaining := 1; remaining >= 0; remaining-- {
+		decision, err := limiter.Allow(ctx, "key")
+		assert.NoError(t, err)
+		assert.Equal(t, Decision{Allowed: true, Remaining: remaining}, decision)
+	}
+	decision, _ := limiter.Allow(ctx, "key")
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, 500*time.Millisecond, decision.RetryAfter)
+
+	// Other keys have their own bucket.
+	decision, _ = limiter.Allow(ctx, "other")
+	assert.True(t, decision.Allowed)
+
+	// Refilled at 2 tokens per second.
+	now = now.Add(500 * time.Millisecond)
+	decision, _ = limiter.Allow(ctx, "key")
+	assert.True(t, decision.Allowed)
+	decision, _ = limiter.Allow(ctx, "key")
+	assert.False(t, decision.Allowed)
+}
+
+func TestMemoryLimiter_SweepsIdleBuckets(t *testing.T) {
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewMemoryLimiter(Rate{Limit: 1, Burst: 1}).(*memoryLimiter)
+	limiter.now = func() time.Time { return now }
+
+	_, _ = limiter.Allow(context.Background(), "idle")
+	now = now.Add(time.Minute)
+	for i := 1; i < sweepInterval; i++ {
+		_, _ = limiter.Allow(context.Background(), "busy")
+	}
+	assert.NotContains(t, limiter.buckets, "idle")
+}
+
+type fakeScripter struct {
+	result interface{}
+	err    error
+	keys   []string
+}
+
+func (f *fakeScripter) Eval(_ context.Context, _ string, keys []string, _ ...interface{}) (interface{}, error) {
+	f.keys = keys
+	return f.result, f.err
+}
+
+func TestRedisLimiter(t *testing.T) {
+	rate := Rate{Limit: 4, Burst: 10}
+
+	t.Run("Allowed", func(t *testing.T) {
+		scripter := &fakeScripter{result: []interface{}{int64(1), int64(7500)}}
+		decision, err := NewRedisLimiter(scripter, rate).Allow(context.Background(), "apikey:id")
+		assert.NoError(t, err)
+		assert.Equal(t, Decision{Allowed: true, Remaining: 7}, decision)
+		assert.Equal(t, []string{"ratelimit:apikey:id"}, scripter.keys)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		scripter := &fakeScripter{result: []interface{}{int64(0), int64(500)}}
+		decision, err := NewRedisLimiter(scripter, rate).Allow(context.Background(), "apikey:id")
+		assert.NoError(t, err)
+		assert.Equal(t, Decision{Allowed: false, RetryAfter: 125 * time.Millisecond}, decision)
+	})
+
+	t.Run("Unexpected result", func(t *testing.T) {
+		_, err := NewRedisLimiter(&fakeScripter{result: "OK"}, rate).Allow(context.Background(), "apikey:id")
+		assert.Error(t, err)
+	})
+}
+
+type fixedLimiter struct {
+	decision Decision
+	err      error
+}
+
+func (f *fixedLimiter) Allow(context.Context, string) (Decision, error) {
+	return f.decision, f.err
+}
+
+func (f *fixedLimiter) Rate() Rate {
+	return Rate{Limit: 1, Burst: 5}
+}
+
+func TestGinMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	serve := func(limiter Limiter, key string) *httptest.ResponseRecorder {
+		router := gin.New()
+		router.Use(GinMiddleware(limiter, func(*gin.Context) string { return key }))
+		router.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		return w
+	}
+
+	t.Run("Allowed", func(t *testing.T) {
+		w := serve(&fixedLimiter{decision: Decision{Allowed: true, Remaining: 4}}, "key")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "5", w.Header().Get("X-RateLimit-Limit"))
+		assert.Equal(t, "4", w.Header().Get("X-RateLimit-Remaining"))
+	})
+
+	t.Run("Rate limited", func(t *testing.T) {
+		w := serve(&fixedLimiter{decision: Decision{RetryAfter: 1500 * time.Millisecond}}, "key")
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	})
+
+	t.Run("Without key", func(t *testing.T) {
+		w := serve(&fixedLimiter{decision: Decision{}}, "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("X-RateLimit-Limit"))
+	})
+
+	t.Run("Limiter failure fails open", func(t *testing.T) {
+		w := serve(&fixedLimiter{err: errors.New("redis down")}, "key")
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const redisKeyPrefix = "ratelimit:"
+
+// tokenBucketScript refills and takes a token atomically, returning {allowed, remaining tokens * 1000}.
+// The bucket expires once it would be full again so idle keys don't stay in Redis.
+const tokenBucketScript = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "last")
+local tokens = tonumber(bucket[1]) or burst
+local last = tonumber(bucket[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - last) / 1000 * rate)
+local allowed = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "last", tostring(now))
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst / rate * 1000))
+return {allowed, math.floor(tokens * 1000)}
+`
+
+// RedisScripter is the subset of a Redis client used by the limiter so any client (or a Redis compatible
+// store like Valkey or KeyDB) can be plugged in through a small adapter, e.g. wrapping go-redis Eval(...).Result().
+type RedisScripter interface {
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+}
+
+type redisLimiter struct {
+	client RedisScripter
+	rate   Rate
+	now    func() time.Time
+}
+
+// NewRedisLimiter returns a token bucket limiter shared between replicas.
+func NewRedisLimiter(client RedisScripter, rate Rate) Limiter {
+	return &redisLimiter{client: client, rate: rate, now: time.Now}
+}
+
+func (l *redisLimiter) Rate() Rate {
+	return l.rate
+}
+
+func (l *redisLimiter) Allow(ctx context.Context, key string) (Decision, error) {
+	result, err := l.client.Eval(ctx, tokenBucketScript, []string{redisKeyPrefix + key},
+		l.rate.Limit, l.rate.Burst, l.now().UnixMilli())
+	if err != nil {
+		return Decision{}, err
+	}
+	values, ok := result.([]interface{})
+	if !ok || len(values) != 2 {
+		return Decision{}, fmt.Errorf("unexpected rate limit script result: %v", result)
+	}
+	allowed, okAllowed := values[0].(int64)
+	milliTokens, okTokens := values[1].(int64)
+	if !okAllowed || !okTokens {
+		return Decision{}, fmt.Errorf("unexpected rate limit script result: %v", result)
+	}
+	tokens := float64(milliTokens) / 1000
+	if allowed == 0 {
+		return Decision{Allowed: false, RetryAfter: l.rate.retryAfter(tokens)}, nil
+	}
+	return Decision{Allowed: true, Remaining: int(tokens)}, nil
+}
@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+)
+
+// Standard services answering without a token (reflection is streaming, i.e. never intercepted by unary interceptors).
+var anonymousServices = []string{healthpb.Health_ServiceDesc.ServiceName}
+
+// UnaryAuthenticate rejects calls without a valid bearer token in the "authorization" metadata and keeps its
+// claims in the context (see auth.ClaimsFromContext), calls of the anonymous services are let through.
+func UnaryAuthenticate(validator auth.TokenValidator, anonymous ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		for _, service := range anonymous {
+			if strings.HasPrefix(info.FullMethod, "/"+service+"/") {
+				return handler(ctx, req)
+			}
+		}
+		token, ok := bearerToken(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+		claims, err := validator.Validate(token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return handler(auth.WithClaims(ctx, claims), req)
+	}
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get(authorizationKey) {
+		if strings.HasPrefix(value, bearerPrefix) {
+			return strings.TrimPrefix(value, bearerPrefix), true
+		}
+	}
+	return "", false
+}
+
+// RequireRoles returns the claims of the call when they hold any of the roles.
+func RequireRoles(ctx context.Context, roles ...auth.Role) (*auth.Claims, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing authentication")
+	}
+	if !claims.HasAnyRole(roles...) {
+		return nil, status.Error(codes.PermissionDenied, "insufficient role")
+	}
+	return claims, nil
+}
+
+// Claims returns the claims of an authenticated call.
+func Claims(ctx context.Context) (*auth.Claims, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing authentication")
+	}
+	return claims, nil
+}
+
+// Error returns the status of a use case outcome mapped to the code, the error message is used when present
+// and the use case status otherwise (e.g. "FindPlayerNotFound").
+func Error(code codes.Code, useCaseStatus fmt.Stringer, err error) error {
+	if err == nil {
+		err = errors.New(useCaseStatus.String())
+	}
+	return status.Error(code, err.Error())
+}
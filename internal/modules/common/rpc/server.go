@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	portEnv     = "GRPC_PORT"
+	defaultPort = "9090"
+)
+
+// Server is the gRPC server of the API services plus the standard health and reflection ones.
+type Server struct {
+	*grpc.Server
+	health *health.Server
+}
+
+// NewServer returns a server authenticating the calls of the API services with bearer tokens, health and
+// reflection calls are anonymous so probes and tools (e.g. grpcurl) work without a token.
+func NewServer(validator auth.TokenValidator, opts ...grpc.ServerOption) *Server {
+	opts = append(opts, grpc.ChainUnaryInterceptor(UnaryAuthenticate(validator, anonymousServices...)))
+	server := &Server{Server: grpc.NewServer(opts...), health: health.NewServer()}
+	healthpb.RegisterHealthServer(server.Server, server.health)
+	reflection.Register(server.Server)
+	return server
+}
+
+// ListenAddress returns the address of the gRPC server, i.e. ":" plus GRPC_PORT (9090 by default).
+func ListenAddress() string {
+	port := os.Getenv(portEnv)
+	if len(port) == 0 {
+		port = defaultPort
+	}
+	return ":" + port
+}
+
+// ListenAndServe marks every registered service as serving and blocks serving calls, i.e. services must be
+// registered before.
+func (s *Server) ListenAndServe(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+	return s.Serve(listener)
+}
+
+func (s *Server) Serve(listener net.Listener) error {
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	for service := range s.GetServiceInfo() {
+		s.health.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	return s.Server.Serve(listener)
+}
+
+// GracefulStop reports every service as not serving before waiting for pending calls.
+func (s *Server) GracefulStop() {
+	s.health.Shutdown()
+	s.Server.GracefulStop()
+}
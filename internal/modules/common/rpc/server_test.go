@@ -0,0 +1,146 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testSecret = "test-secret"
+
+func newTestToken(t *testing.T, roles ...auth.Role) string {
+	claims := &auth.Claims{Email: "someone@example.com", Roles: roles, RegisteredClaims: jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	assert.NoError(t, err)
+	return token
+}
+
+// serve starts the server on an in memory listener and returns a connection to it.
+func serve(t *testing.T, server *Server) *grpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestServer_HealthAndReflection(t *testing.T) {
+	server := NewServer(auth.NewHS256Validator([]byte(testSecret)))
+	conn := serve(t, server)
+
+	t.Run("health check without token", func(t *testing.T) {
+		res, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+
+		res, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{Service: healthpb.Health_ServiceDesc.ServiceName})
+		assert.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+	})
+
+	t.Run("reflection lists services", func(t *testing.T) {
+		stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+		}))
+		res, err := stream.Recv()
+		assert.NoError(t, err)
+		var services []string
+		for _, service := range res.GetListServicesResponse().GetService() {
+			services = append(services, service.GetName())
+		}
+		assert.Contains(t, services, healthpb.Health_ServiceDesc.ServiceName)
+	})
+}
+
+// GracefulStop shuts down health first so load balancers stop routing calls before pending ones are drained.
+func TestServer_HealthShutdown(t *testing.T) {
+	server := NewServer(auth.NewHS256Validator([]byte(testSecret)))
+	conn := serve(t, server)
+	client := healthpb.NewHealthClient(conn)
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+
+	server.health.Shutdown()
+
+	res, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.GetStatus())
+}
+
+func TestUnaryAuthenticate(t *testing.T) {
+	interceptor := UnaryAuthenticate(auth.NewHS256Validator([]byte(testSecret)), "padelplace.v1.Public")
+	info := &grpc.UnaryServerInfo{FullMethod: "/padelplace.v1.PlayerService/FindPlayer"}
+	handler := func(ctx context.Context, _ any) (any, error) {
+		claims, err := RequireRoles(ctx, auth.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		return claims.Email, nil
+	}
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	res, err := interceptor(withToken(newTestToken(t, auth.RoleAdmin)), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "someone@example.com", res)
+
+	_, err = interceptor(withToken(newTestToken(t, auth.RolePlayer)), nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor(withToken("invalid"), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	public := &grpc.UnaryServerInfo{FullMethod: "/padelplace.v1.Public/Ping"}
+	_, err = interceptor(context.Background(), nil, public, func(context.Context, any) (any, error) { return "pong", nil })
+	assert.NoError(t, err)
+}
+
+type testStatus uint8
+
+func (s testStatus) String() string {
+	return "TestNotFound"
+}
+
+func TestError(t *testing.T) {
+	err := Error(codes.NotFound, testStatus(0), nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "TestNotFound", status.Convert(err).Message())
+
+	err = Error(codes.InvalidArgument, testStatus(0), errors.New("invalid id: i"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "invalid id: i", status.Convert(err).Message())
+}
+
+func TestListenAddress(t *testing.T) {
+	t.Setenv(portEnv, "")
+	assert.Equal(t, ":9090", ListenAddress())
+
+	t.Setenv(portEnv, "50051")
+	assert.Equal(t, ":50051", ListenAddress())
+}
@@ -0,0 +1,81 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"strings"
+)
+
+// Format of a spreadsheet file.
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+	// FormatJSONL (JSON Lines) is only written, one object per row.
+	FormatJSONL Format = "jsonl"
+)
+
+const (
+	CSVContentType   = "text/csv"
+	XLSXContentType  = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	JSONLContentType = "application/x-ndjson"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported spreadsheet format")
+
+// FormatOf returns the format of the content type, or else of the extension of the file name, of files to read.
+func FormatOf(contentType, fileName string) (Format, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == CSVContentType:
+		return FormatCSV, nil
+	case mediaType == XLSXContentType:
+		return FormatXLSX, nil
+	}
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".xlsx":
+		return FormatXLSX, nil
+	}
+	return "", fmt.Errorf("%w, expected CSV or XLSX", ErrUnsupportedFormat)
+}
+
+// Row is a row of the spreadsheet, Number being 1 based as shown by spreadsheet applications.
+type Row struct {
+	Number int
+	Cells  []string
+}
+
+// Reader reads the rows of a spreadsheet one at a time, returning io.EOF after the last one. Blank rows may be
+// skipped.
+type Reader interface {
+	Read() (Row, error)
+}
+
+type csvReader struct {
+	reader *csv.Reader
+}
+
+// NewCSVReader reads comma separated rows as they're received, rows can have different lengths.
+func NewCSVReader(r io.Reader) Reader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+	return &csvReader{reader: reader}
+}
+
+func (r *csvReader) Read() (Row, error) {
+	cells, err := r.reader.Read()
+	if err != nil {
+		return Row{}, err
+	}
+	line, _ := r.reader.FieldPos(0)
+	return Row{Number: line, Cells: cells}, nil
+}
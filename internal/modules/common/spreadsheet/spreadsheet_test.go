@@ -0,0 +1,115 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readAll(t *testing.T, reader Reader) []Row {
+	var rows []Row
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return rows
+		}
+		if !assert.NoError(t, err) {
+			return rows
+		}
+		rows = append(rows, Row{Number: row.Number, Cells: append([]string(nil), row.Cells...)})
+	}
+}
+
+func TestFormatOf(t *testing.T) {
+	format, err := FormatOf("text/csv; charset=utf-8", "")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatCSV, format)
+
+	format, err = FormatOf("application/octet-stream", "players.XLSX")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatXLSX, format)
+
+	_, err = FormatOf("application/json", "players.json")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestCSVReader(t *testing.T) {
+	reader := NewCSVReader(strings.NewReader("email,firstName\njohn@example.com, John\n\"multi\nline\"\nshort"))
+
+	assert.Equal(t, []Row{
+		{Number: 1, Cells: []string{"email", "firstName"}},
+		{Number: 2, Cells: []string{"john@example.com", "John"}},
+		{Number: 3, Cells: []string{"multi\nline"}},
+		{Number: 5, Cells: []string{"short"}},
+	}, readAll(t, reader))
+}
+
+func xlsxFile(t *testing.T, files map[string]string) *bytes.Reader {
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	for name, content := range files {
+		file, err := archive.Create(name)
+		assert.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, archive.Close())
+	return bytes.NewReader(buffer.Bytes())
+}
+
+func TestXLSXReader(t *testing.T) {
+	file := xlsxFile(t, map[string]string{
+		workbookPath: `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Players" sheetId="1" r:id="rId2"/></sheets></workbook>`,
+		workbookRelsPath: `<Relationships><Relationship Id="rId1" Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId2" Target="worksheets/players.xml"/></Relationships>`,
+		sharedStringsPath: `<sst><si><t>email</t></si><si><r><t>first</t></r><r><t>Name</t></r></si>` +
+			`<si><t>john@example.com</t><rPh><t>ignored</t></rPh></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row r="1"><c r="A1"><v>wrong sheet</v></c></row></sheetData></worksheet>`,
+		"xl/worksheets/players.xml": `<worksheet><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>` +
+			`<row r="3"><c r="A3" t="s"><v>2</v></c><c r="C3"><v>35</v></c></row>` +
+			`<row><c t="inlineStr"><is><t>inline</t></is></c><c t="b"><v>1</v></c></row>` +
+			`</sheetData></worksheet>`,
+	})
+
+	reader, err := NewXLSXReader(file, file.Size())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Row{
+		{Number: 1, Cells: []string{"email", "firstName"}},
+		{Number: 3, Cells: []string{"john@example.com", "", "35"}},
+		{Number: 4, Cells: []string{"inline", "1"}},
+	}, readAll(t, reader))
+}
+
+func TestXLSXReader_Invalid(t *testing.T) {
+	_, err := NewXLSXReader(strings.NewReader("email,firstName"), 15)
+	assert.ErrorIs(t, err, ErrInvalidXLSX)
+
+	file := xlsxFile(t, map[string]string{
+		firstSheetPath: `<worksheet><sheetData><row r="1"><c r="A1" t="s"><v>3</v></c></row></sheetData></worksheet>`,
+	})
+	reader, err := NewXLSXReader(file, file.Size())
+	assert.NoError(t, err)
+	_, err = reader.Read()
+	assert.ErrorIs(t, err, ErrInvalidXLSX, "Expected unknown shared strings to be rejected")
+}
+
+func TestXLSXReader_SharedStringsLimit(t *testing.T) {
+	originalMax := maxSharedStringsSize
+	defer func() { maxSharedStringsSize = originalMax }()
+	maxSharedStringsSize = 1 << 10
+	file := xlsxFile(t, map[string]string{
+		sharedStringsPath: "<sst>" + strings.Repeat("<si><t>a</t></si>", 1<<10) + "</sst>",
+		firstSheetPath:    `<worksheet><sheetData></sheetData></worksheet>`,
+	})
+
+	_, err := NewXLSXReader(file, file.Size())
+
+	assert.ErrorIs(t, err, ErrInvalidXLSX, "Expected shared strings over the limit to be rejected")
+}
@@ -0,0 +1,127 @@
+package spreadsheet
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Writer writes the rows of a spreadsheet as they're produced, the first one being the header (column names of
+// JSON Lines objects). Close flushes the rows without closing the underlying writer.
+type Writer interface {
+	Write(values ...any) error
+	Close() error
+}
+
+// ParseFormat returns the format named name, e.g. "csv".
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(name)); format {
+	case FormatCSV, FormatJSONL, FormatXLSX:
+		return format, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedFormat, name)
+}
+
+// ContentType returns the MIME type of files of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatJSONL:
+		return JSONLContentType
+	case FormatXLSX:
+		return XLSXContentType
+	default:
+		return CSVContentType
+	}
+}
+
+// NewWriter returns a writer of the format, nothing being written to w until the first row.
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	case FormatJSONL:
+		return &jsonlWriter{writer: bufio.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w), nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+}
+
+// text formats values of text formats, e.g. nil as an empty cell and times as RFC 3339.
+func text(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case time.Time:
+		return value.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+	cells  []string
+}
+
+func (w *csvWriter) Write(values ...any) error {
+	w.cells = w.cells[:0]
+	for _, value := range values {
+		w.cells = append(w.cells, text(value))
+	}
+	return w.writer.Write(w.cells)
+}
+
+func (w *csvWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type jsonlWriter struct {
+	writer *bufio.Writer
+	// keys are the JSON encoded column names of the header.
+	keys [][]byte
+}
+
+// Write encodes rows as objects keeping the order of the header, values keep their JSON type (e.g. numbers).
+func (w *jsonlWriter) Write(values ...any) error {
+	if w.keys == nil {
+		w.keys = make([][]byte, len(values))
+		for i, value := range values {
+			key, err := json.Marshal(text(value))
+			if err != nil {
+				return err
+			}
+			w.keys[i] = key
+		}
+		return nil
+	}
+	if len(values) > len(w.keys) {
+		return fmt.Errorf("row of %d values for %d columns", len(values), len(w.keys))
+	}
+	w.writer.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			w.writer.WriteByte(',')
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		w.writer.Write(w.keys[i])
+		w.writer.WriteByte(':')
+		w.writer.Write(encoded)
+	}
+	_, err := w.writer.WriteString("}\n")
+	return err
+}
+
+func (w *jsonlWriter) Close() error {
+	return w.writer.Flush()
+}
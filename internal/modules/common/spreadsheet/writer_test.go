@@ -0,0 +1,73 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeAll(t *testing.T, format Format, rows ...[]any) *bytes.Buffer {
+	var buffer bytes.Buffer
+	writer, err := NewWriter(format, &buffer)
+	assert.NoError(t, err)
+	for _, row := range rows {
+		assert.NoError(t, writer.Write(row...))
+	}
+	assert.NoError(t, writer.Close())
+	return &buffer
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("JSONL")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatJSONL, format)
+	assert.Equal(t, JSONLContentType, format.ContentType())
+
+	_, err = ParseFormat("pdf")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestCSVWriter(t *testing.T) {
+	timestamp := time.Date(2026, time.October, 1, 9, 30, 0, 0, time.UTC)
+
+	buffer := writeAll(t, FormatCSV, []any{"id", "title", "timestamp", "age"}, []any{"t1", "Open, 1st", timestamp, nil})
+
+	assert.Equal(t, "id,title,timestamp,age\nt1,\"Open, 1st\",2026-10-01T09:30:00Z,\n", buffer.String())
+}
+
+func TestJSONLWriter(t *testing.T) {
+	buffer := writeAll(t, FormatJSONL, []any{"id", "age", "ranking"}, []any{"p1", 35, nil}, []any{"p2"})
+
+	assert.Equal(t, "{\"id\":\"p1\",\"age\":35,\"ranking\":null}\n{\"id\":\"p2\"}\n", buffer.String())
+
+	writer, _ := NewWriter(FormatJSONL, &bytes.Buffer{})
+	_ = writer.Write("id")
+	assert.Error(t, writer.Write("p1", 35), "Expected rows longer than the header to be rejected")
+}
+
+func TestXLSXWriter(t *testing.T) {
+	columns := make([]any, 28)
+	for i := range columns {
+		columns[i] = columnName(i)
+	}
+	buffer := writeAll(t, FormatXLSX, columns, []any{"<Doe & Roe>", 35, nil, true, 1.5})
+
+	reader, err := NewXLSXReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+
+	assert.NoError(t, err)
+	rows := readAll(t, reader)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, []string{"Z", "AA", "AB"}, rows[0].Cells[25:])
+	assert.Equal(t, Row{Number: 2, Cells: []string{"<Doe & Roe>", "35", "", "1", "1.5"}}, rows[1])
+}
+
+func TestXLSXWriter_Empty(t *testing.T) {
+	buffer := writeAll(t, FormatXLSX)
+
+	reader, err := NewXLSXReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+
+	assert.NoError(t, err)
+	assert.Empty(t, readAll(t, reader))
+}
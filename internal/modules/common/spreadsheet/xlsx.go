@@ -0,0 +1,255 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	workbookPath      = "xl/workbook.xml"
+	workbookRelsPath  = "xl/_rels/workbook.xml.rels"
+	sharedStringsPath = "xl/sharedStrings.xml"
+	firstSheetPath    = "xl/worksheets/sheet1.xml"
+)
+
+var ErrInvalidXLSX = errors.New("invalid XLSX file")
+
+// maxSharedStringsSize caps the decompressed shared strings kept in memory, so small uploads can't be zip bombs.
+// Mockable for testing.
+var maxSharedStringsSize int64 = 64 << 20
+
+type xlsxReader struct {
+	sheet         io.ReadCloser
+	decoder       *xml.Decoder
+	sharedStrings []string
+	rows          int
+}
+
+// NewXLSXReader reads the rows of the first worksheet. Zip archives need random access, so uploads are
+// expected to be spooled to a file first. The worksheet is decoded as it's read, only its shared strings
+// (the distinct texts of the workbook) are kept in memory.
+func NewXLSXReader(r io.ReaderAt, size int64) (Reader, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+	}
+	sharedStrings, err := readSharedStrings(archive)
+	if err != nil {
+		return nil, err
+	}
+	sheet, err := archive.Open(firstSheet(archive))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+	}
+	return &xlsxReader{sheet: sheet, decoder: xml.NewDecoder(sheet), sharedStrings: sharedStrings}, nil
+}
+
+// firstSheet resolves the path of the first sheet of the workbook through its relationships.
+func firstSheet(archive *zip.Reader) string {
+	var workbook struct {
+		Sheets []struct {
+			ID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if decodeFile(archive, workbookPath, &workbook) != nil || decodeFile(archive, workbookRelsPath, &rels) != nil ||
+		len(workbook.Sheets) == 0 {
+		return firstSheetPath
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID == workbook.Sheets[0].ID {
+			if strings.HasPrefix(rel.Target, "/") {
+				return strings.TrimPrefix(rel.Target, "/")
+			}
+			return path.Join("xl", rel.Target)
+		}
+	}
+	return firstSheetPath
+}
+
+func decodeFile(archive *zip.Reader, name string, v any) error {
+	file, err := archive.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return xml.NewDecoder(file).Decode(v)
+}
+
+// readSharedStrings returns the texts referenced by cells of type "s", rich text runs being concatenated.
+// Archives declaring more than maxSharedStringsSize are rejected, and reads stop there in case the size lies.
+func readSharedStrings(archive *zip.Reader) ([]string, error) {
+	var entry *zip.File
+	for _, file := range archive.File {
+		if file.Name == sharedStringsPath {
+			entry = file
+		}
+	}
+	if entry == nil {
+		// Workbooks without texts have no shared strings.
+		return nil, nil
+	}
+	if entry.UncompressedSize64 > uint64(maxSharedStringsSize) {
+		return nil, fmt.Errorf("%w: shared strings over %d bytes", ErrInvalidXLSX, maxSharedStringsSize)
+	}
+	file, err := entry.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+	}
+	defer file.Close()
+	var sharedStrings []string
+	var text strings.Builder
+	inText, inPhonetic := false, false
+	decoder := xml.NewDecoder(io.LimitReader(file, maxSharedStringsSize))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return sharedStrings, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			switch token.Name.Local {
+			case "si":
+				text.Reset()
+			case "t":
+				inText = true
+			case "rPh":
+				inPhonetic = true
+			}
+		case xml.EndElement:
+			switch token.Name.Local {
+			case "si":
+				sharedStrings = append(sharedStrings, text.String())
+			case "t":
+				inText = false
+			case "rPh":
+				inPhonetic = false
+			}
+		case xml.CharData:
+			if inText && !inPhonetic {
+				text.Write(token)
+			}
+		}
+	}
+}
+
+func (r *xlsxReader) Read() (Row, error) {
+	for {
+		token, err := r.decoder.Token()
+		if err == io.EOF {
+			r.sheet.Close()
+			return Row{}, io.EOF
+		}
+		if err != nil {
+			return Row{}, fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local == "row" {
+			return r.readRow(start)
+		}
+	}
+}
+
+func (r *xlsxReader) readRow(start xml.StartElement) (Row, error) {
+	r.rows++
+	row := Row{Number: r.rows}
+	if number, err := strconv.Atoi(attr(start, "r")); err == nil {
+		row.Number, r.rows = number, number
+	}
+	for {
+		token, err := r.decoder.Token()
+		if err != nil {
+			return Row{}, fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			if token.Name.Local != "c" {
+				continue
+			}
+			column := len(row.Cells)
+			if index, ok := columnIndex(attr(token, "r")); ok {
+				column = index
+			}
+			value, err := r.readCell(token)
+			if err != nil {
+				return Row{}, err
+			}
+			for len(row.Cells) < column {
+				row.Cells = append(row.Cells, "")
+			}
+			row.Cells = append(row.Cells, value)
+		case xml.EndElement:
+			if token.Name.Local == "row" {
+				return row, nil
+			}
+		}
+	}
+}
+
+// readCell returns the text of the cell: shared or inline strings, formula results, numbers and booleans as stored.
+func (r *xlsxReader) readCell(start xml.StartElement) (string, error) {
+	var cell struct {
+		Value  string `xml:"v"`
+		Inline struct {
+			Text string `xml:"t"`
+			Runs []struct {
+				Text string `xml:"t"`
+			} `xml:"r"`
+		} `xml:"is"`
+	}
+	if err := r.decoder.DecodeElement(&cell, &start); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+	}
+	switch attr(start, "t") {
+	case "s":
+		index, err := strconv.Atoi(cell.Value)
+		if err != nil || index < 0 || index >= len(r.sharedStrings) {
+			return "", fmt.Errorf("%w: shared string %q not found", ErrInvalidXLSX, cell.Value)
+		}
+		return r.sharedStrings[index], nil
+	case "inlineStr":
+		text := cell.Inline.Text
+		for _, run := range cell.Inline.Runs {
+			text += run.Text
+		}
+		return text, nil
+	default:
+		return cell.Value, nil
+	}
+}
+
+func attr(element xml.StartElement, name string) string {
+	for _, a := range element.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// columnIndex returns the 0 based column of a cell reference, e.g. 27 for "AB12".
+func columnIndex(reference string) (int, bool) {
+	index := 0
+	letters := 0
+	for _, char := range reference {
+		if char < 'A' || char > 'Z' {
+			break
+		}
+		index = index*26 + int(char-'A'+1)
+		letters++
+	}
+	return index - 1, letters > 0
+}
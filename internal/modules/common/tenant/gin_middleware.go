@@ -0,0 +1,14 @@
+package tenant
+
+import "github.com/gin-gonic/gin"
+
+// GinMiddleware keeps the tenant of the Host header in the request context. Tokens holding a tenant must match it
+// (see auth.Authenticate), requests of unknown hosts are of the tenant of their token or the Default one.
+func GinMiddleware(hosts Hosts) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if id, ok := hosts.Resolve(c.Request.Host); ok {
+			c.Request = c.Request.WithContext(WithID(c.Request.Context(), id))
+		}
+		c.Next()
+	}
+}
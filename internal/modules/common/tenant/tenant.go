@@ -0,0 +1,104 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	// Default is the tenant of requests without one, e.g. of single tenant deployments or of the documents stored
+	// before tenants (see the tenant migrations of the modules).
+	Default  = "default"
+	hostsEnv = "TENANT_HOSTS"
+)
+
+// IDs are lower case so they can name databases (see mongo.MongoClient).
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,31}$`)
+
+// Mockable for testing.
+var getEnv = os.Getenv
+
+type ctxKey struct{}
+
+// ValidateID checks the tenant is 2 to 32 lower case letters, digits or dashes, e.g. "fep" or "club-norte".
+func ValidateID(id string) error {
+	if !idPattern.MatchString(id) {
+		return fmt.Errorf("invalid tenant: %q", id)
+	}
+	return nil
+}
+
+// WithID returns a copy of the context holding the tenant, used by repositories to scope documents.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns the tenant kept by WithID.
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(ctxKey{}).(string)
+	return id, ok
+}
+
+// ID returns the tenant of the context, Default without one.
+func ID(ctx context.Context) string {
+	if id, ok := FromContext(ctx); ok {
+		return id
+	}
+	return Default
+}
+
+// Hosts maps the hosts of the API (without port) to their tenant, e.g. "fep.padelplace.com" to "fep".
+type Hosts map[string]string
+
+// ParseHosts reads comma separated host=tenant entries, e.g. "fep.padelplace.com=fep,fip.padelplace.com=fip".
+func ParseHosts(value string) (Hosts, error) {
+	hosts := Hosts{}
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); len(entry) == 0 {
+			continue
+		}
+		host, id, ok := strings.Cut(entry, "=")
+		if !ok || len(host) == 0 {
+			return nil, fmt.Errorf("invalid tenant host %q, expected host=tenant", entry)
+		}
+		if err := ValidateID(id); err != nil {
+			return nil, err
+		}
+		hosts[strings.ToLower(host)] = id
+	}
+	return hosts, nil
+}
+
+// HostsFromEnv reads TENANT_HOSTS (see ParseHosts), every host being of the Default tenant when empty.
+func HostsFromEnv() (Hosts, error) {
+	hosts, err := ParseHosts(getEnv(hostsEnv))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", hostsEnv, err)
+	}
+	return hosts, nil
+}
+
+// Resolve returns the tenant of the host, which may hold a port (e.g. a Host header).
+func (h Hosts) Resolve(host string) (string, bool) {
+	if i := strings.LastIndexByte(host, ':'); i >= 0 && !strings.Contains(host[i:], "]") {
+		host = host[:i]
+	}
+	id, ok := h[strings.ToLower(host)]
+	return id, ok
+}
+
+// IDs returns the Default tenant followed by the tenants of the hosts, sorted and without duplicates.
+func (h Hosts) IDs() []string {
+	ids := []string{}
+	for _, id := range h {
+		if id != Default && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return append([]string{Default}, ids...)
+}
@@ -0,0 +1,79 @@
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateID(t *testing.T) {
+	for _, id := range []string{"fep", "club-norte", Default, "a1"} {
+		assert.NoError(t, ValidateID(id), id)
+	}
+	for _, id := range []string{"", "f", "FEP", "-fep", "fep_1", "fep.padel", "a-tenant-id-longer-than-32-chars-x"} {
+		assert.Error(t, ValidateID(id), id)
+	}
+}
+
+func TestID(t *testing.T) {
+	assert.Equal(t, Default, ID(context.Background()))
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	ctx := WithID(context.Background(), "fep")
+	assert.Equal(t, "fep", ID(ctx))
+}
+
+func TestParseHosts(t *testing.T) {
+	hosts, err := ParseHosts("fep.padelplace.com=fep, FIP.padelplace.com=fip,")
+	assert.NoError(t, err)
+	assert.Equal(t, Hosts{"fep.padelplace.com": "fep", "fip.padelplace.com": "fip"}, hosts)
+
+	for host, expected := range map[string]string{"fep.padelplace.com": "fep", "Fip.padelplace.com:8080": "fip"} {
+		id, ok := hosts.Resolve(host)
+		assert.True(t, ok, host)
+		assert.Equal(t, expected, id)
+	}
+	_, ok := hosts.Resolve("localhost:8080")
+	assert.False(t, ok)
+
+	_, err = ParseHosts("fep.padelplace.com")
+	assert.Error(t, err)
+	_, err = ParseHosts("fep.padelplace.com=FEP")
+	assert.Error(t, err)
+
+	originalGetEnv := getEnv
+	defer func() { getEnv = originalGetEnv }()
+	getEnv = func(string) string { return "" }
+	hosts, err = HostsFromEnv()
+	assert.NoError(t, err)
+	assert.Empty(t, hosts)
+}
+
+func TestHosts_IDs(t *testing.T) {
+	hosts := Hosts{"fip.padelplace.com": "fip", "fep.padelplace.com": "fep", "www.fep.padelplace.com": "fep",
+		"padelplace.com": Default}
+	assert.Equal(t, []string{Default, "fep", "fip"}, hosts.IDs())
+	assert.Equal(t, []string{Default}, Hosts{}.IDs())
+}
+
+func TestGinMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(GinMiddleware(Hosts{"fep.padelplace.com": "fep"}))
+	router.GET("/tenant", func(c *gin.Context) {
+		c.String(http.StatusOK, ID(c.Request.Context()))
+	})
+
+	for host, expected := range map[string]string{"fep.padelplace.com:443": "fep", "padelplace.com": Default} {
+		req := httptest.NewRequest(http.MethodGet, "/tenant", nil)
+		req.Host = host
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, expected, w.Body.String(), host)
+	}
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+const (
+	// Response header holding the trace ID so clients can report it.
+	TraceIDHeader = "X-Trace-Id"
+	// Gin key of the trace ID, i.e. otelgin restores the request context once the request is served
+	// so the logger (outer middleware) can't get it from the span.
+	traceIDKey = "traceId"
+)
+
+// GinMiddleware starts a server span per request (named by the gin route template) and exposes its trace ID
+// as response header.
+func GinMiddleware(serviceName string) []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		otelgin.Middleware(serviceName),
+		func(c *gin.Context) {
+			if traceID := TraceID(c.Request.Context()); len(traceID) > 0 {
+				c.Header(TraceIDHeader, traceID)
+				c.Set(traceIDKey, traceID)
+			}
+			c.Next()
+		},
+	}
+}
+
+// LogFormatter is the default gin log line plus the trace ID of the request.
+func LogFormatter(param gin.LogFormatterParams) string {
+	traceID, ok := param.Keys[traceIDKey].(string)
+	if !ok {
+		traceID = "-"
+	}
+	return fmt.Sprintf("[GIN] %v | %3d | %13v | %15s | %-7s %#v | trace_id=%s\n%s",
+		param.TimeStamp.Format(time.RFC3339),
+		param.StatusCode,
+		param.Latency,
+		param.ClientIP,
+		param.Method,
+		param.Path,
+		traceID,
+		param.ErrorMessage,
+	)
+}
@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/paguerre3/goddd"
+	// Same variable name and values of the OpenTelemetry SDK specification, i.e. the OTLP endpoint
+	// is resolved by the exporter itself from OTEL_EXPORTER_OTLP_ENDPOINT (default localhost:4318).
+	exporterEnv     = "OTEL_TRACES_EXPORTER"
+	otlpExporter    = "otlp"
+	stdoutExporter  = "stdout"
+	consoleExporter = "console"
+	noneExporter    = "none"
+	defaultExporter = noneExporter
+)
+
+var (
+	getEnv = os.Getenv
+	// Exporters can be replaced in tests.
+	newOtlpExporter = func(ctx context.Context) (sdktrace.SpanExporter, error) {
+		return otlptracehttp.New(ctx)
+	}
+	newStdoutExporter = func() (sdktrace.SpanExporter, error) {
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	}
+)
+
+// NewTracerProvider configures the global tracer provider and propagator using the exporter resolved
+// from OTEL_TRACES_EXPORTER (otlp, stdout or none). Returned function flushes and stops the provider.
+func NewTracerProvider(ctx context.Context, serviceName string) (shutdown func(context.Context) error, err error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	}
+
+	exporter, err := resolveExporter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exporter != nil {
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	} else {
+		log.Println("Tracing without exporter, trace IDs are propagated but spans aren't exported")
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}
+
+func resolveExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	exporter := getEnv(exporterEnv)
+	if len(exporter) == 0 {
+		exporter = defaultExporter
+	}
+	switch exporter {
+	case otlpExporter:
+		return newOtlpExporter(ctx)
+	case stdoutExporter, consoleExporter:
+		return newStdoutExporter()
+	case noneExporter:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("invalid %s: %s", exporterEnv, exporter)
+	}
+}
+
+// Tracer returns the application tracer from the global provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// TraceID returns the trace ID of the span present in the context or empty if there is none.
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
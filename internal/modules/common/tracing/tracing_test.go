@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func mockEnv(t *testing.T, value string) {
+	originalGetEnv := getEnv
+	t.Cleanup(func() { getEnv = originalGetEnv })
+	getEnv = func(key string) string {
+		if key == exporterEnv {
+			return value
+		}
+		return ""
+	}
+}
+
+func TestResolveExporter(t *testing.T) {
+	originalNewOtlpExporter := newOtlpExporter
+	defer func() { newOtlpExporter = originalNewOtlpExporter }()
+	otlpMock := tracetest.NewInMemoryExporter()
+	newOtlpExporter = func(ctx context.Context) (sdktrace.SpanExporter, error) {
+		return otlpMock, nil
+	}
+
+	t.Run("default without exporter", func(t *testing.T) {
+		mockEnv(t, "")
+		exporter, err := resolveExporter(context.Background())
+		assert.NoError(t, err)
+		assert.Nil(t, exporter)
+	})
+
+	t.Run("otlp", func(t *testing.T) {
+		mockEnv(t, otlpExporter)
+		exporter, err := resolveExporter(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, otlpMock, exporter)
+	})
+
+	t.Run("stdout", func(t *testing.T) {
+		mockEnv(t, stdoutExporter)
+		exporter, err := resolveExporter(context.Background())
+		assert.NoError(t, err)
+		assert.NotNil(t, exporter)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		mockEnv(t, "zipkin")
+		_, err := resolveExporter(context.Background())
+		assert.EqualError(t, err, "invalid OTEL_TRACES_EXPORTER: zipkin")
+	})
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	mockEnv(t, noneExporter)
+	shutdown, err := NewTracerProvider(context.Background(), "test")
+	assert.NoError(t, err)
+	defer shutdown(context.Background())
+
+	ctx, span := Tracer().Start(context.Background(), "test-span")
+	defer span.End()
+
+	assert.Len(t, TraceID(ctx), 32)
+	assert.Equal(t, "", TraceID(context.Background()))
+}
+
+func TestGinMiddleware(t *testing.T) {
+	mockEnv(t, noneExporter)
+	shutdown, err := NewTracerProvider(context.Background(), "test")
+	assert.NoError(t, err)
+	defer shutdown(context.Background())
+
+	gin.SetMode(gin.TestMode)
+	var logs bytes.Buffer
+	router := gin.New()
+	router.Use(gin.LoggerWithConfig(gin.LoggerConfig{Formatter: LogFormatter, Output: &logs}))
+	router.Use(GinMiddleware("test")...)
+	var handlerTraceID string
+	router.GET("/players/:playerId", func(c *gin.Context) {
+		handlerTraceID = TraceID(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/players/some-id", nil)
+	router.ServeHTTP(w, req)
+
+	traceID := w.Header().Get(TraceIDHeader)
+	assert.Len(t, traceID, 32)
+	assert.Equal(t, traceID, handlerTraceID)
+	assert.True(t, strings.Contains(logs.String(), "trace_id="+traceID), "Expected trace ID in log line")
+}
@@ -2,15 +2,62 @@ package utils
 
 import (
 	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"sync"
 
 	"github.com/google/uuid"
 )
 
+const (
+	idGeneratorEnv   = "ID_GENERATOR"
+	snowflakeNodeEnv = "SNOWFLAKE_NODE_ID"
+)
+
+// ID generators selected by ID_GENERATOR.
+const (
+	UUIDGenerator      = "uuid"
+	UUIDv7Generator    = "uuidv7"
+	ULIDGenerator      = "ulid"
+	SnowflakeGenerator = "snowflake"
+)
+
+// Mockable for testing.
+var getEnv = os.Getenv
+
 type IDGenerator interface {
 	GenerateID() string
+	// Deprecated: prefixes leak personal data into URLs when they're names, and get stale when they change.
 	GenerateIDWithPrefixes(prefix1 string, prefix2 string) string
 }
 
+// NewIDGeneratorFromEnv returns the generator of ID_GENERATOR: uuid (random UUIDv4, default), uuidv7 (time ordered
+// UUIDs), ulid or snowflake. Snowflake IDs require SNOWFLAKE_NODE_ID, unique per replica.
+func NewIDGeneratorFromEnv() (IDGenerator, error) {
+	switch name := getEnv(idGeneratorEnv); name {
+	case "", UUIDGenerator:
+		return NewUUIDGenerator(), nil
+	case UUIDv7Generator:
+		return NewUUIDv7Generator(), nil
+	case ULIDGenerator:
+		return NewULIDGenerator(), nil
+	case SnowflakeGenerator:
+		value := getEnv(snowflakeNodeEnv)
+		if len(value) == 0 {
+			return nil, fmt.Errorf("missing %s, required by %s IDs", snowflakeNodeEnv, SnowflakeGenerator)
+		}
+		node, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", snowflakeNodeEnv, value)
+		}
+		return NewSnowflakeGenerator(node)
+	default:
+		return nil, fmt.Errorf("invalid %s: %s, expected %s, %s, %s or %s", idGeneratorEnv, name,
+			UUIDGenerator, UUIDv7Generator, ULIDGenerator, SnowflakeGenerator)
+	}
+}
+
 type uuidGenerator struct{}
 
 // Create instance as pointer reference so it can be used as singleton.
@@ -24,5 +71,52 @@ func (g *uuidGenerator) GenerateID() string {
 }
 
 func (g *uuidGenerator) GenerateIDWithPrefixes(prefix1 string, prefix2 string) string {
-	return fmt.Sprintf("%s-%s-%s", prefix1, prefix2, g.GenerateID())
+	return withPrefixes(prefix1, prefix2, g.GenerateID())
+}
+
+type uuidv7Generator struct{}
+
+// NewUUIDv7Generator returns UUIDs starting with their creation time, so they're sorted as they're created and
+// indexed more efficiently than random ones.
+func NewUUIDv7Generator() IDGenerator {
+	return &uuidv7Generator{}
+}
+
+func (g *uuidv7Generator) GenerateID() string {
+	return uuid.Must(uuid.NewV7()).String()
+}
+
+func (g *uuidv7Generator) GenerateIDWithPrefixes(prefix1 string, prefix2 string) string {
+	return withPrefixes(prefix1, prefix2, g.GenerateID())
+}
+
+// seededGenerator is deterministic, the same seed generating the same IDs.
+type seededGenerator struct {
+	mutex  sync.Mutex
+	random *rand.Rand
+}
+
+// NewSeededGenerator returns UUIDv4 formatted IDs generated from the seed, e.g. to compare generated documents in tests.
+// They aren't unique across seeds, so it's not meant for production.
+func NewSeededGenerator(seed int64) IDGenerator {
+	return &seededGenerator{random: rand.New(rand.NewSource(seed))}
+}
+
+func (g *seededGenerator) GenerateID() string {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	var id uuid.UUID
+	g.random.Read(id[:])
+	// Version 4 and RFC 4122 variant bits, as random UUIDs.
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	return id.String()
+}
+
+func (g *seededGenerator) GenerateIDWithPrefixes(prefix1 string, prefix2 string) string {
+	return withPrefixes(prefix1, prefix2, g.GenerateID())
+}
+
+func withPrefixes(prefix1, prefix2, id string) string {
+	return fmt.Sprintf("%s-%s-%s", prefix1, prefix2, id)
 }
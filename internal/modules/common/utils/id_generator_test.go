@@ -2,7 +2,9 @@ package utils
 
 import (
 	"regexp"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -32,3 +34,62 @@ func TestGenerateIDWithPrefixes_SamePrefixes(t *testing.T) {
 	id2 := idGen.GenerateIDWithPrefixes(prefix1, prefix2)
 	assert.NotEqual(t, id1, id2, "GenerateIDWithPrefixes should return different UUIDs when the prefixes are the same")
 }
+
+func TestNewIDGeneratorFromEnv(t *testing.T) {
+	original := getEnv
+	defer func() { getEnv = original }()
+	env := map[string]string{}
+	getEnv = func(key string) string { return env[key] }
+
+	for name, pattern := range map[string]string{
+		"":          `^[a-f0-9\-]{36}$`,
+		"uuidv7":    `^[a-f0-9]{8}-[a-f0-9]{4}-7[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12}$`,
+		"ulid":      `^[0-9A-HJKMNP-TV-Z]{26}$`,
+		"snowflake": `^[0-9]+$`,
+	} {
+		env = map[string]string{idGeneratorEnv: name, snowflakeNodeEnv: "7"}
+		idGen, err := NewIDGeneratorFromEnv()
+		assert.NoError(t, err)
+		assert.Regexp(t, pattern, idGen.GenerateID(), "ID_GENERATOR=%s", name)
+	}
+
+	env = map[string]string{idGeneratorEnv: "snowflake"}
+	_, err := NewIDGeneratorFromEnv()
+	assert.EqualError(t, err, "missing SNOWFLAKE_NODE_ID, required by snowflake IDs")
+	env = map[string]string{idGeneratorEnv: "snowflake", snowflakeNodeEnv: "1024"}
+	_, err = NewIDGeneratorFromEnv()
+	assert.EqualError(t, err, "invalid snowflake node 1024, expected 0 to 1023")
+	env = map[string]string{idGeneratorEnv: "uuidv1"}
+	_, err = NewIDGeneratorFromEnv()
+	assert.EqualError(t, err, "invalid ID_GENERATOR: uuidv1, expected uuid, uuidv7, ulid or snowflake")
+}
+
+// TestTimeOrderedIDs checks that IDs created later sort after the earlier ones.
+func TestTimeOrderedIDs(t *testing.T) {
+	at := time.Date(2026, time.October, 19, 8, 0, 0, 0, time.UTC)
+	ulids := &ulidGenerator{now: func() time.Time { return at }}
+	first := ulids.GenerateID()
+	assert.Equal(t, "01M59JSC00", first[:10], "Expected the timestamp first")
+	at = at.Add(time.Millisecond)
+	assert.Less(t, first, ulids.GenerateID())
+
+	snowflakes := &snowflakeGenerator{node: 3, now: func() time.Time { return at }}
+	ids := []string{snowflakes.GenerateID(), snowflakes.GenerateID()}
+	at = at.Add(-time.Second)
+	ids = append(ids, snowflakes.GenerateID())
+	for i := 1; i < len(ids); i++ {
+		previous, _ := strconv.ParseInt(ids[i-1], 10, 64)
+		id, _ := strconv.ParseInt(ids[i], 10, 64)
+		assert.Greater(t, id, previous, "Expected ordered IDs, even when the clock goes backwards")
+		assert.Equal(t, int64(3), id>>snowflakeSequenceBits&snowflakeMaxNode, "Expected the node")
+	}
+}
+
+func TestSeededGenerator_Deterministic(t *testing.T) {
+	idGen1, idGen2 := NewSeededGenerator(42), NewSeededGenerator(42)
+	id := idGen1.GenerateID()
+	assert.Equal(t, id, idGen2.GenerateID())
+	assert.NotEqual(t, id, idGen1.GenerateID())
+	assert.NotEqual(t, id, NewSeededGenerator(43).GenerateID())
+	assert.Regexp(t, `^[a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12}$`, id)
+}
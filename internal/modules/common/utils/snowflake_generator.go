@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	snowflakeNodeBits     = 10
+	snowflakeSequenceBits = 12
+	snowflakeMaxNode      = 1<<snowflakeNodeBits - 1
+	snowflakeMaxSequence  = 1<<snowflakeSequenceBits - 1
+)
+
+// snowflakeEpoch is the start of the 41 bits of milliseconds of Snowflake IDs, i.e. they last until 2094.
+var snowflakeEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// snowflakeGenerator generates 63 bits IDs: milliseconds since snowflakeEpoch, the node and a sequence number of the
+// millisecond. Up to 4096 IDs per millisecond and node, it waits for the next millisecond afterwards.
+type snowflakeGenerator struct {
+	mutex    sync.Mutex
+	node     int64
+	last     int64
+	sequence int64
+	now      func() time.Time
+}
+
+// NewSnowflakeGenerator returns decimal Snowflake IDs of the node (0 to 1023), which must be unique per replica.
+func NewSnowflakeGenerator(node int64) (IDGenerator, error) {
+	if node < 0 || node > snowflakeMaxNode {
+		return nil, fmt.Errorf("invalid snowflake node %d, expected 0 to %d", node, snowflakeMaxNode)
+	}
+	return &snowflakeGenerator{node: node, now: time.Now}, nil
+}
+
+func (g *snowflakeGenerator) GenerateID() string {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	millis := g.now().Sub(snowflakeEpoch).Milliseconds()
+	// Clocks going backwards keep the last millisecond, so IDs stay ordered and unique.
+	if millis <= g.last {
+		millis = g.last
+		g.sequence = (g.sequence + 1) & snowflakeMaxSequence
+		if g.sequence == 0 {
+			for millis <= g.last {
+				time.Sleep(time.Millisecond)
+				millis = g.now().Sub(snowflakeEpoch).Milliseconds()
+			}
+		}
+	} else {
+		g.sequence = 0
+	}
+	g.last = millis
+	id := millis<<(snowflakeNodeBits+snowflakeSequenceBits) | g.node<<snowflakeSequenceBits | g.sequence
+	return strconv.FormatInt(id, 10)
+}
+
+func (g *snowflakeGenerator) GenerateIDWithPrefixes(prefix1 string, prefix2 string) string {
+	return withPrefixes(prefix1, prefix2, g.GenerateID())
+}
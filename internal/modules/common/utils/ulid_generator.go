@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"time"
+)
+
+// Crockford's base 32, i.e. without I, L, O and U.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+type ulidGenerator struct {
+	now func() time.Time
+}
+
+// NewULIDGenerator returns ULIDs: 26 characters, sorted by creation time (milliseconds) followed by 80 random bits.
+func NewULIDGenerator() IDGenerator {
+	return &ulidGenerator{now: time.Now}
+}
+
+func (g *ulidGenerator) GenerateID() string {
+	var id [16]byte
+	binary.BigEndian.PutUint64(id[:8], uint64(g.now().UnixMilli())<<16)
+	_, _ = rand.Read(id[6:])
+	return encodeULID(id)
+}
+
+func (g *ulidGenerator) GenerateIDWithPrefixes(prefix1 string, prefix2 string) string {
+	return withPrefixes(prefix1, prefix2, g.GenerateID())
+}
+
+// encodeULID encodes the 128 bits as 26 characters of 5 bits, the first one holding the 3 leftover bits.
+func encodeULID(id [16]byte) string {
+	high := binary.BigEndian.Uint64(id[:8])
+	low := binary.BigEndian.Uint64(id[8:])
+	text := make([]byte, 26)
+	for i := len(text) - 1; i >= 0; i-- {
+		text[i] = crockfordAlphabet[low&0x1f]
+		low = low>>5 | high<<59
+		high >>= 5
+	}
+	return string(text)
+}
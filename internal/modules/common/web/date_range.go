@@ -0,0 +1,42 @@
+package web
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseDateRange parses the optional bounds of [from, to) as RFC 3339 times or dates (UTC), a missing bound being
+// nil. Dates are whole days, i.e. to "2026-10-31" includes that day.
+func ParseDateRange(from, to string) (*time.Time, *time.Time, error) {
+	start, err := parseDate("from", from, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	end, err := parseDate("to", to, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return start, end, nil
+}
+
+// ParseDate parses an optional RFC 3339 time or date (UTC midnight) of the named parameter, nil when missing.
+func ParseDate(name, value string) (*time.Time, error) {
+	return parseDate(name, value, false)
+}
+
+func parseDate(name, value string, endOfDay bool) (*time.Time, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		if endOfDay {
+			date = date.AddDate(0, 0, 1)
+		}
+		return &date, nil
+	}
+	instant, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %s, expected a date (2006-01-02) or RFC 3339 time", name, value)
+	}
+	return &instant, nil
+}
@@ -0,0 +1,43 @@
+package web
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDateRange(t *testing.T) {
+	from, to, err := ParseDateRange("2026-10-01", "2026-10-31")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), *from)
+	assert.Equal(t, time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC), *to, "Expected the last day included")
+
+	from, to, err = ParseDateRange("2026-10-01T10:00:00Z", "2026-10-01T12:30:00+02:00")
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2026, time.October, 1, 10, 0, 0, 0, time.UTC).Equal(*from))
+	assert.True(t, time.Date(2026, time.October, 1, 10, 30, 0, 0, time.UTC).Equal(*to), "Expected times kept as is")
+
+	from, to, err = ParseDateRange("", "")
+	assert.NoError(t, err)
+	assert.Nil(t, from)
+	assert.Nil(t, to)
+
+	_, _, err = ParseDateRange("01/10/2026", "")
+	assert.EqualError(t, err, "invalid from: 01/10/2026, expected a date (2006-01-02) or RFC 3339 time")
+	_, _, err = ParseDateRange("", "tomorrow")
+	assert.ErrorContains(t, err, "invalid to: tomorrow")
+}
+
+func TestParseDate(t *testing.T) {
+	at, err := ParseDate("at", "2026-10-31")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, time.October, 31, 0, 0, 0, 0, time.UTC), *at, "Expected the start of the day")
+
+	at, err = ParseDate("at", "")
+	assert.NoError(t, err)
+	assert.Nil(t, at)
+
+	_, err = ParseDate("at", "tomorrow")
+	assert.EqualError(t, err, "invalid at: tomorrow, expected a date (2006-01-02) or RFC 3339 time")
+}
@@ -0,0 +1,16 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/tracing"
+)
+
+// ErrorBody builds the JSON body of error responses including the trace ID of the request (when present)
+// so clients can correlate failures with logs and traces.
+func ErrorBody(c *gin.Context, err error) gin.H {
+	body := gin.H{"error": err.Error()}
+	if traceID := tracing.TraceID(c.Request.Context()); len(traceID) > 0 {
+		body["traceId"] = traceID
+	}
+	return body
+}
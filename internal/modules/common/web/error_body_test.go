@@ -0,0 +1,32 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestErrorBody(t *testing.T) {
+	t.Run("without trace", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/players/1", nil)
+
+		assert.Equal(t, gin.H{"error": "some error"}, ErrorBody(c, errors.New("some error")))
+	})
+
+	t.Run("with trace", func(t *testing.T) {
+		ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+		defer span.End()
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/players/1", nil).WithContext(ctx)
+
+		body := ErrorBody(c, errors.New("some error"))
+		assert.Equal(t, "some error", body["error"])
+		assert.Equal(t, span.SpanContext().TraceID().String(), body["traceId"])
+	})
+}
@@ -0,0 +1,90 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ugorji/go/codec"
+)
+
+// Media types served besides JSON, negotiated through Accept (responses) and Content-Type (request bodies).
+const (
+	MIMEJSON    = gin.MIMEJSON
+	MIMEMsgPack = "application/x-msgpack"
+	MIMECBOR    = "application/cbor"
+)
+
+// Offered media types, JSON goes first so it's served when Accept is missing or doesn't match.
+var offered = []string{MIMEJSON, MIMEMsgPack, MIMECBOR}
+
+var (
+	msgPackHandle = newMsgPackHandle()
+	cborHandle    = newCBORHandle()
+)
+
+// Both encodings read json tags (after codec ones) so they produce the same fields as JSON.
+func newMsgPackHandle() *codec.MsgpackHandle {
+	handle := &codec.MsgpackHandle{WriteExt: true}
+	handle.RawToString = true
+	handle.MapType = reflect.TypeOf(map[string]any(nil))
+	return handle
+}
+
+func newCBORHandle() *codec.CborHandle {
+	handle := &codec.CborHandle{TimeRFC3339: true}
+	handle.MapType = reflect.TypeOf(map[string]any(nil))
+	return handle
+}
+
+// Respond writes the body encoded with the media type negotiated from the Accept header.
+func Respond(c *gin.Context, code int, obj any) {
+	switch c.NegotiateFormat(offered...) {
+	case MIMEMsgPack:
+		c.Render(code, codecRender{contentType: MIMEMsgPack, handle: msgPackHandle, data: obj})
+	case MIMECBOR:
+		c.Render(code, codecRender{contentType: MIMECBOR, handle: cborHandle, data: obj})
+	default:
+		c.JSON(code, obj)
+	}
+}
+
+// Abort is Respond for middlewares, i.e. the remaining handlers aren't called.
+func Abort(c *gin.Context, code int, obj any) {
+	c.Abort()
+	Respond(c, code, obj)
+}
+
+// Bind decodes the request body according to its Content-Type, JSON when missing.
+func Bind(c *gin.Context, obj any) error {
+	var handle codec.Handle
+	switch c.ContentType() {
+	case MIMEMsgPack:
+		handle = msgPackHandle
+	case MIMECBOR:
+		handle = cborHandle
+	default:
+		return c.ShouldBindJSON(obj)
+	}
+	if c.Request.Body == nil {
+		return errors.New("invalid request body")
+	}
+	return codec.NewDecoder(c.Request.Body, handle).Decode(obj)
+}
+
+// codecRender implements render.Render for the codec handles.
+type codecRender struct {
+	contentType string
+	handle      codec.Handle
+	data        any
+}
+
+func (r codecRender) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+	return codec.NewEncoder(w, r.handle).Encode(r.data)
+}
+
+func (r codecRender) WriteContentType(w http.ResponseWriter) {
+	w.Header()["Content-Type"] = []string{r.contentType}
+}
@@ -0,0 +1,114 @@
+package web
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/ugorji/go/codec"
+)
+
+type negotiated struct {
+	ID        string    `json:"id"`
+	Secret    string    `json:"-"`
+	Age       *int      `json:"age,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func respond(accept string, obj any) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/players/1", nil)
+	if len(accept) > 0 {
+		c.Request.Header.Set("Accept", accept)
+	}
+	Respond(c, http.StatusOK, obj)
+	return w
+}
+
+func TestRespond(t *testing.T) {
+	createdAt := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+	value := negotiated{ID: "1", Secret: "secret", CreatedAt: createdAt}
+
+	for _, accept := range []string{"", "*/*", "text/html", MIMEJSON} {
+		t.Run("JSON for Accept "+accept, func(t *testing.T) {
+			w := respond(accept, value)
+			assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.JSONEq(t, `{"id": "1", "createdAt": "2024-10-01T12:00:00Z"}`, w.Body.String())
+		})
+	}
+
+	for _, test := range []struct {
+		accept string
+		handle codec.Handle
+	}{
+		{MIMEMsgPack, msgPackHandle},
+		{MIMECBOR, cborHandle},
+		{"application/cbor;q=0.9, application/json;q=0.1", cborHandle},
+	} {
+		t.Run(test.accept, func(t *testing.T) {
+			w := respond(test.accept, value)
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var decoded map[string]any
+			assert.NoError(t, codec.NewDecoderBytes(w.Body.Bytes(), test.handle).Decode(&decoded))
+			assert.Equal(t, "1", decoded["id"])
+			assert.NotContains(t, decoded, "Secret", "Expected json tags honored")
+			assert.NotContains(t, decoded, "age", "Expected omitempty honored")
+		})
+	}
+}
+
+func TestAbort(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/players/1", nil)
+	c.Request.Header.Set("Accept", MIMEMsgPack)
+
+	Abort(c, http.StatusForbidden, gin.H{"error": "insufficient role"})
+
+	assert.True(t, c.IsAborted())
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, MIMEMsgPack, w.Header().Get("Content-Type"))
+}
+
+func TestBind(t *testing.T) {
+	encode := func(handle codec.Handle) []byte {
+		var body []byte
+		assert.NoError(t, codec.NewEncoderBytes(&body, handle).Encode(map[string]any{"id": "1", "age": 30}))
+		return body
+	}
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+	}{
+		{"JSON", MIMEJSON, []byte(`{"id": "1", "age": 30}`)},
+		{"JSON by default", "", []byte(`{"id": "1", "age": 30}`)},
+		{"MessagePack", MIMEMsgPack, encode(msgPackHandle)},
+		{"CBOR", MIMECBOR, encode(cborHandle)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPost, "/players", bytes.NewReader(test.body))
+			c.Request.Header.Set("Content-Type", test.contentType)
+
+			var bound negotiated
+			assert.NoError(t, Bind(c, &bound))
+			assert.Equal(t, "1", bound.ID)
+			assert.Equal(t, 30, *bound.Age)
+		})
+	}
+
+	t.Run("Invalid CBOR", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, "/players", bytes.NewBufferString("invalid"))
+		c.Request.Header.Set("Content-Type", MIMECBOR)
+		assert.Error(t, Bind(c, &negotiated{}))
+	})
+}
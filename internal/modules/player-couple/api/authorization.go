@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+// Authorization rules shared by the HTTP handlers and the gRPC servers.
+
+var errNotOwnProfile = errors.New("players can only register or update their own profile")
+
+// authorizeProfile lets admins, organizers and club integrations register any player while players can only
+// register or update their own profile, i.e. the token email must match the player email (and the stored one on
+// updates by ID). The find status tells invalid IDs apart from repository errors.
+func authorizeProfile(ctx context.Context, findPlayerUseCase application.FindPlayerUseCase, claims *auth.Claims,
+	player domain.Player) (application.FindPlayerStatus, error) {
+	if claims.HasAnyRole(auth.RoleAdmin, auth.RoleOrganizer, auth.RoleClub) {
+		return application.FindPlayerPending, nil
+	}
+	if !claims.HasAnyRole(auth.RolePlayer) || !claims.OwnsEmail(player.Email) {
+		return application.FindPlayerPending, errNotOwnProfile
+	}
+	if len(player.ID) == 0 {
+		return application.FindPlayerPending, nil
+	}
+	existing, status, err := findPlayerUseCase.FindPlayerByIDUseCase(ctx, player.ID)
+	if err != nil {
+		return status, err
+	}
+	if status == application.FindPlayerFound && !claims.OwnsEmail(existing.Email) {
+		return status, errNotOwnProfile
+	}
+	return status, nil
+}
+
+// maskFor hides the SSN of the players unless the claims are the ones of an admin. Every response goes through it,
+// so it also derives the (read-only) age of the players.
+func maskFor[T domain.Player | []domain.Player](claims *auth.Claims, playerS T) T {
+	admin := claims != nil && claims.HasAnyRole(auth.RoleAdmin)
+	mask := func(player domain.Player) domain.Player {
+		player.Profile = player.WithAge(time.Now())
+		if admin {
+			return player
+		}
+		return player.WithMaskedSocialSecurityNumber()
+	}
+	switch value := any(playerS).(type) {
+	case domain.Player:
+		return any(mask(value)).(T)
+	case []domain.Player:
+		masked := make([]domain.Player, 0, len(value))
+		for _, player := range value {
+			masked = append(masked, mask(player))
+		}
+		return any(masked).(T)
+	}
+	return playerS
+}
+
+// maskCoupleFor hides the SSN of both players unless the claims are the ones of an admin.
+func maskCoupleFor(claims *auth.Claims, playerCouple domain.PlayerCouple) domain.PlayerCouple {
+	playerCouple.Player1 = maskFor(claims, playerCouple.Player1)
+	playerCouple.Player2 = maskFor(claims, playerCouple.Player2)
+	return playerCouple
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+type exportFunc func(ctx context.Context, filter domain.ExportFilter, rows spreadsheet.Writer) (application.ExportStatus, error)
+
+// ExportHandler streams players and couples as CSV (default), JSON Lines or XLSX files, selected through the
+// format, from, to, tournamentId and categoryId query parameters.
+type ExportHandler struct {
+	exportUseCase application.ExportUseCase
+}
+
+func NewExportHandler(exportUseCase application.ExportUseCase) *ExportHandler {
+	return &ExportHandler{exportUseCase: exportUseCase}
+}
+
+func (h *ExportHandler) ExportPlayers(c *gin.Context) {
+	export(c, "players", h.exportUseCase.ExportPlayersUseCase)
+}
+
+func (h *ExportHandler) ExportPlayerCouples(c *gin.Context) {
+	export(c, "couples", h.exportUseCase.ExportPlayerCouplesUseCase)
+}
+
+// ParseExportFilter returns the filter of the query parameters (see web.ParseDateRange for dates).
+func ParseExportFilter(from, to, tournamentID, categoryID string) (domain.ExportFilter, error) {
+	start, end, err := web.ParseDateRange(from, to)
+	if err != nil {
+		return domain.ExportFilter{}, err
+	}
+	return domain.ExportFilter{From: start, To: end, TournamentID: tournamentID, CategoryID: categoryID}, nil
+}
+
+// export responds with an error until the first rows are flushed, a failure after that truncates the file.
+func export(c *gin.Context, name string, exportRows exportFunc) {
+	format, err := spreadsheet.ParseFormat(c.DefaultQuery("format", string(spreadsheet.FormatCSV)))
+	if err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	filter, err := ParseExportFilter(c.Query("from"), c.Query("to"), c.Query("tournamentId"), c.Query("categoryId"))
+	if err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, name, format))
+	status := application.ExportPending
+	rows, err := spreadsheet.NewWriter(format, c.Writer)
+	if err == nil {
+		status, err = exportRows(c.Request.Context(), filter, rows)
+	}
+	if err == nil && status != application.ExportExported {
+		err = fmt.Errorf("invalid status %d", status)
+	}
+	if err == nil {
+		if err = rows.Close(); err == nil {
+			return
+		}
+	}
+	switch {
+	case c.Writer.Written():
+		_ = c.Error(err)
+		c.Abort()
+	case status == application.ExportInvalid:
+		respondExportError(c, http.StatusBadRequest, err)
+	default:
+		respondExportError(c, http.StatusInternalServerError, err)
+	}
+}
+
+// respondExportError drops the headers of the file, i.e. the body is the error.
+func respondExportError(c *gin.Context, code int, err error) {
+	c.Writer.Header().Del("Content-Type")
+	c.Writer.Header().Del("Content-Disposition")
+	web.Respond(c, code, web.ErrorBody(c, err))
+}
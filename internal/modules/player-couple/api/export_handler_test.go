@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockExportUseCase writes the rows set by tests before returning.
+type mockExportUseCase struct {
+	mock.Mock
+	rows [][]any
+}
+
+func (m *mockExportUseCase) export(method string, filter domain.ExportFilter, rows spreadsheet.Writer) (application.ExportStatus, error) {
+	args := m.Called(method, filter)
+	for _, row := range m.rows {
+		if err := rows.Write(row...); err != nil {
+			return application.ExportPending, err
+		}
+	}
+	return args.Get(0).(application.ExportStatus), args.Error(1)
+}
+
+func (m *mockExportUseCase) ExportPlayersUseCase(_ context.Context, filter domain.ExportFilter, rows spreadsheet.Writer) (application.ExportStatus, error) {
+	return m.export("players", filter, rows)
+}
+
+func (m *mockExportUseCase) ExportPlayerCouplesUseCase(_ context.Context, filter domain.ExportFilter, rows spreadsheet.Writer) (application.ExportStatus, error) {
+	return m.export("couples", filter, rows)
+}
+
+func serveExport(handler gin.HandlerFunc, target string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+	handler(c)
+	return w
+}
+
+func TestExportPlayers(t *testing.T) {
+	useCase := &mockExportUseCase{rows: [][]any{{"id", "email", "age"}, {"player-1", "john@example.com", 30}}}
+	from, to := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	useCase.On("export", "players", domain.ExportFilter{From: &from, To: &to, TournamentID: "tournament-1"}).
+		Return(application.ExportExported, nil)
+
+	w := serveExport(NewExportHandler(useCase).ExportPlayers, "/exports/players?from=2026-10-01&to=2026-10-31&tournamentId=tournament-1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, spreadsheet.CSVContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="players.csv"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "id,email,age\nplayer-1,john@example.com,30\n", w.Body.String())
+	useCase.AssertExpectations(t)
+}
+
+func TestExportPlayerCouples_JSONL(t *testing.T) {
+	useCase := &mockExportUseCase{rows: [][]any{{"id", "ranking"}, {"couple-1", 3}}}
+	useCase.On("export", "couples", domain.ExportFilter{CategoryID: "category-1"}).Return(application.ExportExported, nil)
+
+	w := serveExport(NewExportHandler(useCase).ExportPlayerCouples, "/exports/couples?format=jsonl&categoryId=category-1")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, spreadsheet.JSONLContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="couples.jsonl"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, `{"id":"couple-1","ranking":3}`+"\n", w.Body.String())
+}
+
+func TestExportPlayers_InvalidQuery(t *testing.T) {
+	for target, expected := range map[string]string{
+		"/exports/players?format=pdf":     "unsupported spreadsheet format: pdf",
+		"/exports/players?from=yesterday": "invalid from: yesterday, expected a date (2006-01-02) or RFC 3339 time",
+		"/exports/players?to=2026-13-01":  "invalid to: 2026-13-01, expected a date (2006-01-02) or RFC 3339 time",
+	} {
+		w := serveExport(NewExportHandler(&mockExportUseCase{}).ExportPlayers, target)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, target)
+		assert.JSONEq(t, `{"error":"`+expected+`"}`, w.Body.String(), target)
+	}
+}
+
+func TestExportPlayers_InvalidFilter(t *testing.T) {
+	useCase := &mockExportUseCase{}
+	useCase.On("export", "players", mock.Anything).Return(application.ExportInvalid, errors.New("invalid date range: from must be before to"))
+
+	w := serveExport(NewExportHandler(useCase).ExportPlayers, "/exports/players?from=2026-10-31&to=2026-10-01")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Empty(t, w.Header().Get("Content-Disposition"))
+	var body map[string]string
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "invalid date range: from must be before to", body["error"])
+}
+
+func TestExportPlayers_Failure(t *testing.T) {
+	// Rows are buffered, so the error is still the response.
+	useCase := &mockExportUseCase{rows: [][]any{{"id"}, {"player-1"}}}
+	useCase.On("export", "players", domain.ExportFilter{}).Return(application.ExportPending, errors.New("cursor failure"))
+
+	w := serveExport(NewExportHandler(useCase).ExportPlayers, "/exports/players")
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.JSONEq(t, `{"error":"cursor failure"}`, w.Body.String())
+	assert.Empty(t, w.Header().Get("Content-Disposition"))
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"time"
+
+	padelplacev1 "github.com/paguerre3/goddd/api/proto/padelplace/v1"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+// Conversions between domain objects and protobuf messages of the gRPC servers.
+
+func toPlayerMessage(player domain.Player) *padelplacev1.Player {
+	message := &padelplacev1.Player{
+		Id:                   player.ID,
+		Email:                player.Email,
+		SocialSecurityNumber: player.SocialSecurityNumber,
+		FirstName:            player.FirstName,
+		LastName:             player.LastName,
+		Gender:               string(player.Gender),
+		Handedness:           string(player.Handedness),
+		Side:                 string(player.Side),
+		Level:                int32(player.Level),
+		Club:                 string(player.Club),
+	}
+	if age := player.AgeOn(time.Now()); age != nil {
+		birthDate, age := string(player.BirthDate), int32(*age)
+		message.BirthDate, message.Age = &birthDate, &age
+	}
+	return message
+}
+
+func toPlayerMessages(players []domain.Player) []*padelplacev1.Player {
+	messages := make([]*padelplacev1.Player, 0, len(players))
+	for _, player := range players {
+		messages = append(messages, toPlayerMessage(player))
+	}
+	return messages
+}
+
+// fromPlayerMessage keeps the age so it's checked against the birth date it's derived from (see domain.Profile).
+func fromPlayerMessage(message *padelplacev1.Player) domain.Player {
+	var age *int
+	if message.Age != nil {
+		value := int(message.GetAge())
+		age = &value
+	}
+	return domain.Player{
+		ID:                   message.GetId(),
+		Email:                message.GetEmail(),
+		SocialSecurityNumber: message.SocialSecurityNumber,
+		FirstName:            message.GetFirstName(),
+		LastName:             message.GetLastName(),
+		Profile: domain.Profile{
+			BirthDate:  domain.BirthDate(message.GetBirthDate()),
+			Gender:     domain.Gender(message.GetGender()),
+			Handedness: domain.Handedness(message.GetHandedness()),
+			Side:       domain.Side(message.GetSide()),
+			Level:      domain.Level(message.GetLevel()),
+			Club:       domain.Club(message.GetClub()),
+			Age:        age,
+		},
+	}
+}
+
+func toPlayerCoupleMessage(playerCouple domain.PlayerCouple) *padelplacev1.PlayerCouple {
+	message := &padelplacev1.PlayerCouple{
+		Id:      playerCouple.ID,
+		Player1: toPlayerMessage(playerCouple.Player1),
+		Player2: toPlayerMessage(playerCouple.Player2),
+	}
+	if playerCouple.Ranking != nil {
+		ranking := int32(*playerCouple.Ranking)
+		message.Ranking = &ranking
+	}
+	return message
+}
@@ -0,0 +1,197 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/paguerre3/goddd/internal/modules/common/openapi"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+const playersTag = "players"
+
+// DescribePlayerRoutes documents the routes of PlayerHandler and PlayerDataHandler registered under basePath.
+func DescribePlayerRoutes(doc *openapi.Document, basePath string) {
+	add := func(method, path string, operation openapi.Operation) {
+		operation.Tags = []string{playersTag}
+		operation = doc.Authenticated(operation, openapi.BearerOrAPIKey)
+		// Club integrations (API keys) are rate limited.
+		operation.Responses["429"] = &openapi.Response{
+			Description: "Rate limit of the API key exceeded",
+			Headers:     map[string]*openapi.Header{"Retry-After": {Description: "Seconds to wait", Schema: &openapi.Schema{Type: "integer"}}},
+			Content:     doc.Content(openapi.ErrorBody{}),
+		}
+		doc.Add(method, basePath+path, operation)
+	}
+	findResponses := func(found any) map[string]*openapi.Response {
+		return map[string]*openapi.Response{
+			"200": doc.Response("Found, SSN masked unless the caller is an admin", found),
+			// Handlers return the empty result as body.
+			"404": doc.Response("Not found, the body is the empty result", found),
+			"400": doc.ErrorResponse("Invalid input"),
+			"500": doc.ErrorResponse("Internal error"),
+		}
+	}
+
+	add(http.MethodPost, "", openapi.Operation{
+		Summary:     "Register or update a player",
+		Description: "Updates when the ID or email already exists. Players can only register or update their own profile.",
+		RequestBody: doc.Body(domain.Player{}),
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Player updated", domain.Player{}),
+			"201": doc.Response("Player registered", domain.Player{}),
+			"400": doc.ErrorResponse("Invalid player"),
+			"403": doc.ErrorResponse("Role or scope not allowed, or profile of another player"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	binary := &openapi.MediaType{Schema: &openapi.Schema{Type: "string", Format: "binary"}}
+	add(http.MethodPost, "/import", openapi.Operation{
+		Summary: "Import players from a CSV or XLSX file (admin, organizer or club)",
+		Description: "The first row is the header (email, firstName and lastName required, socialSecurityNumber or ssn, " +
+			"birthDate, gender, handedness, side, level and club optional). " +
+			"Players are registered or updated matching on email, the file being sent as the body or as the \"file\" part of a multipart form.",
+		Parameters: []openapi.Parameter{{Name: "dryRun", In: "query", Description: "Only validate the rows, reporting what would be imported",
+			Schema: &openapi.Schema{Type: "boolean"}}},
+		RequestBody: &openapi.RequestBody{Required: true, Content: map[string]*openapi.MediaType{
+			spreadsheet.CSVContentType:  binary,
+			spreadsheet.XLSXContentType: binary,
+			"multipart/form-data": {Schema: &openapi.Schema{Type: "object",
+				Properties: map[string]*openapi.Schema{importFileField: {Type: "string", Format: "binary"}}}},
+		}},
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Created, updated and invalid rows with reasons", domain.PlayerImportReport{}),
+			"400": doc.ErrorResponse("Invalid file or header"),
+			"403": doc.ErrorResponse("Role or scope not allowed"),
+			"413": doc.ErrorResponse("XLSX file too large"),
+			"415": doc.ErrorResponse("Neither CSV nor XLSX"),
+			"500": doc.ErrorResponse("Internal error, rows before the failing one are imported"),
+		},
+	})
+	add(http.MethodDelete, "/:playerId", openapi.Operation{
+		Summary: "Unregister a player (admin)",
+		Responses: map[string]*openapi.Response{
+			"200": doc.StatusResponse("Player unregistered"),
+			"400": doc.ErrorResponse("Invalid player ID"),
+			"404": doc.StatusResponse("Player not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodGet, "/:playerId", openapi.Operation{Summary: "Find a player by ID", Responses: findResponses(domain.Player{})})
+	add(http.MethodGet, "/email/:email", openapi.Operation{Summary: "Find a player by email", Responses: findResponses(domain.Player{})})
+	add(http.MethodGet, "/last-name/:lastName", openapi.Operation{Summary: "Find players by last name", Responses: findResponses([]domain.Player{})})
+	add(http.MethodPost, "/ssn-lookup", openapi.Operation{
+		Summary:     "Find a player by SSN (admin)",
+		RequestBody: doc.Body(socialSecurityNumberLookup{}),
+		Responses:   findResponses(domain.Player{}),
+	})
+	exportContent := doc.Content(domain.PlayerDataExport{})
+	exportContent[zipContentType] = &openapi.MediaType{Schema: &openapi.Schema{Type: "string", Format: "binary"}}
+	add(http.MethodGet, "/:playerId/export", openapi.Operation{
+		Summary: "Export the personal data of a player (admin or the player itself)",
+		Parameters: []openapi.Parameter{{Name: "format", In: "query", Description: "zip returns a ZIP archive (same as Accept: application/zip)",
+			Schema: &openapi.Schema{Type: "string", Enum: []string{"json", "zip"}}}},
+		Responses: map[string]*openapi.Response{
+			"200": {Description: "Personal data export", Content: exportContent},
+			"400": doc.ErrorResponse("Invalid player ID"),
+			"403": doc.ErrorResponse("Personal data of another player"),
+			"404": doc.StatusResponse("Player not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodDelete, "/:playerId/personal-data", openapi.Operation{
+		Summary: "Erase (anonymize) the personal data of a player (admin or the player itself)",
+		Responses: map[string]*openapi.Response{
+			"200": doc.StatusResponse("Personal data erased"),
+			"400": doc.ErrorResponse("Invalid player ID"),
+			"403": doc.ErrorResponse("Personal data of another player"),
+			"404": doc.StatusResponse("Player not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+}
+
+const exportsTag = "exports"
+
+// DescribeExportRoutes documents the routes of ExportHandler registered under basePath.
+func DescribeExportRoutes(doc *openapi.Document, basePath string) {
+	text := &openapi.MediaType{Schema: &openapi.Schema{Type: "string"}}
+	content := map[string]*openapi.MediaType{
+		spreadsheet.CSVContentType:   text,
+		spreadsheet.JSONLContentType: text,
+		spreadsheet.XLSXContentType:  {Schema: &openapi.Schema{Type: "string", Format: "binary"}},
+	}
+	parameters := []openapi.Parameter{
+		{Name: "format", In: "query", Schema: &openapi.Schema{Type: "string",
+			Enum: []string{string(spreadsheet.FormatCSV), string(spreadsheet.FormatJSONL), string(spreadsheet.FormatXLSX)}}},
+		{Name: "from", In: "query", Description: "Tournaments held since this date (2006-01-02) or RFC 3339 time", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "to", In: "query", Description: "Tournaments held before this RFC 3339 time or until this date (included)", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "tournamentId", In: "query", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "categoryId", In: "query", Schema: &openapi.Schema{Type: "string"}},
+	}
+	add := func(path, summary string) {
+		doc.Add(http.MethodGet, basePath+path, doc.Authenticated(openapi.Operation{
+			Summary: summary,
+			Description: "Admins and organizers. Rows are streamed as CSV (default), JSON Lines or XLSX, the first one being the header. " +
+				"Filters select the participants of the tournaments, SSNs are masked.",
+			Tags:       []string{exportsTag},
+			Parameters: parameters,
+			Responses: map[string]*openapi.Response{
+				"200": {Description: "Export file (attachment), truncated when it fails midway", Content: content},
+				"400": doc.ErrorResponse("Invalid format or filter"),
+				"500": doc.ErrorResponse("Internal error"),
+			},
+		}, openapi.Bearer))
+	}
+
+	add("/players", "Export players")
+	add("/couples", "Export couples")
+}
+
+const couplesTag = "couples"
+
+// DescribePlayerCoupleRoutes documents the routes of PlayerCoupleHandler registered under basePath.
+func DescribePlayerCoupleRoutes(doc *openapi.Document, basePath string) {
+	add := func(method, path string, operation openapi.Operation) {
+		operation.Tags = []string{couplesTag}
+		doc.Add(method, basePath+path, doc.Authenticated(operation, openapi.Bearer))
+	}
+
+	add(http.MethodPost, "", openapi.Operation{
+		Summary:     "Form a couple of registered players (admin or organizer)",
+		Description: "Updates the ranking when both players already play together, in any order.",
+		RequestBody: doc.Body(registerPlayerCoupleRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Couple updated, SSNs masked unless the caller is an admin", domain.PlayerCouple{}),
+			"201": doc.Response("Couple formed, SSNs masked unless the caller is an admin", domain.PlayerCouple{}),
+			"400": doc.ErrorResponse("Invalid player IDs, same or erased players"),
+			"404": doc.StatusResponse("Player not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodGet, "", openapi.Operation{
+		Summary:     "Find couples by players or last names",
+		Description: "Either both player IDs, or one or two last names. Players are matched in any order.",
+		Parameters: []openapi.Parameter{
+			{Name: "player1Id", In: "query", Description: "ID of a player", Schema: &openapi.Schema{Type: "string"}},
+			{Name: "player2Id", In: "query", Description: "ID of the partner", Schema: &openapi.Schema{Type: "string"}},
+			{Name: "lastName1", In: "query", Description: "Last name of a player", Schema: &openapi.Schema{Type: "string"}},
+			{Name: "lastName2", In: "query", Description: "Last name of the partner, any partner when missing", Schema: &openapi.Schema{Type: "string"}},
+		},
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Found, SSNs masked unless the caller is an admin", []domain.PlayerCouple{}),
+			"404": doc.Response("Not found, the body is the empty list", []domain.PlayerCouple{}),
+			"400": doc.ErrorResponse("Missing or invalid player IDs or last names"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodGet, "/:coupleId", openapi.Operation{
+		Summary: "Find a couple by ID",
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Found, SSNs masked unless the caller is an admin", domain.PlayerCouple{}),
+			"404": doc.Response("Not found, the body is the empty couple", domain.PlayerCouple{}),
+			"400": doc.ErrorResponse("Invalid ID"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+}
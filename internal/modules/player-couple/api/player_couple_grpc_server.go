@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+
+	padelplacev1 "github.com/paguerre3/goddd/api/proto/padelplace/v1"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/rpc"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"google.golang.org/grpc/codes"
+)
+
+// PlayerCoupleGRPCServer exposes the player couple use cases through gRPC, couples are registered by
+// organizers (and admins) while any authenticated caller can find them.
+type PlayerCoupleGRPCServer struct {
+	padelplacev1.UnimplementedPlayerCoupleServiceServer
+	registerPlayerCoupleUseCase application.RegisterPlayerCoupleUseCase
+	findPlayerCoupleUseCase     application.FindPlayerCoupleUseCase
+}
+
+func NewPlayerCoupleGRPCServer(registerPlayerCoupleUseCase application.RegisterPlayerCoupleUseCase,
+	findPlayerCoupleUseCase application.FindPlayerCoupleUseCase) *PlayerCoupleGRPCServer {
+	return &PlayerCoupleGRPCServer{
+		registerPlayerCoupleUseCase: registerPlayerCoupleUseCase,
+		findPlayerCoupleUseCase:     findPlayerCoupleUseCase,
+	}
+}
+
+func (s *PlayerCoupleGRPCServer) RegisterPlayerCouple(ctx context.Context, req *padelplacev1.RegisterPlayerCoupleRequest) (*padelplacev1.RegisterPlayerCoupleResponse, error) {
+	claims, err := rpc.RequireRoles(ctx, auth.RoleAdmin, auth.RoleOrganizer)
+	if err != nil {
+		return nil, err
+	}
+	var ranking *int
+	if req.Ranking != nil {
+		value := int(req.GetRanking())
+		ranking = &value
+	}
+	playerCouple, registerStatus, err := s.registerPlayerCoupleUseCase.RegisterPlayerCoupleUseCase(ctx, req.GetPlayer1Id(), req.GetPlayer2Id(), ranking)
+	if code := registerPlayerCoupleCode(registerStatus, err); code != codes.OK {
+		return nil, rpc.Error(code, registerStatus, err)
+	}
+	return &padelplacev1.RegisterPlayerCoupleResponse{
+		PlayerCouple: toPlayerCoupleMessage(maskCoupleFor(claims, playerCouple)),
+		Created:      registerStatus == application.RegisterPlayerCoupleCreated,
+	}, nil
+}
+
+func (s *PlayerCoupleGRPCServer) FindPlayerCouple(ctx context.Context, req *padelplacev1.FindPlayerCoupleRequest) (*padelplacev1.FindPlayerCoupleResponse, error) {
+	claims, err := rpc.Claims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	playerCouple, findStatus, err := s.findPlayerCoupleUseCase.FindPlayerCoupleByIDUseCase(ctx, req.GetId())
+	if code := findPlayerCoupleCode(findStatus, err); code != codes.OK {
+		return nil, rpc.Error(code, findStatus, err)
+	}
+	return &padelplacev1.FindPlayerCoupleResponse{PlayerCouple: toPlayerCoupleMessage(maskCoupleFor(claims, playerCouple))}, nil
+}
+
+func (s *PlayerCoupleGRPCServer) FindPlayerCouplesByPlayer(ctx context.Context, req *padelplacev1.FindPlayerCouplesByPlayerRequest) (*padelplacev1.FindPlayerCouplesByPlayerResponse, error) {
+	claims, err := rpc.Claims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	playerCouples, findStatus, err := s.findPlayerCoupleUseCase.FindPlayerCouplesByPlayerIDUseCase(ctx, req.GetPlayerId())
+	if code := findPlayerCoupleCode(findStatus, err); code != codes.OK {
+		return nil, rpc.Error(code, findStatus, err)
+	}
+	messages := make([]*padelplacev1.PlayerCouple, 0, len(playerCouples))
+	for _, playerCouple := range playerCouples {
+		messages = append(messages, toPlayerCoupleMessage(maskCoupleFor(claims, playerCouple)))
+	}
+	return &padelplacev1.FindPlayerCouplesByPlayerResponse{PlayerCouples: messages}, nil
+}
+
+func registerPlayerCoupleCode(status application.RegisterPlayerCoupleStatus, err error) codes.Code {
+	switch {
+	case status == application.RegisterPlayerCoupleInvalid:
+		return codes.InvalidArgument
+	case err != nil:
+		return codes.Internal
+	case status == application.RegisterPlayerCouplePlayerNotFound:
+		// The request refers to players that must be registered first.
+		return codes.FailedPrecondition
+	case status == application.RegisterPlayerCoupleCreated, status == application.RegisterPlayerCoupleUpdated:
+		return codes.OK
+	default:
+		return codes.Internal
+	}
+}
+
+func findPlayerCoupleCode(status application.FindPlayerCoupleStatus, err error) codes.Code {
+	switch {
+	case status == application.FindPlayerCoupleInvalid:
+		return codes.InvalidArgument
+	case err != nil:
+		return codes.Internal
+	case status == application.FindPlayerCoupleNotFound:
+		return codes.NotFound
+	case status == application.FindPlayerCoupleFound:
+		return codes.OK
+	default:
+		return codes.Internal
+	}
+}
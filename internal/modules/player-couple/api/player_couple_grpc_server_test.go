@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	padelplacev1 "github.com/paguerre3/goddd/api/proto/padelplace/v1"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockRegisterPlayerCoupleUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRegisterPlayerCoupleUseCase) RegisterPlayerCoupleUseCase(_ context.Context, player1Id, player2Id string, ranking *int) (domain.PlayerCouple, application.RegisterPlayerCoupleStatus, error) {
+	args := m.Called(player1Id, player2Id, ranking)
+	return args.Get(0).(domain.PlayerCouple), args.Get(1).(application.RegisterPlayerCoupleStatus), args.Error(2)
+}
+
+type mockFindPlayerCoupleUseCase struct {
+	mock.Mock
+}
+
+func (m *mockFindPlayerCoupleUseCase) FindPlayerCoupleByIDUseCase(_ context.Context, playerCoupleId string) (domain.PlayerCouple, application.FindPlayerCoupleStatus, error) {
+	args := m.Called(playerCoupleId)
+	return args.Get(0).(domain.PlayerCouple), args.Get(1).(application.FindPlayerCoupleStatus), args.Error(2)
+}
+
+func (m *mockFindPlayerCoupleUseCase) FindPlayerCouplesByPlayerIDUseCase(_ context.Context, playerId string) ([]domain.PlayerCouple, application.FindPlayerCoupleStatus, error) {
+	args := m.Called(playerId)
+	return args.Get(0).([]domain.PlayerCouple), args.Get(1).(application.FindPlayerCoupleStatus), args.Error(2)
+}
+
+func (m *mockFindPlayerCoupleUseCase) FindPlayerCouplesByPlayersUseCase(_ context.Context, player1Id, player2Id string) ([]domain.PlayerCouple, application.FindPlayerCoupleStatus, error) {
+	args := m.Called(player1Id, player2Id)
+	return args.Get(0).([]domain.PlayerCouple), args.Get(1).(application.FindPlayerCoupleStatus), args.Error(2)
+}
+
+func (m *mockFindPlayerCoupleUseCase) FindPlayerCouplesByLastNamesUseCase(_ context.Context, lastName1, lastName2 string) ([]domain.PlayerCouple, application.FindPlayerCoupleStatus, error) {
+	args := m.Called(lastName1, lastName2)
+	return args.Get(0).([]domain.PlayerCouple), args.Get(1).(application.FindPlayerCoupleStatus), args.Error(2)
+}
+
+func TestPlayerCoupleGRPCServer(t *testing.T) {
+	registerUseCase := &mockRegisterPlayerCoupleUseCase{}
+	findUseCase := &mockFindPlayerCoupleUseCase{}
+	server := NewPlayerCoupleGRPCServer(registerUseCase, findUseCase)
+	ssn := "123456789"
+	couple := domain.PlayerCouple{ID: "Doe-Roe-id", Player1: domain.Player{ID: "p1", SocialSecurityNumber: &ssn}, Player2: domain.Player{ID: "p2"}}
+	ranking := 2
+	registerUseCase.On("RegisterPlayerCoupleUseCase", "p1", "p2", &ranking).Return(couple, application.RegisterPlayerCoupleCreated, nil)
+	registerUseCase.On("RegisterPlayerCoupleUseCase", "p1", "p9", (*int)(nil)).Return(domain.PlayerCouple{}, application.RegisterPlayerCouplePlayerNotFound, nil)
+	findUseCase.On("FindPlayerCoupleByIDUseCase", couple.ID).Return(couple, application.FindPlayerCoupleFound, nil)
+	findUseCase.On("FindPlayerCouplesByPlayerIDUseCase", "p9").Return([]domain.PlayerCouple{}, application.FindPlayerCoupleNotFound, nil)
+	organizer := withRoles("organizer@example.com", auth.RoleOrganizer)
+
+	t.Run("register", func(t *testing.T) {
+		rank := int32(ranking)
+		res, err := server.RegisterPlayerCouple(organizer, &padelplacev1.RegisterPlayerCoupleRequest{Player1Id: "p1", Player2Id: "p2", Ranking: &rank})
+		assert.NoError(t, err)
+		assert.True(t, res.GetCreated())
+		assert.Equal(t, couple.ID, res.GetPlayerCouple().GetId())
+		assert.Equal(t, "*****6789", res.GetPlayerCouple().GetPlayer1().GetSocialSecurityNumber())
+	})
+
+	t.Run("register with unknown player", func(t *testing.T) {
+		_, err := server.RegisterPlayerCouple(organizer, &padelplacev1.RegisterPlayerCoupleRequest{Player1Id: "p1", Player2Id: "p9"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("register by players", func(t *testing.T) {
+		_, err := server.RegisterPlayerCouple(withRoles("p1@example.com", auth.RolePlayer), &padelplacev1.RegisterPlayerCoupleRequest{Player1Id: "p1", Player2Id: "p2"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("find", func(t *testing.T) {
+		res, err := server.FindPlayerCouple(organizer, &padelplacev1.FindPlayerCoupleRequest{Id: couple.ID})
+		assert.NoError(t, err)
+		assert.Equal(t, "p2", res.GetPlayerCouple().GetPlayer2().GetId())
+
+		_, err = server.FindPlayerCouplesByPlayer(organizer, &padelplacev1.FindPlayerCouplesByPlayerRequest{PlayerId: "p9"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+// PlayerCoupleHandler forms couples of registered players, as PlayerCoupleGRPCServer does.
+type PlayerCoupleHandler struct {
+	registerPlayerCoupleUseCase application.RegisterPlayerCoupleUseCase
+	findPlayerCoupleUseCase     application.FindPlayerCoupleUseCase
+}
+
+func NewPlayerCoupleHandler(registerPlayerCoupleUseCase application.RegisterPlayerCoupleUseCase,
+	findPlayerCoupleUseCase application.FindPlayerCoupleUseCase) *PlayerCoupleHandler {
+	return &PlayerCoupleHandler{
+		registerPlayerCoupleUseCase: registerPlayerCoupleUseCase,
+		findPlayerCoupleUseCase:     findPlayerCoupleUseCase,
+	}
+}
+
+type registerPlayerCoupleRequest struct {
+	Player1ID string `json:"player1Id" binding:"required"`
+	Player2ID string `json:"player2Id" binding:"required"`
+	Ranking   *int   `json:"ranking,omitempty"`
+}
+
+// RegisterPlayerCouple creates the couple, or updates its ranking when both players already play together.
+func (h *PlayerCoupleHandler) RegisterPlayerCouple(c *gin.Context) {
+	var request registerPlayerCoupleRequest
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	playerCouple, status, err := h.registerPlayerCoupleUseCase.RegisterPlayerCoupleUseCase(c.Request.Context(),
+		request.Player1ID, request.Player2ID, request.Ranking)
+	if err != nil {
+		if status == application.RegisterPlayerCoupleInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	switch status {
+	case application.RegisterPlayerCouplePlayerNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	case application.RegisterPlayerCoupleUpdated:
+		web.Respond(c, http.StatusOK, maskCoupleForCaller(c, playerCouple))
+	case application.RegisterPlayerCoupleCreated:
+		web.Respond(c, http.StatusCreated, maskCoupleForCaller(c, playerCouple))
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
+
+func (h *PlayerCoupleHandler) FindPlayerCoupleByID(c *gin.Context) {
+	playerCouple, status, err := h.findPlayerCoupleUseCase.FindPlayerCoupleByIDUseCase(c.Request.Context(), c.Param("coupleId"))
+	if err != nil {
+		if status == application.FindPlayerCoupleInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	switch status {
+	case application.FindPlayerCoupleNotFound:
+		web.Respond(c, http.StatusNotFound, playerCouple)
+	case application.FindPlayerCoupleFound:
+		web.Respond(c, http.StatusOK, maskCoupleForCaller(c, playerCouple))
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
+
+// FindPlayerCouples finds the couples of both players (player1Id and player2Id), or of players with the last names
+// (lastName1 and the optional lastName2), in any order.
+func (h *PlayerCoupleHandler) FindPlayerCouples(c *gin.Context) {
+	var (
+		playerCouples []domain.PlayerCouple
+		status        application.FindPlayerCoupleStatus
+		err           error
+	)
+	ctx := c.Request.Context()
+	switch {
+	case len(c.Query("player1Id")) > 0 || len(c.Query("player2Id")) > 0:
+		playerCouples, status, err = h.findPlayerCoupleUseCase.FindPlayerCouplesByPlayersUseCase(ctx, c.Query("player1Id"), c.Query("player2Id"))
+	case len(c.Query("lastName1")) > 0:
+		playerCouples, status, err = h.findPlayerCoupleUseCase.FindPlayerCouplesByLastNamesUseCase(ctx, c.Query("lastName1"), c.Query("lastName2"))
+	default:
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, errors.New("player1Id and player2Id, or lastName1 required")))
+		return
+	}
+	if err != nil {
+		if status == application.FindPlayerCoupleInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	switch status {
+	case application.FindPlayerCoupleNotFound:
+		web.Respond(c, http.StatusNotFound, []domain.PlayerCouple{})
+	case application.FindPlayerCoupleFound:
+		claims, _ := auth.ClaimsFrom(c)
+		masked := make([]domain.PlayerCouple, 0, len(playerCouples))
+		for _, playerCouple := range playerCouples {
+			masked = append(masked, maskCoupleFor(claims, playerCouple))
+		}
+		web.Respond(c, http.StatusOK, masked)
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
+
+// maskCoupleForCaller hides the SSN of both players unless the caller is an admin.
+func maskCoupleForCaller(c *gin.Context, playerCouple domain.PlayerCouple) domain.PlayerCouple {
+	claims, _ := auth.ClaimsFrom(c)
+	return maskCoupleFor(claims, playerCouple)
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPlayerCoupleRouter(handler *PlayerCoupleHandler, claims *auth.Claims) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		auth.SetClaims(c, claims)
+	})
+	router.POST("/couples", handler.RegisterPlayerCouple)
+	router.GET("/couples", handler.FindPlayerCouples)
+	router.GET("/couples/:coupleId", handler.FindPlayerCoupleByID)
+	return router
+}
+
+func serveCouple(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestPlayerCoupleHandler_RegisterPlayerCouple(t *testing.T) {
+	ssn := "123-45-6789"
+	ranking := 3
+	couple := domain.PlayerCouple{ID: "couple-1", Ranking: &ranking,
+		Player1: domain.Player{ID: "player-1", SocialSecurityNumber: &ssn}, Player2: domain.Player{ID: "player-2"}}
+	registerUseCase := &mockRegisterPlayerCoupleUseCase{}
+	registerUseCase.On("RegisterPlayerCoupleUseCase", "player-1", "player-2", &ranking).Return(couple, application.RegisterPlayerCoupleCreated, nil)
+	registerUseCase.On("RegisterPlayerCoupleUseCase", "player-1", "player-1", (*int)(nil)).
+		Return(domain.PlayerCouple{}, application.RegisterPlayerCoupleInvalid, errors.New("players must be different"))
+	registerUseCase.On("RegisterPlayerCoupleUseCase", "player-1", "unknown", (*int)(nil)).
+		Return(domain.PlayerCouple{}, application.RegisterPlayerCouplePlayerNotFound, nil)
+	router := newPlayerCoupleRouter(NewPlayerCoupleHandler(registerUseCase, &mockFindPlayerCoupleUseCase{}),
+		&auth.Claims{Roles: []auth.Role{auth.RoleOrganizer}})
+
+	w := serveCouple(router, http.MethodPost, "/couples", `{"player1Id":"player-1","player2Id":"player-2","ranking":3}`)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	var created domain.PlayerCouple
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, "couple-1", created.ID)
+	assert.Equal(t, "*******6789", *created.Player1.SocialSecurityNumber, "Expected SSN masked for organizers")
+
+	w = serveCouple(router, http.MethodPost, "/couples", `{"player1Id":"player-1","player2Id":"player-1"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = serveCouple(router, http.MethodPost, "/couples", `{"player1Id":"player-1","player2Id":"unknown"}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"status":"RegisterPlayerCouplePlayerNotFound"}`, w.Body.String())
+
+	w = serveCouple(router, http.MethodPost, "/couples", `{"player1Id":"player-1"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "Expected both players required")
+}
+
+func TestPlayerCoupleHandler_FindPlayerCoupleByID(t *testing.T) {
+	findUseCase := &mockFindPlayerCoupleUseCase{}
+	findUseCase.On("FindPlayerCoupleByIDUseCase", "couple-1").Return(domain.PlayerCouple{ID: "couple-1"}, application.FindPlayerCoupleFound, nil)
+	findUseCase.On("FindPlayerCoupleByIDUseCase", "couple-2").Return(domain.PlayerCouple{}, application.FindPlayerCoupleNotFound, nil)
+	findUseCase.On("FindPlayerCoupleByIDUseCase", "$bad").Return(domain.PlayerCouple{}, application.FindPlayerCoupleInvalid, errors.New("invalid ID"))
+	findUseCase.On("FindPlayerCoupleByIDUseCase", "couple-3").Return(domain.PlayerCouple{}, application.FindPlayerCouplePending, errors.New("repo error"))
+	router := newPlayerCoupleRouter(NewPlayerCoupleHandler(&mockRegisterPlayerCoupleUseCase{}, findUseCase),
+		&auth.Claims{Roles: []auth.Role{auth.RolePlayer}})
+
+	w := serveCouple(router, http.MethodGet, "/couples/couple-1", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"id":"couple-1"`)
+
+	assert.Equal(t, http.StatusNotFound, serveCouple(router, http.MethodGet, "/couples/couple-2", "").Code)
+	assert.Equal(t, http.StatusBadRequest, serveCouple(router, http.MethodGet, "/couples/$bad", "").Code)
+	assert.Equal(t, http.StatusInternalServerError, serveCouple(router, http.MethodGet, "/couples/couple-3", "").Code)
+}
+
+func TestPlayerCoupleHandler_FindPlayerCouples(t *testing.T) {
+	ssn := "123-45-6789"
+	couples := []domain.PlayerCouple{{ID: "couple-1", Player1: domain.Player{ID: "player-1", SocialSecurityNumber: &ssn}, Player2: domain.Player{ID: "player-2"}}}
+	findUseCase := &mockFindPlayerCoupleUseCase{}
+	findUseCase.On("FindPlayerCouplesByPlayersUseCase", "player-2", "player-1").Return(couples, application.FindPlayerCoupleFound, nil)
+	findUseCase.On("FindPlayerCouplesByPlayersUseCase", "player-1", "").Return([]domain.PlayerCouple(nil), application.FindPlayerCoupleInvalid, errors.New("invalid ID"))
+	findUseCase.On("FindPlayerCouplesByLastNamesUseCase", "O'Neil", "").Return(couples, application.FindPlayerCoupleFound, nil)
+	findUseCase.On("FindPlayerCouplesByLastNamesUseCase", "Doe", "Roe").Return([]domain.PlayerCouple(nil), application.FindPlayerCoupleNotFound, nil)
+	findUseCase.On("FindPlayerCouplesByLastNamesUseCase", "Roe", "Doe").Return([]domain.PlayerCouple(nil), application.FindPlayerCouplePending, errors.New("repo error"))
+	router := newPlayerCoupleRouter(NewPlayerCoupleHandler(&mockRegisterPlayerCoupleUseCase{}, findUseCase),
+		&auth.Claims{Roles: []auth.Role{auth.RolePlayer}})
+
+	w := serveCouple(router, http.MethodGet, "/couples?player1Id=player-2&player2Id=player-1", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	var found []domain.PlayerCouple
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &found))
+	assert.Equal(t, "couple-1", found[0].ID)
+	assert.Equal(t, "*******6789", *found[0].Player1.SocialSecurityNumber, "Expected SSN masked for players")
+
+	w = serveCouple(router, http.MethodGet, "/couples?lastName1=O%27Neil", "")
+	assert.Equal(t, http.StatusOK, w.Code, "Expected names not interpreted as patterns")
+
+	w = serveCouple(router, http.MethodGet, "/couples?lastName1=Doe&lastName2=Roe", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `[]`, w.Body.String())
+
+	assert.Equal(t, http.StatusBadRequest, serveCouple(router, http.MethodGet, "/couples?player1Id=player-1", "").Code)
+	assert.Equal(t, http.StatusBadRequest, serveCouple(router, http.MethodGet, "/couples", "").Code)
+	assert.Equal(t, http.StatusInternalServerError, serveCouple(router, http.MethodGet, "/couples?lastName1=Roe&lastName2=Doe", "").Code)
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+const zipContentType = "application/zip"
+
+// PlayerDataHandler exposes the data subject rights of players (GDPR), i.e. export and erasure of personal data.
+type PlayerDataHandler struct {
+	playerDataUseCase application.PlayerDataUseCase
+	findPlayerUseCase application.FindPlayerUseCase
+}
+
+func NewPlayerDataHandler(playerDataUseCase application.PlayerDataUseCase, findPlayerUseCase application.FindPlayerUseCase) *PlayerDataHandler {
+	return &PlayerDataHandler{
+		playerDataUseCase: playerDataUseCase,
+		findPlayerUseCase: findPlayerUseCase,
+	}
+}
+
+// ExportPlayerData returns the export as JSON or as a ZIP archive (one JSON file per section) when
+// requested through "?format=zip" or "Accept: application/zip".
+func (h *PlayerDataHandler) ExportPlayerData(c *gin.Context) {
+	playerId := c.Param("playerId")
+	if code, err := h.authorizeDataSubject(c, playerId); err != nil {
+		web.Respond(c, code, web.ErrorBody(c, err))
+		return
+	}
+	export, status, err := h.playerDataUseCase.ExportPlayerDataUseCase(c.Request.Context(), playerId)
+	if err != nil {
+		handlePlayerDataError(c, status, err)
+		return
+	}
+	switch status {
+	case application.PlayerDataExported:
+		// Exports hold the full SSN, they must never be cached by intermediaries.
+		c.Header("Cache-Control", "no-store")
+		if !wantsZip(c) {
+			web.Respond(c, http.StatusOK, export)
+			return
+		}
+		archive, err := zipExport(export)
+		if err != nil {
+			web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+			return
+		}
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="player-%s-export.zip"`, playerId))
+		c.Data(http.StatusOK, zipContentType, archive)
+	case application.PlayerDataNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
+
+func (h *PlayerDataHandler) ErasePlayerData(c *gin.Context) {
+	playerId := c.Param("playerId")
+	if code, err := h.authorizeDataSubject(c, playerId); err != nil {
+		web.Respond(c, code, web.ErrorBody(c, err))
+		return
+	}
+	status, err := h.playerDataUseCase.ErasePlayerDataUseCase(c.Request.Context(), playerId)
+	if err != nil {
+		handlePlayerDataError(c, status, err)
+		return
+	}
+	switch status {
+	case application.PlayerDataErased:
+		web.Respond(c, http.StatusOK, gin.H{"status": status.String()})
+	case application.PlayerDataNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
+
+// authorizeDataSubject lets admins act on any player while players can only export or erase their own data.
+func (h *PlayerDataHandler) authorizeDataSubject(c *gin.Context, playerId string) (int, error) {
+	claims, ok := auth.ClaimsFrom(c)
+	if !ok {
+		return http.StatusUnauthorized, errors.New("missing authentication")
+	}
+	if claims.HasAnyRole(auth.RoleAdmin) {
+		return http.StatusOK, nil
+	}
+	if !claims.HasAnyRole(auth.RolePlayer) {
+		return http.StatusForbidden, errors.New("players can only access their own personal data")
+	}
+	player, status, err := h.findPlayerUseCase.FindPlayerByIDUseCase(c.Request.Context(), playerId)
+	if err != nil {
+		if status == application.FindPlayerInvalid {
+			return http.StatusBadRequest, err
+		}
+		return http.StatusInternalServerError, err
+	}
+	if status != application.FindPlayerFound || !claims.OwnsEmail(player.Email) {
+		return http.StatusForbidden, errors.New("players can only access their own personal data")
+	}
+	return http.StatusOK, nil
+}
+
+func handlePlayerDataError(c *gin.Context, status application.PlayerDataStatus, err error) {
+	if status == application.PlayerDataInvalid {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+}
+
+func wantsZip(c *gin.Context) bool {
+	return c.Query("format") == "zip" || strings.Contains(c.GetHeader("Accept"), zipContentType)
+}
+
+func zipExport(export domain.PlayerDataExport) ([]byte, error) {
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	files := []struct {
+		name    string
+		content any
+	}{
+		{"player.json", export.Player},
+		{"couples.json", export.Couples},
+		{"tournaments.json", export.Tournaments},
+		{"matches.json", export.Matches},
+	}
+	for _, file := range files {
+		writer, err := archive.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: export.ExportedAt})
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPlayerDataUseCase struct {
+	mock.Mock
+}
+
+func (m *mockPlayerDataUseCase) ExportPlayerDataUseCase(_ context.Context, playerId string) (domain.PlayerDataExport, application.PlayerDataStatus, error) {
+	args := m.Called(playerId)
+	return args.Get(0).(domain.PlayerDataExport), args.Get(1).(application.PlayerDataStatus), args.Error(2)
+}
+
+func (m *mockPlayerDataUseCase) ErasePlayerDataUseCase(_ context.Context, playerId string) (application.PlayerDataStatus, error) {
+	args := m.Called(playerId)
+	return args.Get(0).(application.PlayerDataStatus), args.Error(1)
+}
+
+func servePlayerData(handler gin.HandlerFunc, target string, claims *auth.Claims, accept string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+	if len(accept) > 0 {
+		c.Request.Header.Set("Accept", accept)
+	}
+	c.Params = gin.Params{{Key: "playerId", Value: "1234567"}}
+	if claims != nil {
+		auth.SetClaims(c, claims)
+	}
+	handler(c)
+	return w
+}
+
+func TestExportPlayerData(t *testing.T) {
+	ssn := "20123456789"
+	export := domain.PlayerDataExport{
+		Player:      domain.Player{ID: "1234567", Email: "agus.tapia@gmail.com", SocialSecurityNumber: &ssn},
+		Couples:     []domain.PlayerCouple{{ID: "c1"}},
+		Tournaments: []domain.TournamentParticipation{{TournamentID: "t1", CoupleID: "c1"}},
+		Matches:     []domain.MatchRecord{{TournamentID: "t1", MatchID: "m1"}},
+		ExportedAt:  time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC),
+	}
+	admin := &auth.Claims{Roles: []auth.Role{auth.RoleAdmin}}
+
+	t.Run("JSON", func(t *testing.T) {
+		useCase := &mockPlayerDataUseCase{}
+		useCase.On("ExportPlayerDataUseCase", "1234567").Return(export, application.PlayerDataExported, nil)
+		h := NewPlayerDataHandler(useCase, nil)
+
+		w := servePlayerData(h.ExportPlayerData, "/players/1234567/export", admin, "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+		assert.Contains(t, w.Body.String(), ssn, "Expected full SSN exported to the data subject")
+		assert.Contains(t, w.Body.String(), `"matchId":"m1"`)
+	})
+
+	for _, test := range []struct {
+		name, target, accept string
+	}{
+		{"ZIP by query", "/players/1234567/export?format=zip", ""},
+		{"ZIP by Accept header", "/players/1234567/export", "application/zip"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			useCase := &mockPlayerDataUseCase{}
+			useCase.On("ExportPlayerDataUseCase", "1234567").Return(export, application.PlayerDataExported, nil)
+			h := NewPlayerDataHandler(useCase, nil)
+
+			w := servePlayerData(h.ExportPlayerData, test.target, admin, test.accept)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+			assert.Contains(t, w.Header().Get("Content-Disposition"), "player-1234567-export.zip")
+
+			archive, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+			assert.NoError(t, err)
+			var names []string
+			for _, file := range archive.File {
+				names = append(names, file.Name)
+			}
+			assert.Equal(t, []string{"player.json", "couples.json", "tournaments.json", "matches.json"}, names)
+		})
+	}
+
+	t.Run("Not found", func(t *testing.T) {
+		useCase := &mockPlayerDataUseCase{}
+		useCase.On("ExportPlayerDataUseCase", "1234567").Return(domain.PlayerDataExport{}, application.PlayerDataNotFound, nil)
+		h := NewPlayerDataHandler(useCase, nil)
+		assert.Equal(t, http.StatusNotFound, servePlayerData(h.ExportPlayerData, "/players/1234567/export", admin, "").Code)
+	})
+
+	t.Run("Internal error", func(t *testing.T) {
+		useCase := &mockPlayerDataUseCase{}
+		useCase.On("ExportPlayerDataUseCase", "1234567").Return(domain.PlayerDataExport{}, application.PlayerDataPending, errors.New("repo error"))
+		h := NewPlayerDataHandler(useCase, nil)
+		assert.Equal(t, http.StatusInternalServerError, servePlayerData(h.ExportPlayerData, "/players/1234567/export", admin, "").Code)
+	})
+}
+
+func TestErasePlayerData(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     application.PlayerDataStatus
+		err        error
+		statusCode int
+	}{
+		{"Erased", application.PlayerDataErased, nil, http.StatusOK},
+		{"Not found", application.PlayerDataNotFound, nil, http.StatusNotFound},
+		{"Invalid", application.PlayerDataInvalid, errors.New("invalid ID"), http.StatusBadRequest},
+		{"Internal error", application.PlayerDataPending, errors.New("repo error"), http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			useCase := &mockPlayerDataUseCase{}
+			useCase.On("ErasePlayerDataUseCase", "1234567").Return(test.status, test.err)
+			h := NewPlayerDataHandler(useCase, nil)
+
+			w := servePlayerData(h.ErasePlayerData, "/players/1234567/personal-data", &auth.Claims{Roles: []auth.Role{auth.RoleAdmin}}, "")
+			assert.Equal(t, test.statusCode, w.Code)
+		})
+	}
+}
+
+func TestPlayerData_DataSubjectAuthorization(t *testing.T) {
+	tests := []struct {
+		name       string
+		claims     *auth.Claims
+		found      domain.Player
+		statusCode int
+	}{
+		{"Missing claims", nil, domain.Player{}, http.StatusUnauthorized},
+		{"Own data", &auth.Claims{Email: "agus.tapia@gmail.com", Roles: []auth.Role{auth.RolePlayer}},
+			domain.Player{ID: "1234567", Email: "agus.tapia@gmail.com"}, http.StatusOK},
+		{"Data of another player", &auth.Claims{Email: "other@gmail.com", Roles: []auth.Role{auth.RolePlayer}},
+			domain.Player{ID: "1234567", Email: "agus.tapia@gmail.com"}, http.StatusForbidden},
+		{"Club integration", &auth.Claims{Roles: []auth.Role{auth.RoleClub}}, domain.Player{}, http.StatusForbidden},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			findUseCase := &mockFindPlayerUseCase{}
+			findUseCase.On("FindPlayerByIDUseCase", "1234567").Return(test.found, application.FindPlayerFound, nil)
+			useCase := &mockPlayerDataUseCase{}
+			useCase.On("ErasePlayerDataUseCase", "1234567").Return(application.PlayerDataErased, nil)
+			h := NewPlayerDataHandler(useCase, findUseCase)
+
+			w := servePlayerData(h.ErasePlayerData, "/players/1234567/personal-data", test.claims, "")
+			assert.Equal(t, test.statusCode, w.Code)
+		})
+	}
+}
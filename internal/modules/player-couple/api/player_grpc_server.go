@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	padelplacev1 "github.com/paguerre3/goddd/api/proto/padelplace/v1"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/rpc"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PlayerGRPCServer exposes the player use cases through gRPC with the same authorization rules as PlayerHandler.
+type PlayerGRPCServer struct {
+	padelplacev1.UnimplementedPlayerServiceServer
+	registerPlayerUseCase   application.RegisterPlayerUseCase
+	unregisterPlayerUseCase application.UnregisterPlayerUseCase
+	findPlayerUseCase       application.FindPlayerUseCase
+}
+
+func NewPlayerGRPCServer(registerPlayerUseCase application.RegisterPlayerUseCase,
+	unregisterPlayerUseCase application.UnregisterPlayerUseCase,
+	findPlayerUseCase application.FindPlayerUseCase) *PlayerGRPCServer {
+	return &PlayerGRPCServer{
+		registerPlayerUseCase:   registerPlayerUseCase,
+		unregisterPlayerUseCase: unregisterPlayerUseCase,
+		findPlayerUseCase:       findPlayerUseCase,
+	}
+}
+
+func (s *PlayerGRPCServer) RegisterPlayer(ctx context.Context, req *padelplacev1.RegisterPlayerRequest) (*padelplacev1.RegisterPlayerResponse, error) {
+	claims, err := rpc.RequireRoles(ctx, auth.RoleAdmin, auth.RoleOrganizer, auth.RolePlayer, auth.RoleClub)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetPlayer() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing player")
+	}
+	player := fromPlayerMessage(req.GetPlayer())
+	if findStatus, err := authorizeProfile(ctx, s.findPlayerUseCase, claims, player); err != nil {
+		if errors.Is(err, errNotOwnProfile) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, rpc.Error(findPlayerCode(findStatus, err), findStatus, err)
+	}
+	newPlayer, registerStatus, err := s.registerPlayerUseCase.RegisterPlayerUseCase(ctx, player)
+	if code := registerPlayerCode(registerStatus, err); code != codes.OK {
+		return nil, rpc.Error(code, registerStatus, err)
+	}
+	return &padelplacev1.RegisterPlayerResponse{
+		Player:  toPlayerMessage(maskFor(claims, newPlayer)),
+		Created: registerStatus == application.RegisterPlayerCreated,
+	}, nil
+}
+
+func (s *PlayerGRPCServer) FindPlayer(ctx context.Context, req *padelplacev1.FindPlayerRequest) (*padelplacev1.FindPlayerResponse, error) {
+	claims, err := rpc.Claims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	player, findStatus, err := s.findPlayerUseCase.FindPlayerByIDUseCase(ctx, req.GetId())
+	if code := findPlayerCode(findStatus, err); code != codes.OK {
+		return nil, rpc.Error(code, findStatus, err)
+	}
+	return &padelplacev1.FindPlayerResponse{Player: toPlayerMessage(maskFor(claims, player))}, nil
+}
+
+func (s *PlayerGRPCServer) FindPlayerByEmail(ctx context.Context, req *padelplacev1.FindPlayerByEmailRequest) (*padelplacev1.FindPlayerResponse, error) {
+	claims, err := rpc.Claims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	player, findStatus, err := s.findPlayerUseCase.FindPlayerByEmailUseCase(ctx, req.GetEmail())
+	if code := findPlayerCode(findStatus, err); code != codes.OK {
+		return nil, rpc.Error(code, findStatus, err)
+	}
+	return &padelplacev1.FindPlayerResponse{Player: toPlayerMessage(maskFor(claims, player))}, nil
+}
+
+func (s *PlayerGRPCServer) FindPlayersByLastName(ctx context.Context, req *padelplacev1.FindPlayersByLastNameRequest) (*padelplacev1.FindPlayersByLastNameResponse, error) {
+	claims, err := rpc.Claims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	players, findStatus, err := s.findPlayerUseCase.FindPlayersByLastNameUseCase(ctx, req.GetLastName())
+	if code := findPlayerCode(findStatus, err); code != codes.OK {
+		return nil, rpc.Error(code, findStatus, err)
+	}
+	return &padelplacev1.FindPlayersByLastNameResponse{Players: toPlayerMessages(maskFor(claims, players))}, nil
+}
+
+func (s *PlayerGRPCServer) UnregisterPlayer(ctx context.Context, req *padelplacev1.UnregisterPlayerRequest) (*padelplacev1.UnregisterPlayerResponse, error) {
+	if _, err := rpc.RequireRoles(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	unregisterStatus, err := s.unregisterPlayerUseCase.UnregisterPlayerUseCase(ctx, req.GetId())
+	if code := unregisterPlayerCode(unregisterStatus, err); code != codes.OK {
+		return nil, rpc.Error(code, unregisterStatus, err)
+	}
+	return &padelplacev1.UnregisterPlayerResponse{}, nil
+}
+
+// Use case statuses mapped to gRPC codes, i.e. the equivalent of the HTTP status codes of PlayerHandler.
+
+func registerPlayerCode(status application.RegisterPlayerStatus, err error) codes.Code {
+	switch {
+	case status == application.RegisterPlayerInvalid:
+		return codes.InvalidArgument
+	case err != nil:
+		return codes.Internal
+	case status == application.RegisterPlayerCreated, status == application.RegisterPlayerUpdated:
+		return codes.OK
+	default:
+		return codes.Internal
+	}
+}
+
+func findPlayerCode(status application.FindPlayerStatus, err error) codes.Code {
+	switch {
+	case status == application.FindPlayerInvalid:
+		return codes.InvalidArgument
+	case err != nil:
+		return codes.Internal
+	case status == application.FindPlayerNotFound:
+		return codes.NotFound
+	case status == application.FindPlayerFound:
+		return codes.OK
+	default:
+		return codes.Internal
+	}
+}
+
+func unregisterPlayerCode(status application.UnregisterPlayerStatus, err error) codes.Code {
+	switch {
+	case status == application.UnregisterPlayerInvalid:
+		return codes.InvalidArgument
+	case err != nil:
+		return codes.Internal
+	case status == application.UnregisterPlayerNotFound:
+		return codes.NotFound
+	case status == application.UnregisterPlayerDeleted:
+		return codes.OK
+	default:
+		return codes.Internal
+	}
+}
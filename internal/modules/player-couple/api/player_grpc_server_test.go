@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	padelplacev1 "github.com/paguerre3/goddd/api/proto/padelplace/v1"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func withRoles(email string, roles ...auth.Role) context.Context {
+	return auth.WithClaims(context.Background(), &auth.Claims{Email: email, Roles: roles})
+}
+
+func TestPlayerGRPCServer_RegisterPlayer(t *testing.T) {
+	server := NewPlayerGRPCServer(&mockRegisterPlayerUseCase{}, &mockUnregisterPlayerUseCase{}, &mockFindPlayerUseCase{})
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		email string
+		code  codes.Code
+	}{
+		{"created", withRoles("admin@example.com", auth.RoleAdmin), "new@example.com", codes.OK},
+		{"updated", withRoles("admin@example.com", auth.RoleAdmin), "existing@example.com", codes.OK},
+		{"invalid", withRoles("admin@example.com", auth.RoleAdmin), "invalid", codes.InvalidArgument},
+		{"internal error", withRoles("admin@example.com", auth.RoleAdmin), "error@example.com", codes.Internal},
+		{"invalid status", withRoles("admin@example.com", auth.RoleAdmin), "invalid-status@example.com", codes.Internal},
+		{"own profile", withRoles("new@example.com", auth.RolePlayer), "new@example.com", codes.OK},
+		{"other profile", withRoles("other@example.com", auth.RolePlayer), "new@example.com", codes.PermissionDenied},
+		{"insufficient role", withRoles("referee@example.com", auth.RoleReferee), "new@example.com", codes.PermissionDenied},
+		{"unauthenticated", context.Background(), "new@example.com", codes.Unauthenticated},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := server.RegisterPlayer(test.ctx, &padelplacev1.RegisterPlayerRequest{Player: &padelplacev1.Player{Email: test.email}})
+
+			assert.Equal(t, test.code, status.Code(err))
+			if test.code == codes.OK {
+				assert.Equal(t, test.email, res.GetPlayer().GetEmail())
+				assert.Equal(t, test.email == "new@example.com", res.GetCreated())
+			}
+		})
+	}
+
+	t.Run("missing player", func(t *testing.T) {
+		_, err := server.RegisterPlayer(withRoles("admin@example.com", auth.RoleAdmin), &padelplacev1.RegisterPlayerRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestPlayerGRPCServer_FindPlayer(t *testing.T) {
+	findPlayerUseCaseMock := &mockFindPlayerUseCase{}
+	server := NewPlayerGRPCServer(&mockRegisterPlayerUseCase{}, &mockUnregisterPlayerUseCase{}, findPlayerUseCaseMock)
+	ssn := "123456789"
+	found := domain.Player{ID: "valid-id", Email: "john.doe@example.com", SocialSecurityNumber: &ssn}
+	findPlayerUseCaseMock.On("FindPlayerByIDUseCase", "valid-id").Return(found, application.FindPlayerFound, nil)
+	findPlayerUseCaseMock.On("FindPlayerByIDUseCase", "not-found-id").Return(domain.Player{}, application.FindPlayerNotFound, nil)
+	findPlayerUseCaseMock.On("FindPlayerByIDUseCase", "i").Return(domain.Player{}, application.FindPlayerInvalid, errors.New("invalid id: i"))
+	findPlayerUseCaseMock.On("FindPlayerByIDUseCase", "error-id").Return(domain.Player{}, application.FindPlayerPending, errors.New("repo error"))
+
+	t.Run("found masked for non admins", func(t *testing.T) {
+		res, err := server.FindPlayer(withRoles("organizer@example.com", auth.RoleOrganizer), &padelplacev1.FindPlayerRequest{Id: "valid-id"})
+		assert.NoError(t, err)
+		assert.Equal(t, "*****6789", res.GetPlayer().GetSocialSecurityNumber())
+	})
+
+	t.Run("found unmasked for admins", func(t *testing.T) {
+		res, err := server.FindPlayer(withRoles("admin@example.com", auth.RoleAdmin), &padelplacev1.FindPlayerRequest{Id: "valid-id"})
+		assert.NoError(t, err)
+		assert.Equal(t, ssn, res.GetPlayer().GetSocialSecurityNumber())
+	})
+
+	for id, code := range map[string]codes.Code{"not-found-id": codes.NotFound, "i": codes.InvalidArgument, "error-id": codes.Internal} {
+		t.Run(code.String(), func(t *testing.T) {
+			_, err := server.FindPlayer(withRoles("admin@example.com", auth.RoleAdmin), &padelplacev1.FindPlayerRequest{Id: id})
+			assert.Equal(t, code, status.Code(err))
+		})
+	}
+
+	t.Run("not found message is the use case status", func(t *testing.T) {
+		_, err := server.FindPlayer(withRoles("admin@example.com", auth.RoleAdmin), &padelplacev1.FindPlayerRequest{Id: "not-found-id"})
+		assert.Equal(t, "FindPlayerNotFound", status.Convert(err).Message())
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, err := server.FindPlayer(context.Background(), &padelplacev1.FindPlayerRequest{Id: "valid-id"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestPlayerGRPCServer_FindPlayersByLastName(t *testing.T) {
+	findPlayerUseCaseMock := &mockFindPlayerUseCase{}
+	server := NewPlayerGRPCServer(&mockRegisterPlayerUseCase{}, &mockUnregisterPlayerUseCase{}, findPlayerUseCaseMock)
+	findPlayerUseCaseMock.On("FindPlayersByLastNameUseCase", "Doe").Return([]domain.Player{{ID: "1"}, {ID: "2"}}, application.FindPlayerFound, nil)
+	findPlayerUseCaseMock.On("FindPlayerByEmailUseCase", "john.doe@example.com").Return(domain.Player{ID: "1"}, application.FindPlayerFound, nil)
+
+	res, err := server.FindPlayersByLastName(withRoles("organizer@example.com", auth.RoleOrganizer), &padelplacev1.FindPlayersByLastNameRequest{LastName: "Doe"})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetPlayers(), 2)
+
+	found, err := server.FindPlayerByEmail(withRoles("organizer@example.com", auth.RoleOrganizer), &padelplacev1.FindPlayerByEmailRequest{Email: "john.doe@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", found.GetPlayer().GetId())
+}
+
+func TestPlayerGRPCServer_UnregisterPlayer(t *testing.T) {
+	server := NewPlayerGRPCServer(&mockRegisterPlayerUseCase{}, &mockUnregisterPlayerUseCase{}, &mockFindPlayerUseCase{})
+	admin := withRoles("admin@example.com", auth.RoleAdmin)
+
+	for id, code := range map[string]codes.Code{
+		"valid-id":        codes.OK,
+		"invalid-id":      codes.InvalidArgument,
+		"non-existent-id": codes.NotFound,
+		"pending-id":      codes.Internal,
+		"error-id":        codes.Internal,
+	} {
+		t.Run(id, func(t *testing.T) {
+			_, err := server.UnregisterPlayer(admin, &padelplacev1.UnregisterPlayerRequest{Id: id})
+			assert.Equal(t, code, status.Code(err))
+		})
+	}
+
+	t.Run("admins only", func(t *testing.T) {
+		_, err := server.UnregisterPlayer(withRoles("organizer@example.com", auth.RoleOrganizer), &padelplacev1.UnregisterPlayerRequest{Id: "valid-id"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -1,10 +1,13 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
 	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
 	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
 )
@@ -27,84 +30,128 @@ func NewPlayerHandler(registerPlayerUseCase application.RegisterPlayerUseCase,
 
 func (h *PlayerHandler) RegisterPlayer(c *gin.Context) {
 	var player domain.Player
-	if err := c.ShouldBindJSON(&player); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := web.Bind(c, &player); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
 		return
 	}
-	newPlayer, status, err := h.registerPlayerUseCase.RegisterPlayerUseCase(player)
+	if code, err := h.authorizeProfile(c, player); err != nil {
+		web.Respond(c, code, web.ErrorBody(c, err))
+		return
+	}
+	newPlayer, status, err := h.registerPlayerUseCase.RegisterPlayerUseCase(c.Request.Context(), player)
 	if err != nil {
 		if status == application.RegisterPlayerInvalid {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
 		return
 	}
 	switch status {
 	case application.RegisterPlayerUpdated:
-		c.JSON(http.StatusOK, newPlayer)
+		web.Respond(c, http.StatusOK, maskForCaller(c, newPlayer))
 	case application.RegisterPlayerCreated:
-		c.JSON(http.StatusCreated, newPlayer)
+		web.Respond(c, http.StatusCreated, maskForCaller(c, newPlayer))
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
+
+// authorizeProfile maps the profile rules shared with the gRPC server (see authorization.go) to HTTP statuses.
+func (h *PlayerHandler) authorizeProfile(c *gin.Context, player domain.Player) (int, error) {
+	claims, ok := auth.ClaimsFrom(c)
+	if !ok {
+		return http.StatusUnauthorized, errors.New("missing authentication")
+	}
+	status, err := authorizeProfile(c.Request.Context(), h.findPlayerUseCase, claims, player)
+	switch {
+	case err == nil:
+		return http.StatusOK, nil
+	case errors.Is(err, errNotOwnProfile):
+		return http.StatusForbidden, err
+	case status == application.FindPlayerInvalid:
+		return http.StatusBadRequest, err
 	default:
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Errorf("invalid status %d", status)})
+		return http.StatusInternalServerError, err
 	}
 }
 
 func (h *PlayerHandler) UnregisterPlayer(c *gin.Context) {
 	playerId := c.Param("playerId")
-	status, err := h.unregisterPlayerUseCase.UnregisterPlayerUseCase(playerId)
+	status, err := h.unregisterPlayerUseCase.UnregisterPlayerUseCase(c.Request.Context(), playerId)
 	if err != nil {
 		if status == application.UnregisterPlayerInvalid {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
 		return
 	}
 	if status == application.UnregisterPlayerNotFound {
-		c.JSON(http.StatusNotFound, gin.H{"status": status.String()})
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
 		return
 	}
 	if status == application.UnregisterPlayerPending {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Errorf("invalid status %d", status)})
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"status": status.String()})
+	web.Respond(c, http.StatusOK, gin.H{"status": status.String()})
 }
 
 func (h *PlayerHandler) FindPlayerByID(c *gin.Context) {
 	playerId := c.Param("playerId")
-	player, status, err := h.findPlayerUseCase.FindPlayerByIDUseCase(playerId)
+	player, status, err := h.findPlayerUseCase.FindPlayerByIDUseCase(c.Request.Context(), playerId)
 	handleFindResponse(c, player, status, err)
 }
 
 func (h *PlayerHandler) FindPlayerByEmail(c *gin.Context) {
 	email := c.Param("email")
-	player, status, err := h.findPlayerUseCase.FindPlayerByEmailUseCase(email)
+	player, status, err := h.findPlayerUseCase.FindPlayerByEmailUseCase(c.Request.Context(), email)
 	handleFindResponse(c, player, status, err)
 }
 
 func (h *PlayerHandler) FindPlayersByLastName(c *gin.Context) {
 	lastName := c.Param("lastName")
-	players, status, err := h.findPlayerUseCase.FindPlayersByLastNameUseCase(lastName)
+	players, status, err := h.findPlayerUseCase.FindPlayersByLastNameUseCase(c.Request.Context(), lastName)
 	handleFindResponse(c, players, status, err)
 }
 
+type socialSecurityNumberLookup struct {
+	SocialSecurityNumber string `json:"socialSecurityNumber"`
+}
+
+// FindPlayerBySocialSecurityNumber receives the SSN in the body so it doesn't end up in URLs and access logs.
+func (h *PlayerHandler) FindPlayerBySocialSecurityNumber(c *gin.Context) {
+	var lookup socialSecurityNumberLookup
+	if err := web.Bind(c, &lookup); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	player, status, err := h.findPlayerUseCase.FindPlayerBySocialSecurityNumberUseCase(c.Request.Context(), lookup.SocialSecurityNumber)
+	handleFindResponse(c, player, status, err)
+}
+
+// maskForCaller hides the SSN of the players unless the caller is an admin.
+func maskForCaller[T domain.Player | []domain.Player](c *gin.Context, playerS T) T {
+	claims, _ := auth.ClaimsFrom(c)
+	return maskFor(claims, playerS)
+}
+
 func handleFindResponse[T domain.Player | []domain.Player](c *gin.Context, playerS T, status application.FindPlayerStatus, err error) {
 	if err != nil {
 		if status == application.FindPlayerInvalid {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
 		return
 	}
 	switch status {
 	case application.FindPlayerNotFound:
-		c.JSON(http.StatusNotFound, playerS)
+		web.Respond(c, http.StatusNotFound, playerS)
 	case application.FindPlayerFound:
-		c.JSON(http.StatusOK, playerS)
+		web.Respond(c, http.StatusOK, maskForCaller(c, playerS))
 	default:
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Errorf("invalid status %d", status)})
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
 	}
 }
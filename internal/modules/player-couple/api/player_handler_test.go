@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,8 +10,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
 	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
 	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
 	"github.com/stretchr/testify/assert"
@@ -67,6 +70,7 @@ func TestRegisterPlayer(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
 			c.Request = req
+			auth.SetClaims(c, &auth.Claims{Email: "admin@example.com", Roles: []auth.Role{auth.RoleAdmin}})
 
 			h.RegisterPlayer(c)
 
@@ -85,9 +89,90 @@ func TestRegisterPlayer(t *testing.T) {
 	}
 }
 
+func TestRegisterPlayer_ProfileAuthorization(t *testing.T) {
+	findPlayerUseCaseMock := &mockFindPlayerUseCase{}
+	h := &PlayerHandler{
+		registerPlayerUseCase: &mockRegisterPlayerUseCase{},
+		findPlayerUseCase:     findPlayerUseCaseMock,
+	}
+	findPlayerUseCaseMock.On("FindPlayerByIDUseCase", "own-id").Return(domain.Player{ID: "own-id", Email: "existing@example.com"}, application.FindPlayerFound, nil)
+	findPlayerUseCaseMock.On("FindPlayerByIDUseCase", "other-id").Return(domain.Player{ID: "other-id", Email: "other@example.com"}, application.FindPlayerFound, nil)
+	findPlayerUseCaseMock.On("FindPlayerByIDUseCase", "error-id").Return(domain.Player{}, application.FindPlayerPending, errors.New("repo error"))
+
+	tests := []struct {
+		name       string
+		claims     *auth.Claims
+		request    string
+		statusCode int
+	}{
+		{
+			name:       "Unauthenticated",
+			request:    `{"email": "existing@example.com"}`,
+			statusCode: http.StatusUnauthorized,
+		},
+		{
+			name:       "Organizer registers any player",
+			claims:     &auth.Claims{Email: "organizer@example.com", Roles: []auth.Role{auth.RoleOrganizer}},
+			request:    `{"email": "new@example.com"}`,
+			statusCode: http.StatusCreated,
+		},
+		{
+			name:       "Club integration registers any player",
+			claims:     &auth.Claims{Roles: []auth.Role{auth.RoleClub}, Scopes: []string{"players:write"}},
+			request:    `{"email": "new@example.com"}`,
+			statusCode: http.StatusCreated,
+		},
+		{
+			name:       "Referee can't register players",
+			claims:     &auth.Claims{Email: "new@example.com", Roles: []auth.Role{auth.RoleReferee}},
+			request:    `{"email": "new@example.com"}`,
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name:       "Player updates own profile",
+			claims:     &auth.Claims{Email: "existing@example.com", Roles: []auth.Role{auth.RolePlayer}},
+			request:    `{"id": "own-id", "email": "existing@example.com"}`,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "Player updates another profile",
+			claims:     &auth.Claims{Email: "existing@example.com", Roles: []auth.Role{auth.RolePlayer}},
+			request:    `{"email": "new@example.com"}`,
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name:       "Player takes over another profile by ID",
+			claims:     &auth.Claims{Email: "existing@example.com", Roles: []auth.Role{auth.RolePlayer}},
+			request:    `{"id": "other-id", "email": "existing@example.com"}`,
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name:       "Error checking stored profile",
+			claims:     &auth.Claims{Email: "existing@example.com", Roles: []auth.Role{auth.RolePlayer}},
+			request:    `{"id": "error-id", "email": "existing@example.com"}`,
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/players", bytes.NewBufferString(test.request))
+			if test.claims != nil {
+				auth.SetClaims(c, test.claims)
+			}
+
+			h.RegisterPlayer(c)
+
+			assert.Equal(t, test.statusCode, w.Code)
+		})
+	}
+}
+
 type mockRegisterPlayerUseCase struct{}
 
-func (m *mockRegisterPlayerUseCase) RegisterPlayerUseCase(player domain.Player) (domain.Player, application.RegisterPlayerStatus, error) {
+func (m *mockRegisterPlayerUseCase) RegisterPlayerUseCase(_ context.Context, player domain.Player) (domain.Player, application.RegisterPlayerStatus, error) {
 	switch player.Email {
 	case "invalid":
 		return domain.Player{}, application.RegisterPlayerInvalid, fmt.Errorf("invalid email")
@@ -112,6 +197,7 @@ func TestUnregisterPlayer(t *testing.T) {
 	t.Run("invalid player ID", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{gin.Param{Key: "playerId", Value: "invalid-id"}}
 		h.UnregisterPlayer(c)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -120,6 +206,7 @@ func TestUnregisterPlayer(t *testing.T) {
 	t.Run("non-existent player ID", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{gin.Param{Key: "playerId", Value: "non-existent-id"}}
 		h.UnregisterPlayer(c)
 		assert.Equal(t, http.StatusNotFound, w.Code)
@@ -128,6 +215,7 @@ func TestUnregisterPlayer(t *testing.T) {
 	t.Run("pending status", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{gin.Param{Key: "playerId", Value: "pending-id"}}
 		h.UnregisterPlayer(c)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -136,6 +224,7 @@ func TestUnregisterPlayer(t *testing.T) {
 	t.Run("successful unregister", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{gin.Param{Key: "playerId", Value: "valid-id"}}
 		h.UnregisterPlayer(c)
 		assert.Equal(t, http.StatusOK, w.Code)
@@ -144,6 +233,7 @@ func TestUnregisterPlayer(t *testing.T) {
 	t.Run("internal server error", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{gin.Param{Key: "playerId", Value: "error-id"}}
 		h.UnregisterPlayer(c)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -152,7 +242,7 @@ func TestUnregisterPlayer(t *testing.T) {
 
 type mockUnregisterPlayerUseCase struct{}
 
-func (m *mockUnregisterPlayerUseCase) UnregisterPlayerUseCase(playerId string) (application.UnregisterPlayerStatus, error) {
+func (m *mockUnregisterPlayerUseCase) UnregisterPlayerUseCase(_ context.Context, playerId string) (application.UnregisterPlayerStatus, error) {
 	switch playerId {
 	case "invalid-id":
 		return application.UnregisterPlayerInvalid, errors.New("invalid player ID")
@@ -173,21 +263,26 @@ type mockFindPlayerUseCase struct {
 	mock.Mock
 }
 
-func (m *mockFindPlayerUseCase) FindPlayerByIDUseCase(playerId string) (domain.Player, application.FindPlayerStatus, error) {
+func (m *mockFindPlayerUseCase) FindPlayerByIDUseCase(_ context.Context, playerId string) (domain.Player, application.FindPlayerStatus, error) {
 	args := m.Called(playerId)
 	return args.Get(0).(domain.Player), args.Get(1).(application.FindPlayerStatus), args.Error(2)
 }
 
-func (m *mockFindPlayerUseCase) FindPlayerByEmailUseCase(email string) (domain.Player, application.FindPlayerStatus, error) {
+func (m *mockFindPlayerUseCase) FindPlayerByEmailUseCase(_ context.Context, email string) (domain.Player, application.FindPlayerStatus, error) {
 	args := m.Called(email)
 	return args.Get(0).(domain.Player), args.Get(1).(application.FindPlayerStatus), args.Error(2)
 }
 
-func (m *mockFindPlayerUseCase) FindPlayersByLastNameUseCase(lastName string) ([]domain.Player, application.FindPlayerStatus, error) {
+func (m *mockFindPlayerUseCase) FindPlayersByLastNameUseCase(_ context.Context, lastName string) ([]domain.Player, application.FindPlayerStatus, error) {
 	args := m.Called(lastName)
 	return args.Get(0).([]domain.Player), args.Get(1).(application.FindPlayerStatus), args.Error(2)
 }
 
+func (m *mockFindPlayerUseCase) FindPlayerBySocialSecurityNumberUseCase(_ context.Context, socialSecurityNumber string) (domain.Player, application.FindPlayerStatus, error) {
+	args := m.Called(socialSecurityNumber)
+	return args.Get(0).(domain.Player), args.Get(1).(application.FindPlayerStatus), args.Error(2)
+}
+
 func TestFindPlayerByID(t *testing.T) {
 	// Arrange
 	findPlayerUseCaseMock := &mockFindPlayerUseCase{}
@@ -204,6 +299,7 @@ func TestFindPlayerByID(t *testing.T) {
 
 		// Act
 		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{
 			{Key: "playerId", Value: playerId},
 		}
@@ -221,6 +317,7 @@ func TestFindPlayerByID(t *testing.T) {
 
 		// Act
 		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{
 			{Key: "playerId", Value: playerId},
 		}
@@ -238,6 +335,7 @@ func TestFindPlayerByID(t *testing.T) {
 
 		// Act
 		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{
 			{Key: "playerId", Value: playerId},
 		}
@@ -255,6 +353,7 @@ func TestFindPlayerByID(t *testing.T) {
 
 		// Act
 		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{
 			{Key: "playerId", Value: playerId},
 		}
@@ -274,6 +373,7 @@ func TestFindPlayerByEmail(t *testing.T) {
 		foundPlayer := domain.Player{ID: "1234567", Email: email}
 		mockFindPlayerUseCase.On("FindPlayerByEmailUseCase", email).Return(foundPlayer, application.FindPlayerFound, nil)
 		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 
 		// Act
 		c.Params = gin.Params{{Key: "email", Value: email}}
@@ -291,6 +391,7 @@ func TestFindPlayerByEmail(t *testing.T) {
 		email := "test@example.com"
 		mockFindPlayerUseCase.On("FindPlayerByEmailUseCase", email).Return(domain.Player{}, application.FindPlayerNotFound, nil)
 		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 
 		// Act
 		c.Params = gin.Params{{Key: "email", Value: email}}
@@ -309,6 +410,7 @@ func TestFindPlayerByEmail(t *testing.T) {
 		expectedErr := domain.ValidateEmail(email)
 		mockFindPlayerUseCase.On("FindPlayerByEmailUseCase", email).Return(domain.Player{}, application.FindPlayerInvalid, expectedErr)
 		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 
 		// Act
 		c.Params = gin.Params{{Key: "email", Value: email}}
@@ -327,6 +429,7 @@ func TestFindPlayerByEmail(t *testing.T) {
 		expectedErr := errors.New("some error")
 		mockFindPlayerUseCase.On("FindPlayerByEmailUseCase", email).Return(domain.Player{}, application.FindPlayerPending, expectedErr)
 		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 
 		// Act
 		c.Params = gin.Params{{Key: "email", Value: email}}
@@ -343,6 +446,7 @@ func TestFindPlayersByLastName(t *testing.T) {
 		// Arrange
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{
 			{Key: "lastName", Value: "Doe"},
 		}
@@ -369,6 +473,7 @@ func TestFindPlayersByLastName(t *testing.T) {
 		// Arrange
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{
 			{Key: "lastName", Value: "Doe"},
 		}
@@ -395,6 +500,7 @@ func TestFindPlayersByLastName(t *testing.T) {
 		// Arrange
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{
 			{Key: "lastName", Value: ""},
 		}
@@ -421,6 +527,7 @@ func TestFindPlayersByLastName(t *testing.T) {
 		// Arrange
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
 		c.Params = gin.Params{
 			{Key: "lastName", Value: "Doe"},
 		}
@@ -443,3 +550,103 @@ func TestFindPlayersByLastName(t *testing.T) {
 		assert.Equal(t, map[string]string{"error": "internal error"}, err)
 	})
 }
+
+func TestFindPlayer_MasksSocialSecurityNumber(t *testing.T) {
+	ssn := "20123456789"
+	foundPlayer := domain.Player{ID: "1234567", Email: "test@example.com", SocialSecurityNumber: &ssn}
+
+	tests := []struct {
+		name     string
+		claims   *auth.Claims
+		expected string
+	}{
+		{"Admin sees the full SSN", &auth.Claims{Roles: []auth.Role{auth.RoleAdmin}}, "20123456789"},
+		{"Organizer sees the masked SSN", &auth.Claims{Roles: []auth.Role{auth.RoleOrganizer}}, "*******6789"},
+		{"Player sees the masked SSN", &auth.Claims{Email: "test@example.com", Roles: []auth.Role{auth.RolePlayer}}, "*******6789"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			mockFindPlayerUseCase := &mockFindPlayerUseCase{}
+			playerHandler := &PlayerHandler{findPlayerUseCase: mockFindPlayerUseCase}
+			mockFindPlayerUseCase.On("FindPlayerByIDUseCase", foundPlayer.ID).Return(foundPlayer, application.FindPlayerFound, nil)
+			mockFindPlayerUseCase.On("FindPlayersByLastNameUseCase", "Doe").Return([]domain.Player{foundPlayer}, application.FindPlayerFound, nil)
+
+			// Act
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			auth.SetClaims(c, test.claims)
+			c.Params = gin.Params{{Key: "playerId", Value: foundPlayer.ID}}
+			playerHandler.FindPlayerByID(c)
+
+			wList := httptest.NewRecorder()
+			cList, _ := gin.CreateTestContext(wList)
+			cList.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			auth.SetClaims(cList, test.claims)
+			cList.Params = gin.Params{{Key: "lastName", Value: "Doe"}}
+			playerHandler.FindPlayersByLastName(cList)
+
+			// Assert
+			var player domain.Player
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &player))
+			assert.Equal(t, test.expected, *player.SocialSecurityNumber)
+			var players []domain.Player
+			assert.NoError(t, json.Unmarshal(wList.Body.Bytes(), &players))
+			assert.Equal(t, test.expected, *players[0].SocialSecurityNumber)
+		})
+	}
+}
+
+func TestFindPlayer_DerivesAge(t *testing.T) {
+	// Arrange
+	foundPlayer := domain.Player{ID: "1234567", Email: "test@example.com", Profile: domain.Profile{BirthDate: "1990-05-17"}}
+	mockFindPlayerUseCase := &mockFindPlayerUseCase{}
+	mockFindPlayerUseCase.On("FindPlayerByIDUseCase", foundPlayer.ID).Return(foundPlayer, application.FindPlayerFound, nil)
+	playerHandler := &PlayerHandler{findPlayerUseCase: mockFindPlayerUseCase}
+
+	// Act
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	c.Params = gin.Params{{Key: "playerId", Value: foundPlayer.ID}}
+	playerHandler.FindPlayerByID(c)
+
+	// Assert
+	var player map[string]any
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &player))
+	assert.Equal(t, float64(*foundPlayer.AgeOn(time.Now())), player["age"], "Expected the age derived from the birth date")
+}
+
+func TestFindPlayerBySocialSecurityNumber(t *testing.T) {
+	t.Run("Player found", func(t *testing.T) {
+		// Arrange
+		mockFindPlayerUseCase := &mockFindPlayerUseCase{}
+		playerHandler := &PlayerHandler{findPlayerUseCase: mockFindPlayerUseCase}
+		ssn := "20123456789"
+		mockFindPlayerUseCase.On("FindPlayerBySocialSecurityNumberUseCase", ssn).
+			Return(domain.Player{ID: "1234567", SocialSecurityNumber: &ssn}, application.FindPlayerFound, nil)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/players/ssn-lookup", bytes.NewBufferString(`{"socialSecurityNumber": "20123456789"}`))
+		auth.SetClaims(c, &auth.Claims{Roles: []auth.Role{auth.RoleAdmin}})
+
+		// Act
+		playerHandler.FindPlayerBySocialSecurityNumber(c)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), ssn)
+	})
+
+	t.Run("Invalid JSON binding", func(t *testing.T) {
+		playerHandler := &PlayerHandler{findPlayerUseCase: &mockFindPlayerUseCase{}}
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/players/ssn-lookup", bytes.NewBufferString(`invalid json`))
+
+		playerHandler.FindPlayerBySocialSecurityNumber(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
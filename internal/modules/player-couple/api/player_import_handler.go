@@ -0,0 +1,144 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+)
+
+const (
+	importFileField = "file"
+	// XLSX files are zip archives that can't be read as a stream, so they're spooled to disk up to this size.
+	maxXLSXImportSize = 64 << 20
+)
+
+var (
+	errMissingImportFile = fmt.Errorf("missing %q multipart file", importFileField)
+	errImportTooLarge    = fmt.Errorf("XLSX files can't exceed %d MB", maxXLSXImportSize>>20)
+)
+
+// PlayerImportHandler imports players in bulk from CSV or XLSX files, i.e. sent as the request body or as the
+// "file" part of a multipart form.
+type PlayerImportHandler struct {
+	importPlayersUseCase application.ImportPlayersUseCase
+}
+
+func NewPlayerImportHandler(importPlayersUseCase application.ImportPlayersUseCase) *PlayerImportHandler {
+	return &PlayerImportHandler{importPlayersUseCase: importPlayersUseCase}
+}
+
+// ImportPlayers returns the report of each row, only validating them on "?dryRun=true".
+func (h *PlayerImportHandler) ImportPlayers(c *gin.Context) {
+	dryRun := false
+	if value := c.Query("dryRun"); len(value) > 0 {
+		var err error
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, fmt.Errorf("invalid dryRun: %s", value)))
+			return
+		}
+	}
+	rows, cleanup, err := importRows(c)
+	if err != nil {
+		respondImportError(c, err)
+		return
+	}
+	defer cleanup()
+	report, status, err := h.importPlayersUseCase.ImportPlayersUseCase(c.Request.Context(), rows, dryRun)
+	if err != nil {
+		if status == application.ImportPlayersInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	if status != application.ImportPlayersImported {
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+		return
+	}
+	web.Respond(c, http.StatusOK, report)
+}
+
+func respondImportError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, spreadsheet.ErrUnsupportedFormat):
+		web.Respond(c, http.StatusUnsupportedMediaType, web.ErrorBody(c, err))
+	case errors.Is(err, errImportTooLarge):
+		web.Respond(c, http.StatusRequestEntityTooLarge, web.ErrorBody(c, err))
+	case errors.Is(err, spreadsheet.ErrInvalidXLSX), errors.Is(err, errMissingImportFile):
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+	}
+}
+
+// importRows reads CSV files as they're received, the returned function releases the spooled XLSX files.
+func importRows(c *gin.Context) (spreadsheet.Reader, func(), error) {
+	body, contentType, fileName := io.Reader(c.Request.Body), c.GetHeader("Content-Type"), ""
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		part, err := importFilePart(c)
+		if err != nil {
+			return nil, nil, err
+		}
+		body, contentType, fileName = part, part.Header.Get("Content-Type"), part.FileName()
+	}
+	format, err := spreadsheet.FormatOf(contentType, fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if format == spreadsheet.FormatCSV {
+		return spreadsheet.NewCSVReader(body), func() {}, nil
+	}
+	file, err := os.CreateTemp("", "players-import-*.xlsx")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}
+	size, err := io.Copy(file, io.LimitReader(body, maxXLSXImportSize+1))
+	if err == nil && size > maxXLSXImportSize {
+		err = errImportTooLarge
+	}
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	rows, err := spreadsheet.NewXLSXReader(file, size)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return rows, cleanup, nil
+}
+
+// importFilePart skips the other parts of the form without buffering them.
+func importFilePart(c *gin.Context) (*multipart.Part, error) {
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMissingImportFile, err)
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil, errMissingImportFile
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errMissingImportFile, err)
+		}
+		if part.FormName() == importFileField {
+			return part, nil
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/application"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockImportPlayersUseCase reads every row, so tests can check what was parsed from the request.
+type mockImportPlayersUseCase struct {
+	mock.Mock
+	rows [][]string
+}
+
+func (m *mockImportPlayersUseCase) ImportPlayersUseCase(_ context.Context, rows spreadsheet.Reader, dryRun bool) (domain.PlayerImportReport,
+	application.ImportPlayersStatus, error) {
+	for {
+		row, err := rows.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return domain.PlayerImportReport{}, application.ImportPlayersInvalid, err
+		}
+		m.rows = append(m.rows, append([]string(nil), row.Cells...))
+	}
+	args := m.Called(dryRun)
+	return args.Get(0).(domain.PlayerImportReport), args.Get(1).(application.ImportPlayersStatus), args.Error(2)
+}
+
+func serveImport(useCase application.ImportPlayersUseCase, target, contentType string, body io.Reader) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, target, body)
+	c.Request.Header.Set("Content-Type", contentType)
+	NewPlayerImportHandler(useCase).ImportPlayers(c)
+	return w
+}
+
+func TestImportPlayers_CSV(t *testing.T) {
+	report := domain.PlayerImportReport{Created: 1, Rows: []domain.PlayerImportRow{
+		{Row: 2, Email: "john@example.com", Status: domain.ImportRowCreated, PlayerID: "player-1"},
+	}}
+	useCase := &mockImportPlayersUseCase{}
+	useCase.On("ImportPlayersUseCase", false).Return(report, application.ImportPlayersImported, nil)
+
+	w := serveImport(useCase, "/players/import", "text/csv", strings.NewReader("email,firstName,lastName\njohn@example.com,John,Doe\n"))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body domain.PlayerImportReport
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, report, body)
+	assert.Equal(t, [][]string{{"email", "firstName", "lastName"}, {"john@example.com", "John", "Doe"}}, useCase.rows)
+}
+
+func TestImportPlayers_Multipart(t *testing.T) {
+	var archive bytes.Buffer
+	zipWriter := zip.NewWriter(&archive)
+	sheet, _ := zipWriter.Create("xl/worksheets/sheet1.xml")
+	_, _ = sheet.Write([]byte(`<worksheet><sheetData><row r="1"><c r="A1" t="inlineStr"><is><t>email</t></is></c></row></sheetData></worksheet>`))
+	assert.NoError(t, zipWriter.Close())
+
+	var form bytes.Buffer
+	formWriter := multipart.NewWriter(&form)
+	_ = formWriter.WriteField("comment", "skipped")
+	file, _ := formWriter.CreateFormFile(importFileField, "players.xlsx")
+	_, _ = file.Write(archive.Bytes())
+	assert.NoError(t, formWriter.Close())
+
+	useCase := &mockImportPlayersUseCase{}
+	useCase.On("ImportPlayersUseCase", true).Return(domain.PlayerImportReport{DryRun: true}, application.ImportPlayersImported, nil)
+
+	w := serveImport(useCase, "/players/import?dryRun=true", formWriter.FormDataContentType(), &form)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, [][]string{{"email"}}, useCase.rows)
+}
+
+func TestImportPlayers_Errors(t *testing.T) {
+	t.Run("unsupported format", func(t *testing.T) {
+		w := serveImport(&mockImportPlayersUseCase{}, "/players/import", "application/json", strings.NewReader("[]"))
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+
+	t.Run("invalid dry run", func(t *testing.T) {
+		w := serveImport(&mockImportPlayersUseCase{}, "/players/import?dryRun=maybe", "text/csv", strings.NewReader(""))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		var form bytes.Buffer
+		formWriter := multipart.NewWriter(&form)
+		_ = formWriter.WriteField("comment", "no file")
+		_ = formWriter.Close()
+		w := serveImport(&mockImportPlayersUseCase{}, "/players/import", formWriter.FormDataContentType(), &form)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid XLSX", func(t *testing.T) {
+		w := serveImport(&mockImportPlayersUseCase{}, "/players/import", spreadsheet.XLSXContentType, strings.NewReader("not a zip"))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid header", func(t *testing.T) {
+		useCase := &mockImportPlayersUseCase{}
+		useCase.On("ImportPlayersUseCase", false).Return(domain.PlayerImportReport{}, application.ImportPlayersInvalid, errors.New("missing header columns: email"))
+		w := serveImport(useCase, "/players/import", "text/csv", strings.NewReader("name\n"))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("pending", func(t *testing.T) {
+		useCase := &mockImportPlayersUseCase{}
+		useCase.On("ImportPlayersUseCase", false).Return(domain.PlayerImportReport{}, application.ImportPlayersPending, errors.New("db error"))
+		w := serveImport(useCase, "/players/import", "text/csv", strings.NewReader("email\n"))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+// ExportUseCase streams players and couples as spreadsheet rows, e.g. for federation reports. Exports are files
+// shared around, so SSNs are always masked.
+type ExportUseCase interface {
+	ExportPlayersUseCase(ctx context.Context, filter domain.ExportFilter, rows spreadsheet.Writer) (ExportStatus, error)
+	ExportPlayerCouplesUseCase(ctx context.Context, filter domain.ExportFilter, rows spreadsheet.Writer) (ExportStatus, error)
+}
+
+type ExportStatus uint8
+
+const (
+	ExportPending ExportStatus = iota
+	ExportInvalid
+	ExportExported
+)
+
+// Implement the Stringer interface.
+func (s ExportStatus) String() string {
+	return [...]string{"ExportPending", "ExportInvalid", "ExportExported"}[s]
+}
+
+var (
+	playerExportHeader = []any{"id", "email", "firstName", "lastName", "socialSecurityNumber", "birthDate",
+		"gender", "handedness", "side", "level", "club", "erasedAt"}
+	coupleExportHeader = []any{"id", "ranking",
+		"player1Id", "player1Email", "player1FirstName", "player1LastName",
+		"player2Id", "player2Email", "player2FirstName", "player2LastName"}
+)
+
+type exportService struct {
+	playerRepo        domain.PlayerRepository
+	playerCoupleRepo  domain.PlayerCoupleRepository
+	tournamentHistory domain.TournamentHistory
+}
+
+func NewExportUseCase(playerRepository domain.PlayerRepository, playerCoupleRepository domain.PlayerCoupleRepository,
+	tournamentHistory domain.TournamentHistory) ExportUseCase {
+	return &exportService{
+		playerRepo:        playerRepository,
+		playerCoupleRepo:  playerCoupleRepository,
+		tournamentHistory: tournamentHistory,
+	}
+}
+
+// ExportPlayersUseCase writes nothing when the filter is invalid, otherwise the header and one row per player. Rows
+// already written are kept when it fails midway.
+func (s *exportService) ExportPlayersUseCase(ctx context.Context, filter domain.ExportFilter, rows spreadsheet.Writer) (ExportStatus, error) {
+	participants, status, err := s.participants(ctx, filter)
+	if err != nil {
+		return status, err
+	}
+	if err := rows.Write(playerExportHeader...); err != nil {
+		return ExportPending, err
+	}
+	err = s.playerRepo.ForEach(ctx, participants.PlayerIDs, func(player domain.Player) error {
+		player = player.WithMaskedSocialSecurityNumber()
+		return rows.Write(player.ID, player.Email, player.FirstName, player.LastName,
+			valueOf(player.SocialSecurityNumber), optional(player.BirthDate), optional(player.Gender), optional(player.Handedness),
+			optional(player.Side), optional(player.Level), optional(player.Club), valueOf(player.ErasedAt))
+	})
+	if err != nil {
+		return ExportPending, err
+	}
+	return ExportExported, nil
+}
+
+func (s *exportService) ExportPlayerCouplesUseCase(ctx context.Context, filter domain.ExportFilter, rows spreadsheet.Writer) (ExportStatus, error) {
+	participants, status, err := s.participants(ctx, filter)
+	if err != nil {
+		return status, err
+	}
+	if err := rows.Write(coupleExportHeader...); err != nil {
+		return ExportPending, err
+	}
+	err = s.playerCoupleRepo.ForEach(ctx, participants.CoupleIDs, func(playerCouple domain.PlayerCouple) error {
+		player1, player2 := playerCouple.Player1, playerCouple.Player2
+		return rows.Write(playerCouple.ID, valueOf(playerCouple.Ranking),
+			player1.ID, player1.Email, player1.FirstName, player1.LastName,
+			player2.ID, player2.Email, player2.FirstName, player2.LastName)
+	})
+	if err != nil {
+		return ExportPending, err
+	}
+	return ExportExported, nil
+}
+
+// participants returns the IDs selected by the filter, nil IDs (everyone) for the zero filter.
+func (s *exportService) participants(ctx context.Context, filter domain.ExportFilter) (domain.Participants, ExportStatus, error) {
+	if err := filter.Validate(); err != nil {
+		return domain.Participants{}, ExportInvalid, err
+	}
+	if filter.IsZero() {
+		return domain.Participants{}, ExportPending, nil
+	}
+	participants, err := s.tournamentHistory.FindParticipants(ctx, filter)
+	return participants, ExportPending, err
+}
+
+// optional returns nil for the zero value, i.e. unknown fields of profiles are empty cells.
+func optional[T comparable](value T) any {
+	var zero T
+	if value == zero {
+		return nil
+	}
+	return value
+}
+
+// valueOf returns the value of optional fields, nil being an empty cell.
+func valueOf[T any](pointer *T) any {
+	if pointer == nil {
+		return nil
+	}
+	return *pointer
+}
@@ -0,0 +1,87 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func csvRows(t *testing.T, export func(rows spreadsheet.Writer) (ExportStatus, error)) (string, ExportStatus, error) {
+	var buffer bytes.Buffer
+	rows, err := spreadsheet.NewWriter(spreadsheet.FormatCSV, &buffer)
+	assert.NoError(t, err)
+	status, err := export(rows)
+	assert.NoError(t, rows.Close())
+	return buffer.String(), status, err
+}
+
+func TestExportPlayersUseCase(t *testing.T) {
+	ssn := "20123456789"
+	playerRepo := &mockPlayerRepository{}
+	playerRepo.On("ForEach", []string(nil)).Return([]domain.Player{
+		{ID: "player-1", Email: "john@example.com", FirstName: "John", LastName: "Doe", SocialSecurityNumber: &ssn,
+			Profile: domain.Profile{BirthDate: "1990-05-17", Gender: domain.GenderMale, Side: domain.SideReves, Level: 5, Club: "Club Norte"}},
+		{ID: "player-2", Email: "jane@example.com", FirstName: "Jane", LastName: "Roe"},
+	}, nil)
+	useCase := NewExportUseCase(playerRepo, nil, nil)
+
+	rows, status, err := csvRows(t, func(rows spreadsheet.Writer) (ExportStatus, error) {
+		return useCase.ExportPlayersUseCase(context.Background(), domain.ExportFilter{}, rows)
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, ExportExported, status)
+	assert.Equal(t, "id,email,firstName,lastName,socialSecurityNumber,birthDate,gender,handedness,side,level,club,erasedAt\n"+
+		"player-1,john@example.com,John,Doe,*******6789,1990-05-17,male,,reves,5,Club Norte,\n"+
+		"player-2,jane@example.com,Jane,Roe,,,,,,,,\n", rows)
+}
+
+func TestExportPlayerCouplesUseCase_Filtered(t *testing.T) {
+	from := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	filter := domain.ExportFilter{From: &from, CategoryID: "men-40"}
+	history := &mockTournamentHistory{}
+	history.On("FindParticipants", filter).Return(domain.Participants{CoupleIDs: []string{"couple-1"}, PlayerIDs: []string{"player-1", "player-2"}}, nil)
+	coupleRepo := &mockPlayerCoupleRepository{}
+	coupleRepo.On("ForEach", []string{"couple-1"}).Return([]domain.PlayerCouple{{ID: "couple-1",
+		Player1: domain.Player{ID: "player-1", Email: "john@example.com", FirstName: "John", LastName: "Doe"},
+		Player2: domain.Player{ID: "player-2", Email: "jane@example.com", FirstName: "Jane", LastName: "Roe"},
+	}}, nil)
+	useCase := NewExportUseCase(nil, coupleRepo, history)
+
+	rows, status, err := csvRows(t, func(rows spreadsheet.Writer) (ExportStatus, error) {
+		return useCase.ExportPlayerCouplesUseCase(context.Background(), filter, rows)
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, ExportExported, status)
+	assert.Equal(t, "id,ranking,player1Id,player1Email,player1FirstName,player1LastName,player2Id,player2Email,player2FirstName,player2LastName\n"+
+		"couple-1,,player-1,john@example.com,John,Doe,player-2,jane@example.com,Jane,Roe\n", rows)
+}
+
+func TestExportUseCase_Errors(t *testing.T) {
+	t.Run("Invalid", func(t *testing.T) {
+		from := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+		rows, status, err := csvRows(t, func(rows spreadsheet.Writer) (ExportStatus, error) {
+			return NewExportUseCase(nil, nil, nil).ExportPlayersUseCase(context.Background(), domain.ExportFilter{From: &from, To: &from}, rows)
+		})
+		assert.Error(t, err)
+		assert.Equal(t, ExportInvalid, status)
+		assert.Empty(t, rows, "Expected nothing to be written")
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		history := &mockTournamentHistory{}
+		history.On("FindParticipants", domain.ExportFilter{TournamentID: "tournament-1"}).Return(domain.Participants{}, errors.New("db error"))
+		_, status, err := csvRows(t, func(rows spreadsheet.Writer) (ExportStatus, error) {
+			return NewExportUseCase(nil, nil, history).ExportPlayersUseCase(context.Background(), domain.ExportFilter{TournamentID: "tournament-1"}, rows)
+		})
+		assert.Error(t, err)
+		assert.Equal(t, ExportPending, status)
+	})
+}
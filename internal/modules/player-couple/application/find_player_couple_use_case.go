@@ -0,0 +1,109 @@
+package application
+
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+type FindPlayerCoupleUseCase interface {
+	FindPlayerCoupleByIDUseCase(ctx context.Context, playerCoupleId string) (domain.PlayerCouple, FindPlayerCoupleStatus, error)
+	FindPlayerCouplesByPlayerIDUseCase(ctx context.Context, playerId string) ([]domain.PlayerCouple, FindPlayerCoupleStatus, error)
+	// FindPlayerCouplesByPlayersUseCase finds the couple of both players, in any order.
+	FindPlayerCouplesByPlayersUseCase(ctx context.Context, player1Id, player2Id string) ([]domain.PlayerCouple, FindPlayerCoupleStatus, error)
+	// FindPlayerCouplesByLastNamesUseCase finds the couples of players with the last names, in any order, or with any
+	// partner when lastName2 is empty.
+	FindPlayerCouplesByLastNamesUseCase(ctx context.Context, lastName1, lastName2 string) ([]domain.PlayerCouple, FindPlayerCoupleStatus, error)
+}
+
+type FindPlayerCoupleStatus uint8
+
+const (
+	FindPlayerCouplePending FindPlayerCoupleStatus = iota
+	FindPlayerCoupleInvalid
+	FindPlayerCoupleNotFound
+	FindPlayerCoupleFound
+)
+
+// Implement the Stringer interface.
+func (s FindPlayerCoupleStatus) String() string {
+	return [...]string{"FindPlayerCouplePending", "FindPlayerCoupleInvalid", "FindPlayerCoupleNotFound", "FindPlayerCoupleFound"}[s]
+}
+
+func NewFindPlayerCoupleUseCase(playerRepository domain.PlayerRepository, playerCoupleRepository domain.PlayerCoupleRepository) FindPlayerCoupleUseCase {
+	return &playerCoupleService{playerRepo: playerRepository, playerCoupleRepo: playerCoupleRepository}
+}
+
+func (s *playerCoupleService) FindPlayerCoupleByIDUseCase(ctx context.Context, playerCoupleId string) (domain.PlayerCouple, FindPlayerCoupleStatus, error) {
+	if err := domain.ValidateID(playerCoupleId); err != nil {
+		return domain.PlayerCouple{}, FindPlayerCoupleInvalid, err
+	}
+	playerCouple, err := s.playerCoupleRepo.FindByID(ctx, playerCoupleId)
+	if err != nil {
+		return playerCouple, FindPlayerCouplePending, err
+	}
+	if len(playerCouple.ID) == 0 {
+		return playerCouple, FindPlayerCoupleNotFound, nil
+	}
+	return playerCouple, FindPlayerCoupleFound, nil
+}
+
+func (s *playerCoupleService) FindPlayerCouplesByPlayerIDUseCase(ctx context.Context, playerId string) ([]domain.PlayerCouple, FindPlayerCoupleStatus, error) {
+	if err := domain.ValidateID(playerId); err != nil {
+		return nil, FindPlayerCoupleInvalid, err
+	}
+	playerCouples, err := s.playerCoupleRepo.FindByPlayerID(ctx, playerId)
+	if err != nil {
+		return playerCouples, FindPlayerCouplePending, err
+	}
+	if len(playerCouples) == 0 {
+		return playerCouples, FindPlayerCoupleNotFound, nil
+	}
+	return playerCouples, FindPlayerCoupleFound, nil
+}
+
+func (s *playerCoupleService) FindPlayerCouplesByPlayersUseCase(ctx context.Context, player1Id, player2Id string) ([]domain.PlayerCouple, FindPlayerCoupleStatus, error) {
+	for _, playerId := range []string{player1Id, player2Id} {
+		if err := domain.ValidateID(playerId); err != nil {
+			return nil, FindPlayerCoupleInvalid, err
+		}
+	}
+	return s.findByPlayers(ctx, []string{player1Id}, []string{player2Id})
+}
+
+// FindPlayerCouplesByLastNamesUseCase looks up the players first, so couples are matched on the indexed player IDs
+// rather than on their IDs or the names embedded in them.
+func (s *playerCoupleService) FindPlayerCouplesByLastNamesUseCase(ctx context.Context, lastName1, lastName2 string) ([]domain.PlayerCouple, FindPlayerCoupleStatus, error) {
+	lastNames := []string{lastName1}
+	if len(lastName2) > 0 {
+		lastNames = append(lastNames, lastName2)
+	}
+	playerIds := make([][]string, 2)
+	for i, lastName := range lastNames {
+		if err := domain.ValidateLastName(lastName); err != nil {
+			return nil, FindPlayerCoupleInvalid, err
+		}
+		players, err := s.playerRepo.FindByLastName(ctx, lastName)
+		if err != nil {
+			return nil, FindPlayerCouplePending, err
+		}
+		if len(players) == 0 {
+			return nil, FindPlayerCoupleNotFound, nil
+		}
+		for _, player := range players {
+			playerIds[i] = append(playerIds[i], player.ID)
+		}
+	}
+	return s.findByPlayers(ctx, playerIds[0], playerIds[1])
+}
+
+func (s *playerCoupleService) findByPlayers(ctx context.Context, player1Ids, player2Ids []string) ([]domain.PlayerCouple, FindPlayerCoupleStatus, error) {
+	playerCouples, err := s.playerCoupleRepo.FindByPlayers(ctx, player1Ids, player2Ids)
+	if err != nil {
+		return playerCouples, FindPlayerCouplePending, err
+	}
+	if len(playerCouples) == 0 {
+		return playerCouples, FindPlayerCoupleNotFound, nil
+	}
+	return playerCouples, FindPlayerCoupleFound, nil
+}
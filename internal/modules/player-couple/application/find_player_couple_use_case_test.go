@@ -0,0 +1,117 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindPlayerCoupleByIDUseCase(t *testing.T) {
+	repo := &mockPlayerCoupleRepository{}
+	useCase := NewFindPlayerCoupleUseCase(&mockPlayerRepository{}, repo)
+	found := domain.PlayerCouple{ID: "Doe-Roe-id", Player1: domain.Player{ID: "player-1"}, Player2: domain.Player{ID: "player-2"}}
+	repo.On("FindByID", found.ID).Return(found, nil)
+	repo.On("FindByID", "not-found-id").Return(domain.PlayerCouple{}, nil)
+	repo.On("FindByID", "error-id").Return(domain.PlayerCouple{}, errors.New("repo error"))
+
+	playerCouple, status, err := useCase.FindPlayerCoupleByIDUseCase(context.Background(), found.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, FindPlayerCoupleFound, status)
+	assert.Equal(t, found, playerCouple)
+
+	_, status, err = useCase.FindPlayerCoupleByIDUseCase(context.Background(), "not-found-id")
+	assert.NoError(t, err)
+	assert.Equal(t, FindPlayerCoupleNotFound, status)
+
+	_, status, err = useCase.FindPlayerCoupleByIDUseCase(context.Background(), "i")
+	assert.Error(t, err)
+	assert.Equal(t, FindPlayerCoupleInvalid, status)
+
+	_, status, err = useCase.FindPlayerCoupleByIDUseCase(context.Background(), "error-id")
+	assert.Error(t, err)
+	assert.Equal(t, FindPlayerCouplePending, status)
+}
+
+func TestFindPlayerCouplesByPlayerIDUseCase(t *testing.T) {
+	repo := &mockPlayerCoupleRepository{}
+	useCase := NewFindPlayerCoupleUseCase(&mockPlayerRepository{}, repo)
+	found := []domain.PlayerCouple{{ID: "Doe-Roe-id"}}
+	repo.On("FindByPlayerID", "player-1").Return(found, nil)
+	repo.On("FindByPlayerID", "player-9").Return([]domain.PlayerCouple{}, nil)
+
+	playerCouples, status, err := useCase.FindPlayerCouplesByPlayerIDUseCase(context.Background(), "player-1")
+	assert.NoError(t, err)
+	assert.Equal(t, FindPlayerCoupleFound, status)
+	assert.Equal(t, found, playerCouples)
+
+	_, status, err = useCase.FindPlayerCouplesByPlayerIDUseCase(context.Background(), "player-9")
+	assert.NoError(t, err)
+	assert.Equal(t, FindPlayerCoupleNotFound, status)
+
+	_, status, err = useCase.FindPlayerCouplesByPlayerIDUseCase(context.Background(), "i")
+	assert.Error(t, err)
+	assert.Equal(t, FindPlayerCoupleInvalid, status)
+}
+
+func TestFindPlayerCouplesByPlayersUseCase(t *testing.T) {
+	repo := &mockPlayerCoupleRepository{}
+	useCase := NewFindPlayerCoupleUseCase(&mockPlayerRepository{}, repo)
+	found := []domain.PlayerCouple{{ID: "couple-id"}}
+	repo.On("FindByPlayers", []string{"player-2"}, []string{"player-1"}).Return(found, nil)
+	repo.On("FindByPlayers", []string{"player-1"}, []string{"player-9"}).Return([]domain.PlayerCouple{}, nil)
+	repo.On("FindByPlayers", []string{"player-1"}, []string{"error-id"}).Return([]domain.PlayerCouple(nil), errors.New("repo error"))
+
+	playerCouples, status, err := useCase.FindPlayerCouplesByPlayersUseCase(context.Background(), "player-2", "player-1")
+	assert.NoError(t, err)
+	assert.Equal(t, FindPlayerCoupleFound, status)
+	assert.Equal(t, found, playerCouples)
+
+	_, status, err = useCase.FindPlayerCouplesByPlayersUseCase(context.Background(), "player-1", "player-9")
+	assert.NoError(t, err)
+	assert.Equal(t, FindPlayerCoupleNotFound, status)
+
+	_, status, err = useCase.FindPlayerCouplesByPlayersUseCase(context.Background(), "player-1", "")
+	assert.Error(t, err)
+	assert.Equal(t, FindPlayerCoupleInvalid, status)
+
+	_, status, err = useCase.FindPlayerCouplesByPlayersUseCase(context.Background(), "player-1", "error-id")
+	assert.Error(t, err)
+	assert.Equal(t, FindPlayerCouplePending, status)
+}
+
+func TestFindPlayerCouplesByLastNamesUseCase(t *testing.T) {
+	playerRepo := &mockPlayerRepository{}
+	repo := &mockPlayerCoupleRepository{}
+	useCase := NewFindPlayerCoupleUseCase(playerRepo, repo)
+	found := []domain.PlayerCouple{{ID: "couple-id"}}
+	playerRepo.On("FindByLastName", "Doe").Return([]domain.Player{{ID: "player-1"}, {ID: "player-3"}}, nil)
+	playerRepo.On("FindByLastName", "Roe").Return([]domain.Player{{ID: "player-2"}}, nil)
+	playerRepo.On("FindByLastName", "Unknown").Return([]domain.Player{}, nil)
+	playerRepo.On("FindByLastName", "Error").Return([]domain.Player{}, errors.New("repo error"))
+	repo.On("FindByPlayers", []string{"player-2"}, []string{"player-1", "player-3"}).Return(found, nil)
+	repo.On("FindByPlayers", []string{"player-1", "player-3"}, []string(nil)).Return(found, nil)
+
+	playerCouples, status, err := useCase.FindPlayerCouplesByLastNamesUseCase(context.Background(), "Roe", "Doe")
+	assert.NoError(t, err)
+	assert.Equal(t, FindPlayerCoupleFound, status, "Expected couples found in any order")
+	assert.Equal(t, found, playerCouples)
+
+	_, status, err = useCase.FindPlayerCouplesByLastNamesUseCase(context.Background(), "Doe", "")
+	assert.NoError(t, err)
+	assert.Equal(t, FindPlayerCoupleFound, status, "Expected couples with any partner")
+
+	_, status, err = useCase.FindPlayerCouplesByLastNamesUseCase(context.Background(), "Doe", "Unknown")
+	assert.NoError(t, err)
+	assert.Equal(t, FindPlayerCoupleNotFound, status)
+
+	_, status, err = useCase.FindPlayerCouplesByLastNamesUseCase(context.Background(), "D", "")
+	assert.Error(t, err)
+	assert.Equal(t, FindPlayerCoupleInvalid, status)
+
+	_, status, err = useCase.FindPlayerCouplesByLastNamesUseCase(context.Background(), "Error", "Doe")
+	assert.Error(t, err)
+	assert.Equal(t, FindPlayerCouplePending, status)
+}
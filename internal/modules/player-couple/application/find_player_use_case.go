@@ -1,11 +1,16 @@
 package application
 
-import "github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
 
 type FindPlayerUseCase interface {
-	FindPlayerByIDUseCase(playerId string) (domain.Player, FindPlayerStatus, error)
-	FindPlayerByEmailUseCase(email string) (domain.Player, FindPlayerStatus, error)
-	FindPlayersByLastNameUseCase(lastName string) ([]domain.Player, FindPlayerStatus, error)
+	FindPlayerByIDUseCase(ctx context.Context, playerId string) (domain.Player, FindPlayerStatus, error)
+	FindPlayerByEmailUseCase(ctx context.Context, email string) (domain.Player, FindPlayerStatus, error)
+	FindPlayersByLastNameUseCase(ctx context.Context, lastName string) ([]domain.Player, FindPlayerStatus, error)
+	FindPlayerBySocialSecurityNumberUseCase(ctx context.Context, socialSecurityNumber string) (domain.Player, FindPlayerStatus, error)
 }
 
 type FindPlayerStatus uint8
@@ -17,15 +22,20 @@ const (
 	FindPlayerFound
 )
 
+// Implement the Stringer interface.
+func (s FindPlayerStatus) String() string {
+	return [...]string{"FindPlayerPending", "FindPlayerInvalid", "FindPlayerNotFound", "FindPlayerFound"}[s]
+}
+
 func NewFindPlayerUseCase(playerRepository domain.PlayerRepository) FindPlayerUseCase {
 	return &playerService{playerRepo: playerRepository}
 }
 
-func (s *playerService) FindPlayerByIDUseCase(playerId string) (domain.Player, FindPlayerStatus, error) {
+func (s *playerService) FindPlayerByIDUseCase(ctx context.Context, playerId string) (domain.Player, FindPlayerStatus, error) {
 	if err := domain.ValidateID(playerId); err != nil {
 		return domain.Player{}, FindPlayerInvalid, err
 	}
-	player, err := s.playerRepo.FindByID(playerId)
+	player, err := s.playerRepo.FindByID(ctx, playerId)
 	if err != nil {
 		return player, FindPlayerPending, err
 	}
@@ -35,11 +45,11 @@ func (s *playerService) FindPlayerByIDUseCase(playerId string) (domain.Player, F
 	return player, FindPlayerFound, nil
 }
 
-func (s *playerService) FindPlayerByEmailUseCase(email string) (domain.Player, FindPlayerStatus, error) {
+func (s *playerService) FindPlayerByEmailUseCase(ctx context.Context, email string) (domain.Player, FindPlayerStatus, error) {
 	if err := domain.ValidateEmail(email); err != nil {
 		return domain.Player{}, FindPlayerInvalid, err
 	}
-	player, err := s.playerRepo.FindByEmail(email)
+	player, err := s.playerRepo.FindByEmail(ctx, email)
 	if err != nil {
 		return player, FindPlayerPending, err
 	}
@@ -49,11 +59,11 @@ func (s *playerService) FindPlayerByEmailUseCase(email string) (domain.Player, F
 	return player, FindPlayerFound, nil
 }
 
-func (s *playerService) FindPlayersByLastNameUseCase(lastName string) ([]domain.Player, FindPlayerStatus, error) {
+func (s *playerService) FindPlayersByLastNameUseCase(ctx context.Context, lastName string) ([]domain.Player, FindPlayerStatus, error) {
 	if err := domain.ValidateLastName(lastName); err != nil {
 		return nil, FindPlayerInvalid, err
 	}
-	players, err := s.playerRepo.FindByLastName(lastName)
+	players, err := s.playerRepo.FindByLastName(ctx, lastName)
 	if err != nil {
 		return players, FindPlayerPending, err
 	}
@@ -62,3 +72,17 @@ func (s *playerService) FindPlayersByLastNameUseCase(lastName string) ([]domain.
 	}
 	return players, FindPlayerFound, nil
 }
+
+func (s *playerService) FindPlayerBySocialSecurityNumberUseCase(ctx context.Context, socialSecurityNumber string) (domain.Player, FindPlayerStatus, error) {
+	if err := domain.ValidateSocialSecurityNumber(socialSecurityNumber); err != nil {
+		return domain.Player{}, FindPlayerInvalid, err
+	}
+	player, err := s.playerRepo.FindBySocialSecurityNumber(ctx, socialSecurityNumber)
+	if err != nil {
+		return player, FindPlayerPending, err
+	}
+	if len(player.ID) == 0 {
+		return player, FindPlayerNotFound, nil
+	}
+	return player, FindPlayerFound, nil
+}
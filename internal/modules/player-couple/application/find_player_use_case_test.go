@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -18,7 +19,7 @@ func TestFindPlayerByIDUseCase(t *testing.T) {
 		repo.On("FindByID", playerId).Return(foundPlayer, nil)
 
 		// Act
-		player, status, err := service.FindPlayerByIDUseCase(playerId)
+		player, status, err := service.FindPlayerByIDUseCase(context.Background(), playerId)
 
 		// Assert
 		assert.NoError(t, err)
@@ -34,7 +35,7 @@ func TestFindPlayerByIDUseCase(t *testing.T) {
 		expectedErr := domain.ValidateID(playerId)
 
 		// Act
-		player, status, err := service.FindPlayerByIDUseCase(playerId)
+		player, status, err := service.FindPlayerByIDUseCase(context.Background(), playerId)
 
 		// Assert
 		assert.Error(t, err)
@@ -51,7 +52,7 @@ func TestFindPlayerByIDUseCase(t *testing.T) {
 		repo.On("FindByID", playerId).Return(domain.Player{}, nil)
 
 		// Act
-		player, status, err := service.FindPlayerByIDUseCase(playerId)
+		player, status, err := service.FindPlayerByIDUseCase(context.Background(), playerId)
 
 		// Assert
 		assert.NoError(t, err)
@@ -68,7 +69,7 @@ func TestFindPlayerByIDUseCase(t *testing.T) {
 		repo.On("FindByID", playerId).Return(domain.Player{}, expectedErr)
 
 		// Act
-		player, status, err := service.FindPlayerByIDUseCase(playerId)
+		player, status, err := service.FindPlayerByIDUseCase(context.Background(), playerId)
 
 		// Assert
 		assert.Error(t, err)
@@ -88,7 +89,7 @@ func TestFindPlayerByEmailUseCase(t *testing.T) {
 		repo.On("FindByEmail", email).Return(foundPlayer, nil)
 
 		// Act
-		player, status, err := service.FindPlayerByEmailUseCase(email)
+		player, status, err := service.FindPlayerByEmailUseCase(context.Background(), email)
 
 		// Assert
 		assert.NoError(t, err)
@@ -104,7 +105,7 @@ func TestFindPlayerByEmailUseCase(t *testing.T) {
 		repo.On("FindByEmail", email).Return(domain.Player{}, nil)
 
 		// Act
-		player, status, err := service.FindPlayerByEmailUseCase(email)
+		player, status, err := service.FindPlayerByEmailUseCase(context.Background(), email)
 
 		// Assert
 		assert.NoError(t, err)
@@ -120,7 +121,7 @@ func TestFindPlayerByEmailUseCase(t *testing.T) {
 		expectedErr := domain.ValidateEmail(email)
 
 		// Act
-		player, status, err := service.FindPlayerByEmailUseCase(email)
+		player, status, err := service.FindPlayerByEmailUseCase(context.Background(), email)
 
 		// Assert
 		assert.Error(t, err)
@@ -138,7 +139,7 @@ func TestFindPlayerByEmailUseCase(t *testing.T) {
 		repo.On("FindByEmail", email).Return(domain.Player{}, repoErr)
 
 		// Act
-		player, status, err := service.FindPlayerByEmailUseCase(email)
+		player, status, err := service.FindPlayerByEmailUseCase(context.Background(), email)
 
 		// Assert
 		assert.Error(t, err)
@@ -159,7 +160,7 @@ func TestFindPlayersByLastNameUseCase(t *testing.T) {
 		repo.On("FindByLastName", lastName).Return(expectedPlayers, nil)
 
 		// Act
-		players, status, err := service.FindPlayersByLastNameUseCase(lastName)
+		players, status, err := service.FindPlayersByLastNameUseCase(context.Background(), lastName)
 
 		// Assert
 		assert.NoError(t, err)
@@ -177,7 +178,7 @@ func TestFindPlayersByLastNameUseCase(t *testing.T) {
 		repo.On("FindByLastName", lastName).Return(expectedPlayers, nil)
 
 		// Act
-		players, status, err := service.FindPlayersByLastNameUseCase(lastName)
+		players, status, err := service.FindPlayersByLastNameUseCase(context.Background(), lastName)
 
 		// Assert
 		assert.NoError(t, err)
@@ -193,7 +194,7 @@ func TestFindPlayersByLastNameUseCase(t *testing.T) {
 		expectedErr := domain.ValidateLastName(lastName)
 
 		// Act
-		players, status, err := service.FindPlayersByLastNameUseCase(lastName)
+		players, status, err := service.FindPlayersByLastNameUseCase(context.Background(), lastName)
 
 		// Assert
 		assert.Error(t, err)
@@ -214,7 +215,7 @@ func TestFindPlayersByLastNameUseCase(t *testing.T) {
 		repo.On("FindByLastName", lastName).Return([]domain.Player{}, expectedErr)
 
 		// Act
-		players, status, err := service.FindPlayersByLastNameUseCase(lastName)
+		players, status, err := service.FindPlayersByLastNameUseCase(context.Background(), lastName)
 
 		// Assert
 		assert.Error(t, err)
@@ -225,3 +226,44 @@ func TestFindPlayersByLastNameUseCase(t *testing.T) {
 		assert.Equal(t, []domain.Player{}, players)
 	})
 }
+
+func TestFindPlayerBySocialSecurityNumberUseCase(t *testing.T) {
+	t.Run("Player found", func(t *testing.T) {
+		// Arrange
+		repo := &mockPlayerRepository{}
+		service := NewFindPlayerUseCase(repo)
+		ssn := "20123456789"
+		foundPlayer := domain.Player{ID: "valid-id", SocialSecurityNumber: &ssn}
+		repo.On("FindBySocialSecurityNumber", ssn).Return(foundPlayer, nil)
+
+		// Act
+		player, status, err := service.FindPlayerBySocialSecurityNumberUseCase(context.Background(), ssn)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, FindPlayerFound, status)
+		assert.Equal(t, foundPlayer, player)
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		repo := &mockPlayerRepository{}
+		service := NewFindPlayerUseCase(repo)
+		repo.On("FindBySocialSecurityNumber", "20123456789").Return(domain.Player{}, nil)
+
+		_, status, err := service.FindPlayerBySocialSecurityNumberUseCase(context.Background(), "20123456789")
+
+		assert.NoError(t, err)
+		assert.Equal(t, FindPlayerNotFound, status)
+	})
+
+	t.Run("Invalid SSN", func(t *testing.T) {
+		repo := &mockPlayerRepository{}
+		service := NewFindPlayerUseCase(repo)
+
+		_, status, err := service.FindPlayerBySocialSecurityNumberUseCase(context.Background(), "123")
+
+		assert.EqualError(t, err, "invalid social security number: 123")
+		assert.Equal(t, FindPlayerInvalid, status)
+		repo.AssertNotCalled(t, "FindBySocialSecurityNumber", "123")
+	})
+}
@@ -0,0 +1,240 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+// Normalized header columns, i.e. lower case without spaces, underscores nor hyphens (e.g. "First Name").
+const (
+	emailColumn                = "email"
+	firstNameColumn            = "firstname"
+	lastNameColumn             = "lastname"
+	socialSecurityNumberColumn = "socialsecuritynumber"
+	ssnColumn                  = "ssn"
+	birthDateColumn            = "birthdate"
+	genderColumn               = "gender"
+	handednessColumn           = "handedness"
+	sideColumn                 = "side"
+	levelColumn                = "level"
+	clubColumn                 = "club"
+	// Ages are derived from birth dates, i.e. they're only checked against them (e.g. files of exports).
+	ageColumn = "age"
+)
+
+type ImportPlayersUseCase interface {
+	// ImportPlayersUseCase registers or updates (matching on email) the players of the rows, the first one being the
+	// header. Rows are handled as they're read, dry runs only report what would happen.
+	ImportPlayersUseCase(ctx context.Context, rows spreadsheet.Reader, dryRun bool) (domain.PlayerImportReport, ImportPlayersStatus, error)
+}
+
+type ImportPlayersStatus uint8
+
+const (
+	ImportPlayersPending ImportPlayersStatus = iota
+	ImportPlayersInvalid
+	ImportPlayersImported
+)
+
+// Implement the Stringer interface.
+func (s ImportPlayersStatus) String() string {
+	return [...]string{"ImportPlayersPending", "ImportPlayersInvalid", "ImportPlayersImported"}[s]
+}
+
+func NewImportPlayersUseCase(playerRepository domain.PlayerRepository) ImportPlayersUseCase {
+	return &playerService{playerRepo: playerRepository}
+}
+
+// ImportPlayersUseCase stops at the first repository (or unreadable file) error returning the report of the rows
+// handled so far, invalid rows are reported and skipped.
+func (s *playerService) ImportPlayersUseCase(ctx context.Context, rows spreadsheet.Reader, dryRun bool) (domain.PlayerImportReport,
+	ImportPlayersStatus, error) {
+	report := domain.PlayerImportReport{DryRun: dryRun, Rows: []domain.PlayerImportRow{}}
+	header, err := rows.Read()
+	if err == io.EOF {
+		return report, ImportPlayersInvalid, errors.New("missing header row")
+	}
+	if err != nil {
+		return report, ImportPlayersInvalid, err
+	}
+	columns, err := importColumns(header.Cells)
+	if err != nil {
+		return report, ImportPlayersInvalid, err
+	}
+	// Emails of the file seen on dry runs, telling whether they already exist, as nothing is saved.
+	seen := map[string]string{}
+	for {
+		row, err := rows.Read()
+		if err == io.EOF {
+			return report, ImportPlayersImported, nil
+		}
+		if err != nil {
+			return report, ImportPlayersInvalid, err
+		}
+		if isBlank(row.Cells) {
+			continue
+		}
+		player, err := columns.player(row.Cells)
+		if err != nil {
+			report.Add(domain.PlayerImportRow{Row: row.Number, Email: player.Email, Status: domain.ImportRowInvalid, Reason: err.Error()})
+			continue
+		}
+		var result domain.PlayerImportRow
+		if dryRun {
+			result, err = s.dryRunImport(ctx, player, seen)
+		} else {
+			result, err = s.importPlayer(ctx, player)
+		}
+		if err != nil {
+			return report, ImportPlayersPending, err
+		}
+		result.Row = row.Number
+		report.Add(result)
+	}
+}
+
+// importPlayer goes through RegisterPlayerUseCase, so imported players are validated and matched like registered ones.
+func (s *playerService) importPlayer(ctx context.Context, player domain.Player) (domain.PlayerImportRow, error) {
+	result := domain.PlayerImportRow{Email: player.Email}
+	newPlayer, status, err := s.RegisterPlayerUseCase(ctx, player)
+	switch status {
+	case RegisterPlayerInvalid:
+		result.Status, result.Reason = domain.ImportRowInvalid, err.Error()
+		return result, nil
+	case RegisterPlayerCreated:
+		result.Status = domain.ImportRowCreated
+	case RegisterPlayerUpdated:
+		result.Status = domain.ImportRowUpdated
+	default:
+		if err == nil {
+			err = fmt.Errorf("invalid status %d", status)
+		}
+		return result, err
+	}
+	result.PlayerID = newPlayer.ID
+	return result, nil
+}
+
+func (s *playerService) dryRunImport(ctx context.Context, player domain.Player, seen map[string]string) (domain.PlayerImportRow, error) {
+	result := domain.PlayerImportRow{Email: player.Email}
+	if _, err := domain.NewPlayer(player.Email, player.SocialSecurityNumber, player.FirstName, player.LastName, player.Profile); err != nil {
+		result.Status, result.Reason = domain.ImportRowInvalid, err.Error()
+		return result, nil
+	}
+	id, ok := seen[player.Email]
+	if !ok {
+		found, err := s.playerRepo.FindByEmail(ctx, player.Email)
+		if err != nil {
+			return result, err
+		}
+		id = found.ID
+	}
+	if len(id) > 0 || ok {
+		result.Status, result.PlayerID = domain.ImportRowUpdated, id
+	} else {
+		result.Status = domain.ImportRowCreated
+	}
+	seen[player.Email] = id
+	return result, nil
+}
+
+// importColumnIndexes holds the index of each column in the rows, -1 when missing.
+type importColumnIndexes struct {
+	email, firstName, lastName, socialSecurityNumber      int
+	birthDate, gender, handedness, side, level, club, age int
+}
+
+func importColumns(header []string) (importColumnIndexes, error) {
+	columns := importColumnIndexes{email: -1, firstName: -1, lastName: -1, socialSecurityNumber: -1,
+		birthDate: -1, gender: -1, handedness: -1, side: -1, level: -1, club: -1, age: -1}
+	for i, cell := range header {
+		name := strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(strings.TrimSpace(cell)))
+		switch name {
+		case emailColumn:
+			columns.email = i
+		case firstNameColumn:
+			columns.firstName = i
+		case lastNameColumn:
+			columns.lastName = i
+		case socialSecurityNumberColumn, ssnColumn:
+			columns.socialSecurityNumber = i
+		case birthDateColumn:
+			columns.birthDate = i
+		case genderColumn:
+			columns.gender = i
+		case handednessColumn:
+			columns.handedness = i
+		case sideColumn:
+			columns.side = i
+		case levelColumn:
+			columns.level = i
+		case clubColumn:
+			columns.club = i
+		case ageColumn:
+			columns.age = i
+		}
+	}
+	var missing []string
+	for _, required := range []struct {
+		name  string
+		index int
+	}{{emailColumn, columns.email}, {firstNameColumn, columns.firstName}, {lastNameColumn, columns.lastName}} {
+		if required.index < 0 {
+			missing = append(missing, required.name)
+		}
+	}
+	if len(missing) > 0 {
+		return columns, fmt.Errorf("missing header columns: %s", strings.Join(missing, ", "))
+	}
+	return columns, nil
+}
+
+// player maps the cells of a row, leaving its validation to NewPlayer.
+func (c importColumnIndexes) player(cells []string) (domain.Player, error) {
+	cell := func(index int) string {
+		if index < 0 || index >= len(cells) {
+			return ""
+		}
+		return strings.TrimSpace(cells[index])
+	}
+	player := domain.Player{Email: cell(c.email), FirstName: cell(c.firstName), LastName: cell(c.lastName)}
+	if ssn := cell(c.socialSecurityNumber); len(ssn) > 0 {
+		player.SocialSecurityNumber = &ssn
+	}
+	if level := cell(c.level); len(level) > 0 {
+		value, err := strconv.Atoi(level)
+		if err != nil {
+			return player, fmt.Errorf("invalid level: %s", level)
+		}
+		player.Level = domain.Level(value)
+	}
+	if age := cell(c.age); len(age) > 0 {
+		value, err := strconv.Atoi(age)
+		if err != nil {
+			return player, fmt.Errorf("invalid age: %s", age)
+		}
+		player.Age = &value
+	}
+	player.BirthDate = domain.BirthDate(cell(c.birthDate))
+	player.Gender = domain.Gender(strings.ToLower(cell(c.gender)))
+	player.Handedness = domain.Handedness(strings.ToLower(cell(c.handedness)))
+	player.Side = domain.Side(strings.ToLower(cell(c.side)))
+	player.Club = domain.Club(cell(c.club))
+	return player, nil
+}
+
+func isBlank(cells []string) bool {
+	for _, cell := range cells {
+		if len(strings.TrimSpace(cell)) > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const importFile = `Email,First Name,last_name,SSN,Birth Date,Side,Level
+john@example.com,John,Doe,,1990-05-17,Reves,5
+jane@example.com,Jane,Roe,12345678,,,
+
+bad-email,Jim,Poe,,,,
+jim@example.com,Jim,Poe,,old,,
+new@example.com,New,Player,,,,
+`
+
+func TestImportPlayersUseCase(t *testing.T) {
+	existing := domain.Player{ID: "player-1", Email: "jane@example.com", FirstName: "Jane", LastName: "Roe"}
+	repo := &mockPlayerRepository{}
+	repo.On("FindByEmail", "john@example.com").Return(domain.Player{}, nil)
+	repo.On("FindByEmail", "jane@example.com").Return(existing, nil)
+	repo.On("FindByEmail", "new@example.com").Return(domain.Player{}, nil)
+	repo.On("Upsert", mock.Anything).Return(nil)
+
+	report, status, err := NewImportPlayersUseCase(repo).ImportPlayersUseCase(context.Background(),
+		spreadsheet.NewCSVReader(strings.NewReader(importFile)), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ImportPlayersImported, status)
+	assert.Equal(t, domain.PlayerImportReport{Created: 2, Updated: 1, Invalid: 2, Rows: []domain.PlayerImportRow{
+		{Row: 2, Email: "john@example.com", Status: domain.ImportRowCreated, PlayerID: mockId},
+		{Row: 3, Email: "jane@example.com", Status: domain.ImportRowUpdated, PlayerID: "player-1"},
+		{Row: 5, Email: "bad-email", Status: domain.ImportRowInvalid, Reason: "invalid email: bad-email"},
+		{Row: 6, Email: "jim@example.com", Status: domain.ImportRowInvalid, Reason: "invalid birth date: old, expected 2006-01-02"},
+		{Row: 7, Email: "new@example.com", Status: domain.ImportRowCreated, PlayerID: mockId},
+	}}, report)
+	repo.AssertNumberOfCalls(t, "Upsert", 3)
+}
+
+func TestImportPlayersUseCase_DryRun(t *testing.T) {
+	repo := &mockPlayerRepository{}
+	repo.On("FindByEmail", "john@example.com").Return(domain.Player{}, nil)
+	repo.On("FindByEmail", "jane@example.com").Return(domain.Player{ID: "player-1"}, nil)
+	rows := "email,firstName,lastName\njohn@example.com,John,Doe\njane@example.com,Jane,Roe\njohn@example.com,Johnny,Doe\n"
+
+	report, status, err := NewImportPlayersUseCase(repo).ImportPlayersUseCase(context.Background(),
+		spreadsheet.NewCSVReader(strings.NewReader(rows)), true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ImportPlayersImported, status)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, []string{domain.ImportRowCreated, domain.ImportRowUpdated, domain.ImportRowUpdated},
+		[]string{report.Rows[0].Status, report.Rows[1].Status, report.Rows[2].Status},
+		"Expected repeated emails to update the player created by a previous row")
+	repo.AssertNumberOfCalls(t, "FindByEmail", 2)
+	repo.AssertNotCalled(t, "Upsert", mock.Anything)
+}
+
+func TestImportPlayersUseCase_Invalid(t *testing.T) {
+	useCase := NewImportPlayersUseCase(&mockPlayerRepository{})
+
+	_, status, err := useCase.ImportPlayersUseCase(context.Background(), spreadsheet.NewCSVReader(strings.NewReader("")), false)
+	assert.Error(t, err)
+	assert.Equal(t, ImportPlayersInvalid, status)
+
+	_, status, err = useCase.ImportPlayersUseCase(context.Background(), spreadsheet.NewCSVReader(strings.NewReader("email,birthDate\n")), false)
+	assert.EqualError(t, err, "missing header columns: firstname, lastname")
+	assert.Equal(t, ImportPlayersInvalid, status)
+}
+
+func TestImportPlayersUseCase_Ages(t *testing.T) {
+	repo := &mockPlayerRepository{}
+	repo.On("FindByEmail", mock.Anything).Return(domain.Player{}, nil)
+	today := time.Now().UTC()
+	birthDate := domain.NewBirthDate(time.Date(today.Year()-30, today.Month(), today.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1))
+	rows := "email,firstName,lastName,birthDate,age\n" +
+		"john@example.com,John,Doe," + string(birthDate) + ",30\n" +
+		"jane@example.com,Jane,Roe,,30\n" +
+		"joe@example.com,Joe,Poe," + string(birthDate) + ",31\n"
+
+	report, status, err := NewImportPlayersUseCase(repo).ImportPlayersUseCase(context.Background(),
+		spreadsheet.NewCSVReader(strings.NewReader(rows)), true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ImportPlayersImported, status)
+	assert.Equal(t, domain.ImportRowCreated, report.Rows[0].Status, "Expected the age derived from the birth date accepted")
+	for _, row := range report.Rows[1:] {
+		assert.Equal(t, domain.ImportRowInvalid, row.Status, "Expected %s rejected", row.Email)
+		assert.Contains(t, row.Reason, "ages are derived from birthDate")
+	}
+}
+
+func TestImportPlayersUseCase_Pending(t *testing.T) {
+	repo := &mockPlayerRepository{}
+	repo.On("FindByEmail", "john@example.com").Return(domain.Player{}, nil)
+	repo.On("FindByEmail", "jane@example.com").Return(domain.Player{}, errors.New("db error"))
+	repo.On("Upsert", mock.Anything).Return(nil)
+	rows := "email,firstName,lastName\njohn@example.com,John,Doe\njane@example.com,Jane,Roe\nnew@example.com,New,Player\n"
+
+	report, status, err := NewImportPlayersUseCase(repo).ImportPlayersUseCase(context.Background(),
+		spreadsheet.NewCSVReader(strings.NewReader(rows)), false)
+
+	assert.Error(t, err)
+	assert.Equal(t, ImportPlayersPending, status)
+	assert.Equal(t, 1, report.Created, "Expected the report of the rows imported before the error")
+	assert.Len(t, report.Rows, 1)
+}
@@ -0,0 +1,208 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/metrics"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/common/tracing"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Decorators recording the outcome of every use case execution, i.e. each status value is a metric label
+// and a span attribute so use case logic stays free of observability concerns.
+
+// instrument starts the use case span (child of the request one) and returns the function ending it.
+func instrument(ctx context.Context, useCase string) (context.Context, func(status fmt.Stringer, err error)) {
+	start := time.Now()
+	ctx, span := tracing.Tracer().Start(ctx, useCase)
+	return ctx, func(status fmt.Stringer, err error) {
+		metrics.ObserveUseCase(useCase, status.String(), start)
+		span.SetAttributes(attribute.String("use_case.status", status.String()))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+type instrumentedRegisterPlayerUseCase struct {
+	next RegisterPlayerUseCase
+}
+
+func NewInstrumentedRegisterPlayerUseCase(next RegisterPlayerUseCase) RegisterPlayerUseCase {
+	return &instrumentedRegisterPlayerUseCase{next: next}
+}
+
+func (u *instrumentedRegisterPlayerUseCase) RegisterPlayerUseCase(ctx context.Context, inputPlayer domain.Player) (domain.Player, RegisterPlayerStatus, error) {
+	ctx, end := instrument(ctx, "RegisterPlayerUseCase")
+	newPlayer, status, err := u.next.RegisterPlayerUseCase(ctx, inputPlayer)
+	end(status, err)
+	return newPlayer, status, err
+}
+
+type instrumentedUnregisterPlayerUseCase struct {
+	next UnregisterPlayerUseCase
+}
+
+func NewInstrumentedUnregisterPlayerUseCase(next UnregisterPlayerUseCase) UnregisterPlayerUseCase {
+	return &instrumentedUnregisterPlayerUseCase{next: next}
+}
+
+func (u *instrumentedUnregisterPlayerUseCase) UnregisterPlayerUseCase(ctx context.Context, playerId string) (UnregisterPlayerStatus, error) {
+	ctx, end := instrument(ctx, "UnregisterPlayerUseCase")
+	status, err := u.next.UnregisterPlayerUseCase(ctx, playerId)
+	end(status, err)
+	return status, err
+}
+
+type instrumentedFindPlayerUseCase struct {
+	next FindPlayerUseCase
+}
+
+func NewInstrumentedFindPlayerUseCase(next FindPlayerUseCase) FindPlayerUseCase {
+	return &instrumentedFindPlayerUseCase{next: next}
+}
+
+func (u *instrumentedFindPlayerUseCase) FindPlayerByIDUseCase(ctx context.Context, playerId string) (domain.Player, FindPlayerStatus, error) {
+	ctx, end := instrument(ctx, "FindPlayerByIDUseCase")
+	player, status, err := u.next.FindPlayerByIDUseCase(ctx, playerId)
+	end(status, err)
+	return player, status, err
+}
+
+func (u *instrumentedFindPlayerUseCase) FindPlayerByEmailUseCase(ctx context.Context, email string) (domain.Player, FindPlayerStatus, error) {
+	ctx, end := instrument(ctx, "FindPlayerByEmailUseCase")
+	player, status, err := u.next.FindPlayerByEmailUseCase(ctx, email)
+	end(status, err)
+	return player, status, err
+}
+
+func (u *instrumentedFindPlayerUseCase) FindPlayersByLastNameUseCase(ctx context.Context, lastName string) ([]domain.Player, FindPlayerStatus, error) {
+	ctx, end := instrument(ctx, "FindPlayersByLastNameUseCase")
+	players, status, err := u.next.FindPlayersByLastNameUseCase(ctx, lastName)
+	end(status, err)
+	return players, status, err
+}
+
+func (u *instrumentedFindPlayerUseCase) FindPlayerBySocialSecurityNumberUseCase(ctx context.Context, socialSecurityNumber string) (domain.Player, FindPlayerStatus, error) {
+	ctx, end := instrument(ctx, "FindPlayerBySocialSecurityNumberUseCase")
+	player, status, err := u.next.FindPlayerBySocialSecurityNumberUseCase(ctx, socialSecurityNumber)
+	end(status, err)
+	return player, status, err
+}
+
+type instrumentedImportPlayersUseCase struct {
+	next ImportPlayersUseCase
+}
+
+func NewInstrumentedImportPlayersUseCase(next ImportPlayersUseCase) ImportPlayersUseCase {
+	return &instrumentedImportPlayersUseCase{next: next}
+}
+
+func (u *instrumentedImportPlayersUseCase) ImportPlayersUseCase(ctx context.Context, rows spreadsheet.Reader, dryRun bool) (domain.PlayerImportReport, ImportPlayersStatus, error) {
+	ctx, end := instrument(ctx, "ImportPlayersUseCase")
+	report, status, err := u.next.ImportPlayersUseCase(ctx, rows, dryRun)
+	end(status, err)
+	return report, status, err
+}
+
+type instrumentedExportUseCase struct {
+	next ExportUseCase
+}
+
+func NewInstrumentedExportUseCase(next ExportUseCase) ExportUseCase {
+	return &instrumentedExportUseCase{next: next}
+}
+
+func (u *instrumentedExportUseCase) ExportPlayersUseCase(ctx context.Context, filter domain.ExportFilter, rows spreadsheet.Writer) (ExportStatus, error) {
+	ctx, end := instrument(ctx, "ExportPlayersUseCase")
+	status, err := u.next.ExportPlayersUseCase(ctx, filter, rows)
+	end(status, err)
+	return status, err
+}
+
+func (u *instrumentedExportUseCase) ExportPlayerCouplesUseCase(ctx context.Context, filter domain.ExportFilter, rows spreadsheet.Writer) (ExportStatus, error) {
+	ctx, end := instrument(ctx, "ExportPlayerCouplesUseCase")
+	status, err := u.next.ExportPlayerCouplesUseCase(ctx, filter, rows)
+	end(status, err)
+	return status, err
+}
+
+type instrumentedPlayerDataUseCase struct {
+	next PlayerDataUseCase
+}
+
+func NewInstrumentedPlayerDataUseCase(next PlayerDataUseCase) PlayerDataUseCase {
+	return &instrumentedPlayerDataUseCase{next: next}
+}
+
+func (u *instrumentedPlayerDataUseCase) ExportPlayerDataUseCase(ctx context.Context, playerId string) (domain.PlayerDataExport, PlayerDataStatus, error) {
+	ctx, end := instrument(ctx, "ExportPlayerDataUseCase")
+	export, status, err := u.next.ExportPlayerDataUseCase(ctx, playerId)
+	end(status, err)
+	return export, status, err
+}
+
+func (u *instrumentedPlayerDataUseCase) ErasePlayerDataUseCase(ctx context.Context, playerId string) (PlayerDataStatus, error) {
+	ctx, end := instrument(ctx, "ErasePlayerDataUseCase")
+	status, err := u.next.ErasePlayerDataUseCase(ctx, playerId)
+	end(status, err)
+	return status, err
+}
+
+type instrumentedRegisterPlayerCoupleUseCase struct {
+	next RegisterPlayerCoupleUseCase
+}
+
+func NewInstrumentedRegisterPlayerCoupleUseCase(next RegisterPlayerCoupleUseCase) RegisterPlayerCoupleUseCase {
+	return &instrumentedRegisterPlayerCoupleUseCase{next: next}
+}
+
+func (u *instrumentedRegisterPlayerCoupleUseCase) RegisterPlayerCoupleUseCase(ctx context.Context, player1Id, player2Id string, ranking *int) (domain.PlayerCouple, RegisterPlayerCoupleStatus, error) {
+	ctx, end := instrument(ctx, "RegisterPlayerCoupleUseCase")
+	playerCouple, status, err := u.next.RegisterPlayerCoupleUseCase(ctx, player1Id, player2Id, ranking)
+	end(status, err)
+	return playerCouple, status, err
+}
+
+type instrumentedFindPlayerCoupleUseCase struct {
+	next FindPlayerCoupleUseCase
+}
+
+func NewInstrumentedFindPlayerCoupleUseCase(next FindPlayerCoupleUseCase) FindPlayerCoupleUseCase {
+	return &instrumentedFindPlayerCoupleUseCase{next: next}
+}
+
+func (u *instrumentedFindPlayerCoupleUseCase) FindPlayerCoupleByIDUseCase(ctx context.Context, playerCoupleId string) (domain.PlayerCouple, FindPlayerCoupleStatus, error) {
+	ctx, end := instrument(ctx, "FindPlayerCoupleByIDUseCase")
+	playerCouple, status, err := u.next.FindPlayerCoupleByIDUseCase(ctx, playerCoupleId)
+	end(status, err)
+	return playerCouple, status, err
+}
+
+func (u *instrumentedFindPlayerCoupleUseCase) FindPlayerCouplesByPlayerIDUseCase(ctx context.Context, playerId string) ([]domain.PlayerCouple, FindPlayerCoupleStatus, error) {
+	ctx, end := instrument(ctx, "FindPlayerCouplesByPlayerIDUseCase")
+	playerCouples, status, err := u.next.FindPlayerCouplesByPlayerIDUseCase(ctx, playerId)
+	end(status, err)
+	return playerCouples, status, err
+}
+
+func (u *instrumentedFindPlayerCoupleUseCase) FindPlayerCouplesByPlayersUseCase(ctx context.Context, player1Id, player2Id string) ([]domain.PlayerCouple, FindPlayerCoupleStatus, error) {
+	ctx, end := instrument(ctx, "FindPlayerCouplesByPlayersUseCase")
+	playerCouples, status, err := u.next.FindPlayerCouplesByPlayersUseCase(ctx, player1Id, player2Id)
+	end(status, err)
+	return playerCouples, status, err
+}
+
+func (u *instrumentedFindPlayerCoupleUseCase) FindPlayerCouplesByLastNamesUseCase(ctx context.Context, lastName1, lastName2 string) ([]domain.PlayerCouple, FindPlayerCoupleStatus, error) {
+	ctx, end := instrument(ctx, "FindPlayerCouplesByLastNamesUseCase")
+	playerCouples, status, err := u.next.FindPlayerCouplesByLastNamesUseCase(ctx, lastName1, lastName2)
+	end(status, err)
+	return playerCouples, status, err
+}
@@ -0,0 +1,153 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInstrumentedRegisterPlayerUseCase(t *testing.T) {
+	// Arrange
+	repo := &mockPlayerRepository{}
+	useCase := NewInstrumentedRegisterPlayerUseCase(NewRegisterPlayerUseCase(repo))
+	inputPlayer := domain.Player{FirstName: "John", LastName: "Doe", Email: "test@example.com"}
+	repo.On("FindByEmail", inputPlayer.Email).Return(domain.Player{}, nil)
+	repo.On("Upsert", mock.Anything).Return(nil)
+
+	// Act
+	newPlayer, status, err := useCase.RegisterPlayerUseCase(context.Background(), inputPlayer)
+
+	// Assert: decorator is transparent.
+	assert.NoError(t, err)
+	assert.Equal(t, RegisterPlayerCreated, status)
+	assert.Equal(t, mockId, newPlayer.ID)
+}
+
+func TestInstrumentedUnregisterPlayerUseCase(t *testing.T) {
+	// Arrange
+	repo := &mockPlayerRepository{}
+	useCase := NewInstrumentedUnregisterPlayerUseCase(NewUnregisterPlayerUseCase(repo))
+	repo.On("FindByID", "not-found-id").Return(domain.Player{}, nil)
+
+	// Act
+	status, err := useCase.UnregisterPlayerUseCase(context.Background(), "not-found-id")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, UnregisterPlayerNotFound, status)
+}
+
+func TestInstrumentedFindPlayerUseCase(t *testing.T) {
+	repo := &mockPlayerRepository{}
+	useCase := NewInstrumentedFindPlayerUseCase(NewFindPlayerUseCase(repo))
+
+	t.Run("by ID", func(t *testing.T) {
+		repo.On("FindByID", "valid-id").Return(domain.Player{ID: "valid-id"}, nil)
+		player, status, err := useCase.FindPlayerByIDUseCase(context.Background(), "valid-id")
+		assert.NoError(t, err)
+		assert.Equal(t, FindPlayerFound, status)
+		assert.Equal(t, "valid-id", player.ID)
+	})
+
+	t.Run("by email", func(t *testing.T) {
+		repo.On("FindByEmail", "test@example.com").Return(domain.Player{}, errors.New("repo error"))
+		_, status, err := useCase.FindPlayerByEmailUseCase(context.Background(), "test@example.com")
+		assert.Error(t, err)
+		assert.Equal(t, FindPlayerPending, status)
+	})
+
+	t.Run("by last name", func(t *testing.T) {
+		_, status, err := useCase.FindPlayersByLastNameUseCase(context.Background(), "D")
+		assert.Error(t, err)
+		assert.Equal(t, FindPlayerInvalid, status)
+	})
+}
+
+func TestInstrumentedPlayerDataUseCase(t *testing.T) {
+	repo := &mockPlayerRepository{}
+	useCase := NewInstrumentedPlayerDataUseCase(NewPlayerDataUseCase(repo, nil, nil))
+	repo.On("FindByID", "not-found-id").Return(domain.Player{}, nil)
+
+	t.Run("export", func(t *testing.T) {
+		_, status, err := useCase.ExportPlayerDataUseCase(context.Background(), "not-found-id")
+		assert.NoError(t, err)
+		assert.Equal(t, PlayerDataNotFound, status)
+	})
+
+	t.Run("erase", func(t *testing.T) {
+		status, err := useCase.ErasePlayerDataUseCase(context.Background(), "not-found-id")
+		assert.NoError(t, err)
+		assert.Equal(t, PlayerDataNotFound, status)
+	})
+}
+
+func TestInstrumentedImportPlayersUseCase(t *testing.T) {
+	useCase := NewInstrumentedImportPlayersUseCase(NewImportPlayersUseCase(&mockPlayerRepository{}))
+
+	report, status, err := useCase.ImportPlayersUseCase(context.Background(),
+		spreadsheet.NewCSVReader(strings.NewReader("email,firstName,lastName\nbad-email,John,Doe\n")), true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ImportPlayersImported, status)
+	assert.Equal(t, 1, report.Invalid)
+}
+
+func TestInstrumentedExportUseCase(t *testing.T) {
+	repo := &mockPlayerRepository{}
+	repo.On("ForEach", []string(nil)).Return([]domain.Player{}, nil)
+	coupleRepo := &mockPlayerCoupleRepository{}
+	coupleRepo.On("ForEach", []string(nil)).Return([]domain.PlayerCouple{}, nil)
+	useCase := NewInstrumentedExportUseCase(NewExportUseCase(repo, coupleRepo, nil))
+	players, _ := spreadsheet.NewWriter(spreadsheet.FormatJSONL, io.Discard)
+	couples, _ := spreadsheet.NewWriter(spreadsheet.FormatJSONL, io.Discard)
+
+	status, err := useCase.ExportPlayersUseCase(context.Background(), domain.ExportFilter{}, players)
+	assert.NoError(t, err)
+	assert.Equal(t, ExportExported, status)
+
+	status, err = useCase.ExportPlayerCouplesUseCase(context.Background(), domain.ExportFilter{}, couples)
+	assert.NoError(t, err)
+	assert.Equal(t, ExportExported, status)
+}
+
+func TestInstrumentedUseCase_Span(t *testing.T) {
+	// Arrange
+	recorder := tracetest.NewSpanRecorder()
+	originalProvider := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(originalProvider)
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	repo := &mockPlayerRepository{}
+	useCase := NewInstrumentedUnregisterPlayerUseCase(NewUnregisterPlayerUseCase(repo))
+	repo.On("FindByID", "error-id").Return(domain.Player{}, errors.New("repo error"))
+
+	// Act
+	_, err := useCase.UnregisterPlayerUseCase(context.Background(), "error-id")
+
+	// Assert
+	assert.Error(t, err)
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "UnregisterPlayerUseCase", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "UnregisterPlayerPending", spans[0].Attributes()[0].Value.AsString())
+}
+
+func TestUseCaseStatus_String(t *testing.T) {
+	assert.Equal(t, "RegisterPlayerCreated", RegisterPlayerCreated.String())
+	assert.Equal(t, "FindPlayerNotFound", FindPlayerNotFound.String())
+	assert.Equal(t, "UnregisterPlayerDeleted", UnregisterPlayerDeleted.String())
+	assert.Equal(t, "PlayerDataErased", PlayerDataErased.String())
+	assert.Equal(t, "ImportPlayersImported", ImportPlayersImported.String())
+}
@@ -5,3 +5,8 @@ import "github.com/paguerre3/goddd/internal/modules/player-couple/domain"
 type playerService struct {
 	playerRepo domain.PlayerRepository
 }
+
+type playerCoupleService struct {
+	playerRepo       domain.PlayerRepository
+	playerCoupleRepo domain.PlayerCoupleRepository
+}
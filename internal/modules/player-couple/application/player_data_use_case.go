@@ -0,0 +1,119 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+// PlayerDataUseCase implements the data subject rights of players, i.e. access/portability (export)
+// and erasure (anonymization).
+type PlayerDataUseCase interface {
+	ExportPlayerDataUseCase(ctx context.Context, playerId string) (domain.PlayerDataExport, PlayerDataStatus, error)
+	ErasePlayerDataUseCase(ctx context.Context, playerId string) (PlayerDataStatus, error)
+}
+
+// PersonalDataEraser erases personal data of a player held by other modules (e.g. accounts).
+type PersonalDataEraser interface {
+	ErasePlayerData(ctx context.Context, playerId string) error
+}
+
+type PlayerDataStatus uint8
+
+const (
+	PlayerDataPending PlayerDataStatus = iota
+	PlayerDataInvalid
+	PlayerDataNotFound
+	PlayerDataExported
+	PlayerDataErased
+)
+
+// Implement the Stringer interface.
+func (s PlayerDataStatus) String() string {
+	return [...]string{"PlayerDataPending", "PlayerDataInvalid", "PlayerDataNotFound", "PlayerDataExported", "PlayerDataErased"}[s]
+}
+
+type playerDataService struct {
+	playerRepo        domain.PlayerRepository
+	playerCoupleRepo  domain.PlayerCoupleRepository
+	tournamentHistory domain.TournamentHistory
+	erasers           []PersonalDataEraser
+	now               func() time.Time
+}
+
+func NewPlayerDataUseCase(playerRepository domain.PlayerRepository, playerCoupleRepository domain.PlayerCoupleRepository,
+	tournamentHistory domain.TournamentHistory, erasers ...PersonalDataEraser) PlayerDataUseCase {
+	return &playerDataService{
+		playerRepo:        playerRepository,
+		playerCoupleRepo:  playerCoupleRepository,
+		tournamentHistory: tournamentHistory,
+		erasers:           erasers,
+		now:               time.Now,
+	}
+}
+
+func (s *playerDataService) ExportPlayerDataUseCase(ctx context.Context, playerId string) (export domain.PlayerDataExport, status PlayerDataStatus, err error) {
+	player, status, err := s.findPlayer(ctx, playerId)
+	if err != nil || status == PlayerDataNotFound {
+		return export, status, err
+	}
+	couples, err := s.playerCoupleRepo.FindByPlayerID(ctx, playerId)
+	if err != nil {
+		return export, status, err
+	}
+	participations, matches, err := s.tournamentHistory.FindByPlayerID(ctx, playerId)
+	if err != nil {
+		return export, status, err
+	}
+	export = domain.PlayerDataExport{
+		Player:      player,
+		Couples:     couples,
+		Tournaments: participations,
+		Matches:     matches,
+		ExportedAt:  s.now().UTC(),
+	}
+	return export, PlayerDataExported, nil
+}
+
+// ErasePlayerDataUseCase anonymizes the player and its embedded copies keeping IDs, so couples, draws and
+// match results stay consistent. It's idempotent, i.e. a failed erasure is completed by running it again.
+func (s *playerDataService) ErasePlayerDataUseCase(ctx context.Context, playerId string) (status PlayerDataStatus, err error) {
+	player, status, err := s.findPlayer(ctx, playerId)
+	if err != nil || status == PlayerDataNotFound {
+		return status, err
+	}
+	if !player.IsErased() {
+		player.Anonymize(s.now().UTC())
+	}
+	// Copies go first so the player is only marked as erased once nothing else is left.
+	if err = s.playerCoupleRepo.ReplacePlayer(ctx, player); err != nil {
+		return status, err
+	}
+	if err = s.tournamentHistory.ReplacePlayer(ctx, player); err != nil {
+		return status, err
+	}
+	for _, eraser := range s.erasers {
+		if err = eraser.ErasePlayerData(ctx, playerId); err != nil {
+			return status, err
+		}
+	}
+	if err = s.playerRepo.Upsert(ctx, &player); err != nil {
+		return status, err
+	}
+	return PlayerDataErased, nil
+}
+
+func (s *playerDataService) findPlayer(ctx context.Context, playerId string) (domain.Player, PlayerDataStatus, error) {
+	if err := domain.ValidateID(playerId); err != nil {
+		return domain.Player{}, PlayerDataInvalid, err
+	}
+	player, err := s.playerRepo.FindByID(ctx, playerId)
+	if err != nil {
+		return player, PlayerDataPending, err
+	}
+	if len(player.ID) == 0 {
+		return player, PlayerDataNotFound, nil
+	}
+	return player, PlayerDataPending, nil
+}
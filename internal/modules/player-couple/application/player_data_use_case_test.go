@@ -0,0 +1,198 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPlayerCoupleRepository struct {
+	mock.Mock
+}
+
+func (m *mockPlayerCoupleRepository) Upsert(_ context.Context, playerCouple *domain.PlayerCouple) error {
+	return m.Called(playerCouple).Error(0)
+}
+
+func (m *mockPlayerCoupleRepository) FindByID(_ context.Context, id string) (domain.PlayerCouple, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.PlayerCouple), args.Error(1)
+}
+
+func (m *mockPlayerCoupleRepository) FindByPlayers(_ context.Context, player1IDs, player2IDs []string) ([]domain.PlayerCouple, error) {
+	args := m.Called(player1IDs, player2IDs)
+	return args.Get(0).([]domain.PlayerCouple), args.Error(1)
+}
+
+func (m *mockPlayerCoupleRepository) FindByPlayerID(_ context.Context, playerID string) ([]domain.PlayerCouple, error) {
+	args := m.Called(playerID)
+	return args.Get(0).([]domain.PlayerCouple), args.Error(1)
+}
+
+func (m *mockPlayerCoupleRepository) ReplacePlayer(_ context.Context, player domain.Player) error {
+	return m.Called(player).Error(0)
+}
+
+// ForEach streams the couples returned by the expectation.
+func (m *mockPlayerCoupleRepository) ForEach(_ context.Context, ids []string, fn func(domain.PlayerCouple) error) error {
+	args := m.Called(ids)
+	for _, playerCouple := range args.Get(0).([]domain.PlayerCouple) {
+		if err := fn(playerCouple); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *mockPlayerCoupleRepository) Delete(_ context.Context, id string) error {
+	return m.Called(id).Error(0)
+}
+
+type mockTournamentHistory struct {
+	mock.Mock
+}
+
+func (m *mockTournamentHistory) FindByPlayerID(_ context.Context, playerID string) ([]domain.TournamentParticipation, []domain.MatchRecord, error) {
+	args := m.Called(playerID)
+	return args.Get(0).([]domain.TournamentParticipation), args.Get(1).([]domain.MatchRecord), args.Error(2)
+}
+
+func (m *mockTournamentHistory) ReplacePlayer(_ context.Context, player domain.Player) error {
+	return m.Called(player).Error(0)
+}
+
+func (m *mockTournamentHistory) FindParticipants(_ context.Context, filter domain.ExportFilter) (domain.Participants, error) {
+	args := m.Called(filter)
+	return args.Get(0).(domain.Participants), args.Error(1)
+}
+
+type mockPersonalDataEraser struct {
+	mock.Mock
+}
+
+func (m *mockPersonalDataEraser) ErasePlayerData(_ context.Context, playerId string) error {
+	return m.Called(playerId).Error(0)
+}
+
+var testNow = time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestPlayerDataService(playerRepo *mockPlayerRepository, coupleRepo *mockPlayerCoupleRepository,
+	history *mockTournamentHistory, erasers ...PersonalDataEraser) PlayerDataUseCase {
+	service := NewPlayerDataUseCase(playerRepo, coupleRepo, history, erasers...).(*playerDataService)
+	service.now = func() time.Time { return testNow }
+	return service
+}
+
+func TestExportPlayerDataUseCase(t *testing.T) {
+	ssn := "20123456789"
+	player := domain.Player{ID: "valid-id", Email: "agus.tapia@gmail.com", SocialSecurityNumber: &ssn, FirstName: "Agustin", LastName: "Tapia"}
+	couples := []domain.PlayerCouple{{ID: "c1", Player1: player, Player2: domain.Player{ID: "other-id"}}}
+	participations := []domain.TournamentParticipation{{TournamentID: "t1", CoupleID: "c1"}}
+	matches := []domain.MatchRecord{{TournamentID: "t1", MatchID: "m1", CoupleID: "c1", OpponentCoupleID: "c2"}}
+
+	t.Run("Exported", func(t *testing.T) {
+		playerRepo, coupleRepo, history := &mockPlayerRepository{}, &mockPlayerCoupleRepository{}, &mockTournamentHistory{}
+		playerRepo.On("FindByID", player.ID).Return(player, nil)
+		coupleRepo.On("FindByPlayerID", player.ID).Return(couples, nil)
+		history.On("FindByPlayerID", player.ID).Return(participations, matches, nil)
+
+		export, status, err := newTestPlayerDataService(playerRepo, coupleRepo, history).ExportPlayerDataUseCase(context.Background(), player.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, PlayerDataExported, status)
+		assert.Equal(t, domain.PlayerDataExport{Player: player, Couples: couples, Tournaments: participations, Matches: matches, ExportedAt: testNow}, export)
+	})
+
+	t.Run("Invalid player ID", func(t *testing.T) {
+		_, status, err := newTestPlayerDataService(&mockPlayerRepository{}, nil, nil).ExportPlayerDataUseCase(context.Background(), "i")
+		assert.Error(t, err)
+		assert.Equal(t, PlayerDataInvalid, status)
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		playerRepo := &mockPlayerRepository{}
+		playerRepo.On("FindByID", "not-found-id").Return(domain.Player{}, nil)
+
+		_, status, err := newTestPlayerDataService(playerRepo, nil, nil).ExportPlayerDataUseCase(context.Background(), "not-found-id")
+		assert.NoError(t, err)
+		assert.Equal(t, PlayerDataNotFound, status)
+	})
+
+	t.Run("Error finding tournament history", func(t *testing.T) {
+		playerRepo, coupleRepo, history := &mockPlayerRepository{}, &mockPlayerCoupleRepository{}, &mockTournamentHistory{}
+		expectedErr := errors.New("error finding tournaments")
+		playerRepo.On("FindByID", player.ID).Return(player, nil)
+		coupleRepo.On("FindByPlayerID", player.ID).Return(couples, nil)
+		history.On("FindByPlayerID", player.ID).Return([]domain.TournamentParticipation(nil), []domain.MatchRecord(nil), expectedErr)
+
+		_, status, err := newTestPlayerDataService(playerRepo, coupleRepo, history).ExportPlayerDataUseCase(context.Background(), player.ID)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, PlayerDataPending, status)
+	})
+}
+
+func TestErasePlayerDataUseCase(t *testing.T) {
+	ssn := "20123456789"
+	player := domain.Player{ID: "valid-id", Email: "agus.tapia@gmail.com", SocialSecurityNumber: &ssn, FirstName: "Agustin", LastName: "Tapia"}
+	erased := domain.Player{ID: player.ID, Email: "erased-valid-id@erased.invalid", FirstName: "Erased", LastName: "Player", ErasedAt: &testNow}
+
+	t.Run("Erased", func(t *testing.T) {
+		playerRepo, coupleRepo, history, eraser := &mockPlayerRepository{}, &mockPlayerCoupleRepository{}, &mockTournamentHistory{}, &mockPersonalDataEraser{}
+		playerRepo.On("FindByID", player.ID).Return(player, nil)
+		coupleRepo.On("ReplacePlayer", erased).Return(nil)
+		history.On("ReplacePlayer", erased).Return(nil)
+		eraser.On("ErasePlayerData", player.ID).Return(nil)
+		playerRepo.On("Upsert", &erased).Return(nil)
+
+		status, err := newTestPlayerDataService(playerRepo, coupleRepo, history, eraser).ErasePlayerDataUseCase(context.Background(), player.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, PlayerDataErased, status)
+		playerRepo.AssertExpectations(t)
+		coupleRepo.AssertExpectations(t)
+		history.AssertExpectations(t)
+		eraser.AssertExpectations(t)
+	})
+
+	t.Run("Already erased keeps the erasure date", func(t *testing.T) {
+		erasedBefore := erased
+		before := testNow.Add(-time.Hour)
+		erasedBefore.ErasedAt = &before
+		playerRepo, coupleRepo, history := &mockPlayerRepository{}, &mockPlayerCoupleRepository{}, &mockTournamentHistory{}
+		playerRepo.On("FindByID", player.ID).Return(erasedBefore, nil)
+		coupleRepo.On("ReplacePlayer", erasedBefore).Return(nil)
+		history.On("ReplacePlayer", erasedBefore).Return(nil)
+		playerRepo.On("Upsert", &erasedBefore).Return(nil)
+
+		status, err := newTestPlayerDataService(playerRepo, coupleRepo, history).ErasePlayerDataUseCase(context.Background(), player.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, PlayerDataErased, status)
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		playerRepo := &mockPlayerRepository{}
+		playerRepo.On("FindByID", "not-found-id").Return(domain.Player{}, nil)
+
+		status, err := newTestPlayerDataService(playerRepo, nil, nil).ErasePlayerDataUseCase(context.Background(), "not-found-id")
+		assert.NoError(t, err)
+		assert.Equal(t, PlayerDataNotFound, status)
+	})
+
+	t.Run("Player kept when copies fail to be erased", func(t *testing.T) {
+		playerRepo, coupleRepo, history := &mockPlayerRepository{}, &mockPlayerCoupleRepository{}, &mockTournamentHistory{}
+		expectedErr := errors.New("error replacing player")
+		playerRepo.On("FindByID", player.ID).Return(player, nil)
+		coupleRepo.On("ReplacePlayer", erased).Return(nil)
+		history.On("ReplacePlayer", erased).Return(expectedErr)
+
+		status, err := newTestPlayerDataService(playerRepo, coupleRepo, history).ErasePlayerDataUseCase(context.Background(), player.ID)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, PlayerDataPending, status)
+		playerRepo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+}
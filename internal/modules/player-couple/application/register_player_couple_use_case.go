@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+type RegisterPlayerCoupleUseCase interface {
+	RegisterPlayerCoupleUseCase(ctx context.Context, player1Id, player2Id string, ranking *int) (domain.PlayerCouple, RegisterPlayerCoupleStatus, error)
+}
+
+type RegisterPlayerCoupleStatus uint8
+
+const (
+	RegisterPlayerCouplePending RegisterPlayerCoupleStatus = iota
+	RegisterPlayerCoupleInvalid
+	RegisterPlayerCouplePlayerNotFound
+	RegisterPlayerCoupleUpdated
+	RegisterPlayerCoupleCreated
+)
+
+// Implement the Stringer interface.
+func (s RegisterPlayerCoupleStatus) String() string {
+	return [...]string{"RegisterPlayerCouplePending", "RegisterPlayerCoupleInvalid", "RegisterPlayerCouplePlayerNotFound",
+		"RegisterPlayerCoupleUpdated", "RegisterPlayerCoupleCreated"}[s]
+}
+
+func NewRegisterPlayerCoupleUseCase(playerRepository domain.PlayerRepository, playerCoupleRepository domain.PlayerCoupleRepository) RegisterPlayerCoupleUseCase {
+	return &playerCoupleService{playerRepo: playerRepository, playerCoupleRepo: playerCoupleRepository}
+}
+
+// RegisterPlayerCoupleUseCase registers a couple of registered players or updates its ranking if both players
+// already play together.
+func (s *playerCoupleService) RegisterPlayerCoupleUseCase(ctx context.Context, player1Id, player2Id string,
+	ranking *int) (domain.PlayerCouple, RegisterPlayerCoupleStatus, error) {
+	players := make([]domain.Player, 0, 2)
+	for _, playerId := range []string{player1Id, player2Id} {
+		if err := domain.ValidateID(playerId); err != nil {
+			return domain.PlayerCouple{}, RegisterPlayerCoupleInvalid, err
+		}
+		player, err := s.playerRepo.FindByID(ctx, playerId)
+		if err != nil {
+			return domain.PlayerCouple{}, RegisterPlayerCouplePending, err
+		}
+		if len(player.ID) == 0 {
+			return domain.PlayerCouple{}, RegisterPlayerCouplePlayerNotFound, nil
+		}
+		if player.IsErased() {
+			return domain.PlayerCouple{}, RegisterPlayerCoupleInvalid, errors.New("erased players cannot be registered in couples")
+		}
+		players = append(players, player)
+	}
+	newPlayerCouple, err := domain.NewPlayerCouple(players[0], players[1], ranking)
+	if err != nil {
+		return domain.PlayerCouple{}, RegisterPlayerCoupleInvalid, err
+	}
+
+	// A couple is identified by its players regardless of their order.
+	couples, err := s.playerCoupleRepo.FindByPlayers(ctx, []string{player1Id}, []string{player2Id})
+	if err != nil {
+		return domain.PlayerCouple{}, RegisterPlayerCouplePending, err
+	}
+	status := RegisterPlayerCoupleCreated
+	if len(couples) > 0 {
+		newPlayerCouple.ID = couples[0].ID
+		status = RegisterPlayerCoupleUpdated
+	}
+	if err = s.playerCoupleRepo.Upsert(ctx, newPlayerCouple); err != nil {
+		return domain.PlayerCouple{}, RegisterPlayerCouplePending, err
+	}
+	return *newPlayerCouple, status, nil
+}
@@ -0,0 +1,116 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRegisterPlayerCoupleUseCase(t *testing.T) {
+	player1 := domain.Player{ID: "player-1", Email: "john.doe@example.com", FirstName: "John", LastName: "Doe"}
+	player2 := domain.Player{ID: "player-2", Email: "jane.roe@example.com", FirstName: "Jane", LastName: "Roe"}
+	ranking := 3
+
+	newRepos := func() (*mockPlayerRepository, *mockPlayerCoupleRepository) {
+		playerRepo := &mockPlayerRepository{}
+		playerRepo.On("FindByID", player1.ID).Return(player1, nil)
+		playerRepo.On("FindByID", player2.ID).Return(player2, nil)
+		return playerRepo, &mockPlayerCoupleRepository{}
+	}
+
+	t.Run("Created", func(t *testing.T) {
+		// Arrange
+		playerRepo, coupleRepo := newRepos()
+		coupleRepo.On("FindByPlayers", []string{player1.ID}, []string{player2.ID}).Return([]domain.PlayerCouple{}, nil)
+		coupleRepo.On("Upsert", mock.MatchedBy(func(c *domain.PlayerCouple) bool { return c.ID == "" })).Return(nil)
+		useCase := NewRegisterPlayerCoupleUseCase(playerRepo, coupleRepo)
+
+		// Act
+		playerCouple, status, err := useCase.RegisterPlayerCoupleUseCase(context.Background(), player1.ID, player2.ID, &ranking)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, RegisterPlayerCoupleCreated, status)
+		assert.Equal(t, player1, playerCouple.Player1)
+		assert.Equal(t, player2, playerCouple.Player2)
+		assert.Equal(t, &ranking, playerCouple.Ranking)
+	})
+
+	t.Run("Updated regardless of the players order", func(t *testing.T) {
+		// Arrange
+		playerRepo, coupleRepo := newRepos()
+		existing := domain.PlayerCouple{ID: "Roe-Doe-id", Player1: player2, Player2: player1}
+		coupleRepo.On("FindByPlayers", []string{player1.ID}, []string{player2.ID}).Return([]domain.PlayerCouple{existing}, nil)
+		coupleRepo.On("Upsert", mock.MatchedBy(func(c *domain.PlayerCouple) bool { return c.ID == existing.ID })).Return(nil)
+		useCase := NewRegisterPlayerCoupleUseCase(playerRepo, coupleRepo)
+
+		// Act
+		playerCouple, status, err := useCase.RegisterPlayerCoupleUseCase(context.Background(), player1.ID, player2.ID, &ranking)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, RegisterPlayerCoupleUpdated, status)
+		assert.Equal(t, existing.ID, playerCouple.ID)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		playerRepo, coupleRepo := newRepos()
+		useCase := NewRegisterPlayerCoupleUseCase(playerRepo, coupleRepo)
+
+		_, status, err := useCase.RegisterPlayerCoupleUseCase(context.Background(), "i", player2.ID, nil)
+		assert.Error(t, err)
+		assert.Equal(t, RegisterPlayerCoupleInvalid, status)
+
+		_, status, err = useCase.RegisterPlayerCoupleUseCase(context.Background(), player1.ID, player1.ID, nil)
+		assert.Error(t, err)
+		assert.Equal(t, RegisterPlayerCoupleInvalid, status)
+
+		invalidRanking := 9
+		_, status, err = useCase.RegisterPlayerCoupleUseCase(context.Background(), player1.ID, player2.ID, &invalidRanking)
+		assert.Error(t, err)
+		assert.Equal(t, RegisterPlayerCoupleInvalid, status)
+	})
+
+	t.Run("Erased player", func(t *testing.T) {
+		erased := player2
+		erased.Anonymize(time.Now())
+		playerRepo := &mockPlayerRepository{}
+		playerRepo.On("FindByID", player1.ID).Return(player1, nil)
+		playerRepo.On("FindByID", player2.ID).Return(erased, nil)
+		useCase := NewRegisterPlayerCoupleUseCase(playerRepo, &mockPlayerCoupleRepository{})
+
+		_, status, err := useCase.RegisterPlayerCoupleUseCase(context.Background(), player1.ID, player2.ID, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, RegisterPlayerCoupleInvalid, status)
+	})
+
+	t.Run("Player not found", func(t *testing.T) {
+		playerRepo := &mockPlayerRepository{}
+		playerRepo.On("FindByID", player1.ID).Return(domain.Player{}, nil)
+		useCase := NewRegisterPlayerCoupleUseCase(playerRepo, &mockPlayerCoupleRepository{})
+
+		_, status, err := useCase.RegisterPlayerCoupleUseCase(context.Background(), player1.ID, player2.ID, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, RegisterPlayerCouplePlayerNotFound, status)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		playerRepo, coupleRepo := newRepos()
+		expectedErr := errors.New("upsert error")
+		coupleRepo.On("FindByPlayers", []string{player1.ID}, []string{player2.ID}).Return([]domain.PlayerCouple{}, nil)
+		coupleRepo.On("Upsert", mock.Anything).Return(expectedErr)
+		useCase := NewRegisterPlayerCoupleUseCase(playerRepo, coupleRepo)
+
+		_, status, err := useCase.RegisterPlayerCoupleUseCase(context.Background(), player1.ID, player2.ID, nil)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, RegisterPlayerCouplePending, status)
+	})
+}
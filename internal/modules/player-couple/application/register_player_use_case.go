@@ -1,11 +1,13 @@
 package application
 
 import (
+	"context"
+
 	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
 )
 
 type RegisterPlayerUseCase interface {
-	RegisterPlayerUseCase(inputPlayer domain.Player) (newPlayer domain.Player, status RegisterPlayerStatus, err error)
+	RegisterPlayerUseCase(ctx context.Context, inputPlayer domain.Player) (newPlayer domain.Player, status RegisterPlayerStatus, err error)
 }
 
 type RegisterPlayerStatus uint8
@@ -17,26 +19,31 @@ const (
 	RegisterPlayerCreated
 )
 
+// Implement the Stringer interface.
+func (s RegisterPlayerStatus) String() string {
+	return [...]string{"RegisterPlayerPending", "RegisterPlayerInvalid", "RegisterPlayerUpdated", "RegisterPlayerCreated"}[s]
+}
+
 func NewRegisterPlayerUseCase(playerRepository domain.PlayerRepository) RegisterPlayerUseCase {
 	return &playerService{playerRepo: playerRepository}
 }
 
 // RegisterPlayerUseCase registers a player or updates it if it already exists.
-func (s *playerService) RegisterPlayerUseCase(inputPlayer domain.Player) (newPlayer domain.Player,
+func (s *playerService) RegisterPlayerUseCase(ctx context.Context, inputPlayer domain.Player) (newPlayer domain.Player,
 	status RegisterPlayerStatus, err error) {
 	// Validate new player entries.
 	newPlayerRef, err := domain.NewPlayer(inputPlayer.Email,
 		inputPlayer.SocialSecurityNumber,
 		inputPlayer.FirstName,
 		inputPlayer.LastName,
-		inputPlayer.Age)
+		inputPlayer.Profile)
 	if err != nil {
 		status = RegisterPlayerInvalid
 		return newPlayer, status, err
 	}
 
 	// Check if the player already exists.
-	foundPlayer, status, err := s.findByIDOrEmail(inputPlayer.ID, inputPlayer.Email)
+	foundPlayer, status, err := s.findByIDOrEmail(ctx, inputPlayer.ID, inputPlayer.Email)
 	if err != nil {
 		return newPlayer, status, err
 	}
@@ -51,7 +58,7 @@ func (s *playerService) RegisterPlayerUseCase(inputPlayer domain.Player) (newPla
 		status = RegisterPlayerCreated
 	}
 
-	err = s.playerRepo.Upsert(newPlayerRef)
+	err = s.playerRepo.Upsert(ctx, newPlayerRef)
 	if err != nil {
 		status = RegisterPlayerPending
 		return newPlayer, status, err
@@ -62,17 +69,17 @@ func (s *playerService) RegisterPlayerUseCase(inputPlayer domain.Player) (newPla
 }
 
 // FindByIDOrEmail returns a player found by ID or email.
-func (s *playerService) findByIDOrEmail(id, email string) (player domain.Player,
+func (s *playerService) findByIDOrEmail(ctx context.Context, id, email string) (player domain.Player,
 	status RegisterPlayerStatus, err error) {
 	if len(id) > 0 {
 		if err = domain.ValidateID(id); err != nil {
 			status = RegisterPlayerInvalid
 			return player, status, err
 		}
-		player, err = s.playerRepo.FindByID(id)
+		player, err = s.playerRepo.FindByID(ctx, id)
 	} else {
 		// Email validation is already done at the beginning of RegisterPlayerUseCase function.
-		player, err = s.playerRepo.FindByEmail(email)
+		player, err = s.playerRepo.FindByEmail(ctx, email)
 	}
 	return player, status, err
 }
@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -17,7 +18,7 @@ type mockPlayerRepository struct {
 	mock.Mock
 }
 
-func (m *mockPlayerRepository) Upsert(player *domain.Player) error {
+func (m *mockPlayerRepository) Upsert(_ context.Context, player *domain.Player) error {
 	args := m.Called(player)
 	if player.ID == "" {
 		idGen := mockIDGenerator{}
@@ -26,22 +27,38 @@ func (m *mockPlayerRepository) Upsert(player *domain.Player) error {
 	return args.Error(0)
 }
 
-func (m *mockPlayerRepository) FindByID(id string) (domain.Player, error) {
+func (m *mockPlayerRepository) FindByID(_ context.Context, id string) (domain.Player, error) {
 	args := m.Called(id)
 	return args.Get(0).(domain.Player), args.Error(1)
 }
 
-func (m *mockPlayerRepository) FindByEmail(email string) (domain.Player, error) {
+func (m *mockPlayerRepository) FindByEmail(_ context.Context, email string) (domain.Player, error) {
 	args := m.Called(email)
 	return args.Get(0).(domain.Player), args.Error(1)
 }
 
-func (m *mockPlayerRepository) FindByLastName(lastName string) ([]domain.Player, error) {
+func (m *mockPlayerRepository) FindByLastName(_ context.Context, lastName string) ([]domain.Player, error) {
 	args := m.Called(lastName)
 	return args.Get(0).([]domain.Player), args.Error(1)
 }
 
-func (m *mockPlayerRepository) Delete(id string) error {
+func (m *mockPlayerRepository) FindBySocialSecurityNumber(_ context.Context, socialSecurityNumber string) (domain.Player, error) {
+	args := m.Called(socialSecurityNumber)
+	return args.Get(0).(domain.Player), args.Error(1)
+}
+
+// ForEach streams the players returned by the expectation.
+func (m *mockPlayerRepository) ForEach(_ context.Context, ids []string, fn func(domain.Player) error) error {
+	args := m.Called(ids)
+	for _, player := range args.Get(0).([]domain.Player) {
+		if err := fn(player); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *mockPlayerRepository) Delete(_ context.Context, id string) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
@@ -85,7 +102,7 @@ func TestRegisterPlayerUseCase_Success(t *testing.T) {
 	}
 
 	// Act
-	newPlayer, status, err := service.RegisterPlayerUseCase(inputPlayer)
+	newPlayer, status, err := service.RegisterPlayerUseCase(context.Background(), inputPlayer)
 
 	// Assert
 	assert.NoError(t, err)
@@ -110,7 +127,7 @@ func TestRegisterPlayerUseCase_UpdateExistingPlayerByID(t *testing.T) {
 	expectedNewPlayer := inputPlayer
 
 	// Act
-	newPlayer, status, err := service.RegisterPlayerUseCase(inputPlayer)
+	newPlayer, status, err := service.RegisterPlayerUseCase(context.Background(), inputPlayer)
 
 	// Assert
 	assert.NoError(t, err)
@@ -135,7 +152,7 @@ func TestRegisterPlayerUseCase_UpdateExistingPlayerByIDValidationError(t *testin
 	var expectedNewPlayer domain.Player
 
 	// Act
-	newPlayer, status, err := service.RegisterPlayerUseCase(inputPlayer)
+	newPlayer, status, err := service.RegisterPlayerUseCase(context.Background(), inputPlayer)
 
 	// Assert
 	assert.Error(t, err)
@@ -165,7 +182,7 @@ func TestRegisterPlayerUseCase_UpdateExistingPlayerByEmail(t *testing.T) {
 	}
 
 	// Act
-	newPlayer, status, err := service.RegisterPlayerUseCase(inputPlayer)
+	newPlayer, status, err := service.RegisterPlayerUseCase(context.Background(), inputPlayer)
 
 	// Assert
 	assert.NoError(t, err)
@@ -180,12 +197,12 @@ func TestRegisterPlayerUseCase_ValidationError(t *testing.T) {
 	inputPlayer := domain.Player{Email: ""}
 
 	// Expect
-	_, expectedErr := domain.NewPlayer(inputPlayer.Email, nil, "", "", nil)
+	_, expectedErr := domain.NewPlayer(inputPlayer.Email, nil, "", "", domain.Profile{})
 	assert.Error(t, expectedErr)
 	var expectedNewPlayer domain.Player
 
 	// Act
-	newPlayer, status, err := service.RegisterPlayerUseCase(inputPlayer)
+	newPlayer, status, err := service.RegisterPlayerUseCase(context.Background(), inputPlayer)
 
 	// Assert
 	assert.Error(t, err)
@@ -194,6 +211,23 @@ func TestRegisterPlayerUseCase_ValidationError(t *testing.T) {
 	assert.Equal(t, expectedNewPlayer, newPlayer)
 }
 
+func TestRegisterPlayerUseCase_StaleAge(t *testing.T) {
+	// Arrange
+	repo := &mockPlayerRepository{}
+	service := NewRegisterPlayerUseCase(repo)
+	age := 30
+	inputPlayer := domain.Player{Email: "john.doe@example.com", FirstName: "John", LastName: "Doe",
+		Profile: domain.Profile{BirthDate: "1990-05-17", Age: &age}}
+
+	// Act
+	_, status, err := service.RegisterPlayerUseCase(context.Background(), inputPlayer)
+
+	// Assert
+	assert.ErrorContains(t, err, "ages are derived from birthDate")
+	assert.Equal(t, RegisterPlayerInvalid, status)
+	repo.AssertNotCalled(t, "Upsert", mock.Anything)
+}
+
 func TestRegisterPlayerUseCase_FindByIDError(t *testing.T) {
 	// Arrange
 	repo := &mockPlayerRepository{}
@@ -211,7 +245,7 @@ func TestRegisterPlayerUseCase_FindByIDError(t *testing.T) {
 	var expectedNewPlayer domain.Player
 
 	// Act
-	newPlayer, status, err := service.RegisterPlayerUseCase(inputPlayer)
+	newPlayer, status, err := service.RegisterPlayerUseCase(context.Background(), inputPlayer)
 
 	// Assert
 	assert.Error(t, err)
@@ -236,7 +270,7 @@ func TestRegisterPlayerUseCase_FindByEmailError(t *testing.T) {
 	var expectedNewPlayer domain.Player
 
 	// Act
-	newPlayer, status, err := service.RegisterPlayerUseCase(inputPlayer)
+	newPlayer, status, err := service.RegisterPlayerUseCase(context.Background(), inputPlayer)
 
 	// Assert
 	assert.Error(t, err)
@@ -262,7 +296,7 @@ func TestRegisterPlayerUseCase_SaveError(t *testing.T) {
 	var expectedNewPlayer domain.Player
 
 	// Act
-	newPlayer, status, err := service.RegisterPlayerUseCase(inputPlayer)
+	newPlayer, status, err := service.RegisterPlayerUseCase(context.Background(), inputPlayer)
 
 	// Assert
 	assert.Error(t, err)
@@ -289,7 +323,7 @@ func TestPlayerUseCase_UpdateError(t *testing.T) {
 	var expectedNewPlayer domain.Player
 
 	// Act
-	newPlayer, status, err := service.RegisterPlayerUseCase(inputPlayer)
+	newPlayer, status, err := service.RegisterPlayerUseCase(context.Background(), inputPlayer)
 
 	// Assert
 	assert.Error(t, err)
@@ -1,9 +1,13 @@
 package application
 
-import "github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
 
 type UnregisterPlayerUseCase interface {
-	UnregisterPlayerUseCase(playerId string) (status UnregisterPlayerStatus, err error)
+	UnregisterPlayerUseCase(ctx context.Context, playerId string) (status UnregisterPlayerStatus, err error)
 }
 
 type UnregisterPlayerStatus uint8
@@ -24,12 +28,12 @@ func NewUnregisterPlayerUseCase(playerRepository domain.PlayerRepository) Unregi
 	return &playerService{playerRepo: playerRepository}
 }
 
-func (s *playerService) UnregisterPlayerUseCase(playerId string) (status UnregisterPlayerStatus, err error) {
+func (s *playerService) UnregisterPlayerUseCase(ctx context.Context, playerId string) (status UnregisterPlayerStatus, err error) {
 	if err := domain.ValidateID(playerId); err != nil {
 		status = UnregisterPlayerInvalid
 		return status, err
 	}
-	foundPlayer, err := s.playerRepo.FindByID(playerId)
+	foundPlayer, err := s.playerRepo.FindByID(ctx, playerId)
 	if err != nil {
 		return status, err
 	}
@@ -37,7 +41,7 @@ func (s *playerService) UnregisterPlayerUseCase(playerId string) (status Unregis
 		status = UnregisterPlayerNotFound
 		return status, nil
 	}
-	if err = s.playerRepo.Delete(playerId); err != nil {
+	if err = s.playerRepo.Delete(ctx, playerId); err != nil {
 		return status, err
 	}
 	status = UnregisterPlayerDeleted
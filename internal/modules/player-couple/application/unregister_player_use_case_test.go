@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -20,7 +21,7 @@ func TestUnregisterPlayerUseCase(t *testing.T) {
 		repo.On("Delete", playerId).Return(nil)
 
 		// Act
-		status, err := service.UnregisterPlayerUseCase(playerId)
+		status, err := service.UnregisterPlayerUseCase(context.Background(), playerId)
 
 		// Assert
 		assert.NoError(t, err)
@@ -35,7 +36,7 @@ func TestUnregisterPlayerUseCase(t *testing.T) {
 		expectedErr := domain.ValidateID(playerId)
 
 		// Act
-		status, err := service.UnregisterPlayerUseCase(playerId)
+		status, err := service.UnregisterPlayerUseCase(context.Background(), playerId)
 
 		// Assert
 		assert.Error(t, err)
@@ -51,7 +52,7 @@ func TestUnregisterPlayerUseCase(t *testing.T) {
 		repo.On("FindByID", playerId).Return(domain.Player{}, nil)
 
 		// Act
-		status, err := service.UnregisterPlayerUseCase(playerId)
+		status, err := service.UnregisterPlayerUseCase(context.Background(), playerId)
 
 		// Assert
 		assert.NoError(t, err)
@@ -67,7 +68,7 @@ func TestUnregisterPlayerUseCase(t *testing.T) {
 		repo.On("FindByID", playerId).Return(domain.Player{}, expectedErr)
 
 		// Act
-		status, err := service.UnregisterPlayerUseCase(playerId)
+		status, err := service.UnregisterPlayerUseCase(context.Background(), playerId)
 
 		// Assert
 		assert.Error(t, err)
@@ -86,7 +87,7 @@ func TestUnregisterPlayerUseCase(t *testing.T) {
 		repo.On("Delete", playerId).Return(expectedErr)
 
 		// Act
-		status, err := service.UnregisterPlayerUseCase(playerId)
+		status, err := service.UnregisterPlayerUseCase(context.Background(), playerId)
 
 		// Assert
 		assert.Error(t, err)
@@ -1,17 +1,41 @@
 package domain
 
+import "context"
+
 // interfaces to be used by infrastructure layer:
 type PlayerRepository interface {
-	Upsert(player *Player) error
-	FindByID(id string) (Player, error)
-	FindByEmail(email string) (Player, error)
-	FindByLastName(lastName string) ([]Player, error)
-	Delete(id string) error
+	Upsert(ctx context.Context, player *Player) error
+	FindByID(ctx context.Context, id string) (Player, error)
+	FindByEmail(ctx context.Context, email string) (Player, error)
+	FindByLastName(ctx context.Context, lastName string) ([]Player, error)
+	// FindBySocialSecurityNumber looks up the SSN without decrypting stored values (blind index).
+	FindBySocialSecurityNumber(ctx context.Context, socialSecurityNumber string) (Player, error)
+	// ForEach streams the players with the IDs (every player when ids is nil) in ID order through a cursor, i.e.
+	// without loading them, stopping at the first error of fn.
+	ForEach(ctx context.Context, ids []string, fn func(Player) error) error
+	Delete(ctx context.Context, id string) error
 }
 
 type PlayerCoupleRepository interface {
-	Upsert(playerCouple *PlayerCouple) error
-	FindByID(id string) (PlayerCouple, error)
-	FindByPrefixes(lastNamePlayer1, lastNamePlayer2 string) ([]PlayerCouple, error)
-	Delete(id string) error
+	Upsert(ctx context.Context, playerCouple *PlayerCouple) error
+	// FindByID also finds migrated couples by the ID they had before, i.e. prefixed with the last names of the players.
+	FindByID(ctx context.Context, id string) (PlayerCouple, error)
+	// FindByPlayers finds the couples of any of player1IDs with any of player2IDs, in any order, or with any partner
+	// when player2IDs is empty.
+	FindByPlayers(ctx context.Context, player1IDs, player2IDs []string) ([]PlayerCouple, error)
+	FindByPlayerID(ctx context.Context, playerID string) ([]PlayerCouple, error)
+	// ReplacePlayer replaces every embedded copy of the player (e.g. once anonymized).
+	ReplacePlayer(ctx context.Context, player Player) error
+	// ForEach streams the couples with the IDs (every couple when ids is nil) like PlayerRepository.ForEach.
+	ForEach(ctx context.Context, ids []string, fn func(PlayerCouple) error) error
+	Delete(ctx context.Context, id string) error
+}
+
+// TournamentHistory reads and anonymizes copies of players embedded in tournaments, owned by the
+// tournament module (anti-corruption layer).
+type TournamentHistory interface {
+	FindByPlayerID(ctx context.Context, playerID string) ([]TournamentParticipation, []MatchRecord, error)
+	ReplacePlayer(ctx context.Context, player Player) error
+	// FindParticipants returns the couples entered in the tournaments selected by the filter, see ExportFilter.
+	FindParticipants(ctx context.Context, filter ExportFilter) (Participants, error)
 }
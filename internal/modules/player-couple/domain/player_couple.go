@@ -3,6 +3,8 @@ package domain
 import (
 	"fmt"
 	"net/mail"
+	"strings"
+	"time"
 )
 
 const (
@@ -13,6 +15,14 @@ const (
 	maxAge        = 100
 	minNameDigits = 3
 	minIdDigits   = 3
+	// Trailing SSN digits kept when masked.
+	visibleSSNDigits = 4
+	// Prefix of the SSNs encrypted at rest, reserved so a plaintext SSN can't pass for a ciphertext.
+	reservedSSNPrefix = "enc:"
+	// Reserved TLD so anonymized emails can't be delivered.
+	erasedEmailDomain = "erased.invalid"
+	erasedFirstName   = "Erased"
+	erasedLastName    = "Player"
 )
 
 type Player struct {
@@ -21,7 +31,9 @@ type Player struct {
 	SocialSecurityNumber *string `bson:"socialSecurityNumber,omitempty" json:"socialSecurityNumber,omitempty"`
 	FirstName            string  `bson:"firstName" json:"firstName"`
 	LastName             string  `bson:"lastName" json:"lastName"`
-	Age                  *int    `bson:"age,omitempty" json:"age,omitempty"`
+	Profile              `bson:",inline"`
+	// ErasedAt is set once personal data is erased (right to erasure), the ID is kept so results stay linked.
+	ErasedAt *time.Time `bson:"erasedAt,omitempty" json:"erasedAt,omitempty"`
 }
 
 type PlayerCouple struct {
@@ -32,12 +44,14 @@ type PlayerCouple struct {
 }
 
 func NewPlayer(email string, socialSecurityNumber *string,
-	firstName, lastName string, age *int) (*Player, error) {
+	firstName, lastName string, profile Profile) (*Player, error) {
 	if err := ValidateEmail(email); err != nil {
 		return nil, err
 	}
-	if socialSecurityNumber != nil && len(*socialSecurityNumber) < minSSNDigits {
-		return nil, fmt.Errorf("invalid social security number: %s", *socialSecurityNumber)
+	if socialSecurityNumber != nil {
+		if err := ValidateSocialSecurityNumber(*socialSecurityNumber); err != nil {
+			return nil, err
+		}
 	}
 	if len(firstName) < minNameDigits {
 		return nil, fmt.Errorf("invalid first name: %s", firstName)
@@ -45,8 +59,8 @@ func NewPlayer(email string, socialSecurityNumber *string,
 	if err := ValidateLastName(lastName); err != nil {
 		return nil, err
 	}
-	if age != nil && (*age < minAge || *age > maxAge) {
-		return nil, fmt.Errorf("invalid age: %d", *age)
+	if err := profile.Validate(time.Now()); err != nil {
+		return nil, err
 	}
 	return &Player{
 		//ID:                 auto generated ID set in the repository.
@@ -54,10 +68,45 @@ func NewPlayer(email string, socialSecurityNumber *string,
 		SocialSecurityNumber: socialSecurityNumber,
 		FirstName:            firstName,
 		LastName:             lastName,
-		Age:                  age,
+		Profile:              profile,
 	}, nil
 }
 
+func ValidateSocialSecurityNumber(socialSecurityNumber string) error {
+	if len(socialSecurityNumber) < minSSNDigits || strings.HasPrefix(socialSecurityNumber, reservedSSNPrefix) {
+		return fmt.Errorf("invalid social security number: %s", socialSecurityNumber)
+	}
+	return nil
+}
+
+// WithMaskedSocialSecurityNumber returns a copy of the player showing only the last SSN digits,
+// i.e. the one to be returned to callers not allowed to see the full SSN.
+func (p Player) WithMaskedSocialSecurityNumber() Player {
+	if p.SocialSecurityNumber == nil {
+		return p
+	}
+	ssn := *p.SocialSecurityNumber
+	visible := min(visibleSSNDigits, len(ssn)/2)
+	masked := strings.Repeat("*", len(ssn)-visible) + ssn[len(ssn)-visible:]
+	p.SocialSecurityNumber = &masked
+	return p
+}
+
+// Anonymize erases the personal data of the player keeping its ID.
+func (p *Player) Anonymize(now time.Time) {
+	p.Email = fmt.Sprintf("erased-%s@%s", p.ID, erasedEmailDomain)
+	p.SocialSecurityNumber = nil
+	p.FirstName = erasedFirstName
+	p.LastName = erasedLastName
+	p.Profile = Profile{}
+	p.ErasedAt = &now
+}
+
+// IsErased returns true once personal data was erased.
+func (p *Player) IsErased() bool {
+	return p.ErasedAt != nil
+}
+
 func ValidateID(id string) error {
 	if len(id) < minIdDigits {
 		return fmt.Errorf("invalid id: %s", id)
@@ -3,6 +3,7 @@ package domain
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -33,8 +34,8 @@ func TestValidateID(t *testing.T) {
 // TestNewPlayer_Success tests successful creation of a Player
 func TestNewPlayer_Success(t *testing.T) {
 	ssn := "12345678"
-	age := 25
-	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "Tapia", &age)
+	profile := Profile{BirthDate: yearsAgo(25), Gender: GenderMale, Handedness: HandednessRight, Side: SideReves, Level: 8, Club: "Club Norte"}
+	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "Tapia", profile)
 
 	assert.NoError(t, err, "Expected no error for valid inputs")
 	assert.NotNil(t, player, "Expected player object to be created")
@@ -43,14 +44,15 @@ func TestNewPlayer_Success(t *testing.T) {
 	assert.Equal(t, &ssn, player.SocialSecurityNumber, "Expected correct SSN")
 	assert.Equal(t, "Agustin", player.FirstName, "Expected correct player name")
 	assert.Equal(t, "Tapia", player.LastName, "Expected correct player surname")
-	assert.Equal(t, &age, player.Age, "Expected correct age")
+	assert.Equal(t, profile, player.Profile, "Expected correct profile")
+	assert.Equal(t, 25, *player.AgeOn(time.Now()), "Expected age derived from the birth date")
 }
 
 // TestNewPlayer_Fail_InvalidEmail tests failure for invalid email
 func TestNewPlayer_Fail_InvalidEmail(t *testing.T) {
 	ssn := "12345678"
-	age := 25
-	player, err := NewPlayer("agustapia", &ssn, "Agustin", "Tapia", &age)
+	profile := Profile{BirthDate: yearsAgo(25)}
+	player, err := NewPlayer("agustapia", &ssn, "Agustin", "Tapia", profile)
 
 	assert.Nil(t, player, "Expected no player to be created with invalid email")
 	assert.EqualError(t, err, "invalid email: agustapia", "Expected invalid email")
@@ -59,18 +61,28 @@ func TestNewPlayer_Fail_InvalidEmail(t *testing.T) {
 // TestNewPlayer_Fail_InvalidSSN tests failure for invalid SSN
 func TestNewPlayer_Fail_InvalidSSN(t *testing.T) {
 	ssn := "123"
-	age := 25
-	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "Tapia", &age)
+	profile := Profile{BirthDate: yearsAgo(25)}
+	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "Tapia", profile)
 
 	assert.Nil(t, player, "Expected no player to be created with invalid SSN")
 	assert.EqualError(t, err, "invalid social security number: 123", "Expected invalid SSN error")
 }
 
+// TestNewPlayer_Fail_CiphertextSSN tests failure for SSNs with the prefix of the encrypted ones
+func TestNewPlayer_Fail_CiphertextSSN(t *testing.T) {
+	ssn := "enc:v1:k1:12345678"
+	profile := Profile{BirthDate: yearsAgo(25)}
+	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "Tapia", profile)
+
+	assert.Nil(t, player, "Expected no player to be created with a ciphertext SSN")
+	assert.EqualError(t, err, "invalid social security number: enc:v1:k1:12345678", "Expected invalid SSN error")
+}
+
 // TestNewPlayer_Fail_InvalidFirstName tests failure for invalid player first name
 func TestNewPlayer_Fail_InvalidFirstName(t *testing.T) {
 	ssn := "12345678"
-	age := 25
-	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "A", "Tapia", &age)
+	profile := Profile{BirthDate: yearsAgo(25)}
+	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "A", "Tapia", profile)
 
 	assert.Nil(t, player, "Expected no player to be created with invalid first name")
 	assert.EqualError(t, err, "invalid first name: A", "Expected invalid first name error")
@@ -79,29 +91,37 @@ func TestNewPlayer_Fail_InvalidFirstName(t *testing.T) {
 // TestNewPlayer_Fail_InvalidLastName tests failure for invalid player last name
 func TestNewPlayer_Fail_InvalidLastName(t *testing.T) {
 	ssn := "12345678"
-	age := 25
-	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "T", &age)
+	profile := Profile{BirthDate: yearsAgo(25)}
+	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "T", profile)
 
 	assert.Nil(t, player, "Expected no player to be created with invalid last name")
 	assert.EqualError(t, err, "invalid last name: T", "Expected invalid last name error")
 }
 
-// TestNewPlayer_Fail_InvalidAge tests failure for invalid age
-func TestNewPlayer_Fail_InvalidAge(t *testing.T) {
+// TestNewPlayer_Fail_InvalidBirthDate tests failure for birth dates giving an invalid age
+func TestNewPlayer_Fail_InvalidBirthDate(t *testing.T) {
 	ssn := "12345678"
-	age := 120
-	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "Tapia", &age)
+	birthDate := yearsAgo(120)
+	player, err := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "Tapia", Profile{BirthDate: birthDate})
+
+	assert.Nil(t, player, "Expected no player to be created with invalid birth date")
+	assert.EqualError(t, err, "invalid birth date: "+string(birthDate), "Expected invalid birth date error")
+}
+
+// TestNewPlayer_Fail_InvalidProfile tests failure for invalid profile fields
+func TestNewPlayer_Fail_InvalidProfile(t *testing.T) {
+	player, err := NewPlayer("agus.tapia@gmail.com", nil, "Agustin", "Tapia", Profile{Side: "left"})
 
-	assert.Nil(t, player, "Expected no player to be created with invalid age")
-	assert.EqualError(t, err, "invalid age: 120", "Expected invalid age error")
+	assert.Nil(t, player, "Expected no player to be created with invalid side")
+	assert.EqualError(t, err, "invalid side: left, expected drive, reves or both")
 }
 
 // TestNewPlayerCouple_Success tests successful creation of a PlayerCouple
 func TestNewPlayerCouple_Success(t *testing.T) {
 	// Creating two valid players
-	player1, _ := NewPlayer("agus.tapia@gmail.com", nil, "Agustin", "Tapia", nil)
+	player1, _ := NewPlayer("agus.tapia@gmail.com", nil, "Agustin", "Tapia", Profile{})
 	player1.ID = mockId
-	player2, _ := NewPlayer("ale.galan@gmail.com", nil, "Ale", "Galan", nil)
+	player2, _ := NewPlayer("ale.galan@gmail.com", nil, "Ale", "Galan", Profile{})
 	player2.ID = anotherMockId
 
 	couple, err := NewPlayerCouple(*player1, *player2, nil)
@@ -115,7 +135,7 @@ func TestNewPlayerCouple_Success(t *testing.T) {
 
 // TestNewPlayerCouple_Fail_SamePlayer tests failure when Player1 and Player2 are the same
 func TestNewPlayerCouple_Fail_SamePlayer(t *testing.T) {
-	player, _ := NewPlayer("agus.tapia@gmail.com", nil, "Agustin", "Tapia", nil)
+	player, _ := NewPlayer("agus.tapia@gmail.com", nil, "Agustin", "Tapia", Profile{})
 	player.ID = mockId
 
 	couple, err := NewPlayerCouple(*player, *player, nil)
@@ -126,9 +146,9 @@ func TestNewPlayerCouple_Fail_SamePlayer(t *testing.T) {
 
 // TestNewPlayerCouple_Fail_InvalidRanking tests failure for invalid ranking
 func TestNewPlayerCouple_Fail_InvalidRanking(t *testing.T) {
-	player1, _ := NewPlayer("agus.tapia@gmail.com", nil, "Agustin", "Tapia", nil)
+	player1, _ := NewPlayer("agus.tapia@gmail.com", nil, "Agustin", "Tapia", Profile{})
 	player1.ID = mockId
-	player2, _ := NewPlayer("ale.galan@gmail.com", nil, "Ale", "Galan", nil)
+	player2, _ := NewPlayer("ale.galan@gmail.com", nil, "Ale", "Galan", Profile{})
 	player2.ID = anotherMockId
 	ranking := 9
 
@@ -137,3 +157,30 @@ func TestNewPlayerCouple_Fail_InvalidRanking(t *testing.T) {
 	assert.Nil(t, couple, "Expected no couple to be created with invalid ranking")
 	assert.EqualError(t, err, "invalid ranking: 9", "Expected invalid ranking error")
 }
+
+// TestPlayer_WithMaskedSocialSecurityNumber tests only the last SSN digits are kept
+func TestPlayer_WithMaskedSocialSecurityNumber(t *testing.T) {
+	ssn := "20123456789"
+	player, _ := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "Tapia", Profile{})
+
+	masked := player.WithMaskedSocialSecurityNumber()
+
+	assert.Equal(t, "*******6789", *masked.SocialSecurityNumber, "Expected only the last 4 digits")
+	assert.Equal(t, "20123456789", *player.SocialSecurityNumber, "Expected original player not modified")
+	withoutSSN := Player{Email: "ale.galan@gmail.com"}
+	assert.Nil(t, withoutSSN.WithMaskedSocialSecurityNumber().SocialSecurityNumber, "Expected no SSN")
+}
+
+// TestPlayer_Anonymize tests personal data is erased keeping the ID
+func TestPlayer_Anonymize(t *testing.T) {
+	ssn := "20123456789"
+	player, _ := NewPlayer("agus.tapia@gmail.com", &ssn, "Agustin", "Tapia", Profile{BirthDate: yearsAgo(30), Gender: GenderMale, Club: "Club Norte"})
+	player.ID = mockId
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	player.Anonymize(now)
+
+	assert.Equal(t, Player{ID: mockId, Email: "erased-mock-id@erased.invalid", FirstName: "Erased", LastName: "Player", ErasedAt: &now}, *player)
+	assert.True(t, player.IsErased(), "Expected player erased")
+	assert.NoError(t, ValidateEmail(player.Email), "Expected anonymized email still valid")
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// PlayerDataExport bundles every personal data held about a player (right of access / portability).
+type PlayerDataExport struct {
+	Player      Player                    `json:"player"`
+	Couples     []PlayerCouple            `json:"couples"`
+	Tournaments []TournamentParticipation `json:"tournaments"`
+	Matches     []MatchRecord             `json:"matches"`
+	ExportedAt  time.Time                 `json:"exportedAt"`
+}
+
+// TournamentParticipation is the player view of a tournament registration (owned by the tournament module).
+type TournamentParticipation struct {
+	TournamentID string    `json:"tournamentId"`
+	Title        string    `json:"title"`
+	Timestamp    time.Time `json:"timestamp"`
+	CoupleID     string    `json:"coupleId"`
+}
+
+// MatchRecord is the player view of a match, i.e. sets from the point of view of the player couple.
+type MatchRecord struct {
+	TournamentID     string      `json:"tournamentId"`
+	Round            int         `json:"round"`
+	MatchID          string      `json:"matchId"`
+	Timestamp        time.Time   `json:"timestamp"`
+	CoupleID         string      `json:"coupleId"`
+	OpponentCoupleID string      `json:"opponentCoupleId"`
+	Sets             []SetRecord `json:"sets,omitempty"`
+}
+
+type SetRecord struct {
+	Games                  int  `json:"games"`
+	OpponentGames          int  `json:"opponentGames"`
+	TiebreakPoints         *int `json:"tiebreakPoints,omitempty"`
+	OpponentTiebreakPoints *int `json:"opponentTiebreakPoints,omitempty"`
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ExportFilter selects the players and couples entered in tournaments held in [From, To), in a tournament or in a
+// category (of any tournament unless TournamentID is set). The zero value selects every player or couple.
+type ExportFilter struct {
+	From         *time.Time
+	To           *time.Time
+	TournamentID string
+	CategoryID   string
+}
+
+// IsZero tells whether the filter selects everyone, i.e. without looking up tournaments.
+func (f ExportFilter) IsZero() bool {
+	return f.From == nil && f.To == nil && len(f.TournamentID) == 0 && len(f.CategoryID) == 0
+}
+
+func (f ExportFilter) Validate() error {
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return errors.New("invalid date range: from must be before to")
+	}
+	if len(f.TournamentID) > 0 {
+		if err := ValidateID(f.TournamentID); err != nil {
+			return err
+		}
+	}
+	if len(f.CategoryID) > 0 {
+		return ValidateID(f.CategoryID)
+	}
+	return nil
+}
+
+// Participants are the couples entered in the tournaments selected by an export filter and their players.
+type Participants struct {
+	CoupleIDs []string
+	PlayerIDs []string
+}
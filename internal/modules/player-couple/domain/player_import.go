@@ -0,0 +1,39 @@
+package domain
+
+// Statuses of a row of a player import.
+const (
+	ImportRowCreated = "created"
+	ImportRowUpdated = "updated"
+	ImportRowInvalid = "invalid"
+)
+
+// PlayerImportReport tells what happened (or would happen on dry runs) to each row of a player import.
+type PlayerImportReport struct {
+	DryRun  bool              `json:"dryRun"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Invalid int               `json:"invalid"`
+	Rows    []PlayerImportRow `json:"rows"`
+}
+
+type PlayerImportRow struct {
+	// Row is the number shown by spreadsheet applications, the header being row 1.
+	Row      int    `json:"row"`
+	Email    string `json:"email,omitempty"`
+	Status   string `json:"status"`
+	PlayerID string `json:"playerId,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// Add counts the row in the report.
+func (r *PlayerImportReport) Add(row PlayerImportRow) {
+	switch row.Status {
+	case ImportRowCreated:
+		r.Created++
+	case ImportRowUpdated:
+		r.Updated++
+	case ImportRowInvalid:
+		r.Invalid++
+	}
+	r.Rows = append(r.Rows, row)
+}
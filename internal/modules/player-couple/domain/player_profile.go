@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	minLevel          = 1
+	maxLevel          = 8
+	maxClubNameDigits = 100
+	// BirthDateFormat is the format of birth dates in the API and imports.
+	BirthDateFormat = "2006-01-02"
+)
+
+// BirthDate is formatted as BirthDateFormat, it's stored instead of the age which gets stale (see AgeOn).
+type BirthDate string
+
+// Gender of the player, checked by the eligibility of tournament categories.
+type Gender string
+
+const (
+	GenderMale   Gender = "male"
+	GenderFemale Gender = "female"
+)
+
+// Handedness is the dominant hand of the player.
+type Handedness string
+
+const (
+	HandednessRight Handedness = "right"
+	HandednessLeft  Handedness = "left"
+)
+
+// Side is the preferred side of the court: drive (right) or revés (left, backhand side).
+type Side string
+
+const (
+	SideDrive Side = "drive"
+	SideReves Side = "reves"
+	// SideBoth is for players comfortable on either side.
+	SideBoth Side = "both"
+)
+
+// Level of play, from 1 (beginner) to 8 like couple rankings.
+type Level int
+
+// Club the player is a member of, by name.
+type Club string
+
+// Profile is the padel profile of a player. Every field is optional, the zero value being unknown.
+type Profile struct {
+	BirthDate  BirthDate  `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
+	Gender     Gender     `bson:"gender,omitempty" json:"gender,omitempty"`
+	Handedness Handedness `bson:"handedness,omitempty" json:"handedness,omitempty"`
+	Side       Side       `bson:"side,omitempty" json:"side,omitempty"`
+	Level      Level      `bson:"level,omitempty" json:"level,omitempty"`
+	Club       Club       `bson:"club,omitempty" json:"club,omitempty"`
+	// Age is read-only, i.e. never stored but derived from the birth date for responses (see WithAge). Ages given
+	// back are only accepted when they're the derived ones.
+	Age *int `bson:"-" json:"age,omitempty"`
+}
+
+// NewBirthDate formats the date, the time of the day being dropped.
+func NewBirthDate(date time.Time) BirthDate {
+	return BirthDate(date.Format(BirthDateFormat))
+}
+
+func (b BirthDate) Validate() error {
+	if b == "" {
+		return nil
+	}
+	if _, err := b.Time(); err != nil {
+		return fmt.Errorf("invalid birth date: %s, expected %s", b, BirthDateFormat)
+	}
+	return nil
+}
+
+// Time returns the birth date at midnight UTC.
+func (b BirthDate) Time() (time.Time, error) {
+	return time.Parse(BirthDateFormat, string(b))
+}
+
+func (g Gender) Validate() error {
+	switch g {
+	case "", GenderMale, GenderFemale:
+		return nil
+	}
+	return fmt.Errorf("invalid gender: %s, expected %s or %s", g, GenderMale, GenderFemale)
+}
+
+func (h Handedness) Validate() error {
+	switch h {
+	case "", HandednessRight, HandednessLeft:
+		return nil
+	}
+	return fmt.Errorf("invalid handedness: %s, expected %s or %s", h, HandednessRight, HandednessLeft)
+}
+
+func (s Side) Validate() error {
+	switch s {
+	case "", SideDrive, SideReves, SideBoth:
+		return nil
+	}
+	return fmt.Errorf("invalid side: %s, expected %s, %s or %s", s, SideDrive, SideReves, SideBoth)
+}
+
+func (l Level) Validate() error {
+	if l != 0 && (l < minLevel || l > maxLevel) {
+		return fmt.Errorf("invalid level: %d, expected %d to %d", l, minLevel, maxLevel)
+	}
+	return nil
+}
+
+func (c Club) Validate() error {
+	if c == "" {
+		return nil
+	}
+	if name := strings.TrimSpace(string(c)); len(name) < minNameDigits || len(name) > maxClubNameDigits || name != string(c) {
+		return fmt.Errorf("invalid club: %q", c)
+	}
+	return nil
+}
+
+// Validate checks every field, the birth date giving an age of minAge to maxAge on today, which is the age given if
+// any.
+func (p Profile) Validate(today time.Time) error {
+	if err := p.BirthDate.Validate(); err != nil {
+		return err
+	}
+	if age := p.AgeOn(today); age != nil && (*age < minAge || *age > maxAge) {
+		return fmt.Errorf("invalid birth date: %s", p.BirthDate)
+	}
+	if p.Age != nil {
+		if derived := p.AgeOn(today); derived == nil || *derived != *p.Age {
+			return fmt.Errorf("invalid age: %d, ages are derived from birthDate (%s)", *p.Age, BirthDateFormat)
+		}
+	}
+	for _, value := range []interface{ Validate() error }{p.Gender, p.Handedness, p.Side, p.Level, p.Club} {
+		if err := value.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithAge returns a copy of the profile with the age derived on today, none when the birth date is unknown.
+func (p Profile) WithAge(today time.Time) Profile {
+	p.Age = p.AgeOn(today)
+	return p
+}
+
+// AgeOn returns the age in years on the date, nil when the birth date is unknown (or invalid).
+func (p Profile) AgeOn(date time.Time) *int {
+	birthDate, err := p.BirthDate.Time()
+	if err != nil {
+		return nil
+	}
+	date = date.UTC()
+	age := date.Year() - birthDate.Year()
+	if date.Month() < birthDate.Month() || (date.Month() == birthDate.Month() && date.Day() < birthDate.Day()) {
+		age--
+	}
+	return &age
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// yearsAgo returns the birth date of someone turning years yesterday.
+func yearsAgo(years int) BirthDate {
+	today := time.Now().UTC()
+	return NewBirthDate(time.Date(today.Year()-years, today.Month(), today.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1))
+}
+
+func TestProfile_Validate(t *testing.T) {
+	today := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, Profile{}.Validate(today), "Expected every field optional")
+	assert.NoError(t, Profile{BirthDate: "1990-05-17", Gender: GenderFemale, Handedness: HandednessLeft, Side: SideBoth, Level: 1,
+		Club: "Club Norte"}.Validate(today))
+	age, staleAge := 36, 35
+	assert.NoError(t, Profile{BirthDate: "1990-05-17", Age: &age}.Validate(today), "Expected the derived age accepted")
+
+	for name, profile := range map[string]Profile{
+		"gender":         {Gender: "m"},
+		"handedness":     {Handedness: "ambidextrous"},
+		"side":           {Side: "right"},
+		"level":          {Level: 9},
+		"short club":     {Club: "CN"},
+		"untrimmed club": {Club: " Club Norte"},
+		"future birth":   {BirthDate: NewBirthDate(today.AddDate(0, 0, 1))},
+		"too young":      {BirthDate: yearsAgo(2)},
+		"birth format":   {BirthDate: "17/05/1990"},
+		"negative level": {Level: -1},
+		"stale age":      {BirthDate: "1990-05-17", Age: &staleAge},
+		"age only":       {Age: &age},
+	} {
+		assert.Error(t, profile.Validate(today), "Expected invalid %s", name)
+	}
+}
+
+func TestProfile_AgeOn(t *testing.T) {
+	profile := Profile{BirthDate: "1990-05-17"}
+
+	assert.Equal(t, 35, *profile.AgeOn(time.Date(2026, time.May, 16, 23, 0, 0, 0, time.UTC)), "Expected age before the birthday")
+	assert.Equal(t, 36, *profile.AgeOn(time.Date(2026, time.May, 17, 0, 0, 0, 0, time.UTC)), "Expected age on the birthday")
+	assert.Nil(t, Profile{}.AgeOn(time.Now()), "Expected no age without birth date")
+	assert.Nil(t, Profile{BirthDate: "17/05/1990"}.AgeOn(time.Now()), "Expected no age with an invalid birth date")
+}
+
+func TestProfile_WithAge(t *testing.T) {
+	today := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 36, *Profile{BirthDate: "1990-05-17"}.WithAge(today).Age)
+	assert.Nil(t, Profile{}.WithAge(today).Age, "Expected no age without birth date")
+}
@@ -0,0 +1,153 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// legacyIDField keeps the ID couples had when IDs were prefixed with the last names of the players.
+const legacyIDField = "legacyId"
+
+var legacyIDIndex = mongo.IndexModel{
+	Keys:    bson.D{{Key: legacyIDField, Value: 1}},
+	Options: options.Index().SetName(legacyIDField + "_1").SetSparse(true),
+}
+
+// coupleIDMigration replaces the IDs of couples prefixed with the last names of the players (LastName1-LastName2-uuid),
+// which leak into URLs and get stale when a player changes their last name. Couples are copied with a new ID, keeping
+// the old one in legacyId so FindByID still finds them, and the copies embedded in tournaments are updated. It can be
+// run again after a failure, couples already copied keep their new ID. Couples of every tenant sharing the database of
+// ctx are migrated, keeping their tenant.
+type coupleIDMigration struct {
+	idGen         utils.IDGenerator
+	playerCouples *mongo.Collection
+	tournaments   *mongo.Collection
+}
+
+func newCoupleIDMigration(ctx context.Context, idGen utils.IDGenerator, client common.MongoClient) *coupleIDMigration {
+	return &coupleIDMigration{
+		idGen:         idGen,
+		playerCouples: client.GetCollection(ctx, playerCouplesColName),
+		tournaments:   client.GetCollection(ctx, tournamentsColName),
+	}
+}
+
+// legacyCouple is the part of couple documents telling whether their ID is prefixed with the last names.
+type legacyCouple struct {
+	ID       string `bson:"_id"`
+	LegacyID string `bson:"legacyId"`
+	Player1  struct {
+		LastName string `bson:"lastName"`
+	} `bson:"player1"`
+	Player2 struct {
+		LastName string `bson:"lastName"`
+	} `bson:"player2"`
+}
+
+func (c legacyCouple) prefixed() bool {
+	return strings.HasPrefix(c.ID, c.Player1.LastName+"-"+c.Player2.LastName+"-")
+}
+
+func (m *coupleIDMigration) up(ctx context.Context) error {
+	if _, err := m.playerCouples.Indexes().CreateOne(ctx, legacyIDIndex); err != nil {
+		return err
+	}
+	return m.forEach(ctx, bson.M{legacyIDField: bson.M{"$exists": false}}, func(couple legacyCouple, document bson.M) error {
+		if !couple.prefixed() {
+			return nil
+		}
+		document[legacyIDField] = couple.ID
+		return m.move(ctx, document, couple.ID, m.idGen.GenerateID(), bson.M{legacyIDField: couple.ID})
+	})
+}
+
+func (m *coupleIDMigration) down(ctx context.Context) error {
+	err := m.forEach(ctx, bson.M{legacyIDField: bson.M{"$exists": true}}, func(couple legacyCouple, document bson.M) error {
+		delete(document, legacyIDField)
+		return m.move(ctx, document, couple.ID, couple.LegacyID, bson.M{"_id": couple.LegacyID})
+	})
+	if err != nil {
+		return err
+	}
+	_, err = m.playerCouples.Indexes().DropOne(ctx, *legacyIDIndex.Options.Name)
+	return err
+}
+
+func (m *coupleIDMigration) forEach(ctx context.Context, filter bson.M, fn func(couple legacyCouple, document bson.M) error) error {
+	cursor, err := m.playerCouples.Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var couple legacyCouple
+		var document bson.M
+		if err := cursor.Decode(&couple); err != nil {
+			return err
+		}
+		if err := cursor.Decode(&document); err != nil {
+			return err
+		}
+		if err := fn(couple, document); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// move inserts the document with the new ID unless a previous run did (found by moved), then deletes the old one
+// and replaces the ID in tournaments.
+func (m *coupleIDMigration) move(ctx context.Context, document bson.M, from, to string, moved bson.M) error {
+	var existing legacyCouple
+	err := m.playerCouples.FindOne(ctx, moved).Decode(&existing)
+	switch {
+	case err == nil:
+		to = existing.ID
+	case errors.Is(err, mongo.ErrNoDocuments):
+		document["_id"] = to
+		if _, err := m.playerCouples.InsertOne(ctx, document); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+	if _, err := m.playerCouples.DeleteOne(ctx, bson.M{"_id": from}); err != nil {
+		return err
+	}
+	return m.replaceInTournaments(ctx, from, to)
+}
+
+// replaceInTournaments replaces the ID of the couple registered, drawn or entered in categories. Array filters select
+// the rounds and categories holding the couple, as "$[]" fails on the ones without matches, entries or waitlist.
+func (m *coupleIDMigration) replaceInTournaments(ctx context.Context, from, to string) error {
+	updates := []struct {
+		filter  bson.M
+		path    string
+		filters bson.A
+	}{
+		{bson.M{"player_couples._id": from}, "player_couples.$[c]._id", bson.A{bson.M{"c._id": from}}},
+		{bson.M{"rounds.matches.couple1._id": from}, "rounds.$[r].matches.$[m].couple1._id",
+			bson.A{bson.M{"r.matches.couple1._id": from}, bson.M{"m.couple1._id": from}}},
+		{bson.M{"rounds.matches.couple2._id": from}, "rounds.$[r].matches.$[m].couple2._id",
+			bson.A{bson.M{"r.matches.couple2._id": from}, bson.M{"m.couple2._id": from}}},
+		{bson.M{"categories.entries._id": from}, "categories.$[c].entries.$[e]._id",
+			bson.A{bson.M{"c.entries._id": from}, bson.M{"e._id": from}}},
+		{bson.M{"categories.waitlist._id": from}, "categories.$[c].waitlist.$[w]._id",
+			bson.A{bson.M{"c.waitlist._id": from}, bson.M{"w._id": from}}},
+	}
+	for _, update := range updates {
+		_, err := m.tournaments.UpdateMany(ctx, update.filter, bson.M{"$set": bson.M{update.path: to}},
+			options.Update().SetArrayFilters(options.ArrayFilters{Filters: update.filters}))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
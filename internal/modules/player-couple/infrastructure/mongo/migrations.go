@@ -0,0 +1,115 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/common/migration"
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// Players are upserted by email, and looked up by last name or SSN blind index.
+	playerIndexes = []mongo.IndexModel{
+		{Keys: bson.D{{Key: "email", Value: 1}}, Options: options.Index().SetName("email_1").SetUnique(true)},
+		{Keys: bson.D{{Key: "lastName", Value: 1}}, Options: options.Index().SetName("lastName_1")},
+		{Keys: bson.D{{Key: "ssnBlindIndex", Value: 1}}, Options: options.Index().SetName("ssnBlindIndex_1").SetSparse(true)},
+	}
+	// Emails are unique within the tenant, players of several tenants (e.g. federations) may share one.
+	tenantEmailIndex = mongo.IndexModel{
+		Keys:    bson.D{{Key: common.TenantField, Value: 1}, {Key: "email", Value: 1}},
+		Options: options.Index().SetName(common.TenantField + "_1_email_1").SetUnique(true),
+	}
+	// Couples are looked up by player, e.g. to update the embedded players.
+	playerCoupleIndexes = []mongo.IndexModel{
+		{Keys: bson.D{{Key: "player1._id", Value: 1}}, Options: options.Index().SetName("player1._id_1")},
+		{Keys: bson.D{{Key: "player2._id", Value: 1}}, Options: options.Index().SetName("player2._id_1")},
+	}
+)
+
+// Migrations of the players and player_couples collections, new couple IDs being generated by idGen.
+func Migrations(idGen utils.IDGenerator) []migration.Migration {
+	return []migration.Migration{
+		{
+			Version:     2026101901,
+			Description: "Create players indexes",
+			Up:          migration.CreateIndexes(playersColName, playerIndexes...),
+			Down:        migration.DropIndexes(playersColName, playerIndexes...),
+		},
+		{
+			Version:     2026101902,
+			Description: "Create player_couples indexes",
+			Up:          migration.CreateIndexes(playerCouplesColName, playerCoupleIndexes...),
+			Down:        migration.DropIndexes(playerCouplesColName, playerCoupleIndexes...),
+		},
+		{
+			Version:     2026101903,
+			Description: "Replace couple IDs prefixed with the last names of the players",
+			Up: func(ctx context.Context, client common.MongoClient) error {
+				return newCoupleIDMigration(ctx, idGen, client).up(ctx)
+			},
+			Down: func(ctx context.Context, client common.MongoClient) error {
+				return newCoupleIDMigration(ctx, idGen, client).down(ctx)
+			},
+		},
+		{
+			// Birth dates aren't made up from ages, players without one keep theirs as legacyAge until they give it.
+			// Tournaments keep their ages as a snapshot of their players.
+			Version:     2026101904,
+			Description: "Drop the ages of players, replaced by birth dates",
+			Up:          dropAges,
+			Down:        dropAgesDown,
+		},
+		{
+			Version:     2026101906,
+			Description: "Assign players and player_couples to the default tenant",
+			Up:          migration.AssignTenant(playersColName, playerCouplesColName),
+		},
+		{
+			Version:     2026101907,
+			Description: "Make player emails unique per tenant",
+			Up:          migration.ReplaceIndex(playersColName, playerIndexes[0], tenantEmailIndex),
+			Down:        migration.ReplaceIndex(playersColName, tenantEmailIndex, playerIndexes[0]),
+		},
+	}
+}
+
+// agePaths are the paths of the players, stored or embedded in couples, whose age is replaced by a birth date.
+var agePaths = []struct{ colName, prefix string }{
+	{playersColName, ""}, {playerCouplesColName, "player1."}, {playerCouplesColName, "player2."},
+}
+
+// dropAges keeps the ages as legacyAge, which tells players migrated without birth date apart, e.g. to ask them for
+// it, and lets dropAgesDown restore them.
+func dropAges(ctx context.Context, client common.MongoClient) error {
+	for _, path := range agePaths {
+		if _, err := client.GetCollection(ctx, path.colName).UpdateMany(ctx, bson.M{path.prefix + "age": bson.M{"$exists": true}},
+			bson.M{"$rename": bson.M{path.prefix + "age": path.prefix + "legacyAge"}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropAgesDown restores the ages kept by dropAges, the ones of players added since then are derived from their birth
+// date. Erased players lost both, i.e. they get no age back.
+func dropAgesDown(ctx context.Context, client common.MongoClient) error {
+	for _, path := range agePaths {
+		legacyAge, birthDate := "$"+path.prefix+"legacyAge", "$"+path.prefix+"birthDate"
+		derived := bson.M{"$dateDiff": bson.M{"startDate": bson.M{"$dateFromString": bson.M{"dateString": birthDate,
+			"format": "%Y-%m-%d"}}, "endDate": "$$NOW", "unit": "year"}}
+		if _, err := client.GetCollection(ctx, path.colName).UpdateMany(ctx, bson.M{
+			path.prefix + "age": bson.M{"$exists": false},
+			"$or":               bson.A{bson.M{path.prefix + "legacyAge": bson.M{"$exists": true}}, bson.M{path.prefix + "birthDate": bson.M{"$exists": true}}},
+		}, bson.A{
+			bson.M{"$set": bson.M{path.prefix + "age": bson.M{"$ifNull": bson.A{legacyAge, derived}}}},
+			bson.M{"$unset": path.prefix + "legacyAge"},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package mongo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestMigrations_Indexes(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Up creates and Down drops the indexes", func(mt *mtest.T) {
+		players := Migrations(newIdGenMock())[0]
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, players.Up(context.Background(), newMongoClientMock(mt.Client)))
+
+		event := mt.GetStartedEvent()
+		assert.Equal(t, "createIndexes", event.CommandName)
+		assert.Equal(t, playersColName, event.Command.Lookup("createIndexes").StringValue())
+		indexes, _ := event.Command.Lookup("indexes").Array().Values()
+		var names []string
+		for _, index := range indexes {
+			names = append(names, index.Document().Lookup("name").StringValue())
+		}
+		assert.Equal(t, []string{"email_1", "lastName_1", "ssnBlindIndex_1"}, names)
+		assert.True(t, indexes[0].Document().Lookup("unique").Boolean(), "Expected unique emails")
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+		assert.NoError(t, players.Down(context.Background(), newMongoClientMock(mt.Client)))
+		names = nil
+		for event := mt.GetStartedEvent(); event != nil; event = mt.GetStartedEvent() {
+			names = append(names, event.Command.Lookup("index").StringValue())
+		}
+		assert.Equal(t, []string{"email_1", "lastName_1", "ssnBlindIndex_1"}, names)
+	})
+
+	mt.Run("Couples are indexed by player", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "index error"}))
+		err := Migrations(newIdGenMock())[1].Up(context.Background(), newMongoClientMock(mt.Client))
+		assert.ErrorContains(t, err, "index error")
+
+		indexes, _ := mt.GetStartedEvent().Command.Lookup("indexes").Array().Values()
+		assert.Equal(t, bson.Raw(indexes[0].Document().Lookup("key").Document()), mustMarshal(t, bson.D{{Key: "player1._id", Value: 1}}))
+	})
+}
+
+func TestMigrations_DropAges(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Ages are kept as legacy ages without making up birth dates", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+		assert.NoError(t, Migrations(newIdGenMock())[3].Up(context.Background(), newMongoClientMock(mt.Client)))
+
+		var renamed []string
+		for event := mt.GetStartedEvent(); event != nil; event = mt.GetStartedEvent() {
+			assert.Equal(t, "update", event.CommandName)
+			update := event.Command.Lookup("updates").Array().Index(0).Value().Document()
+			assert.True(t, update.Lookup("multi").Boolean())
+			rename, err := update.Lookup("u", "$rename").Document().Elements()
+			assert.NoError(t, err)
+			assert.Len(t, rename, 1, "Expected no birth date set")
+			field := rename[0].Key()
+			assert.Equal(t, strings.TrimSuffix(field, "age")+"legacyAge", rename[0].Value().StringValue(), "Expected the age kept")
+			renamed = append(renamed, event.Command.Lookup("update").StringValue()+":"+field)
+		}
+		assert.ElementsMatch(t, []string{playersColName + ":age", playerCouplesColName + ":player1.age",
+			playerCouplesColName + ":player2.age"}, renamed)
+	})
+
+	mt.Run("Ages are restored from legacy ages or birth dates", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+		assert.NoError(t, Migrations(newIdGenMock())[3].Down(context.Background(), newMongoClientMock(mt.Client)))
+
+		var restored []string
+		for event := mt.GetStartedEvent(); event != nil; event = mt.GetStartedEvent() {
+			update := event.Command.Lookup("updates").Array().Index(0).Value().Document()
+			pipeline := update.Lookup("u").Array()
+			field := strings.TrimSuffix(pipeline.Index(1).Value().Document().Lookup("$unset").StringValue(), "legacyAge") + "age"
+			assert.False(t, update.Lookup("q", field, "$exists").Boolean(), "Expected only players without age restored")
+			ifNull := pipeline.Index(0).Value().Document().Lookup("$set", field, "$ifNull").Array()
+			assert.Equal(t, "$"+strings.TrimSuffix(field, "age")+"legacyAge", ifNull.Index(0).Value().StringValue())
+			restored = append(restored, event.Command.Lookup("update").StringValue()+":"+field)
+		}
+		assert.ElementsMatch(t, []string{playersColName + ":age", playerCouplesColName + ":player1.age",
+			playerCouplesColName + ":player2.age"}, restored)
+	})
+}
+
+func TestMigrations_Tenant(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Players and couples stored before tenants are of the tenant migrated", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+		ctx := tenant.WithID(context.Background(), "fep")
+		assert.NoError(t, Migrations(newIdGenMock())[4].Up(ctx, newMongoClientMock(mt.Client)))
+
+		for _, colName := range []string{playersColName, playerCouplesColName} {
+			event := mt.GetStartedEvent()
+			assert.Equal(t, colName, event.Command.Lookup("update").StringValue())
+			update := event.Command.Lookup("updates").Array().Index(0).Value().Document()
+			assert.False(t, update.Lookup("q", common.TenantField, "$exists").Boolean(), "Expected only unassigned documents assigned")
+			assert.Equal(t, "fep", update.Lookup("u", "$set", common.TenantField).StringValue())
+		}
+	})
+
+	mt.Run("Emails are unique per tenant", func(mt *mtest.T) {
+		emails := Migrations(newIdGenMock())[5]
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+		assert.NoError(t, emails.Up(context.Background(), newMongoClientMock(mt.Client)))
+
+		indexes, _ := mt.GetStartedEvent().Command.Lookup("indexes").Array().Values()
+		assert.Equal(t, "tenantId_1_email_1", indexes[0].Document().Lookup("name").StringValue())
+		assert.True(t, indexes[0].Document().Lookup("unique").Boolean())
+		assert.Equal(t, "email_1", mt.GetStartedEvent().Command.Lookup("index").StringValue(), "Expected the index replaced once created")
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+		assert.NoError(t, emails.Down(context.Background(), newMongoClientMock(mt.Client)))
+		indexes, _ = mt.GetStartedEvent().Command.Lookup("indexes").Array().Values()
+		assert.Equal(t, "email_1", indexes[0].Document().Lookup("name").StringValue())
+		assert.Equal(t, "tenantId_1_email_1", mt.GetStartedEvent().Command.Lookup("index").StringValue())
+	})
+}
+
+func mustMarshal(t *testing.T, value any) bson.Raw {
+	raw, err := bson.Marshal(value)
+	assert.NoError(t, err)
+	return raw
+}
+
+func TestCoupleIDMigration(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	couple := func(id string, fields ...bson.E) bson.D {
+		return append(bson.D{
+			{Key: "_id", Value: id},
+			{Key: "player1", Value: bson.D{{Key: "_id", Value: "1"}, {Key: "lastName", Value: "Doe"}}},
+			{Key: "player2", Value: bson.D{{Key: "_id", Value: "2"}, {Key: "lastName", Value: "Smith"}}},
+		}, fields...)
+	}
+	commands := func(mt *mtest.T) []string {
+		var names []string
+		for event := mt.GetStartedEvent(); event != nil; event = mt.GetStartedEvent() {
+			names = append(names, event.CommandName)
+			if event.CommandName == "insert" {
+				inserted := event.Command.Lookup("documents").Array().Index(0).Value().Document()
+				legacyID, _ := inserted.Lookup(legacyIDField).StringValueOK()
+				names = append(names, inserted.Lookup("_id").StringValue()+" "+legacyID)
+			}
+		}
+		return names
+	}
+
+	mt.Run("Up replaces prefixed IDs", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(),
+			mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch, couple("Doe-Smith-uuid"), couple("opaque-id")),
+			mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch),
+			mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+
+		err := Migrations(newIdGenMock())[2].Up(context.Background(), newMongoClientMock(mt.Client))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"createIndexes", "find", "find", "insert", mockId + " Doe-Smith-uuid", "delete",
+			"update", "update", "update", "update", "update"}, commands(mt), "Expected the opaque ID skipped")
+	})
+
+	mt.Run("Up again after a failure reuses the copy", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(),
+			mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch, couple("Doe-Smith-uuid")),
+			mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch, couple("new-id", bson.E{Key: legacyIDField, Value: "Doe-Smith-uuid"})),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "update error"}))
+
+		err := Migrations(newIdGenMock())[2].Up(context.Background(), newMongoClientMock(mt.Client))
+		assert.ErrorContains(t, err, "update error")
+		assert.Equal(t, []string{"createIndexes", "find", "find", "delete", "update"}, commands(mt), "Expected no second copy")
+	})
+
+	mt.Run("Down restores legacy IDs", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch, couple("new-id", bson.E{Key: legacyIDField, Value: "Doe-Smith-uuid"})),
+			mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch),
+			mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+
+		err := Migrations(newIdGenMock())[2].Down(context.Background(), newMongoClientMock(mt.Client))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"find", "find", "insert", "Doe-Smith-uuid ", "delete",
+			"update", "update", "update", "update", "update", "dropIndexes"}, commands(mt))
+	})
+}
@@ -3,14 +3,15 @@ package mongo
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
+	"github.com/paguerre3/goddd/internal/modules/common/encryption"
 	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
 	"github.com/paguerre3/goddd/internal/modules/common/utils"
 	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const (
@@ -21,68 +22,88 @@ const (
 
 type mongoPlayerRepository struct {
 	idGen      utils.IDGenerator
-	collection *mongo.Collection
+	keyRing    *encryption.KeyRing
+	collection *common.TenantCollection
 }
 
 type mongoPlayerCoupleRepository struct {
 	idGen      utils.IDGenerator
-	collection *mongo.Collection
+	keyRing    *encryption.KeyRing
+	collection *common.TenantCollection
 }
 
-func NewMongoPlayerRepository(idGen utils.IDGenerator, client common.MongoClient) domain.PlayerRepository {
-	collection := client.GetCollection(playersColName)
+// NewMongoPlayerRepository stores SSNs encrypted with the key ring (see playerDocument).
+func NewMongoPlayerRepository(idGen utils.IDGenerator, client common.MongoClient, keyRing *encryption.KeyRing) domain.PlayerRepository {
+	collection := common.NewTenantCollection(client, playersColName)
 	return &mongoPlayerRepository{
 		idGen:      idGen,
+		keyRing:    keyRing,
 		collection: collection,
 	}
 }
 
-func (r *mongoPlayerRepository) Upsert(player *domain.Player) error {
+func (r *mongoPlayerRepository) Upsert(ctx context.Context, player *domain.Player) error {
 	if player == nil {
 		return errors.New("player is nil")
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// DDD repository principle.
 	if len(player.ID) > 0 {
-		_, err := r.collection.UpdateOne(ctx, bson.M{"_id": player.ID}, bson.M{"$set": player})
+		document, err := newPlayerDocument(*player, r.keyRing)
+		if err != nil {
+			return err
+		}
+		if player.IsErased() {
+			// Replaced so personal data cleared by the erasure is removed, e.g. the SSN or legacyAge.
+			_, err = r.collection.ReplaceOne(ctx, bson.M{"_id": player.ID}, document)
+			return err
+		}
+		// Set so fields stored but not part of the player (e.g. legacyAge) are kept.
+		_, err = r.collection.UpdateOne(ctx, bson.M{"_id": player.ID}, bson.M{"$set": document})
 		return err
 	}
 	player.ID = r.idGen.GenerateID()
-	_, err := r.collection.InsertOne(ctx, player)
+	document, err := newPlayerDocument(*player, r.keyRing)
+	if err == nil {
+		_, err = r.collection.InsertOne(ctx, document)
+	}
 	if err != nil {
 		player.ID = ""
 	}
 	return err
 }
 
-func (r *mongoPlayerRepository) FindByID(id string) (domain.Player, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
+func (r *mongoPlayerRepository) FindByID(ctx context.Context, id string) (domain.Player, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
 
-	var player domain.Player
-	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&player)
-	if mongo.ErrNoDocuments == err {
-		return player, nil
-	}
-	return player, err
+func (r *mongoPlayerRepository) FindByEmail(ctx context.Context, email string) (domain.Player, error) {
+	return r.findOne(ctx, bson.M{"email": email})
+}
+
+func (r *mongoPlayerRepository) FindBySocialSecurityNumber(ctx context.Context, socialSecurityNumber string) (domain.Player, error) {
+	return r.findOne(ctx, bson.M{"ssnBlindIndex": r.keyRing.BlindIndex(socialSecurityNumber)})
 }
 
-func (r *mongoPlayerRepository) FindByEmail(email string) (domain.Player, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (r *mongoPlayerRepository) findOne(ctx context.Context, filter bson.M) (domain.Player, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	var player domain.Player
-	err := r.collection.FindOne(ctx, bson.M{"email": email}).Decode(&player)
+	var document playerDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&document)
 	if mongo.ErrNoDocuments == err {
-		return player, nil
+		return domain.Player{}, nil
 	}
-	return player, err
+	if err != nil {
+		return domain.Player{}, err
+	}
+	return document.toPlayer(r.keyRing)
 }
 
-func (r *mongoPlayerRepository) FindByLastName(lastName string) ([]domain.Player, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (r *mongoPlayerRepository) FindByLastName(ctx context.Context, lastName string) ([]domain.Player, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// Using only json must be "all" lower case as mongo stores it in lower case, even if in the vew is showed in camel case;
@@ -100,8 +121,12 @@ func (r *mongoPlayerRepository) FindByLastName(lastName string) ([]domain.Player
 	defer cursor.Close(ctx)
 	var players []domain.Player
 	for cursor.Next(ctx) {
-		var player domain.Player
-		if err := cursor.Decode(&player); err != nil {
+		var document playerDocument
+		if err := cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+		player, err := document.toPlayer(r.keyRing)
+		if err != nil {
 			return nil, err
 		}
 		players = append(players, player)
@@ -109,65 +134,139 @@ func (r *mongoPlayerRepository) FindByLastName(lastName string) ([]domain.Player
 	return players, nil
 }
 
-func (r *mongoPlayerRepository) Delete(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+// ForEach has no timeout of its own as exports outlast other queries, ctx bounds it.
+func (r *mongoPlayerRepository) ForEach(ctx context.Context, ids []string, fn func(domain.Player) error) error {
+	cursor, err := r.collection.Find(ctx, idsFilter(ids), options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var document playerDocument
+		if err := cursor.Decode(&document); err != nil {
+			return err
+		}
+		player, err := document.toPlayer(r.keyRing)
+		if err != nil {
+			return err
+		}
+		if err := fn(player); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// idsFilter selects the documents with the IDs, or every document when ids is nil.
+func idsFilter(ids []string) bson.M {
+	if ids == nil {
+		return bson.M{}
+	}
+	return bson.M{"_id": bson.M{"$in": ids}}
+}
+
+func (r *mongoPlayerRepository) Delete(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
 	return err
 }
 
-func NewMongoPlayerCoupleRepository(idGen utils.IDGenerator, client common.MongoClient) domain.PlayerCoupleRepository {
-	collection := client.GetCollection(playerCouplesColName)
+// NewMongoPlayerCoupleRepository stores SSNs of the embedded players encrypted with the key ring.
+func NewMongoPlayerCoupleRepository(idGen utils.IDGenerator, client common.MongoClient, keyRing *encryption.KeyRing) domain.PlayerCoupleRepository {
+	collection := common.NewTenantCollection(client, playerCouplesColName)
 	return &mongoPlayerCoupleRepository{
 		idGen:      idGen,
+		keyRing:    keyRing,
 		collection: collection,
 	}
 }
 
-func (r *mongoPlayerCoupleRepository) Upsert(playerCouple *domain.PlayerCouple) error {
+func (r *mongoPlayerCoupleRepository) Upsert(ctx context.Context, playerCouple *domain.PlayerCouple) error {
 	if playerCouple == nil {
 		return errors.New("playerCouple is nil")
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// DDD repository principle.
 	if len(playerCouple.ID) > 0 {
-		_, err := r.collection.UpdateOne(ctx, bson.M{"_id": playerCouple.ID}, bson.M{"$set": playerCouple})
+		document, err := encryptPlayerCouple(*playerCouple, r.keyRing)
+		if err != nil {
+			return err
+		}
+		_, err = r.collection.UpdateOne(ctx, bson.M{"_id": playerCouple.ID}, bson.M{"$set": document})
 		return err
 	}
-	playerCouple.ID = r.idGen.GenerateIDWithPrefixes(playerCouple.Player1.LastName, playerCouple.Player2.LastName)
-	_, err := r.collection.InsertOne(ctx, playerCouple)
+	// IDs don't embed the last names of the players anymore, see the couple IDs migration.
+	playerCouple.ID = r.idGen.GenerateID()
+	document, err := encryptPlayerCouple(*playerCouple, r.keyRing)
+	if err == nil {
+		_, err = r.collection.InsertOne(ctx, document)
+	}
 	if err != nil {
 		playerCouple.ID = ""
 	}
 	return err
 }
 
-func (r *mongoPlayerCoupleRepository) FindByID(id string) (domain.PlayerCouple, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (r *mongoPlayerCoupleRepository) FindByID(ctx context.Context, id string) (domain.PlayerCouple, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	// Couples migrated to IDs without last names are still found by their legacy ID, e.g. in old links.
 	var playerCouple domain.PlayerCouple
-	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&playerCouple)
+	err := r.collection.FindOne(ctx, bson.M{"$or": bson.A{
+		bson.M{"_id": id},
+		bson.M{legacyIDField: id},
+	}}).Decode(&playerCouple)
 	if mongo.ErrNoDocuments == err {
 		return playerCouple, nil
 	}
-	return playerCouple, err
+	if err != nil {
+		return playerCouple, err
+	}
+	return decryptPlayerCouple(playerCouple, r.keyRing)
 }
 
-func (r *mongoPlayerCoupleRepository) FindByPrefixes(lastNamePlayer1, lastNamePlayer2 string) ([]domain.PlayerCouple, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (r *mongoPlayerCoupleRepository) FindByPlayers(ctx context.Context, player1IDs, player2IDs []string) ([]domain.PlayerCouple, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	var prefix = fmt.Sprintf("%s-%s", lastNamePlayer1, lastNamePlayer2)
-	cursor, err := r.collection.Find(ctx, bson.M{
-		"_id": bson.M{"$regex": "^" + prefix},
-	})
-	if err != nil && mongo.ErrNoDocuments != err {
+	// Both branches use the player1._id and player2._id indexes.
+	filter := bson.M{"$or": bson.A{
+		bson.M{"player1._id": bson.M{"$in": player1IDs}},
+		bson.M{"player2._id": bson.M{"$in": player1IDs}},
+	}}
+	if len(player2IDs) > 0 {
+		filter = bson.M{"$or": bson.A{
+			bson.D{{Key: "player1._id", Value: bson.M{"$in": player1IDs}}, {Key: "player2._id", Value: bson.M{"$in": player2IDs}}},
+			bson.D{{Key: "player1._id", Value: bson.M{"$in": player2IDs}}, {Key: "player2._id", Value: bson.M{"$in": player1IDs}}},
+		}}
+	}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
 		return nil, err
 	}
+	return r.decodeAll(ctx, cursor)
+}
+
+func (r *mongoPlayerCoupleRepository) FindByPlayerID(ctx context.Context, playerID string) ([]domain.PlayerCouple, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cursor, err := r.collection.Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"player1._id": playerID},
+		bson.M{"player2._id": playerID},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return r.decodeAll(ctx, cursor)
+}
+
+func (r *mongoPlayerCoupleRepository) decodeAll(ctx context.Context, cursor *mongo.Cursor) ([]domain.PlayerCouple, error) {
 	defer cursor.Close(ctx)
 
 	var playerCouples []domain.PlayerCouple
@@ -176,13 +275,56 @@ func (r *mongoPlayerCoupleRepository) FindByPrefixes(lastNamePlayer1, lastNamePl
 		if err := cursor.Decode(&playerCouple); err != nil {
 			return nil, err
 		}
+		playerCouple, err := decryptPlayerCouple(playerCouple, r.keyRing)
+		if err != nil {
+			return nil, err
+		}
 		playerCouples = append(playerCouples, playerCouple)
 	}
 	return playerCouples, nil
 }
 
-func (r *mongoPlayerCoupleRepository) Delete(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (r *mongoPlayerCoupleRepository) ReplacePlayer(ctx context.Context, player domain.Player) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	embedded, err := encryptPlayer(player, r.keyRing)
+	if err != nil {
+		return err
+	}
+	for _, field := range []string{"player1", "player2"} {
+		_, err = r.collection.UpdateMany(ctx, bson.M{field + "._id": player.ID}, bson.M{"$set": bson.M{field: embedded}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *mongoPlayerCoupleRepository) ForEach(ctx context.Context, ids []string, fn func(domain.PlayerCouple) error) error {
+	cursor, err := r.collection.Find(ctx, idsFilter(ids), options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var playerCouple domain.PlayerCouple
+		if err := cursor.Decode(&playerCouple); err != nil {
+			return err
+		}
+		playerCouple, err := decryptPlayerCouple(playerCouple, r.keyRing)
+		if err != nil {
+			return err
+		}
+		if err := fn(playerCouple); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+func (r *mongoPlayerCoupleRepository) Delete(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
@@ -1,10 +1,13 @@
 package mongo
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
 	"github.com/paguerre3/goddd/internal/modules/common/utils"
 	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
 	"github.com/stretchr/testify/assert"
@@ -40,7 +43,7 @@ type mongoClientMock struct {
 	database *mongo.Database
 }
 
-func (m *mongoClientMock) GetCollection(collectionName string) *mongo.Collection {
+func (m *mongoClientMock) GetCollection(_ context.Context, collectionName string) *mongo.Collection {
 	return m.database.Collection(collectionName)
 }
 
@@ -63,14 +66,14 @@ func TestMongoPlayerRepository_Save_Success(t *testing.T) {
 	mt.Run("Save player successfully", func(mt *mtest.T) {
 		idGen := newIdGenMock()
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(idGen, mongoClientMock)
-		player, err := domain.NewPlayer("john.doe@example.com", nil, "John", "Doe", nil)
+		repo := NewMongoPlayerRepository(idGen, mongoClientMock, newTestKeyRing(t))
+		player, err := domain.NewPlayer("john.doe@example.com", nil, "John", "Doe", domain.Profile{})
 		assert.NoError(t, err)
 		assert.Equal(t, "", player.ID)
 
 		mt.AddMockResponses(mtest.CreateSuccessResponse())
 
-		err = repo.Upsert(player)
+		err = repo.Upsert(context.Background(), player)
 		// generated ID set in repository implies a Save():
 		assert.Equal(t, mockId, player.ID)
 		assert.NoError(t, err, "Expected no error when saving player")
@@ -84,8 +87,8 @@ func TestMongoPlayerRepository_Save_Fail(t *testing.T) {
 	mt.Run("Fail to save player", func(mt *mtest.T) {
 		idGen := newIdGenMock()
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(idGen, mongoClientMock)
-		player, err := domain.NewPlayer("john.doe@example.com", nil, "John", "Doe", nil)
+		repo := NewMongoPlayerRepository(idGen, mongoClientMock, newTestKeyRing(t))
+		player, err := domain.NewPlayer("john.doe@example.com", nil, "John", "Doe", domain.Profile{})
 		assert.NoError(t, err)
 		// generated ID set in repository implies a Save():
 		assert.Equal(t, "", player.ID)
@@ -96,7 +99,7 @@ func TestMongoPlayerRepository_Save_Fail(t *testing.T) {
 			Message: "duplicate key error",
 		}))
 
-		err = repo.Upsert(player)
+		err = repo.Upsert(context.Background(), player)
 		assert.Error(t, err, "Expected error when saving player")
 		assert.Equal(t, "", player.ID)
 	})
@@ -108,8 +111,8 @@ func TestMongoPlayerRepository_FindByID_Success(t *testing.T) {
 	mt.Run("Find player by ID successfully", func(mt *mtest.T) {
 		idGen := newIdGenMock()
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(idGen, mongoClientMock)
-		player, err := domain.NewPlayer("john.doe@example.com", nil, "John", "Doe", nil)
+		repo := NewMongoPlayerRepository(idGen, mongoClientMock, newTestKeyRing(t))
+		player, err := domain.NewPlayer("john.doe@example.com", nil, "John", "Doe", domain.Profile{})
 		assert.NoError(t, err)
 		assert.Equal(t, "", player.ID)
 		// Mock a player with a generated ID set in the repository:
@@ -122,7 +125,7 @@ func TestMongoPlayerRepository_FindByID_Success(t *testing.T) {
 			{Key: "email", Value: player.Email},
 		}))
 
-		result, err := repo.FindByID(mockId)
+		result, err := repo.FindByID(context.Background(), mockId)
 		assert.NoError(t, err, "Expected no error when finding player by ID")
 		assert.Equal(t, *player, result, "Expected player to match")
 	})
@@ -133,11 +136,11 @@ func TestMongoPlayerRepository_FindByID_NotFound(t *testing.T) {
 
 	mt.Run("Find player by ID not found", func(mt *mtest.T) {
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock)
+		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
 
 		mt.AddMockResponses(mtest.CreateCursorResponse(0, testPlayersNs, mtest.FirstBatch))
 
-		result, err := repo.FindByID(mockId)
+		result, err := repo.FindByID(context.Background(), mockId)
 		assert.NoError(t, err)
 		assert.Equal(t, domain.Player{}, result, "Expected result to be empty player")
 	})
@@ -148,11 +151,11 @@ func TestMongoPlayerRepository_FindByID_Fail(t *testing.T) {
 
 	mt.Run("Fail to find player by ID", func(mt *mtest.T) {
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock)
+		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
 
 		mt.AddMockResponses(mtest.CreateCursorResponse(-1, testPlayersNs, mtest.FirstBatch))
 
-		result, err := repo.FindByID(mockId)
+		result, err := repo.FindByID(context.Background(), mockId)
 		assert.Error(t, err, "Expected error when finding player by ID")
 		assert.Equal(t, domain.Player{}, result, "Expected result to be empty player")
 	})
@@ -164,7 +167,7 @@ func TestMongoPlayerRepository_FindByEmail_Success(t *testing.T) {
 	mt.Run("Find player by email successfully", func(mt *mtest.T) {
 		idGen := newIdGenMock()
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(idGen, mongoClientMock)
+		repo := NewMongoPlayerRepository(idGen, mongoClientMock, newTestKeyRing(t))
 		player := domain.Player{ID: idGen.GenerateID(), FirstName: "John", LastName: "Doe", Email: "john.doe@example.com"}
 
 		mt.AddMockResponses(mtest.CreateCursorResponse(1, testPlayersNs, mtest.FirstBatch, bson.D{
@@ -174,7 +177,7 @@ func TestMongoPlayerRepository_FindByEmail_Success(t *testing.T) {
 			{Key: "email", Value: player.Email},
 		}))
 
-		result, err := repo.FindByEmail("john.doe@example.com")
+		result, err := repo.FindByEmail(context.Background(), "john.doe@example.com")
 		assert.NoError(t, err, "Expected no error when finding player by email")
 		assert.Equal(t, player, result, "Expected player to match")
 	})
@@ -185,11 +188,11 @@ func TestMongoPlayerRepository_FindByEmail_NotFound(t *testing.T) {
 
 	mt.Run("Find player by email not found", func(mt *mtest.T) {
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock)
+		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
 
 		mt.AddMockResponses(mtest.CreateCursorResponse(0, testPlayersNs, mtest.FirstBatch))
 
-		result, err := repo.FindByEmail("john.doe@example.com")
+		result, err := repo.FindByEmail(context.Background(), "john.doe@example.com")
 		assert.NoError(t, err)
 		assert.Equal(t, domain.Player{}, result, "Expected result to be empty player")
 	})
@@ -200,11 +203,11 @@ func TestMongoPlayerRepository_FindByEmail_Fail(t *testing.T) {
 
 	mt.Run("Fail to find player by email", func(mt *mtest.T) {
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock)
+		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
 
 		mt.AddMockResponses(mtest.CreateCursorResponse(-1, testPlayersNs, mtest.FirstBatch))
 
-		result, err := repo.FindByEmail("john.doe@example.com")
+		result, err := repo.FindByEmail(context.Background(), "john.doe@example.com")
 		assert.Error(t, err, "Expected error when finding player by email")
 		assert.Equal(t, domain.Player{}, result, "Expected result to be empty player")
 	})
@@ -216,7 +219,7 @@ func TestMongoPlayerRepository_FindByLastName_Success(t *testing.T) {
 	mt.Run("Find players by last name successfully", func(mt *mtest.T) {
 		idGen := newIdGenMock()
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(idGen, mongoClientMock)
+		repo := NewMongoPlayerRepository(idGen, mongoClientMock, newTestKeyRing(t))
 		player1 := domain.Player{ID: idGen.GenerateID() + "1", FirstName: "John", LastName: "Doe", Email: "john.doe@example.com"}
 		player2 := domain.Player{ID: idGen.GenerateID() + "2", FirstName: "Juan", LastName: "Doe", Email: "juan.doe@example.com"}
 
@@ -232,7 +235,7 @@ func TestMongoPlayerRepository_FindByLastName_Success(t *testing.T) {
 			{Key: "email", Value: player2.Email},
 		}))
 
-		result, err := repo.FindByLastName("Doe")
+		result, err := repo.FindByLastName(context.Background(), "Doe")
 		assert.NoError(t, err, "Expected no error when finding players by last name")
 		assert.Equal(t, []domain.Player{player1, player2}, result, "Expected players to match")
 	})
@@ -244,15 +247,15 @@ func TestMongoPlayerRepository_FindByLastName_Fail(t *testing.T) {
 	mt.Run("Fail to find players by last name", func(mt *mtest.T) {
 		idGen := newIdGenMock()
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(idGen, mongoClientMock)
+		repo := NewMongoPlayerRepository(idGen, mongoClientMock, newTestKeyRing(t))
 
 		mt.AddMockResponses(mtest.CreateCursorResponse(-1, testPlayersNs, mtest.FirstBatch, bson.D{
 			{Key: "_id", Value: idGen.GenerateID()},
 			{Key: "lastName", Value: "Smith"},
-			{Key: "age", Value: "invalidAgeDecode"},
+			{Key: "level", Value: "invalidLevelDecode"},
 		}))
 
-		result, err := repo.FindByLastName("Smith")
+		result, err := repo.FindByLastName(context.Background(), "Smith")
 		assert.Error(t, err, "Expected error when finding players by last name")
 		assert.Nil(t, result, "Expected result to be nil")
 	})
@@ -264,17 +267,33 @@ func TestMongoPlayerRepository_Upsert_Update_Success(t *testing.T) {
 	mt.Run("Update player successfully", func(mt *mtest.T) {
 		idGen := newIdGenMock()
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(idGen, mongoClientMock)
+		repo := NewMongoPlayerRepository(idGen, mongoClientMock, newTestKeyRing(t))
 		excpectedId := idGen.GenerateID()
 		player := domain.Player{ID: excpectedId, FirstName: "John", LastName: "Doe", Email: "john.doe@example.com"}
 
 		mt.AddMockResponses(mtest.CreateSuccessResponse())
 
 		// Update inplies ID already set previous to the Upsert method call.
-		err := repo.Upsert(&player)
+		err := repo.Upsert(context.Background(), &player)
 		// NOT new autogenerated ID set in repository implies an Update():
 		assert.Equal(t, excpectedId, player.ID)
 		assert.NoError(t, err, "Expected no error when updating player")
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, "Doe", update.Lookup("u", "$set", "lastName").StringValue(), "Expected fields not part of the player kept")
+	})
+
+	mt.Run("Replace erased player", func(mt *mtest.T) {
+		repo := NewMongoPlayerRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		erasedAt := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+		player := domain.Player{ID: mockId, FirstName: "Erased", LastName: "Player", Email: "erased-1@erased.invalid", ErasedAt: &erasedAt}
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(context.Background(), &player))
+
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, "Player", update.Lookup("u", "lastName").StringValue(), "Expected the erased personal data removed")
+		_, err := update.LookupErr("u", "$set")
+		assert.Error(t, err)
 	})
 }
 
@@ -284,7 +303,7 @@ func TestMongoPlayerRepository_Upsert_Update_Fail(t *testing.T) {
 	mt.Run("Fail to update player", func(mt *mtest.T) {
 		idGen := newIdGenMock()
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(idGen, mongoClientMock)
+		repo := NewMongoPlayerRepository(idGen, mongoClientMock, newTestKeyRing(t))
 		// Update inplies ID already set previous to the Upsert method call.
 		player := domain.Player{ID: idGen.GenerateID(), FirstName: "John", LastName: "Doe", Email: "john.doe@example.com"}
 
@@ -294,7 +313,7 @@ func TestMongoPlayerRepository_Upsert_Update_Fail(t *testing.T) {
 			Message: "duplicate key error",
 		}))
 
-		err := repo.Upsert(&player)
+		err := repo.Upsert(context.Background(), &player)
 		assert.Error(t, err, "Expected error when updating player")
 	})
 }
@@ -304,11 +323,11 @@ func TestMongoPlayerRepository_Delete_Success(t *testing.T) {
 
 	mt.Run("Delete player successfully", func(mt *mtest.T) {
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock)
+		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
 
 		mt.AddMockResponses(mtest.CreateSuccessResponse())
 
-		err := repo.Delete("1")
+		err := repo.Delete(context.Background(), "1")
 		assert.NoError(t, err, "Expected no error when deleting player")
 	})
 }
@@ -318,7 +337,7 @@ func TestMongoPlayerRepository_Delete_Fail(t *testing.T) {
 
 	mt.Run("Fail to delete player", func(mt *mtest.T) {
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock)
+		repo := NewMongoPlayerRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
 
 		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
 			Index:   0,
@@ -326,7 +345,7 @@ func TestMongoPlayerRepository_Delete_Fail(t *testing.T) {
 			Message: "delete error",
 		}))
 
-		err := repo.Delete("1")
+		err := repo.Delete(context.Background(), "1")
 		assert.Error(t, err, "Expected error when deleting player")
 	})
 }
@@ -344,15 +363,14 @@ func TestMongoPlayerCoupleRepository_Upsert_Save(t *testing.T) {
 		mt.AddMockResponses(mtest.CreateSuccessResponse())
 
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(idGen, mongoClientMock)
+		repo := NewMongoPlayerCoupleRepository(idGen, mongoClientMock, newTestKeyRing(t))
 		// couple ID not set in domain implies a Save() before Upsert() call:
 		playerCouple, err := domain.NewPlayerCouple(player1, player2, nil)
 		assert.Equal(t, "", playerCouple.ID)
-		err = repo.Upsert(playerCouple)
+		err = repo.Upsert(context.Background(), playerCouple)
 		assert.NoError(t, err, "Expected no error when saving player couple")
-		// couple ID set in repository implies a Save() inside Upsert() call:
-		expectedCoupleID := idGen.GenerateIDWithPrefixes(player1.LastName, player2.LastName)
-		assert.Equal(t, expectedCoupleID, playerCouple.ID)
+		// couple ID set in repository implies a Save() inside Upsert() call, without the last names of the players:
+		assert.Equal(t, idGen.GenerateID(), playerCouple.ID)
 	})
 
 	mt.Run("failure", func(mt *mtest.T) {
@@ -363,12 +381,12 @@ func TestMongoPlayerCoupleRepository_Upsert_Save(t *testing.T) {
 		}))
 
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(idGen, mongoClientMock)
+		repo := NewMongoPlayerCoupleRepository(idGen, mongoClientMock, newTestKeyRing(t))
 		// couple ID not set in domain implies a Save() before Upsert() call:
 		playerCouple, err := domain.NewPlayerCouple(player1, player2, nil)
 		assert.Equal(t, "", playerCouple.ID)
 
-		err = repo.Upsert(playerCouple)
+		err = repo.Upsert(context.Background(), playerCouple)
 		assert.Error(t, err, "Expected error when saving player couple")
 	})
 }
@@ -398,8 +416,8 @@ func TestMongoPlayerCoupleRepository_FindByID(t *testing.T) {
 		}))
 
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), mongoClientMock)
-		result, err := repo.FindByID(playerCouple.ID)
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
+		result, err := repo.FindByID(context.Background(), playerCouple.ID)
 		assert.NoError(t, err, "Expected no error when finding player couple by ID")
 		assert.Equal(t, playerCouple, result, "Expected player couple to match")
 	})
@@ -408,8 +426,8 @@ func TestMongoPlayerCoupleRepository_FindByID(t *testing.T) {
 		mt.AddMockResponses(mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch))
 
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), mongoClientMock)
-		pc, err := repo.FindByID("c2")
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
+		pc, err := repo.FindByID(context.Background(), "c2")
 		assert.NoError(t, err, "Expected no error when player couple not found")
 		assert.Equal(t, domain.PlayerCouple{}, pc, "Expected empty player couple")
 	})
@@ -418,68 +436,57 @@ func TestMongoPlayerCoupleRepository_FindByID(t *testing.T) {
 		mt.AddMockResponses(mtest.CreateCursorResponse(-1, testPlayerCouplesNs, mtest.FirstBatch))
 
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), mongoClientMock)
-		_, err := repo.FindByID("c2")
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
+		_, err := repo.FindByID(context.Background(), "c2")
 		assert.Error(t, err, "Expected error when finding by player couple ID")
 	})
 }
 
-func TestMongoPlayerCoupleRepository_FindByPrefixes(t *testing.T) {
+func TestMongoPlayerCoupleRepository_FindByPlayers(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 
-	idGen := newIdGenMock()
-	player1 := domain.Player{ID: "1", FirstName: "John", LastName: "Doe", Email: "john.doe@example.com"}
-	player2 := domain.Player{ID: "2", FirstName: "Jane", LastName: "Smith", Email: "jane.smith@example.com"}
-	cid := idGen.GenerateIDWithPrefixes(player1.LastName, player2.LastName)
-	playerCouple := domain.PlayerCouple{ID: cid, Player1: player1, Player2: player2}
-
-	mt.Run("success", func(mt *mtest.T) {
-		mt.AddMockResponses(mtest.CreateCursorResponse(1, testPlayerCouplesNs, mtest.FirstBatch, bson.D{
-			{Key: "_id", Value: playerCouple.ID},
-			{Key: "player1", Value: bson.D{
-				{Key: "_id", Value: player1.ID},
-				{Key: "firstName", Value: player1.FirstName},
-				{Key: "lastName", Value: player1.LastName},
-				{Key: "email", Value: player1.Email},
-			}},
-			{Key: "player2", Value: bson.D{
-				{Key: "_id", Value: player2.ID},
-				{Key: "firstName", Value: player2.FirstName},
-				{Key: "lastName", Value: player2.LastName},
-				{Key: "email", Value: player2.Email},
-			}},
+	mt.Run("success in any order", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "c1"},
+			{Key: "player1", Value: bson.D{{Key: "_id", Value: "1"}, {Key: "lastName", Value: "Doe"}}},
+			{Key: "player2", Value: bson.D{{Key: "_id", Value: "2"}, {Key: "lastName", Value: "Smith"}}},
 		}))
 
-		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(idGen, mongoClientMock)
-		result, err := repo.FindByPrefixes(player1.LastName, player2.LastName)
-		assert.NoError(t, err, "Expected no error when finding player couple by prefixes")
-		assert.Equal(t, []domain.PlayerCouple{playerCouple}, result, "Expected player couples to match")
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		result, err := repo.FindByPlayers(context.Background(), []string{"2"}, []string{"1", "3"})
+		assert.NoError(t, err, "Expected no error when finding player couples by players")
+		assert.Equal(t, []domain.PlayerCouple{{ID: "c1",
+			Player1: domain.Player{ID: "1", LastName: "Doe"}, Player2: domain.Player{ID: "2", LastName: "Smith"}}}, result)
+
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, mustMarshal(t, bson.M{"$or": bson.A{
+			bson.D{{Key: "player1._id", Value: bson.M{"$in": []string{"2"}}}, {Key: "player2._id", Value: bson.M{"$in": []string{"1", "3"}}}},
+			bson.D{{Key: "player1._id", Value: bson.M{"$in": []string{"1", "3"}}}, {Key: "player2._id", Value: bson.M{"$in": []string{"2"}}}},
+		}}).Lookup("$or"), filter.Lookup("$or"), "Expected no regex on IDs")
+		assert.Equal(t, tenant.Default, filter.Lookup(common.TenantField).StringValue())
+	})
+
+	mt.Run("any partner", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch))
+
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		result, err := repo.FindByPlayers(context.Background(), []string{"1"}, nil)
+		assert.NoError(t, err, "Expected no error when no player couple found")
+		assert.Empty(t, result)
+
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, mustMarshal(t, bson.M{"$or": bson.A{
+			bson.M{"player1._id": bson.M{"$in": []string{"1"}}},
+			bson.M{"player2._id": bson.M{"$in": []string{"1"}}},
+		}}).Lookup("$or"), filter.Lookup("$or"))
 	})
 
 	mt.Run("failure", func(mt *mtest.T) {
-		mt.AddMockResponses(mtest.CreateCursorResponse(1, testPlayerCouplesNs, mtest.FirstBatch, bson.D{
-			{Key: "_id", Value: playerCouple.ID},
-			{Key: "player1", Value: bson.D{
-				{Key: "_id", Value: player1.ID},
-				{Key: "firstName", Value: player1.FirstName},
-				{Key: "lastName", Value: player1.LastName},
-				{Key: "email", Value: player1.Email},
-			}},
-			{Key: "player2", Value: bson.D{
-				{Key: "_id", Value: player2.ID},
-				{Key: "firstName", Value: player2.FirstName},
-				{Key: "lastName", Value: player2.LastName},
-				{Key: "email", Value: player2.Email},
-				// Decode error:
-				{Key: "age", Value: "invalidAgeDecode"},
-			}},
-		}))
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "find error"}))
 
-		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(idGen, mongoClientMock)
-		result, err := repo.FindByPrefixes(player1.LastName, player2.LastName)
-		assert.Error(t, err, "Expected error when finding player couple by prefixes")
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		result, err := repo.FindByPlayers(context.Background(), []string{"1"}, []string{"2"})
+		assert.Error(t, err, "Expected error when finding player couples by players")
 		assert.Nil(t, result, "Expected result to be nil")
 	})
 }
@@ -497,10 +504,10 @@ func TestMongoPlayerCoupleRepository_Upsert_Update(t *testing.T) {
 	mt.Run("success", func(mt *mtest.T) {
 		mt.AddMockResponses(mtest.CreateSuccessResponse())
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(idGen, mongoClientMock)
+		repo := NewMongoPlayerCoupleRepository(idGen, mongoClientMock, newTestKeyRing(t))
 
 		assert.Equal(t, coupleIdExpected, playerCouple.ID)
-		err := repo.Upsert(&playerCouple)
+		err := repo.Upsert(context.Background(), &playerCouple)
 		assert.NoError(t, err, "Expected no error when updating player couple")
 		assert.Equal(t, coupleIdExpected, playerCouple.ID)
 	})
@@ -513,10 +520,10 @@ func TestMongoPlayerCoupleRepository_Upsert_Update(t *testing.T) {
 		}))
 
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(idGen, mongoClientMock)
+		repo := NewMongoPlayerCoupleRepository(idGen, mongoClientMock, newTestKeyRing(t))
 
 		assert.Equal(t, coupleIdExpected, playerCouple.ID)
-		err := repo.Upsert(&playerCouple)
+		err := repo.Upsert(context.Background(), &playerCouple)
 		assert.Error(t, err, "Expected error when updating player couple")
 	})
 }
@@ -528,8 +535,8 @@ func TestMongoPlayerCoupleRepository_Delete(t *testing.T) {
 		mt.AddMockResponses(mtest.CreateSuccessResponse())
 
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), mongoClientMock)
-		err := repo.Delete("1")
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
+		err := repo.Delete(context.Background(), "1")
 		assert.NoError(t, err, "Expected no error when deleting player couple")
 	})
 
@@ -541,8 +548,186 @@ func TestMongoPlayerCoupleRepository_Delete(t *testing.T) {
 		}))
 
 		mongoClientMock := newMongoClientMock(mt.Client)
-		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), mongoClientMock)
-		err := repo.Delete("1")
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), mongoClientMock, newTestKeyRing(t))
+		err := repo.Delete(context.Background(), "1")
 		assert.Error(t, err, "Expected error when deleting player couple")
 	})
 }
+
+func TestMongoPlayerCoupleRepository_FindByPlayerID(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("success", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testPlayerCouplesNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "c1"},
+			{Key: "player1", Value: bson.D{{Key: "_id", Value: "1"}, {Key: "lastName", Value: "Doe"}}},
+			{Key: "player2", Value: bson.D{{Key: "_id", Value: "2"}, {Key: "lastName", Value: "Smith"}}},
+		}), mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.NextBatch))
+
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		result, err := repo.FindByPlayerID(context.Background(), "2")
+		assert.NoError(t, err, "Expected no error when finding player couples by player ID")
+		assert.Equal(t, []domain.PlayerCouple{{ID: "c1",
+			Player1: domain.Player{ID: "1", LastName: "Doe"}, Player2: domain.Player{ID: "2", LastName: "Smith"}}}, result)
+	})
+
+	mt.Run("failure", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "find error"}))
+
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		_, err := repo.FindByPlayerID(context.Background(), "2")
+		assert.Error(t, err, "Expected error when finding player couples by player ID")
+	})
+}
+
+func TestMongoPlayerCoupleRepository_ReplacePlayer(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	player := domain.Player{ID: "1", FirstName: "Erased", LastName: "Player", Email: "erased-1@erased.invalid"}
+
+	mt.Run("success", func(mt *mtest.T) {
+		// One update per embedded position (player1, player2).
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		assert.NoError(t, repo.ReplacePlayer(context.Background(), player), "Expected no error when replacing player")
+	})
+
+	mt.Run("failure", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "update error"}))
+
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		assert.Error(t, repo.ReplacePlayer(context.Background(), player), "Expected error when replacing player")
+	})
+}
+
+func TestMongoPlayerRepository_ForEach(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("success", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testPlayersNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "1"}, {Key: "lastName", Value: "Doe"},
+		}), mtest.CreateCursorResponse(1, testPlayersNs, mtest.NextBatch, bson.D{
+			{Key: "_id", Value: "2"}, {Key: "lastName", Value: "Smith"},
+		}), mtest.CreateCursorResponse(0, testPlayersNs, mtest.NextBatch))
+
+		repo := NewMongoPlayerRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		var players []domain.Player
+		err := repo.ForEach(context.Background(), []string{"1", "2"}, func(player domain.Player) error {
+			players = append(players, player)
+			return nil
+		})
+		assert.NoError(t, err, "Expected no error when streaming players")
+		assert.Equal(t, []domain.Player{{ID: "1", LastName: "Doe"}, {ID: "2", LastName: "Smith"}}, players)
+	})
+
+	mt.Run("callback error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testPlayersNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "1"}, {Key: "lastName", Value: "Doe"},
+		}), mtest.CreateCursorResponse(0, testPlayersNs, mtest.NextBatch))
+
+		repo := NewMongoPlayerRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		err := repo.ForEach(context.Background(), nil, func(domain.Player) error {
+			return fmt.Errorf("write error")
+		})
+		assert.EqualError(t, err, "write error")
+	})
+}
+
+func TestMongoPlayerCoupleRepository_ForEach(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("success", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testPlayerCouplesNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "c1"},
+			{Key: "player1", Value: bson.D{{Key: "_id", Value: "1"}}},
+			{Key: "player2", Value: bson.D{{Key: "_id", Value: "2"}}},
+		}), mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.NextBatch))
+
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		var ids []string
+		err := repo.ForEach(context.Background(), nil, func(playerCouple domain.PlayerCouple) error {
+			ids = append(ids, playerCouple.ID)
+			return nil
+		})
+		assert.NoError(t, err, "Expected no error when streaming player couples")
+		assert.Equal(t, []string{"c1"}, ids)
+	})
+
+	mt.Run("failure", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "find error"}))
+
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		err := repo.ForEach(context.Background(), nil, func(domain.PlayerCouple) error { return nil })
+		assert.Error(t, err, "Expected error when streaming player couples")
+	})
+}
+
+func TestMongoPlayerRepository_Tenant(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	ctx := tenant.WithID(context.Background(), "fep")
+
+	mt.Run("Players of other tenants are never read", func(mt *mtest.T) {
+		repo := NewMongoPlayerRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testPlayersNs, mtest.FirstBatch))
+
+		player, err := repo.FindByEmail(ctx, "john.doe@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Player{}, player)
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, "john.doe@example.com", filter.Lookup("email").StringValue())
+		assert.Equal(t, "fep", filter.Lookup(common.TenantField).StringValue())
+	})
+
+	mt.Run("Players are stored with their tenant", func(mt *mtest.T) {
+		repo := NewMongoPlayerRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		player, _ := domain.NewPlayer("john.doe@example.com", nil, "John", "Doe", domain.Profile{})
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(ctx, player))
+		inserted := mt.GetStartedEvent().Command.Lookup("documents").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", inserted.Lookup(common.TenantField).StringValue())
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(ctx, player))
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", update.Lookup("q", common.TenantField).StringValue(), "Expected players of other tenants not updated")
+		_, err := update.LookupErr("u", "$set", common.TenantField)
+		assert.Error(t, err, "Expected the tenant kept when updated")
+	})
+
+	mt.Run("Players of other tenants are never deleted", func(mt *mtest.T) {
+		repo := NewMongoPlayerRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Delete(ctx, mockId))
+		deleted := mt.GetStartedEvent().Command.Lookup("deletes").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", deleted.Lookup("q", common.TenantField).StringValue())
+	})
+}
+
+func TestMongoPlayerCoupleRepository_Tenant(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	ctx := tenant.WithID(context.Background(), "fep")
+
+	mt.Run("Couples of other tenants are never read", func(mt *mtest.T) {
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch))
+
+		playerCouple, err := repo.FindByID(ctx, "c1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PlayerCouple{}, playerCouple)
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, "fep", filter.Lookup(common.TenantField).StringValue(), "Expected legacy IDs found within the tenant")
+	})
+
+	mt.Run("Players embedded in couples of other tenants are never replaced", func(mt *mtest.T) {
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+
+		assert.NoError(t, repo.ReplacePlayer(ctx, domain.Player{ID: "1", LastName: "Doe"}))
+		for event := mt.GetStartedEvent(); event != nil; event = mt.GetStartedEvent() {
+			update := event.Command.Lookup("updates").Array().Index(0).Value().Document()
+			assert.Equal(t, "fep", update.Lookup("q", common.TenantField).StringValue())
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package mongo
+
+import (
+	"github.com/paguerre3/goddd/internal/modules/common/encryption"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+)
+
+// playerDocument is the stored form of a player, i.e. SSN encrypted at rest plus its blind index
+// so players can be looked up by SSN without decrypting every document.
+type playerDocument struct {
+	domain.Player `bson:",inline"`
+	SSNBlindIndex string `bson:"ssnBlindIndex,omitempty"`
+}
+
+func newPlayerDocument(player domain.Player, keyRing *encryption.KeyRing) (playerDocument, error) {
+	var blindIndex string
+	if player.SocialSecurityNumber != nil {
+		blindIndex = keyRing.BlindIndex(*player.SocialSecurityNumber)
+	}
+	encrypted, err := encryptPlayer(player, keyRing)
+	if err != nil {
+		return playerDocument{}, err
+	}
+	return playerDocument{Player: encrypted, SSNBlindIndex: blindIndex}, nil
+}
+
+func (d playerDocument) toPlayer(keyRing *encryption.KeyRing) (domain.Player, error) {
+	return decryptPlayer(d.Player, keyRing)
+}
+
+// encryptPlayer returns a copy of the player with the SSN encrypted (also used for players embedded in couples).
+// Players are always given in plaintext, i.e. as decrypted by the repositories when read.
+func encryptPlayer(player domain.Player, keyRing *encryption.KeyRing) (domain.Player, error) {
+	if player.SocialSecurityNumber == nil {
+		return player, nil
+	}
+	ciphertext, err := keyRing.Encrypt(*player.SocialSecurityNumber)
+	if err != nil {
+		return player, err
+	}
+	player.SocialSecurityNumber = &ciphertext
+	return player, nil
+}
+
+// decryptPlayer returns a copy of the player with the SSN in plaintext.
+func decryptPlayer(player domain.Player, keyRing *encryption.KeyRing) (domain.Player, error) {
+	if player.SocialSecurityNumber == nil {
+		return player, nil
+	}
+	plaintext, err := keyRing.Decrypt(*player.SocialSecurityNumber)
+	if err != nil {
+		return player, err
+	}
+	player.SocialSecurityNumber = &plaintext
+	return player, nil
+}
+
+func encryptPlayerCouple(playerCouple domain.PlayerCouple, keyRing *encryption.KeyRing) (domain.PlayerCouple, error) {
+	var err error
+	if playerCouple.Player1, err = encryptPlayer(playerCouple.Player1, keyRing); err != nil {
+		return playerCouple, err
+	}
+	playerCouple.Player2, err = encryptPlayer(playerCouple.Player2, keyRing)
+	return playerCouple, err
+}
+
+func decryptPlayerCouple(playerCouple domain.PlayerCouple, keyRing *encryption.KeyRing) (domain.PlayerCouple, error) {
+	var err error
+	if playerCouple.Player1, err = decryptPlayer(playerCouple.Player1, keyRing); err != nil {
+		return playerCouple, err
+	}
+	playerCouple.Player2, err = decryptPlayer(playerCouple.Player2, keyRing)
+	return playerCouple, err
+}
@@ -0,0 +1,186 @@
+package mongo
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/common/encryption"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+const testSSN = "20123456789"
+
+func newTestKeyRingWith(t *testing.T, activeKeyID string) *encryption.KeyRing {
+	keyRing, err := encryption.NewKeyRing(map[string][]byte{
+		"k1": bytes.Repeat([]byte{1}, 32),
+		"k2": bytes.Repeat([]byte{2}, 32),
+	}, activeKeyID, bytes.Repeat([]byte{9}, 32))
+	assert.NoError(t, err)
+	return keyRing
+}
+
+func newTestKeyRing(t *testing.T) *encryption.KeyRing {
+	return newTestKeyRingWith(t, "k1")
+}
+
+func TestPlayerDocument(t *testing.T) {
+	keyRing := newTestKeyRing(t)
+	ssn := testSSN
+	player := domain.Player{ID: mockId, Email: "john.doe@example.com", SocialSecurityNumber: &ssn}
+
+	document, err := newPlayerDocument(player, keyRing)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(*document.SocialSecurityNumber, "enc:v1:k1:"))
+	assert.Equal(t, keyRing.BlindIndex(testSSN), document.SSNBlindIndex)
+	// The input player isn't modified.
+	assert.Equal(t, testSSN, *player.SocialSecurityNumber)
+
+	raw, err := bson.Marshal(document)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), testSSN)
+
+	decrypted, err := document.toPlayer(keyRing)
+	assert.NoError(t, err)
+	assert.Equal(t, player, decrypted)
+}
+
+func TestPlayerDocument_PrefixedSSN(t *testing.T) {
+	keyRing := newTestKeyRing(t)
+	// Not encrypted although it looks like it, e.g. stored before such SSNs were rejected.
+	ssn := "enc:v1:k1:" + testSSN
+	player := domain.Player{ID: mockId, Email: "john.doe@example.com", SocialSecurityNumber: &ssn}
+
+	document, err := newPlayerDocument(player, keyRing)
+	assert.NoError(t, err)
+	assert.NotEqual(t, ssn, *document.SocialSecurityNumber, "Expected the SSN encrypted")
+	assert.Equal(t, keyRing.BlindIndex(ssn), document.SSNBlindIndex)
+
+	decrypted, err := document.toPlayer(keyRing)
+	assert.NoError(t, err)
+	assert.Equal(t, player, decrypted)
+}
+
+func TestMongoPlayerRepository_EncryptsSSN(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("SSN encrypted on insert", func(mt *mtest.T) {
+		repo := NewMongoPlayerRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		ssn := testSSN
+		player, err := domain.NewPlayer("john.doe@example.com", &ssn, "John", "Doe", domain.Profile{})
+		assert.NoError(t, err)
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(context.Background(), player))
+
+		command := mt.GetStartedEvent().Command.String()
+		assert.NotContains(t, command, testSSN)
+		assert.Contains(t, command, "enc:v1:k1:")
+		assert.Contains(t, command, "ssnBlindIndex")
+		assert.Equal(t, testSSN, *player.SocialSecurityNumber)
+	})
+
+	mt.Run("Find by SSN uses the blind index and decrypts", func(mt *mtest.T) {
+		keyRing := newTestKeyRing(t)
+		repo := NewMongoPlayerRepository(newIdGenMock(), newMongoClientMock(mt.Client), keyRing)
+		ciphertext, err := keyRing.Encrypt(testSSN)
+		assert.NoError(t, err)
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testPlayersNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockId},
+			{Key: "socialSecurityNumber", Value: ciphertext},
+			{Key: "ssnBlindIndex", Value: keyRing.BlindIndex(testSSN)},
+		}))
+
+		player, err := repo.FindBySocialSecurityNumber(context.Background(), testSSN)
+		assert.NoError(t, err)
+		assert.Equal(t, testSSN, *player.SocialSecurityNumber)
+		filter := mt.GetStartedEvent().Command.Lookup("filter").String()
+		assert.Contains(t, filter, keyRing.BlindIndex(testSSN))
+		assert.NotContains(t, filter, testSSN)
+	})
+
+	mt.Run("Couple players SSN encrypted", func(mt *mtest.T) {
+		repo := NewMongoPlayerCoupleRepository(newIdGenMock(), newMongoClientMock(mt.Client), newTestKeyRing(t))
+		ssn := testSSN
+		playerCouple := &domain.PlayerCouple{
+			Player1: domain.Player{ID: "p1", LastName: "Doe", SocialSecurityNumber: &ssn},
+			Player2: domain.Player{ID: "p2", LastName: "Roe"},
+		}
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(context.Background(), playerCouple))
+		assert.NotContains(t, mt.GetStartedEvent().Command.String(), testSSN)
+		assert.Equal(t, testSSN, *playerCouple.Player1.SocialSecurityNumber)
+	})
+}
+
+func TestSSNKeyRotation(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Re-encrypts stale SSNs with the active key", func(mt *mtest.T) {
+		oldCiphertext, err := newTestKeyRingWith(t, "k1").Encrypt(testSSN)
+		assert.NoError(t, err)
+		rotation := NewSSNKeyRotation(newMongoClientMock(mt.Client), newTestKeyRingWith(t, "k2"))
+
+		mt.AddMockResponses(
+			// players: one encrypted with the retired key and one legacy plaintext.
+			mtest.CreateCursorResponse(1, testPlayersNs, mtest.FirstBatch,
+				bson.D{{Key: "_id", Value: "p1"}, {Key: "socialSecurityNumber", Value: oldCiphertext}},
+				bson.D{{Key: "_id", Value: "p2"}, {Key: "socialSecurityNumber", Value: testSSN}}),
+			bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}},
+			bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}},
+			mtest.CreateCursorResponse(0, testPlayersNs, mtest.NextBatch),
+			// player couples.
+			mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch,
+				bson.D{{Key: "_id", Value: "c1"}, {Key: "player1", Value: bson.D{{Key: "socialSecurityNumber", Value: oldCiphertext}}}}),
+			bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}},
+		)
+
+		rotated, err := rotation.Rotate(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 3, rotated)
+
+		var updates []bson.Raw
+		for _, evt := range mt.GetAllStartedEvents() {
+			if evt.CommandName == "update" {
+				updates = append(updates, evt.Command.Lookup("updates").Array().Index(0).Value().Document())
+			}
+		}
+		assert.Len(t, updates, 3)
+		for _, update := range updates {
+			assert.Contains(t, update.Lookup("u").String(), "enc:v1:k2:")
+			assert.NotContains(t, update.Lookup("u").String(), testSSN)
+		}
+		assert.Contains(t, updates[1].Lookup("u").String(), newTestKeyRing(t).BlindIndex(testSSN))
+		// Only the SSNs read are replaced, players without SSN are left as they are.
+		assert.Equal(t, oldCiphertext, updates[0].Lookup("q", "socialSecurityNumber").StringValue())
+		assert.Equal(t, testSSN, updates[1].Lookup("q", "socialSecurityNumber").StringValue())
+		assert.Equal(t, oldCiphertext, updates[2].Lookup("q", "player1.socialSecurityNumber").StringValue())
+		assert.Equal(t, bson.TypeNull, updates[2].Lookup("q", "player2.socialSecurityNumber").Type)
+		_, err = updates[2].LookupErr("u", "$set", "player2.socialSecurityNumber")
+		assert.Error(t, err, "Expected no null SSN stored")
+	})
+
+	mt.Run("Skips SSNs changed meanwhile", func(mt *mtest.T) {
+		oldCiphertext, err := newTestKeyRingWith(t, "k1").Encrypt(testSSN)
+		assert.NoError(t, err)
+		rotation := NewSSNKeyRotation(newMongoClientMock(mt.Client), newTestKeyRingWith(t, "k2"))
+
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, testPlayersNs, mtest.FirstBatch,
+				bson.D{{Key: "_id", Value: "p1"}, {Key: "socialSecurityNumber", Value: oldCiphertext}}),
+			bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 0}, {Key: "nModified", Value: 0}},
+			mtest.CreateCursorResponse(0, testPlayerCouplesNs, mtest.FirstBatch),
+		)
+
+		rotated, err := rotation.Rotate(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, rotated)
+		find := mt.GetStartedEvent().Command.Lookup("filter", "socialSecurityNumber").Document()
+		assert.Equal(t, "string", find.Lookup("$type").StringValue(), "Expected null SSNs not to be stale")
+	})
+}
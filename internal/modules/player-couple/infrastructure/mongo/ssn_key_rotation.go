@@ -0,0 +1,127 @@
+package mongo
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/paguerre3/goddd/internal/modules/common/encryption"
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// SSNKeyRotation re-encrypts stored SSNs with the active key of the ring, i.e. values encrypted with
+// retired keys and plaintext values stored before encryption was enabled. Documents of every tenant sharing the
+// database of ctx are rotated.
+type SSNKeyRotation struct {
+	keyRing *encryption.KeyRing
+	client  common.MongoClient
+}
+
+func NewSSNKeyRotation(client common.MongoClient, keyRing *encryption.KeyRing) *SSNKeyRotation {
+	return &SSNKeyRotation{
+		keyRing: keyRing,
+		client:  client,
+	}
+}
+
+// Rotate returns the number of re-encrypted documents. It's idempotent so it can be run on every startup.
+func (r *SSNKeyRotation) Rotate(ctx context.Context) (int, error) {
+	rotatedPlayers, err := r.rotatePlayers(ctx)
+	if err != nil {
+		return rotatedPlayers, err
+	}
+	rotatedCouples, err := r.rotatePlayerCouples(ctx)
+	return rotatedPlayers + rotatedCouples, err
+}
+
+// staleFilter matches existing SSNs not encrypted with the active key, nulls being no SSN.
+func (r *SSNKeyRotation) staleFilter(field string) bson.M {
+	return bson.M{field: bson.M{
+		"$type": "string",
+		"$not":  bson.M{"$regex": "^" + regexp.QuoteMeta(r.keyRing.ActivePrefix())},
+	}}
+}
+
+func (r *SSNKeyRotation) rotatePlayers(ctx context.Context) (int, error) {
+	cursor, err := r.client.GetCollection(ctx, playersColName).Find(ctx, r.staleFilter("socialSecurityNumber"))
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	rotated := 0
+	for cursor.Next(ctx) {
+		var stored playerDocument
+		if err := cursor.Decode(&stored); err != nil {
+			return rotated, err
+		}
+		player, err := stored.toPlayer(r.keyRing)
+		if err != nil {
+			return rotated, err
+		}
+		document, err := newPlayerDocument(player, r.keyRing)
+		if err != nil {
+			return rotated, err
+		}
+		// Only the SSN read is replaced, players saved meanwhile are skipped as their SSN is encrypted with the
+		// active key or erased.
+		result, err := r.client.GetCollection(ctx, playersColName).UpdateOne(ctx, bson.M{
+			"_id":                  player.ID,
+			"socialSecurityNumber": stored.SocialSecurityNumber,
+		}, bson.M{"$set": bson.M{
+			"socialSecurityNumber": document.SocialSecurityNumber,
+			"ssnBlindIndex":        document.SSNBlindIndex,
+		}})
+		if err != nil {
+			return rotated, err
+		}
+		rotated += int(result.MatchedCount)
+	}
+	return rotated, cursor.Err()
+}
+
+func (r *SSNKeyRotation) rotatePlayerCouples(ctx context.Context) (int, error) {
+	cursor, err := r.client.GetCollection(ctx, playerCouplesColName).Find(ctx, bson.M{"$or": bson.A{
+		r.staleFilter("player1.socialSecurityNumber"),
+		r.staleFilter("player2.socialSecurityNumber"),
+	}})
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	rotated := 0
+	for cursor.Next(ctx) {
+		var stored domain.PlayerCouple
+		if err := cursor.Decode(&stored); err != nil {
+			return rotated, err
+		}
+		playerCouple, err := decryptPlayerCouple(stored, r.keyRing)
+		if err != nil {
+			return rotated, err
+		}
+		document, err := encryptPlayerCouple(playerCouple, r.keyRing)
+		if err != nil {
+			return rotated, err
+		}
+		// Like players, only the SSNs read are replaced. Players without SSN are left as they are, i.e. not set to
+		// null.
+		filter, set := bson.M{"_id": playerCouple.ID}, bson.M{}
+		for field, ssns := range map[string][2]*string{
+			"player1.socialSecurityNumber": {stored.Player1.SocialSecurityNumber, document.Player1.SocialSecurityNumber},
+			"player2.socialSecurityNumber": {stored.Player2.SocialSecurityNumber, document.Player2.SocialSecurityNumber},
+		} {
+			filter[field] = ssns[0]
+			if ssns[1] != nil {
+				set[field] = ssns[1]
+			}
+		}
+		result, err := r.client.GetCollection(ctx, playerCouplesColName).UpdateOne(ctx, filter, bson.M{"$set": set})
+		if err != nil {
+			return rotated, err
+		}
+		rotated += int(result.MatchedCount)
+	}
+	return rotated, cursor.Err()
+}
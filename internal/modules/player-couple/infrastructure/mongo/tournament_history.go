@@ -0,0 +1,310 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const tournamentsColName = "tournaments"
+
+// Read only view of the tournament documents (owned by the tournament module), i.e. only the fields
+// needed to rebuild the history of a player.
+type tournamentView struct {
+	ID            string            `bson:"_id"`
+	Title         string            `bson:"title"`
+	Timestamp     time.Time         `bson:"timestamp"`
+	PlayerCouples []coupleView      `bson:"player_couples"`
+	Rounds        []tournamentRound `bson:"rounds"`
+}
+
+type coupleView struct {
+	ID      string `bson:"_id"`
+	Player1 struct {
+		ID string `bson:"_id"`
+	} `bson:"player1"`
+	Player2 struct {
+		ID string `bson:"_id"`
+	} `bson:"player2"`
+}
+
+func (c coupleView) hasPlayer(playerID string) bool {
+	return c.Player1.ID == playerID || c.Player2.ID == playerID
+}
+
+// participantsView holds the couples entered in a tournament, in PlayerCouples or in its categories.
+type participantsView struct {
+	PlayerCouples []coupleView `bson:"player_couples"`
+	Categories    []struct {
+		ID      string       `bson:"_id"`
+		Entries []coupleView `bson:"entries"`
+	} `bson:"categories"`
+}
+
+type tournamentRound struct {
+	Number  int         `bson:"number"`
+	Matches []matchView `bson:"matches"`
+}
+
+type matchView struct {
+	ID        string     `bson:"_id"`
+	Timestamp time.Time  `bson:"timestamp"`
+	Couple1   coupleView `bson:"couple1"`
+	Couple2   coupleView `bson:"couple2"`
+	Score     *struct {
+		Set1 *setView `bson:"set1"`
+		Set2 *setView `bson:"set2"`
+		Set3 *setView `bson:"set3"`
+	} `bson:"score"`
+}
+
+type setView struct {
+	GamesCouple1 int `bson:"gamesCouple1"`
+	GamesCouple2 int `bson:"gamesCouple2"`
+	Tiebreak     *struct {
+		PointsCouple1 int `bson:"pointsCouple1"`
+		PointsCouple2 int `bson:"pointsCouple2"`
+	} `bson:"tiebreak"`
+}
+
+// toSetRecord returns the set from the point of view of couple 1 or couple 2.
+func (s setView) toSetRecord(asCouple1 bool) domain.SetRecord {
+	record := domain.SetRecord{Games: s.GamesCouple1, OpponentGames: s.GamesCouple2}
+	if s.Tiebreak != nil {
+		points, opponentPoints := s.Tiebreak.PointsCouple1, s.Tiebreak.PointsCouple2
+		record.TiebreakPoints, record.OpponentTiebreakPoints = &points, &opponentPoints
+	}
+	if !asCouple1 {
+		record.Games, record.OpponentGames = record.OpponentGames, record.Games
+		record.TiebreakPoints, record.OpponentTiebreakPoints = record.OpponentTiebreakPoints, record.TiebreakPoints
+	}
+	return record
+}
+
+type mongoTournamentHistory struct {
+	collection *common.TenantCollection
+}
+
+func NewMongoTournamentHistory(client common.MongoClient) domain.TournamentHistory {
+	return &mongoTournamentHistory{
+		collection: common.NewTenantCollection(client, tournamentsColName),
+	}
+}
+
+func (h *mongoTournamentHistory) FindByPlayerID(ctx context.Context, playerID string) ([]domain.TournamentParticipation, []domain.MatchRecord, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cursor, err := h.collection.Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"player_couples.player1._id": playerID},
+		bson.M{"player_couples.player2._id": playerID},
+		bson.M{"rounds.matches.couple1.player1._id": playerID},
+		bson.M{"rounds.matches.couple1.player2._id": playerID},
+		bson.M{"rounds.matches.couple2.player1._id": playerID},
+		bson.M{"rounds.matches.couple2.player2._id": playerID},
+	}}, options.Find().SetSort(bson.M{"timestamp": 1}))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var participations []domain.TournamentParticipation
+	var matches []domain.MatchRecord
+	for cursor.Next(ctx) {
+		var tournament tournamentView
+		if err := cursor.Decode(&tournament); err != nil {
+			return nil, nil, err
+		}
+		for _, couple := range tournament.PlayerCouples {
+			if couple.hasPlayer(playerID) {
+				participations = append(participations, domain.TournamentParticipation{
+					TournamentID: tournament.ID,
+					Title:        tournament.Title,
+					Timestamp:    tournament.Timestamp,
+					CoupleID:     couple.ID,
+				})
+			}
+		}
+		for _, round := range tournament.Rounds {
+			for _, match := range round.Matches {
+				if record, ok := toMatchRecord(tournament.ID, round.Number, match, playerID); ok {
+					matches = append(matches, record)
+				}
+			}
+		}
+	}
+	return participations, matches, cursor.Err()
+}
+
+func toMatchRecord(tournamentID string, round int, match matchView, playerID string) (domain.MatchRecord, bool) {
+	asCouple1 := match.Couple1.hasPlayer(playerID)
+	if !asCouple1 && !match.Couple2.hasPlayer(playerID) {
+		return domain.MatchRecord{}, false
+	}
+	record := domain.MatchRecord{
+		TournamentID:     tournamentID,
+		Round:            round,
+		MatchID:          match.ID,
+		Timestamp:        match.Timestamp,
+		CoupleID:         match.Couple1.ID,
+		OpponentCoupleID: match.Couple2.ID,
+	}
+	if !asCouple1 {
+		record.CoupleID, record.OpponentCoupleID = record.OpponentCoupleID, record.CoupleID
+	}
+	if match.Score != nil {
+		for _, set := range []*setView{match.Score.Set1, match.Score.Set2, match.Score.Set3} {
+			if set != nil {
+				record.Sets = append(record.Sets, set.toSetRecord(asCouple1))
+			}
+		}
+	}
+	return record, true
+}
+
+// versionIncrement bumps the version of updated tournaments, so saves of the tournament module based on the copies
+// read before fail instead of restoring them.
+var versionIncrement = bson.M{"version": 1}
+
+// ReplacePlayer replaces the embedded copies of the player in registered couples, matches and categories, IDs and
+// scores are kept as they are so results stay statistically intact.
+func (h *mongoTournamentHistory) ReplacePlayer(ctx context.Context, player domain.Player) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Tournaments only embed the public profile of players.
+	embedded := bson.M{
+		"_id":       player.ID,
+		"email":     player.Email,
+		"firstName": player.FirstName,
+		"lastName":  player.LastName,
+	}
+	_, err := h.collection.UpdateMany(ctx,
+		bson.M{"$or": bson.A{
+			bson.M{"player_couples.player1._id": player.ID},
+			bson.M{"player_couples.player2._id": player.ID},
+		}},
+		bson.M{"$set": bson.M{
+			"player_couples.$[c1].player1": embedded,
+			"player_couples.$[c2].player2": embedded,
+		}, "$inc": versionIncrement},
+		options.Update().SetArrayFilters(options.ArrayFilters{Filters: bson.A{
+			bson.M{"c1.player1._id": player.ID},
+			bson.M{"c2.player2._id": player.ID},
+		}}))
+	if err != nil {
+		return err
+	}
+	// Split from the update above as "rounds.$[]" fails on tournaments without rounds.
+	_, err = h.collection.UpdateMany(ctx,
+		bson.M{"$or": bson.A{
+			bson.M{"rounds.matches.couple1.player1._id": player.ID},
+			bson.M{"rounds.matches.couple1.player2._id": player.ID},
+			bson.M{"rounds.matches.couple2.player1._id": player.ID},
+			bson.M{"rounds.matches.couple2.player2._id": player.ID},
+		}},
+		bson.M{"$set": bson.M{
+			"rounds.$[].matches.$[m11].couple1.player1": embedded,
+			"rounds.$[].matches.$[m12].couple1.player2": embedded,
+			"rounds.$[].matches.$[m21].couple2.player1": embedded,
+			"rounds.$[].matches.$[m22].couple2.player2": embedded,
+		}, "$inc": versionIncrement},
+		options.Update().SetArrayFilters(options.ArrayFilters{Filters: bson.A{
+			bson.M{"m11.couple1.player1._id": player.ID},
+			bson.M{"m12.couple1.player2._id": player.ID},
+			bson.M{"m21.couple2.player1._id": player.ID},
+			bson.M{"m22.couple2.player2._id": player.ID},
+		}}))
+	if err != nil {
+		return err
+	}
+	// Couples entered in categories or waiting for a place, array filters select the categories holding the player.
+	for _, list := range []string{"entries", "waitlist"} {
+		_, err = h.collection.UpdateMany(ctx,
+			bson.M{"$or": bson.A{
+				bson.M{"categories." + list + ".player1._id": player.ID},
+				bson.M{"categories." + list + ".player2._id": player.ID},
+			}},
+			bson.M{"$set": bson.M{
+				"categories.$[c1]." + list + ".$[e1].player1": embedded,
+				"categories.$[c2]." + list + ".$[e2].player2": embedded,
+			}, "$inc": versionIncrement},
+			options.Update().SetArrayFilters(options.ArrayFilters{Filters: bson.A{
+				bson.M{"c1." + list + ".player1._id": player.ID},
+				bson.M{"e1.player1._id": player.ID},
+				bson.M{"c2." + list + ".player2._id": player.ID},
+				bson.M{"e2.player2._id": player.ID},
+			}}))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *mongoTournamentHistory) FindParticipants(ctx context.Context, filter domain.ExportFilter) (domain.Participants, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	query := bson.M{}
+	timestamp := bson.M{}
+	if filter.From != nil {
+		timestamp["$gte"] = *filter.From
+	}
+	if filter.To != nil {
+		timestamp["$lt"] = *filter.To
+	}
+	if len(timestamp) > 0 {
+		query["timestamp"] = timestamp
+	}
+	if len(filter.TournamentID) > 0 {
+		query["_id"] = filter.TournamentID
+	}
+	if len(filter.CategoryID) > 0 {
+		query["categories._id"] = filter.CategoryID
+	}
+	cursor, err := h.collection.Find(ctx, query, options.Find().SetProjection(bson.M{"player_couples": 1, "categories._id": 1, "categories.entries": 1}))
+	if err != nil {
+		return domain.Participants{}, err
+	}
+	defer cursor.Close(ctx)
+
+	// Not nil, so no participants select nothing instead of everyone.
+	participants := domain.Participants{CoupleIDs: []string{}, PlayerIDs: []string{}}
+	seenCouples, seenPlayers := map[string]bool{}, map[string]bool{}
+	add := func(couple coupleView) {
+		if !seenCouples[couple.ID] {
+			seenCouples[couple.ID] = true
+			participants.CoupleIDs = append(participants.CoupleIDs, couple.ID)
+		}
+		for _, playerID := range []string{couple.Player1.ID, couple.Player2.ID} {
+			if !seenPlayers[playerID] {
+				seenPlayers[playerID] = true
+				participants.PlayerIDs = append(participants.PlayerIDs, playerID)
+			}
+		}
+	}
+	for cursor.Next(ctx) {
+		var tournament participantsView
+		if err := cursor.Decode(&tournament); err != nil {
+			return domain.Participants{}, err
+		}
+		if len(filter.CategoryID) == 0 {
+			for _, couple := range tournament.PlayerCouples {
+				add(couple)
+			}
+		}
+		for _, category := range tournament.Categories {
+			if len(filter.CategoryID) == 0 || category.ID == filter.CategoryID {
+				for _, couple := range category.Entries {
+					add(couple)
+				}
+			}
+		}
+	}
+	return participants, cursor.Err()
+}
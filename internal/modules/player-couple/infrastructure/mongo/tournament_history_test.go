@@ -0,0 +1,156 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/player-couple/domain"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+const testTournamentsNs = testDbName + "." + tournamentsColName
+
+func coupleDoc(id, player1ID, player2ID string) bson.D {
+	return bson.D{
+		{Key: "_id", Value: id},
+		{Key: "player1", Value: bson.D{{Key: "_id", Value: player1ID}}},
+		{Key: "player2", Value: bson.D{{Key: "_id", Value: player2ID}}},
+	}
+}
+
+func TestMongoTournamentHistory_FindByPlayerID(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	timestamp := time.Date(2024, time.October, 1, 10, 0, 0, 0, time.UTC)
+
+	mt.Run("success", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testTournamentsNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "t1"},
+			{Key: "title", Value: "Premier Padel"},
+			{Key: "timestamp", Value: timestamp},
+			{Key: "player_couples", Value: bson.A{coupleDoc("c1", "1", "2"), coupleDoc("c2", "3", "4")}},
+			{Key: "rounds", Value: bson.A{bson.D{
+				{Key: "number", Value: 1},
+				{Key: "matches", Value: bson.A{bson.D{
+					{Key: "_id", Value: "m1"},
+					{Key: "timestamp", Value: timestamp},
+					{Key: "couple1", Value: coupleDoc("c2", "3", "4")},
+					{Key: "couple2", Value: coupleDoc("c1", "1", "2")},
+					{Key: "score", Value: bson.D{
+						{Key: "set1", Value: bson.D{{Key: "gamesCouple1", Value: 6}, {Key: "gamesCouple2", Value: 4}}},
+						{Key: "set2", Value: bson.D{{Key: "gamesCouple1", Value: 6}, {Key: "gamesCouple2", Value: 7},
+							{Key: "tiebreak", Value: bson.D{{Key: "pointsCouple1", Value: 5}, {Key: "pointsCouple2", Value: 7}}}}},
+					}},
+				}}},
+			}}},
+		}), mtest.CreateCursorResponse(0, testTournamentsNs, mtest.NextBatch))
+
+		history := NewMongoTournamentHistory(newMongoClientMock(mt.Client))
+		participations, matches, err := history.FindByPlayerID(context.Background(), "2")
+		assert.NoError(t, err, "Expected no error when finding tournament history")
+		assert.Equal(t, []domain.TournamentParticipation{{TournamentID: "t1", Title: "Premier Padel", Timestamp: timestamp, CoupleID: "c1"}}, participations)
+
+		// Sets are seen from the point of view of the player couple, i.e. couple 2 in the match.
+		five, seven := 5, 7
+		assert.Equal(t, []domain.MatchRecord{{
+			TournamentID: "t1", Round: 1, MatchID: "m1", Timestamp: timestamp, CoupleID: "c1", OpponentCoupleID: "c2",
+			Sets: []domain.SetRecord{
+				{Games: 4, OpponentGames: 6},
+				{Games: 7, OpponentGames: 6, TiebreakPoints: &seven, OpponentTiebreakPoints: &five},
+			},
+		}}, matches)
+	})
+
+	mt.Run("failure", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "find error"}))
+
+		history := NewMongoTournamentHistory(newMongoClientMock(mt.Client))
+		_, _, err := history.FindByPlayerID(context.Background(), "2")
+		assert.Error(t, err, "Expected error when finding tournament history")
+	})
+}
+
+func TestMongoTournamentHistory_ReplacePlayer(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	player := domain.Player{ID: "2", FirstName: "Erased", LastName: "Player", Email: "erased-2@erased.invalid"}
+
+	mt.Run("success", func(mt *mtest.T) {
+		// Registered couples first, then matches of the rounds, entries and waitlists of categories.
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+
+		history := NewMongoTournamentHistory(newMongoClientMock(mt.Client))
+		assert.NoError(t, history.ReplacePlayer(context.Background(), player), "Expected no error when replacing player")
+
+		for range 2 {
+			update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+			assert.Equal(t, int32(1), update.Lookup("u", "$inc", "version").Int32(), "Expected saves based on the old copies to fail")
+		}
+		for _, list := range []string{"entries", "waitlist"} {
+			update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+			assert.Equal(t, int32(1), update.Lookup("u", "$inc", "version").Int32())
+			for _, path := range []string{"categories.$[c1]." + list + ".$[e1].player1", "categories.$[c2]." + list + ".$[e2].player2"} {
+				embedded := update.Lookup("u", "$set", path).Document()
+				assert.Equal(t, "erased-2@erased.invalid", embedded.Lookup("email").StringValue(), "Expected %s anonymized", path)
+				assert.Equal(t, "Erased", embedded.Lookup("firstName").StringValue())
+				_, err := embedded.LookupErr("birthDate")
+				assert.Error(t, err, "Expected the birth date of %s dropped", path)
+			}
+			filters, _ := update.Lookup("arrayFilters").Array().Values()
+			assert.Len(t, filters, 4)
+		}
+	})
+
+	mt.Run("failure", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(),
+			mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "update error"}))
+
+		history := NewMongoTournamentHistory(newMongoClientMock(mt.Client))
+		assert.Error(t, history.ReplacePlayer(context.Background(), player), "Expected error when replacing player")
+	})
+}
+
+func TestMongoTournamentHistory_FindParticipants(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	tournaments := []bson.D{{
+		{Key: "_id", Value: "t1"},
+		{Key: "player_couples", Value: bson.A{coupleDoc("c1", "1", "2")}},
+		{Key: "categories", Value: bson.A{
+			bson.D{{Key: "_id", Value: "men-40"}, {Key: "entries", Value: bson.A{coupleDoc("c2", "3", "4"), coupleDoc("c1", "1", "2")}}},
+			bson.D{{Key: "_id", Value: "mixed"}, {Key: "entries", Value: bson.A{coupleDoc("c3", "1", "5")}}},
+		}},
+	}}
+
+	mt.Run("every category", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testTournamentsNs, mtest.FirstBatch, tournaments...),
+			mtest.CreateCursorResponse(0, testTournamentsNs, mtest.NextBatch))
+
+		from := time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+		history := NewMongoTournamentHistory(newMongoClientMock(mt.Client))
+		participants, err := history.FindParticipants(context.Background(), domain.ExportFilter{From: &from})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Participants{CoupleIDs: []string{"c1", "c2", "c3"}, PlayerIDs: []string{"1", "2", "3", "4", "5"}}, participants)
+	})
+
+	mt.Run("category", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testTournamentsNs, mtest.FirstBatch, tournaments...),
+			mtest.CreateCursorResponse(0, testTournamentsNs, mtest.NextBatch))
+
+		history := NewMongoTournamentHistory(newMongoClientMock(mt.Client))
+		participants, err := history.FindParticipants(context.Background(), domain.ExportFilter{CategoryID: "mixed"})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Participants{CoupleIDs: []string{"c3"}, PlayerIDs: []string{"1", "5"}}, participants)
+	})
+
+	mt.Run("none", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testTournamentsNs, mtest.FirstBatch))
+
+		history := NewMongoTournamentHistory(newMongoClientMock(mt.Client))
+		participants, err := history.FindParticipants(context.Background(), domain.ExportFilter{TournamentID: "t9"})
+		assert.NoError(t, err)
+		assert.NotNil(t, participants.PlayerIDs, "Expected no participants to select nothing")
+		assert.Empty(t, participants.PlayerIDs)
+	})
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// CategoryHandler lets organizers add categories and enter couples, entries hold personal data so listing
+// categories needs authentication.
+type CategoryHandler struct {
+	categoryUseCase application.CategoryUseCase
+}
+
+func NewCategoryHandler(categoryUseCase application.CategoryUseCase) *CategoryHandler {
+	return &CategoryHandler{categoryUseCase: categoryUseCase}
+}
+
+type enterCategoryRequest struct {
+	CoupleID string `json:"coupleId" binding:"required"`
+}
+
+func (h *CategoryHandler) AddCategory(c *gin.Context) {
+	var request domain.Category
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	category, status, err := h.categoryUseCase.AddCategoryUseCase(c.Request.Context(), c.Param("id"), request)
+	if status == application.CategoryAdded {
+		web.Respond(c, http.StatusCreated, category)
+		return
+	}
+	respondCategoryError(c, status, err)
+}
+
+func (h *CategoryHandler) FindCategories(c *gin.Context) {
+	categories, status, err := h.categoryUseCase.FindCategoriesUseCase(c.Request.Context(), c.Param("id"))
+	if status == application.CategoryFound {
+		web.Respond(c, http.StatusOK, categories)
+		return
+	}
+	respondCategoryError(c, status, err)
+}
+
+func (h *CategoryHandler) EnterCategory(c *gin.Context) {
+	var request enterCategoryRequest
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	entry, status, err := h.categoryUseCase.EnterCategoryUseCase(c.Request.Context(), c.Param("id"), c.Param("categoryId"), request.CoupleID)
+	switch status {
+	case application.CategoryEntered:
+		web.Respond(c, http.StatusCreated, entry)
+	case application.CategoryWaitlisted:
+		web.Respond(c, http.StatusAccepted, entry)
+	default:
+		respondCategoryError(c, status, err)
+	}
+}
+
+func (h *CategoryHandler) WithdrawFromCategory(c *gin.Context) {
+	entry, status, err := h.categoryUseCase.WithdrawFromCategoryUseCase(c.Request.Context(), c.Param("id"), c.Param("categoryId"), c.Param("coupleId"))
+	if status == application.CategoryWithdrawn {
+		web.Respond(c, http.StatusOK, entry)
+		return
+	}
+	respondCategoryError(c, status, err)
+}
+
+func respondCategoryError(c *gin.Context, status application.CategoryStatus, err error) {
+	switch status {
+	case application.CategoryInvalid:
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+	case application.CategoryNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	case application.CategoryConflict:
+		web.Respond(c, http.StatusConflict, web.ErrorBody(c, err))
+	case application.CategoryNotEligible:
+		web.Respond(c, http.StatusUnprocessableEntity, web.ErrorBody(c, err))
+	default:
+		if err == nil {
+			err = fmt.Errorf("invalid status %d", status)
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+	}
+}
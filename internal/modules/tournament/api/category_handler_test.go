@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockCategoryUseCase struct {
+	mock.Mock
+}
+
+func (m *mockCategoryUseCase) AddCategoryUseCase(_ context.Context, tournamentId string, category domain.Category) (domain.Category, application.CategoryStatus, error) {
+	args := m.Called(tournamentId, category)
+	return args.Get(0).(domain.Category), args.Get(1).(application.CategoryStatus), args.Error(2)
+}
+
+func (m *mockCategoryUseCase) FindCategoriesUseCase(_ context.Context, tournamentId string) ([]domain.Category, application.CategoryStatus, error) {
+	args := m.Called(tournamentId)
+	return args.Get(0).([]domain.Category), args.Get(1).(application.CategoryStatus), args.Error(2)
+}
+
+func (m *mockCategoryUseCase) EnterCategoryUseCase(_ context.Context, tournamentId, categoryId, coupleId string) (domain.CategoryEntry, application.CategoryStatus, error) {
+	args := m.Called(tournamentId, categoryId, coupleId)
+	return args.Get(0).(domain.CategoryEntry), args.Get(1).(application.CategoryStatus), args.Error(2)
+}
+
+func (m *mockCategoryUseCase) WithdrawFromCategoryUseCase(_ context.Context, tournamentId, categoryId, coupleId string) (domain.CategoryEntry, application.CategoryStatus, error) {
+	args := m.Called(tournamentId, categoryId, coupleId)
+	return args.Get(0).(domain.CategoryEntry), args.Get(1).(application.CategoryStatus), args.Error(2)
+}
+
+const categoriesPath = "/tournaments/tournament-1/categories"
+
+func newCategoryRouter(useCase *mockCategoryUseCase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewCategoryHandler(useCase)
+	router := gin.New()
+	router.GET("/tournaments/:id/categories", handler.FindCategories)
+	router.POST("/tournaments/:id/categories", handler.AddCategory)
+	router.POST("/tournaments/:id/categories/:categoryId/entries", handler.EnterCategory)
+	router.DELETE("/tournaments/:id/categories/:categoryId/entries/:coupleId", handler.WithdrawFromCategory)
+	return router
+}
+
+func TestCategoryHandler_AddAndFindCategories(t *testing.T) {
+	minAge := 40
+	category := domain.Category{ID: "men-40", Name: "Men +40", MaxEntries: 16, Eligibility: domain.Eligibility{MinAge: &minAge, Gender: domain.CategoryMen}}
+	useCase := &mockCategoryUseCase{}
+	useCase.On("AddCategoryUseCase", "tournament-1", category).Return(category, application.CategoryAdded, nil)
+	useCase.On("AddCategoryUseCase", "tournament-1", domain.Category{ID: "men-40"}).Return(domain.Category{}, application.CategoryConflict, domain.ErrCategoryExists)
+	useCase.On("FindCategoriesUseCase", "tournament-1").Return([]domain.Category{category}, application.CategoryFound, nil)
+	router := newCategoryRouter(useCase)
+	body, _ := json.Marshal(category)
+
+	w := serve(router, http.MethodPost, categoriesPath, string(body))
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	w = serve(router, http.MethodPost, categoriesPath, `{"id":"men-40"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = serve(router, http.MethodGet, categoriesPath, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	var categories []domain.Category
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &categories))
+	assert.Equal(t, []domain.Category{category}, categories)
+}
+
+func TestCategoryHandler_EnterCategory(t *testing.T) {
+	useCase := &mockCategoryUseCase{}
+	useCase.On("EnterCategoryUseCase", "tournament-1", "men-40", "couple-1").Return(domain.CategoryEntry{CategoryID: "men-40", CoupleID: "couple-1", Position: 1}, application.CategoryEntered, nil)
+	useCase.On("EnterCategoryUseCase", "tournament-1", "men-40", "couple-2").Return(domain.CategoryEntry{CategoryID: "men-40", CoupleID: "couple-2", Waitlisted: true, Position: 1}, application.CategoryWaitlisted, nil)
+	useCase.On("EnterCategoryUseCase", "tournament-1", "men-40", "couple-3").Return(domain.CategoryEntry{}, application.CategoryNotEligible, fmt.Errorf("%w: both players must be male", domain.ErrNotEligible))
+	router := newCategoryRouter(useCase)
+	path := categoriesPath + "/men-40/entries"
+
+	w := serve(router, http.MethodPost, path, `{"coupleId":"couple-1"}`)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	w = serve(router, http.MethodPost, path, `{"coupleId":"couple-2"}`)
+	assert.Equal(t, http.StatusAccepted, w.Code, "Expected waitlisted couples to be accepted")
+	assert.JSONEq(t, `{"categoryId":"men-40","coupleId":"couple-2","waitlisted":true,"position":1}`, w.Body.String())
+
+	w = serve(router, http.MethodPost, path, `{"coupleId":"couple-3"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), "both players must be male")
+
+	w = serve(router, http.MethodPost, path, `{}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestCategoryHandler_WithdrawFromCategory(t *testing.T) {
+	promoted := domain.PlayerCouple{ID: "couple-2"}
+	useCase := &mockCategoryUseCase{}
+	useCase.On("WithdrawFromCategoryUseCase", "tournament-1", "men-40", "couple-1").Return(domain.CategoryEntry{CategoryID: "men-40", CoupleID: "couple-1", Promoted: &promoted}, application.CategoryWithdrawn, nil)
+	useCase.On("WithdrawFromCategoryUseCase", "tournament-1", "men-40", "couple-9").Return(domain.CategoryEntry{}, application.CategoryNotFound, domain.ErrNotEntered)
+	router := newCategoryRouter(useCase)
+
+	w := serve(router, http.MethodDelete, categoriesPath+"/men-40/entries/couple-1", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	var entry domain.CategoryEntry
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &entry))
+	assert.Equal(t, "couple-2", entry.Promoted.ID)
+
+	w = serve(router, http.MethodDelete, categoriesPath+"/men-40/entries/couple-9", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"status":"CategoryNotFound"}`, w.Body.String())
+}
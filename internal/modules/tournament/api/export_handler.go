@@ -0,0 +1,76 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// ExportHandler streams tournaments with their match results flattened, one row per match, as CSV (default), JSON
+// Lines or XLSX files, selected through the format, from, to, tournamentId and categoryId query parameters.
+type ExportHandler struct {
+	exportUseCase application.ExportUseCase
+}
+
+func NewExportHandler(exportUseCase application.ExportUseCase) *ExportHandler {
+	return &ExportHandler{exportUseCase: exportUseCase}
+}
+
+// ParseTournamentFilter returns the filter of the query parameters (see web.ParseDateRange for dates).
+func ParseTournamentFilter(from, to, tournamentID, categoryID string) (domain.TournamentFilter, error) {
+	start, end, err := web.ParseDateRange(from, to)
+	if err != nil {
+		return domain.TournamentFilter{}, err
+	}
+	return domain.TournamentFilter{From: start, To: end, TournamentID: tournamentID, CategoryID: categoryID}, nil
+}
+
+// ExportTournaments responds with an error until the first rows are flushed, a failure after that truncates the file.
+func (h *ExportHandler) ExportTournaments(c *gin.Context) {
+	format, err := spreadsheet.ParseFormat(c.DefaultQuery("format", string(spreadsheet.FormatCSV)))
+	if err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	filter, err := ParseTournamentFilter(c.Query("from"), c.Query("to"), c.Query("tournamentId"), c.Query("categoryId"))
+	if err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="tournaments.%s"`, format))
+	status := application.ExportPending
+	rows, err := spreadsheet.NewWriter(format, c.Writer)
+	if err == nil {
+		status, err = h.exportUseCase.ExportTournamentsUseCase(c.Request.Context(), filter, rows)
+	}
+	if err == nil && status != application.ExportExported {
+		err = fmt.Errorf("invalid status %d", status)
+	}
+	if err == nil {
+		if err = rows.Close(); err == nil {
+			return
+		}
+	}
+	switch {
+	case c.Writer.Written():
+		_ = c.Error(err)
+		c.Abort()
+	case status == application.ExportInvalid:
+		respondExportError(c, http.StatusBadRequest, err)
+	default:
+		respondExportError(c, http.StatusInternalServerError, err)
+	}
+}
+
+// respondExportError drops the headers of the file, i.e. the body is the error.
+func respondExportError(c *gin.Context, code int, err error) {
+	c.Writer.Header().Del("Content-Type")
+	c.Writer.Header().Del("Content-Disposition")
+	web.Respond(c, code, web.ErrorBody(c, err))
+}
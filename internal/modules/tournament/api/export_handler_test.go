@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockExportUseCase writes the rows set by tests before returning.
+type mockExportUseCase struct {
+	mock.Mock
+	rows [][]any
+}
+
+func (m *mockExportUseCase) ExportTournamentsUseCase(_ context.Context, filter domain.TournamentFilter, rows spreadsheet.Writer) (application.ExportStatus, error) {
+	args := m.Called(filter)
+	for _, row := range m.rows {
+		if err := rows.Write(row...); err != nil {
+			return application.ExportPending, err
+		}
+	}
+	return args.Get(0).(application.ExportStatus), args.Error(1)
+}
+
+func newExportRouter(useCase *mockExportUseCase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/exports/tournaments", NewExportHandler(useCase).ExportTournaments)
+	return router
+}
+
+func TestExportHandler_ExportTournaments(t *testing.T) {
+	from := time.Date(2026, time.October, 1, 10, 0, 0, 0, time.UTC)
+	useCase := &mockExportUseCase{rows: [][]any{{"tournamentId", "matchId", "set1"}, {"tournament-1", "match-1", "6-4"}}}
+	useCase.On("ExportTournamentsUseCase", domain.TournamentFilter{From: &from, CategoryID: "category-1"}).Return(application.ExportExported, nil)
+	router := newExportRouter(useCase)
+
+	w := serve(router, http.MethodGet, "/exports/tournaments?from=2026-10-01T10:00:00Z&categoryId=category-1", "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, spreadsheet.CSVContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="tournaments.csv"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "tournamentId,matchId,set1\ntournament-1,match-1,6-4\n", w.Body.String())
+
+	w = serve(router, http.MethodGet, "/exports/tournaments?format=xlsx&from=2026-10-01T10:00:00Z&categoryId=category-1", "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, spreadsheet.XLSXContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="tournaments.xlsx"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "PK", w.Body.String()[:2], "Expected a zip archive")
+}
+
+func TestExportHandler_ExportTournamentsErrors(t *testing.T) {
+	useCase := &mockExportUseCase{}
+	useCase.On("ExportTournamentsUseCase", domain.TournamentFilter{TournamentID: "$bad"}).Return(application.ExportInvalid, errors.New("invalid ID"))
+	useCase.On("ExportTournamentsUseCase", domain.TournamentFilter{}).Return(application.ExportPending, errors.New("cursor failure"))
+	router := newExportRouter(useCase)
+
+	w := serve(router, http.MethodGet, "/exports/tournaments?format=ods", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"error":"unsupported spreadsheet format: ods"}`, w.Body.String())
+
+	w = serve(router, http.MethodGet, "/exports/tournaments?tournamentId=$bad", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"error":"invalid ID"}`, w.Body.String())
+	assert.Empty(t, w.Header().Get("Content-Disposition"))
+
+	w = serve(router, http.MethodGet, "/exports/tournaments", "")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.JSONEq(t, `{"error":"cursor failure"}`, w.Body.String())
+}
@@ -0,0 +1,285 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+const (
+	bearerPrefix = "Bearer "
+	// graphQLTransportWS is the subprotocol of subscriptions, see
+	// https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
+	graphQLTransportWS    = "graphql-transport-ws"
+	connectionInitTimeout = 10 * time.Second
+	writeTimeout          = 10 * time.Second
+)
+
+// Close codes of the graphql-transport-ws protocol.
+const (
+	closeBadRequest         = 4400
+	closeUnauthorized       = 4401
+	closeForbidden          = 4403
+	closeInitTimeout        = 4408
+	closeSubscriberExists   = 4409
+	closeTooManyInitRequest = 4429
+)
+
+// GraphQLHandler serves queries and mutations through POST (behind auth.Authenticate) and subscriptions
+// through websockets, which authenticate themselves as browsers can't set headers on websocket requests.
+type GraphQLHandler struct {
+	schema             *graphql.Schema
+	tokenValidator     auth.TokenValidator
+	playerReader       domain.PlayerReader
+	playerCoupleReader domain.PlayerCoupleReader
+	upgrader           websocket.Upgrader
+}
+
+func NewGraphQLHandler(tokenValidator auth.TokenValidator, playerReader domain.PlayerReader, playerCoupleReader domain.PlayerCoupleReader,
+	findTournamentUseCase application.FindTournamentUseCase, recordMatchScoreUseCase application.RecordMatchScoreUseCase,
+	subscribeScoreUpdatesUseCase application.SubscribeScoreUpdatesUseCase) *GraphQLHandler {
+	return &GraphQLHandler{
+		schema: newGraphQLSchema(&graphQLResolver{
+			findTournamentUseCase:        findTournamentUseCase,
+			recordMatchScoreUseCase:      recordMatchScoreUseCase,
+			subscribeScoreUpdatesUseCase: subscribeScoreUpdatesUseCase,
+		}),
+		tokenValidator:     tokenValidator,
+		playerReader:       playerReader,
+		playerCoupleReader: playerCoupleReader,
+		upgrader: websocket.Upgrader{
+			Subprotocols: []string{graphQLTransportWS},
+			// Cross origin connections are fine as credentials are tokens sent by the client (never cookies).
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+	}
+}
+
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Query executes queries and mutations, GraphQL errors are part of the 200 response as the spec requires.
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	var request graphQLRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	ctx := withLoaders(c.Request.Context(), newGraphQLLoaders(h.playerReader, h.playerCoupleReader))
+	c.JSON(http.StatusOK, h.schema.Exec(ctx, request.Query, request.OperationName, request.Variables))
+}
+
+// Subscribe upgrades the request to a graphql-transport-ws websocket, the bearer token is taken from the
+// Authorization header or else from the payload of connection_init.
+func (h *GraphQLHandler) Subscribe(c *gin.Context) {
+	var claims *auth.Claims
+	if header := c.GetHeader("Authorization"); header != "" {
+		var err error
+		if claims, err = h.validate(header); err != nil {
+			c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			web.Respond(c, http.StatusUnauthorized, web.ErrorBody(c, err))
+			return
+		}
+		if !claims.AllowsTenant(c.Request.Context()) {
+			web.Respond(c, http.StatusForbidden, web.ErrorBody(c, errors.New("token of another tenant")))
+			return
+		}
+	}
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader already replied with the error.
+		return
+	}
+	session := &graphQLSession{handler: h, conn: conn, claims: claims, subscriptions: make(map[string]context.CancelFunc)}
+	session.serve(c.Request.Context())
+}
+
+func (h *GraphQLHandler) validate(authorization string) (*auth.Claims, error) {
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return nil, errors.New("missing bearer token")
+	}
+	return h.tokenValidator.Validate(strings.TrimPrefix(authorization, bearerPrefix))
+}
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// graphQLSession is a graphql-transport-ws connection, every operation runs in its own goroutine so
+// writes are serialized.
+type graphQLSession struct {
+	handler *GraphQLHandler
+	conn    *websocket.Conn
+	claims  *auth.Claims
+
+	mu            sync.Mutex
+	initialized   bool
+	subscriptions map[string]context.CancelFunc
+}
+
+func (s *graphQLSession) serve(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer s.conn.Close()
+
+	_ = s.conn.SetReadDeadline(time.Now().Add(connectionInitTimeout))
+	for {
+		var message wsMessage
+		if err := s.conn.ReadJSON(&message); err != nil {
+			var netErr interface{ Timeout() bool }
+			if errors.As(err, &netErr) && netErr.Timeout() && !s.isInitialized() {
+				s.close(closeInitTimeout, "Connection initialisation timeout")
+			}
+			return
+		}
+		switch message.Type {
+		case "connection_init":
+			if !s.init(ctx, message.Payload) {
+				return
+			}
+		case "ping":
+			s.write(wsMessage{Type: "pong"})
+		case "pong":
+		case "subscribe":
+			if !s.subscribe(ctx, message) {
+				return
+			}
+		case "complete":
+			s.complete(message.ID)
+		default:
+			s.close(closeBadRequest, "Invalid message type")
+			return
+		}
+	}
+}
+
+func (s *graphQLSession) isInitialized() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.initialized
+}
+
+func (s *graphQLSession) init(ctx context.Context, payload json.RawMessage) bool {
+	s.mu.Lock()
+	if s.initialized {
+		s.mu.Unlock()
+		s.close(closeTooManyInitRequest, "Too many initialisation requests")
+		return false
+	}
+	s.mu.Unlock()
+
+	if s.claims == nil {
+		var params map[string]any
+		_ = json.Unmarshal(payload, &params)
+		var authorization string
+		for key, value := range params {
+			if strings.EqualFold(key, "Authorization") {
+				authorization, _ = value.(string)
+			}
+		}
+		claims, err := s.handler.validate(authorization)
+		if err != nil || !claims.AllowsTenant(ctx) {
+			s.close(closeForbidden, "Forbidden")
+			return false
+		}
+		s.claims = claims
+	}
+	s.mu.Lock()
+	s.initialized = true
+	s.mu.Unlock()
+	_ = s.conn.SetReadDeadline(time.Time{})
+	s.write(wsMessage{Type: "connection_ack"})
+	return true
+}
+
+func (s *graphQLSession) subscribe(ctx context.Context, message wsMessage) bool {
+	var request graphQLRequest
+	if err := json.Unmarshal(message.Payload, &request); err != nil || message.ID == "" {
+		s.close(closeBadRequest, "Invalid subscribe message")
+		return false
+	}
+	s.mu.Lock()
+	if !s.initialized {
+		s.mu.Unlock()
+		s.close(closeUnauthorized, "Unauthorized")
+		return false
+	}
+	if _, ok := s.subscriptions[message.ID]; ok {
+		s.mu.Unlock()
+		s.close(closeSubscriberExists, "Subscriber for "+message.ID+" already exists")
+		return false
+	}
+	// Loaders live as long as the subscription, i.e. profiles are read once per subscription.
+	ctx, cancel := context.WithCancel(withLoaders(auth.WithClaims(ctx, s.claims),
+		newGraphQLLoaders(s.handler.playerReader, s.handler.playerCoupleReader)))
+	s.subscriptions[message.ID] = cancel
+	s.mu.Unlock()
+
+	go s.run(ctx, message.ID, request)
+	return true
+}
+
+func (s *graphQLSession) run(ctx context.Context, id string, request graphQLRequest) {
+	defer s.complete(id)
+
+	responses, err := s.handler.schema.Subscribe(ctx, request.Query, request.OperationName, request.Variables)
+	if err != nil {
+		payload, _ := json.Marshal([]map[string]string{{"message": err.Error()}})
+		s.write(wsMessage{ID: id, Type: "error", Payload: payload})
+		return
+	}
+	for response := range responses {
+		// Responses are drained once completed by the client so the schema goroutines end.
+		if ctx.Err() != nil {
+			continue
+		}
+		payload, err := json.Marshal(response)
+		if err != nil {
+			continue
+		}
+		s.write(wsMessage{ID: id, Type: "next", Payload: payload})
+	}
+	if ctx.Err() == nil {
+		s.write(wsMessage{ID: id, Type: "complete"})
+	}
+}
+
+// complete cancels the operation, either completed by the client or once its responses end.
+func (s *graphQLSession) complete(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cancel, ok := s.subscriptions[id]; ok {
+		cancel()
+		delete(s.subscriptions, id)
+	}
+}
+
+func (s *graphQLSession) write(message wsMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	_ = s.conn.WriteJSON(message)
+}
+
+func (s *graphQLSession) close(code int, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(writeTimeout))
+}
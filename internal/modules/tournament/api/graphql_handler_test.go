@@ -0,0 +1,410 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// stubTokenValidator accepts tokens named after the role of the caller, e.g. "referee", optionally followed by the
+// tenant of the token, e.g. "referee@fep".
+type stubTokenValidator struct{}
+
+func (stubTokenValidator) Validate(token string) (*auth.Claims, error) {
+	if token == "invalid" {
+		return nil, errors.New("invalid token")
+	}
+	role, tenantID, _ := strings.Cut(token, "@")
+	return &auth.Claims{Email: role + "@example.com", Roles: []auth.Role{auth.Role(role)}, Tenant: tenantID}, nil
+}
+
+// countingPlayerReader records the IDs of every FindByIDs call, i.e. every batch of the loader.
+type countingPlayerReader struct {
+	mu      sync.Mutex
+	batches [][]string
+	players map[string]domain.Player
+}
+
+func (r *countingPlayerReader) FindByIDs(_ context.Context, ids []string) ([]domain.Player, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, ids)
+	var players []domain.Player
+	for _, id := range ids {
+		if player, ok := r.players[id]; ok {
+			players = append(players, player)
+		}
+	}
+	return players, nil
+}
+
+type stubPlayerCoupleReader struct {
+	playerCouples []domain.PlayerCouple
+}
+
+func (r *stubPlayerCoupleReader) FindByIDs(context.Context, []string) ([]domain.PlayerCouple, error) {
+	return r.playerCouples, nil
+}
+
+type mockRecordMatchScoreUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRecordMatchScoreUseCase) RecordMatchScoreUseCase(_ context.Context, tournamentId, matchId string, score domain.Score) (domain.Match, application.RecordMatchScoreStatus, error) {
+	args := m.Called(tournamentId, matchId, score)
+	return args.Get(0).(domain.Match), args.Get(1).(application.RecordMatchScoreStatus), args.Error(2)
+}
+
+type stubSubscribeScoreUpdatesUseCase struct {
+	updates chan domain.ScoreUpdate
+}
+
+func (s *stubSubscribeScoreUpdatesUseCase) SubscribeScoreUpdatesUseCase(ctx context.Context, tournamentId string) (<-chan domain.ScoreUpdate, application.SubscribeScoreUpdatesStatus, error) {
+	if tournamentId != "tournament-1" {
+		return nil, application.SubscribeScoreUpdatesNotFound, nil
+	}
+	updates := make(chan domain.ScoreUpdate)
+	go func() {
+		defer close(updates)
+		for {
+			select {
+			case update := <-s.updates:
+				updates <- update
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return updates, application.SubscribeScoreUpdatesSubscribed, nil
+}
+
+func embeddedPlayer(id, lastName string) domain.Player {
+	return domain.Player{ID: id, Email: id + "@example.com", FirstName: "Embedded", LastName: lastName}
+}
+
+func bracket() domain.Tournament {
+	couple1 := domain.PlayerCouple{ID: "couple-1", Player1: embeddedPlayer("player-1", "Galan"), Player2: embeddedPlayer("player-2", "Lebron")}
+	couple2 := domain.PlayerCouple{ID: "couple-2", Player1: embeddedPlayer("player-3", "Coello"), Player2: embeddedPlayer("player-4", "Tapia")}
+	return domain.Tournament{
+		ID:            "tournament-1",
+		Title:         "Premier Padel",
+		Timestamp:     time.Date(2026, time.November, 1, 10, 0, 0, 0, time.UTC),
+		PlayerCouples: []domain.PlayerCouple{couple1, couple2},
+		Rounds: []domain.Round{{Number: 1, Matches: []domain.Match{{
+			ID: "match-1", Couple1: couple1, Couple2: couple2,
+			Score: &domain.Score{Set1: domain.GameSet{GamesCouple1: 6, GamesCouple2: 4}, Set2: domain.GameSet{GamesCouple1: 7, GamesCouple2: 6, Tiebreak: &domain.Tiebreak{PointsCouple1: 7, PointsCouple2: 5}}},
+		}}}},
+	}
+}
+
+// registeredPlayers reads the current profiles of the bracket players, player-3 being unregistered, i.e. only its
+// embedded copy remains.
+func registeredPlayers() *countingPlayerReader {
+	return &countingPlayerReader{players: map[string]domain.Player{
+		"player-1": {ID: "player-1", Email: "player-1@example.com", FirstName: "Alejandro", LastName: "Galan"},
+		"player-2": {ID: "player-2", Email: "player-2@example.com", FirstName: "Juan", LastName: "Lebron"},
+		"player-4": {ID: "player-4", Email: "player-4@example.com", FirstName: "Agustin", LastName: "Tapia"},
+	}}
+}
+
+func newGraphQLRouter(playerReader *countingPlayerReader, findUseCase *mockFindTournamentUseCase, recordUseCase *mockRecordMatchScoreUseCase, updates chan domain.ScoreUpdate) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	playerCoupleReader := &stubPlayerCoupleReader{playerCouples: []domain.PlayerCouple{bracket().PlayerCouples[0]}}
+	handler := NewGraphQLHandler(stubTokenValidator{}, playerReader, playerCoupleReader, findUseCase, recordUseCase,
+		&stubSubscribeScoreUpdatesUseCase{updates: updates})
+
+	// Test servers listen on 127.0.0.1, i.e. the host of the fed tenant.
+	router := gin.New()
+	router.Use(tenant.GinMiddleware(tenant.Hosts{"127.0.0.1": "fed"}))
+	router.POST("/graphql", auth.Authenticate(stubTokenValidator{}), handler.Query)
+	router.GET("/graphql", handler.Subscribe)
+	return router
+}
+
+func postGraphQL(router *gin.Engine, token, query string, variables map[string]any) (*httptest.ResponseRecorder, map[string]any) {
+	body, _ := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	var response map[string]any
+	_ = json.Unmarshal(w.Body.Bytes(), &response)
+	return w, response
+}
+
+func TestGraphQLHandler_QueryBracket(t *testing.T) {
+	// Arrange
+	playerReader := registeredPlayers()
+	findUseCase := &mockFindTournamentUseCase{}
+	findUseCase.On("FindTournamentByIDUseCase", "tournament-1").Return(bracket(), application.FindTournamentFound, nil)
+	router := newGraphQLRouter(playerReader, findUseCase, &mockRecordMatchScoreUseCase{}, nil)
+
+	// Act
+	w, response := postGraphQL(router, "player", `{
+		tournament(id: "tournament-1") {
+			title
+			playerCouples { id player1 { lastName } player2 { lastName } }
+			rounds { number matches { id couple1 { player1 { firstName } } couple2 { player1 { firstName } } score { set2 { gamesCouple1 tiebreak { pointsCouple2 } } set3 { gamesCouple1 } } } }
+		}
+	}`, nil)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Nil(t, response["errors"])
+	tournament := response["data"].(map[string]any)["tournament"].(map[string]any)
+	assert.Equal(t, "Premier Padel", tournament["title"])
+	assert.Len(t, tournament["playerCouples"], 2)
+	match := tournament["rounds"].([]any)[0].(map[string]any)["matches"].([]any)[0].(map[string]any)
+	assert.Equal(t, map[string]any{"firstName": "Alejandro"}, match["couple1"].(map[string]any)["player1"], "Expected the current profile")
+	assert.Equal(t, map[string]any{"firstName": "Embedded"}, match["couple2"].(map[string]any)["player1"], "Expected the embedded copy once unregistered")
+	assert.Equal(t, map[string]any{"set2": map[string]any{"gamesCouple1": float64(7), "tiebreak": map[string]any{"pointsCouple2": float64(5)}}, "set3": nil}, match["score"])
+
+	// Every player of couples and matches is read at once, cached afterwards.
+	var loaded []string
+	for _, batch := range playerReader.batches {
+		loaded = append(loaded, batch...)
+	}
+	assert.Len(t, playerReader.batches, 1, "Expected a single batch, got %v", playerReader.batches)
+	assert.ElementsMatch(t, []string{"player-1", "player-2", "player-3", "player-4"}, loaded)
+}
+
+func TestGraphQLHandler_QueryCoupleAndPlayer(t *testing.T) {
+	findUseCase := &mockFindTournamentUseCase{}
+	findUseCase.On("FindTournamentByIDUseCase", "tournament-9").Return(domain.Tournament{}, application.FindTournamentNotFound, nil)
+	router := newGraphQLRouter(registeredPlayers(), findUseCase, &mockRecordMatchScoreUseCase{}, nil)
+
+	_, response := postGraphQL(router, "player", `{
+		playerCouple(id: "couple-1") { id player1 { firstName } }
+		missingCouple: playerCouple(id: "couple-9") { id }
+		player(id: "player-2") { firstName lastName }
+		missingPlayer: player(id: "player-9") { id }
+		missingTournament: tournament(id: "tournament-9") { id }
+	}`, nil)
+
+	assert.Nil(t, response["errors"])
+	assert.Equal(t, map[string]any{
+		"playerCouple":      map[string]any{"id": "couple-1", "player1": map[string]any{"firstName": "Alejandro"}},
+		"missingCouple":     nil,
+		"player":            map[string]any{"firstName": "Juan", "lastName": "Lebron"},
+		"missingPlayer":     nil,
+		"missingTournament": nil,
+	}, response["data"])
+}
+
+func TestGraphQLHandler_NoSocialSecurityNumber(t *testing.T) {
+	router := newGraphQLRouter(registeredPlayers(), &mockFindTournamentUseCase{}, &mockRecordMatchScoreUseCase{}, nil)
+
+	_, response := postGraphQL(router, "player", `{ player(id: "player-1") { socialSecurityNumber } }`, nil)
+
+	assert.NotEmpty(t, response["errors"], "Expected SSNs not to be part of the schema")
+}
+
+func TestGraphQLHandler_Unauthenticated(t *testing.T) {
+	findUseCase := &mockFindTournamentUseCase{}
+	router := newGraphQLRouter(registeredPlayers(), findUseCase, &mockRecordMatchScoreUseCase{}, nil)
+
+	w, _ := postGraphQL(router, "", `{ tournament(id: "tournament-1") { title } }`, nil)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	findUseCase.AssertNotCalled(t, "FindTournamentByIDUseCase", mock.Anything)
+}
+
+func TestGraphQLHandler_RecordMatchScore(t *testing.T) {
+	const mutation = `mutation($score: ScoreInput!) {
+		recordMatchScore(tournamentId: "tournament-1", matchId: "match-1", score: $score) { id score { set1 { gamesCouple1 } } }
+	}`
+	variables := map[string]any{"score": map[string]any{
+		"set1": map[string]any{"gamesCouple1": 6, "gamesCouple2": 3},
+		"set2": map[string]any{"gamesCouple1": 6, "gamesCouple2": 7, "tiebreak": map[string]any{"pointsCouple1": 4, "pointsCouple2": 7}},
+		"set3": map[string]any{"gamesCouple1": 6, "gamesCouple2": 0},
+	}}
+	score := domain.Score{
+		Set1: domain.GameSet{GamesCouple1: 6, GamesCouple2: 3},
+		Set2: domain.GameSet{GamesCouple1: 6, GamesCouple2: 7, Tiebreak: &domain.Tiebreak{PointsCouple1: 4, PointsCouple2: 7}},
+		Set3: &domain.GameSet{GamesCouple1: 6, GamesCouple2: 0},
+	}
+
+	t.Run("Recorded", func(t *testing.T) {
+		// Arrange
+		recordUseCase := &mockRecordMatchScoreUseCase{}
+		recordUseCase.On("RecordMatchScoreUseCase", "tournament-1", "match-1", score).
+			Return(domain.Match{ID: "match-1", Score: &score}, application.RecordMatchScoreRecorded, nil)
+		router := newGraphQLRouter(registeredPlayers(), &mockFindTournamentUseCase{}, recordUseCase, nil)
+
+		// Act
+		_, response := postGraphQL(router, string(auth.RoleReferee), mutation, variables)
+
+		// Assert
+		assert.Nil(t, response["errors"])
+		assert.Equal(t, map[string]any{"recordMatchScore": map[string]any{"id": "match-1", "score": map[string]any{"set1": map[string]any{"gamesCouple1": float64(6)}}}}, response["data"])
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		recordUseCase := &mockRecordMatchScoreUseCase{}
+		recordUseCase.On("RecordMatchScoreUseCase", "tournament-1", "match-1", score).
+			Return(domain.Match{}, application.RecordMatchScoreNotFound, nil)
+		router := newGraphQLRouter(registeredPlayers(), &mockFindTournamentUseCase{}, recordUseCase, nil)
+
+		_, response := postGraphQL(router, string(auth.RoleOrganizer), mutation, variables)
+
+		assert.Contains(t, response["errors"].([]any)[0].(map[string]any)["message"], application.RecordMatchScoreNotFound.String())
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		recordUseCase := &mockRecordMatchScoreUseCase{}
+		router := newGraphQLRouter(registeredPlayers(), &mockFindTournamentUseCase{}, recordUseCase, nil)
+
+		_, response := postGraphQL(router, string(auth.RolePlayer), mutation, variables)
+
+		assert.Contains(t, response["errors"].([]any)[0].(map[string]any)["message"], "insufficient role")
+		recordUseCase.AssertNotCalled(t, "RecordMatchScoreUseCase", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func dialGraphQL(t *testing.T, server *httptest.Server, header http.Header) *websocket.Conn {
+	dialer := websocket.Dialer{Subprotocols: []string{graphQLTransportWS}}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/graphql", header)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, graphQLTransportWS, conn.Subprotocol())
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	return conn
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) wsMessage {
+	var message wsMessage
+	assert.NoError(t, conn.ReadJSON(&message))
+	return message
+}
+
+func TestGraphQLHandler_ScoreUpdatedSubscription(t *testing.T) {
+	findUseCase := &mockFindTournamentUseCase{}
+	findUseCase.On("FindTournamentByIDUseCase", "tournament-1").Return(bracket(), application.FindTournamentFound, nil)
+	updates := make(chan domain.ScoreUpdate)
+	server := httptest.NewServer(newGraphQLRouter(registeredPlayers(), findUseCase, &mockRecordMatchScoreUseCase{}, updates))
+	defer server.Close()
+	conn := dialGraphQL(t, server, nil)
+	defer conn.Close()
+
+	// Token sent in connection_init as browsers can't set headers.
+	assert.NoError(t, conn.WriteJSON(wsMessage{Type: "connection_init", Payload: json.RawMessage(`{"Authorization":"Bearer player@fed"}`)}))
+	assert.Equal(t, "connection_ack", readMessage(t, conn).Type)
+	assert.NoError(t, conn.WriteJSON(wsMessage{Type: "ping"}))
+	assert.Equal(t, "pong", readMessage(t, conn).Type)
+
+	payload, _ := json.Marshal(graphQLRequest{Query: `subscription {
+		scoreUpdated(tournamentId: "tournament-1", matchId: "match-2") { matchId score { set1 { gamesCouple1 } } }
+	}`})
+	assert.NoError(t, conn.WriteJSON(wsMessage{ID: "1", Type: "subscribe", Payload: payload}))
+
+	// Updates of other matches are filtered out.
+	updates <- domain.ScoreUpdate{TournamentID: "tournament-1", MatchID: "match-1"}
+	updates <- domain.ScoreUpdate{TournamentID: "tournament-1", MatchID: "match-2", Score: domain.Score{Set1: domain.GameSet{GamesCouple1: 3}}}
+
+	next := readMessage(t, conn)
+	assert.Equal(t, "next", next.Type)
+	assert.Equal(t, "1", next.ID)
+	assert.JSONEq(t, `{"data":{"scoreUpdated":{"matchId":"match-2","score":{"set1":{"gamesCouple1":3}}}}}`, string(next.Payload))
+
+	// Queries complete after their single result.
+	payload, _ = json.Marshal(graphQLRequest{Query: `{ tournament(id: "tournament-1") { title } }`})
+	assert.NoError(t, conn.WriteJSON(wsMessage{ID: "2", Type: "subscribe", Payload: payload}))
+	next = readMessage(t, conn)
+	assert.Equal(t, "2", next.ID)
+	assert.JSONEq(t, `{"data":{"tournament":{"title":"Premier Padel"}}}`, string(next.Payload))
+	assert.Equal(t, wsMessage{ID: "2", Type: "complete"}, readMessage(t, conn))
+
+	assert.NoError(t, conn.WriteJSON(wsMessage{ID: "1", Type: "complete"}))
+}
+
+func TestGraphQLHandler_SubscriptionAuthentication(t *testing.T) {
+	server := httptest.NewServer(newGraphQLRouter(registeredPlayers(), &mockFindTournamentUseCase{}, &mockRecordMatchScoreUseCase{}, nil))
+	defer server.Close()
+
+	t.Run("Header", func(t *testing.T) {
+		conn := dialGraphQL(t, server, http.Header{"Authorization": {"Bearer player@fed"}})
+		defer conn.Close()
+
+		assert.NoError(t, conn.WriteJSON(wsMessage{Type: "connection_init"}))
+		assert.Equal(t, "connection_ack", readMessage(t, conn).Type)
+	})
+
+	t.Run("InvalidHeader", func(t *testing.T) {
+		dialer := websocket.Dialer{Subprotocols: []string{graphQLTransportWS}}
+		_, resp, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/graphql", http.Header{"Authorization": {"Bearer invalid"}})
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("InvalidInit", func(t *testing.T) {
+		conn := dialGraphQL(t, server, nil)
+		defer conn.Close()
+
+		assert.NoError(t, conn.WriteJSON(wsMessage{Type: "connection_init", Payload: json.RawMessage(`{"Authorization":"Bearer invalid"}`)}))
+		_, _, err := conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, closeForbidden), "Expected close %d, got %v", closeForbidden, err)
+	})
+
+	t.Run("OtherTenantHeader", func(t *testing.T) {
+		dialer := websocket.Dialer{Subprotocols: []string{graphQLTransportWS}}
+		_, resp, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/graphql", http.Header{"Authorization": {"Bearer player@fep"}})
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("OtherTenantInit", func(t *testing.T) {
+		conn := dialGraphQL(t, server, nil)
+		defer conn.Close()
+
+		assert.NoError(t, conn.WriteJSON(wsMessage{Type: "connection_init", Payload: json.RawMessage(`{"Authorization":"Bearer player@fep"}`)}))
+		_, _, err := conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, closeForbidden), "Expected close %d, got %v", closeForbidden, err)
+	})
+
+	t.Run("NoTenantHeader", func(t *testing.T) {
+		dialer := websocket.Dialer{Subprotocols: []string{graphQLTransportWS}}
+		_, resp, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/graphql", http.Header{"Authorization": {"Bearer player"}})
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("TenantInit", func(t *testing.T) {
+		conn := dialGraphQL(t, server, nil)
+		defer conn.Close()
+
+		assert.NoError(t, conn.WriteJSON(wsMessage{Type: "connection_init", Payload: json.RawMessage(`{"Authorization":"Bearer player@fed"}`)}))
+		assert.Equal(t, "connection_ack", readMessage(t, conn).Type)
+	})
+
+	t.Run("SubscribeBeforeInit", func(t *testing.T) {
+		conn := dialGraphQL(t, server, nil)
+		defer conn.Close()
+
+		assert.NoError(t, conn.WriteJSON(wsMessage{ID: "1", Type: "subscribe", Payload: json.RawMessage(`{"query":"{ player(id: \"player-1\") { id } }"}`)}))
+		_, _, err := conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, closeUnauthorized), "Expected close %d, got %v", closeUnauthorized, err)
+	})
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/common/dataloader"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// graphQLLoaders batch the lookups of resolvers running concurrently, e.g. the players of every match of a
+// tournament are read with a single query. They cache results so they live as long as a single request.
+type graphQLLoaders struct {
+	players       *dataloader.Loader[string, domain.Player]
+	playerCouples *dataloader.Loader[string, domain.PlayerCouple]
+}
+
+type loadersCtxKey struct{}
+
+func newGraphQLLoaders(playerReader domain.PlayerReader, playerCoupleReader domain.PlayerCoupleReader) *graphQLLoaders {
+	return &graphQLLoaders{
+		players: dataloader.New(func(ctx context.Context, ids []string) (map[string]domain.Player, error) {
+			players, err := playerReader.FindByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string]domain.Player, len(players))
+			for _, player := range players {
+				byID[player.ID] = player
+			}
+			return byID, nil
+		}),
+		playerCouples: dataloader.New(func(ctx context.Context, ids []string) (map[string]domain.PlayerCouple, error) {
+			playerCouples, err := playerCoupleReader.FindByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string]domain.PlayerCouple, len(playerCouples))
+			for _, playerCouple := range playerCouples {
+				byID[playerCouple.ID] = playerCouple
+			}
+			return byID, nil
+		}),
+	}
+}
+
+func withLoaders(ctx context.Context, loaders *graphQLLoaders) context.Context {
+	return context.WithValue(ctx, loadersCtxKey{}, loaders)
+}
+
+// loadersFrom returns the loaders of the request, set by GraphQLHandler.
+func loadersFrom(ctx context.Context) *graphQLLoaders {
+	return ctx.Value(loadersCtxKey{}).(*graphQLLoaders)
+}
@@ -0,0 +1,428 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// Tournaments only expose the public profile of their players (no SSN nor birth date), as the gRPC server does.
+const graphQLSchema = `
+schema {
+	query: Query
+	mutation: Mutation
+	subscription: Subscription
+}
+
+scalar Time
+
+type Query {
+	tournament(id: ID!): Tournament
+	playerCouple(id: ID!): PlayerCouple
+	player(id: ID!): Player
+}
+
+type Mutation {
+	# Referees, organizers and admins record (or correct) the score of a match.
+	recordMatchScore(tournamentId: ID!, matchId: ID!, score: ScoreInput!): Match!
+}
+
+type Subscription {
+	# Score updates of the tournament, or only of the match when matchId is set.
+	scoreUpdated(tournamentId: ID!, matchId: ID): ScoreUpdate!
+}
+
+type Tournament {
+	id: ID!
+	title: String!
+	timestamp: Time!
+	status: String!
+	playerCouples: [PlayerCouple!]!
+	rounds: [Round!]!
+}
+
+type Round {
+	number: Int!
+	matches: [Match!]!
+}
+
+type Match {
+	id: ID!
+	timestamp: Time!
+	# Court booked for the match once scheduled.
+	courtId: ID
+	couple1: PlayerCouple!
+	couple2: PlayerCouple!
+	# Score, partial unless the match is completed.
+	score: Score
+	# Outcome once decided: completed, retired, walkover, disqualified or cancelled.
+	outcome: String
+}
+
+type PlayerCouple {
+	id: ID!
+	player1: Player!
+	player2: Player!
+	ranking: Int
+}
+
+type Player {
+	id: ID!
+	email: String!
+	firstName: String!
+	lastName: String!
+}
+
+type Score {
+	set1: GameSet!
+	set2: GameSet!
+	set3: GameSet
+}
+
+type GameSet {
+	gamesCouple1: Int!
+	gamesCouple2: Int!
+	tiebreak: Tiebreak
+}
+
+type Tiebreak {
+	pointsCouple1: Int!
+	pointsCouple2: Int!
+}
+
+type ScoreUpdate {
+	tournamentId: ID!
+	matchId: ID!
+	score: Score!
+	outcome: String
+	updatedAt: Time!
+}
+
+input ScoreInput {
+	set1: GameSetInput!
+	set2: GameSetInput!
+	set3: GameSetInput
+}
+
+input GameSetInput {
+	gamesCouple1: Int!
+	gamesCouple2: Int!
+	tiebreak: TiebreakInput
+}
+
+input TiebreakInput {
+	pointsCouple1: Int!
+	pointsCouple2: Int!
+}
+`
+
+// maxQueryDepth bounds nested selections, e.g. tournament > rounds > matches > couple1 > player1 > email.
+const maxQueryDepth = 8
+
+var errMissingAuthentication = errors.New("missing authentication")
+
+// graphQLResolver is the root resolver of queries, mutations and subscriptions.
+type graphQLResolver struct {
+	findTournamentUseCase        application.FindTournamentUseCase
+	recordMatchScoreUseCase      application.RecordMatchScoreUseCase
+	subscribeScoreUpdatesUseCase application.SubscribeScoreUpdatesUseCase
+}
+
+func newGraphQLSchema(resolver *graphQLResolver) *graphql.Schema {
+	return graphql.MustParseSchema(graphQLSchema, resolver, graphql.MaxDepth(maxQueryDepth))
+}
+
+func (r *graphQLResolver) Tournament(ctx context.Context, args struct{ ID graphql.ID }) (*tournamentResolver, error) {
+	tournament, status, err := r.findTournamentUseCase.FindTournamentByIDUseCase(ctx, string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+	if status != application.FindTournamentFound {
+		return nil, nil
+	}
+	return &tournamentResolver{tournament: tournament}, nil
+}
+
+func (r *graphQLResolver) PlayerCouple(ctx context.Context, args struct{ ID graphql.ID }) (*playerCoupleResolver, error) {
+	playerCouple, found, err := loadersFrom(ctx).playerCouples.Load(ctx, string(args.ID))
+	if err != nil || !found {
+		return nil, err
+	}
+	return &playerCoupleResolver{playerCouple: playerCouple}, nil
+}
+
+func (r *graphQLResolver) Player(ctx context.Context, args struct{ ID graphql.ID }) (*playerResolver, error) {
+	player, found, err := loadersFrom(ctx).players.Load(ctx, string(args.ID))
+	if err != nil || !found {
+		return nil, err
+	}
+	return &playerResolver{player: player}, nil
+}
+
+type scoreInput struct {
+	Set1 gameSetInput
+	Set2 gameSetInput
+	Set3 *gameSetInput
+}
+
+type gameSetInput struct {
+	GamesCouple1 int32
+	GamesCouple2 int32
+	Tiebreak     *struct {
+		PointsCouple1 int32
+		PointsCouple2 int32
+	}
+}
+
+func (s scoreInput) toScore() domain.Score {
+	score := domain.Score{Set1: s.Set1.toGameSet(), Set2: s.Set2.toGameSet()}
+	if s.Set3 != nil {
+		set3 := s.Set3.toGameSet()
+		score.Set3 = &set3
+	}
+	return score
+}
+
+func (s gameSetInput) toGameSet() domain.GameSet {
+	set := domain.GameSet{GamesCouple1: int(s.GamesCouple1), GamesCouple2: int(s.GamesCouple2)}
+	if s.Tiebreak != nil {
+		set.Tiebreak = &domain.Tiebreak{PointsCouple1: int(s.Tiebreak.PointsCouple1), PointsCouple2: int(s.Tiebreak.PointsCouple2)}
+	}
+	return set
+}
+
+func (r *graphQLResolver) RecordMatchScore(ctx context.Context, args struct {
+	TournamentID graphql.ID
+	MatchID      graphql.ID
+	Score        scoreInput
+}) (*matchResolver, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, errMissingAuthentication
+	}
+	if !claims.HasAnyRole(auth.RoleAdmin, auth.RoleOrganizer, auth.RoleReferee) {
+		return nil, errors.New("insufficient role")
+	}
+	match, status, err := r.recordMatchScoreUseCase.RecordMatchScoreUseCase(ctx, string(args.TournamentID), string(args.MatchID), args.Score.toScore())
+	if err != nil {
+		return nil, err
+	}
+	if status != application.RecordMatchScoreRecorded {
+		return nil, errors.New(status.String())
+	}
+	return &matchResolver{match: match}, nil
+}
+
+func (r *graphQLResolver) ScoreUpdated(ctx context.Context, args struct {
+	TournamentID graphql.ID
+	MatchID      *graphql.ID
+}) (<-chan *scoreUpdateResolver, error) {
+	if _, ok := auth.ClaimsFromContext(ctx); !ok {
+		return nil, errMissingAuthentication
+	}
+	updates, status, err := r.subscribeScoreUpdatesUseCase.SubscribeScoreUpdatesUseCase(ctx, string(args.TournamentID))
+	if err != nil {
+		return nil, err
+	}
+	if status != application.SubscribeScoreUpdatesSubscribed {
+		return nil, errors.New(status.String())
+	}
+	resolvers := make(chan *scoreUpdateResolver)
+	go func() {
+		defer close(resolvers)
+		// updates is closed once the subscription context is done.
+		for update := range updates {
+			if args.MatchID != nil && string(*args.MatchID) != update.MatchID {
+				continue
+			}
+			select {
+			case resolvers <- &scoreUpdateResolver{update: update}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return resolvers, nil
+}
+
+type tournamentResolver struct {
+	tournament domain.Tournament
+}
+
+func (r *tournamentResolver) ID() graphql.ID { return graphql.ID(r.tournament.ID) }
+func (r *tournamentResolver) Title() string  { return r.tournament.Title }
+func (r *tournamentResolver) Status() string { return string(r.tournament.CurrentStatus()) }
+func (r *tournamentResolver) Timestamp() graphql.Time {
+	return graphql.Time{Time: r.tournament.Timestamp}
+}
+
+func (r *tournamentResolver) PlayerCouples() []*playerCoupleResolver {
+	resolvers := make([]*playerCoupleResolver, 0, len(r.tournament.PlayerCouples))
+	for _, playerCouple := range r.tournament.PlayerCouples {
+		resolvers = append(resolvers, &playerCoupleResolver{playerCouple: playerCouple})
+	}
+	return resolvers
+}
+
+func (r *tournamentResolver) Rounds() []*roundResolver {
+	resolvers := make([]*roundResolver, 0, len(r.tournament.Rounds))
+	for _, round := range r.tournament.Rounds {
+		resolvers = append(resolvers, &roundResolver{round: round})
+	}
+	return resolvers
+}
+
+type roundResolver struct {
+	round domain.Round
+}
+
+func (r *roundResolver) Number() int32 { return int32(r.round.Number) }
+
+func (r *roundResolver) Matches() []*matchResolver {
+	resolvers := make([]*matchResolver, 0, len(r.round.Matches))
+	for _, match := range r.round.Matches {
+		resolvers = append(resolvers, &matchResolver{match: match})
+	}
+	return resolvers
+}
+
+type matchResolver struct {
+	match domain.Match
+}
+
+func (r *matchResolver) ID() graphql.ID          { return graphql.ID(r.match.ID) }
+func (r *matchResolver) Timestamp() graphql.Time { return graphql.Time{Time: r.match.Timestamp} }
+
+func (r *matchResolver) CourtID() *graphql.ID {
+	if r.match.CourtID == "" {
+		return nil
+	}
+	courtID := graphql.ID(r.match.CourtID)
+	return &courtID
+}
+
+func (r *matchResolver) Outcome() *string {
+	return outcome(r.match.Decided())
+}
+
+func (r *matchResolver) Couple1() *playerCoupleResolver {
+	return &playerCoupleResolver{playerCouple: r.match.Couple1}
+}
+
+func (r *matchResolver) Couple2() *playerCoupleResolver {
+	return &playerCoupleResolver{playerCouple: r.match.Couple2}
+}
+
+func (r *matchResolver) Score() *scoreResolver {
+	if r.match.Score == nil {
+		return nil
+	}
+	return &scoreResolver{score: *r.match.Score}
+}
+
+// playerCoupleResolver resolves the couple embedded in a tournament at registration time, players are loaded
+// through the dataloader so the current profile is returned (the embedded copy is kept once unregistered).
+type playerCoupleResolver struct {
+	playerCouple domain.PlayerCouple
+}
+
+func (r *playerCoupleResolver) ID() graphql.ID { return graphql.ID(r.playerCouple.ID) }
+
+func (r *playerCoupleResolver) Player1(ctx context.Context) (*playerResolver, error) {
+	return loadPlayer(ctx, r.playerCouple.Player1)
+}
+
+func (r *playerCoupleResolver) Player2(ctx context.Context) (*playerResolver, error) {
+	return loadPlayer(ctx, r.playerCouple.Player2)
+}
+
+func (r *playerCoupleResolver) Ranking() *int32 {
+	if r.playerCouple.Ranking == nil {
+		return nil
+	}
+	ranking := int32(*r.playerCouple.Ranking)
+	return &ranking
+}
+
+func loadPlayer(ctx context.Context, embedded domain.Player) (*playerResolver, error) {
+	player, found, err := loadersFrom(ctx).players.Load(ctx, embedded.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		player = embedded
+	}
+	return &playerResolver{player: player}, nil
+}
+
+type playerResolver struct {
+	player domain.Player
+}
+
+func (r *playerResolver) ID() graphql.ID    { return graphql.ID(r.player.ID) }
+func (r *playerResolver) Email() string     { return r.player.Email }
+func (r *playerResolver) FirstName() string { return r.player.FirstName }
+func (r *playerResolver) LastName() string  { return r.player.LastName }
+
+type scoreResolver struct {
+	score domain.Score
+}
+
+func (r *scoreResolver) Set1() *gameSetResolver { return &gameSetResolver{set: r.score.Set1} }
+func (r *scoreResolver) Set2() *gameSetResolver { return &gameSetResolver{set: r.score.Set2} }
+
+func (r *scoreResolver) Set3() *gameSetResolver {
+	if r.score.Set3 == nil {
+		return nil
+	}
+	return &gameSetResolver{set: *r.score.Set3}
+}
+
+type gameSetResolver struct {
+	set domain.GameSet
+}
+
+func (r *gameSetResolver) GamesCouple1() int32 { return int32(r.set.GamesCouple1) }
+func (r *gameSetResolver) GamesCouple2() int32 { return int32(r.set.GamesCouple2) }
+
+func (r *gameSetResolver) Tiebreak() *tiebreakResolver {
+	if r.set.Tiebreak == nil {
+		return nil
+	}
+	return &tiebreakResolver{tiebreak: *r.set.Tiebreak}
+}
+
+type tiebreakResolver struct {
+	tiebreak domain.Tiebreak
+}
+
+func (r *tiebreakResolver) PointsCouple1() int32 { return int32(r.tiebreak.PointsCouple1) }
+func (r *tiebreakResolver) PointsCouple2() int32 { return int32(r.tiebreak.PointsCouple2) }
+
+type scoreUpdateResolver struct {
+	update domain.ScoreUpdate
+}
+
+func (r *scoreUpdateResolver) TournamentID() graphql.ID { return graphql.ID(r.update.TournamentID) }
+func (r *scoreUpdateResolver) MatchID() graphql.ID      { return graphql.ID(r.update.MatchID) }
+func (r *scoreUpdateResolver) Score() *scoreResolver    { return &scoreResolver{score: r.update.Score} }
+func (r *scoreUpdateResolver) UpdatedAt() graphql.Time  { return graphql.Time{Time: r.update.UpdatedAt} }
+
+func (r *scoreUpdateResolver) Outcome() *string {
+	if r.update.Result == nil {
+		return nil
+	}
+	return outcome(*r.update.Result, true)
+}
+
+func outcome(result domain.Result, decided bool) *string {
+	if !decided {
+		return nil
+	}
+	outcome := string(result.Outcome)
+	return &outcome
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	padelplacev1 "github.com/paguerre3/goddd/api/proto/padelplace/v1"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Conversions between domain objects and protobuf messages of the gRPC server, tournaments only expose the
+// public profile of their players (no SSN nor birth date).
+
+func toTournamentMessage(tournament domain.Tournament) *padelplacev1.Tournament {
+	message := &padelplacev1.Tournament{
+		Id:        tournament.ID,
+		Title:     tournament.Title,
+		Timestamp: timestamppb.New(tournament.Timestamp),
+	}
+	for _, playerCouple := range tournament.PlayerCouples {
+		message.PlayerCouples = append(message.PlayerCouples, toPlayerCoupleMessage(playerCouple))
+	}
+	for _, round := range tournament.Rounds {
+		roundMessage := &padelplacev1.Round{Number: int32(round.Number)}
+		for _, match := range round.Matches {
+			roundMessage.Matches = append(roundMessage.Matches, toMatchMessage(match))
+		}
+		message.Rounds = append(message.Rounds, roundMessage)
+	}
+	return message
+}
+
+func toPlayerCoupleMessage(playerCouple domain.PlayerCouple) *padelplacev1.PlayerCouple {
+	message := &padelplacev1.PlayerCouple{
+		Id:      playerCouple.ID,
+		Player1: toPlayerMessage(playerCouple.Player1),
+		Player2: toPlayerMessage(playerCouple.Player2),
+	}
+	if playerCouple.Ranking != nil {
+		ranking := int32(*playerCouple.Ranking)
+		message.Ranking = &ranking
+	}
+	return message
+}
+
+func toPlayerMessage(player domain.Player) *padelplacev1.Player {
+	return &padelplacev1.Player{
+		Id:        player.ID,
+		Email:     player.Email,
+		FirstName: player.FirstName,
+		LastName:  player.LastName,
+	}
+}
+
+func toMatchMessage(match domain.Match) *padelplacev1.Match {
+	message := &padelplacev1.Match{
+		Id:        match.ID,
+		Timestamp: timestamppb.New(match.Timestamp),
+		Couple1:   toPlayerCoupleMessage(match.Couple1),
+		Couple2:   toPlayerCoupleMessage(match.Couple2),
+	}
+	if match.Score != nil {
+		message.Score = &padelplacev1.Score{
+			Set1: toGameSetMessage(&match.Score.Set1),
+			Set2: toGameSetMessage(&match.Score.Set2),
+			Set3: toGameSetMessage(match.Score.Set3),
+		}
+	}
+	return message
+}
+
+func toGameSetMessage(set *domain.GameSet) *padelplacev1.GameSet {
+	if set == nil {
+		return nil
+	}
+	message := &padelplacev1.GameSet{
+		GamesCouple1: int32(set.GamesCouple1),
+		GamesCouple2: int32(set.GamesCouple2),
+	}
+	if set.Tiebreak != nil {
+		message.Tiebreak = &padelplacev1.Tiebreak{
+			PointsCouple1: int32(set.Tiebreak.PointsCouple1),
+			PointsCouple2: int32(set.Tiebreak.PointsCouple2),
+		}
+	}
+	return message
+}
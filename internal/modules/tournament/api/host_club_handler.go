@@ -0,0 +1,47 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+)
+
+// HostClubHandler assigns the club hosting tournaments, clubs being managed by the club module.
+type HostClubHandler struct {
+	hostClubUseCase application.HostClubUseCase
+}
+
+func NewHostClubHandler(hostClubUseCase application.HostClubUseCase) *HostClubHandler {
+	return &HostClubHandler{hostClubUseCase: hostClubUseCase}
+}
+
+type assignHostClubRequest struct {
+	ClubID string `json:"clubId" binding:"required"`
+}
+
+func (h *HostClubHandler) AssignHostClub(c *gin.Context) {
+	var request assignHostClubRequest
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	tournament, status, err := h.hostClubUseCase.AssignHostClubUseCase(c.Request.Context(), c.Param("id"), request.ClubID)
+	switch status {
+	case application.HostClubAssigned:
+		web.Respond(c, http.StatusOK, tournament)
+	case application.HostClubInvalid:
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+	case application.HostClubNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	case application.HostClubConflict:
+		web.Respond(c, http.StatusConflict, web.ErrorBody(c, err))
+	default:
+		if err == nil {
+			err = fmt.Errorf("invalid status %d", status)
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+	}
+}
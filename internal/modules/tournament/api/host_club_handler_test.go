@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockHostClubUseCase struct {
+	mock.Mock
+}
+
+func (m *mockHostClubUseCase) AssignHostClubUseCase(_ context.Context, tournamentId, clubId string) (domain.Tournament, application.HostClubStatus, error) {
+	args := m.Called(tournamentId, clubId)
+	return args.Get(0).(domain.Tournament), args.Get(1).(application.HostClubStatus), args.Error(2)
+}
+
+func TestHostClubHandler_AssignHostClub(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	useCase := &mockHostClubUseCase{}
+	useCase.On("AssignHostClubUseCase", "tournament-1", "club-1").
+		Return(domain.Tournament{ID: "tournament-1", HostClub: &domain.HostClub{ID: "club-1", Name: "Club Norte"}}, application.HostClubAssigned, nil)
+	useCase.On("AssignHostClubUseCase", "tournament-1", "club-2").Return(domain.Tournament{}, application.HostClubNotFound, nil)
+	useCase.On("AssignHostClubUseCase", "tournament-2", "club-1").Return(domain.Tournament{}, application.HostClubConflict, domain.ErrOperationNotAllowed)
+	handler := NewHostClubHandler(useCase)
+	router := gin.New()
+	router.PUT("/tournaments/:id/host-club", handler.AssignHostClub)
+
+	w := serve(router, http.MethodPut, "/tournaments/tournament-1/host-club", `{"clubId":"club-1"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var tournament map[string]any
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &tournament))
+	assert.Equal(t, map[string]any{"id": "club-1", "name": "Club Norte"}, tournament["hostClub"])
+
+	w = serve(router, http.MethodPut, "/tournaments/tournament-1/host-club", `{"clubId":"club-2"}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"status":"HostClubNotFound"}`, w.Body.String())
+
+	w = serve(router, http.MethodPut, "/tournaments/tournament-2/host-club", `{"clubId":"club-1"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = serve(router, http.MethodPut, "/tournaments/tournament-1/host-club", `{}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// LifecycleHandler exposes the transitions of tournaments as commands, e.g. POST /tournaments/:id/open-registration.
+type LifecycleHandler struct {
+	transitionTournamentUseCase application.TransitionTournamentUseCase
+}
+
+func NewLifecycleHandler(transitionTournamentUseCase application.TransitionTournamentUseCase) *LifecycleHandler {
+	return &LifecycleHandler{transitionTournamentUseCase: transitionTournamentUseCase}
+}
+
+// Transition returns the handler of the transition command.
+func (h *LifecycleHandler) Transition(transition domain.Transition) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tournament, status, err := h.transitionTournamentUseCase.TransitionTournamentUseCase(c.Request.Context(), c.Param("id"), transition)
+		switch status {
+		case application.TournamentLifecycleTransitioned:
+			web.Respond(c, http.StatusOK, tournament)
+		case application.TournamentLifecycleInvalid:
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		case application.TournamentLifecycleNotFound:
+			web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+		case application.TournamentLifecycleConflict:
+			web.Respond(c, http.StatusConflict, web.ErrorBody(c, err))
+		default:
+			if err == nil {
+				err = fmt.Errorf("invalid status %d", status)
+			}
+			web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockTransitionTournamentUseCase struct {
+	mock.Mock
+}
+
+func (m *mockTransitionTournamentUseCase) TransitionTournamentUseCase(_ context.Context, tournamentId string, transition domain.Transition) (domain.Tournament, application.TournamentLifecycleStatus, error) {
+	args := m.Called(tournamentId, transition)
+	return args.Get(0).(domain.Tournament), args.Get(1).(application.TournamentLifecycleStatus), args.Error(2)
+}
+
+func TestLifecycleHandler_Transition(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	useCase := &mockTransitionTournamentUseCase{}
+	useCase.On("TransitionTournamentUseCase", "tournament-1", domain.TransitionOpenRegistration).
+		Return(domain.Tournament{ID: "tournament-1", Status: domain.StatusRegistrationOpen}, application.TournamentLifecycleTransitioned, nil)
+	useCase.On("TransitionTournamentUseCase", "tournament-1", domain.TransitionStart).
+		Return(domain.Tournament{}, application.TournamentLifecycleConflict, domain.ErrTransitionNotAllowed)
+	useCase.On("TransitionTournamentUseCase", "tournament-2", domain.TransitionOpenRegistration).
+		Return(domain.Tournament{}, application.TournamentLifecycleNotFound, nil)
+	handler := NewLifecycleHandler(useCase)
+	router := gin.New()
+	for _, transition := range domain.Transitions {
+		router.POST("/tournaments/:id/"+string(transition), handler.Transition(transition))
+	}
+
+	w := serve(router, http.MethodPost, "/tournaments/tournament-1/open-registration", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	var tournament map[string]any
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &tournament))
+	assert.Equal(t, "RegistrationOpen", tournament["status"])
+
+	w = serve(router, http.MethodPost, "/tournaments/tournament-1/start", "")
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = serve(router, http.MethodPost, "/tournaments/tournament-2/open-registration", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"status":"TournamentLifecycleNotFound"}`, w.Body.String())
+}
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+const (
+	liveScoreEvent         = "score"
+	eventStreamContentType = "text/event-stream"
+	// keepAliveInterval keeps idle streams open through proxies closing silent connections.
+	keepAliveInterval = 15 * time.Second
+)
+
+// LiveMatchHandler lets referees score matches point by point while anyone follows the live score through
+// Server-Sent Events or a websocket (live scores hold no personal data).
+type LiveMatchHandler struct {
+	liveMatchUseCase application.LiveMatchUseCase
+	upgrader         websocket.Upgrader
+	keepAlive        time.Duration
+}
+
+func NewLiveMatchHandler(liveMatchUseCase application.LiveMatchUseCase) *LiveMatchHandler {
+	return &LiveMatchHandler{
+		liveMatchUseCase: liveMatchUseCase,
+		// Spectators follow live scores from any site.
+		upgrader:  websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }},
+		keepAlive: keepAliveInterval,
+	}
+}
+
+type startLiveMatchRequest struct {
+	// GoldenPoint decides games at deuce with a single point.
+	GoldenPoint bool `json:"goldenPoint"`
+}
+
+type scorePointRequest struct {
+	// Couple is 1 or 2.
+	Couple int `json:"couple"`
+}
+
+func (h *LiveMatchHandler) StartLiveMatch(c *gin.Context) {
+	var request startLiveMatchRequest
+	// The body is optional, i.e. no golden point by default.
+	if c.Request.ContentLength != 0 {
+		if err := web.Bind(c, &request); err != nil {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+	}
+	score, status, err := h.liveMatchUseCase.StartLiveMatchUseCase(c.Request.Context(), c.Param("id"), c.Param("matchId"), request.GoldenPoint)
+	respondLiveScore(c, score, status, err)
+}
+
+func (h *LiveMatchHandler) ScorePoint(c *gin.Context) {
+	var request scorePointRequest
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	score, status, err := h.liveMatchUseCase.ScorePointUseCase(c.Request.Context(), c.Param("id"), c.Param("matchId"), request.Couple)
+	respondLiveScore(c, score, status, err)
+}
+
+func (h *LiveMatchHandler) UndoPoint(c *gin.Context) {
+	score, status, err := h.liveMatchUseCase.UndoPointUseCase(c.Request.Context(), c.Param("id"), c.Param("matchId"))
+	respondLiveScore(c, score, status, err)
+}
+
+func respondLiveScore(c *gin.Context, score domain.LiveScore, status application.LiveMatchStatus, err error) {
+	switch status {
+	case application.LiveMatchStarted:
+		web.Respond(c, http.StatusCreated, score)
+	case application.LiveMatchScored, application.LiveMatchUndone, application.LiveMatchFound:
+		web.Respond(c, http.StatusOK, score)
+	case application.LiveMatchInvalid:
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+	case application.LiveMatchNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	case application.LiveMatchConflict:
+		web.Respond(c, http.StatusConflict, web.ErrorBody(c, err))
+	default:
+		if err == nil {
+			err = fmt.Errorf("invalid status %d", status)
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+	}
+}
+
+// StreamLiveScore sends the current score followed by every update, through a websocket when the request is
+// an upgrade or as Server-Sent Events ("score" events) when accepted, otherwise only the current score.
+func (h *LiveMatchHandler) StreamLiveScore(c *gin.Context) {
+	if !websocket.IsWebSocketUpgrade(c.Request) && !strings.Contains(c.GetHeader("Accept"), eventStreamContentType) {
+		score, status, err := h.liveMatchUseCase.FindLiveScoreUseCase(c.Request.Context(), c.Param("id"), c.Param("matchId"))
+		respondLiveScore(c, score, status, err)
+		return
+	}
+	// Hijacked (websocket) connections don't cancel the request context once closed.
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	current, updates, status, err := h.liveMatchUseCase.SubscribeLiveScoreUseCase(ctx, c.Param("id"), c.Param("matchId"))
+	if status != application.LiveMatchFound {
+		respondLiveScore(c, current, status, err)
+		return
+	}
+	if websocket.IsWebSocketUpgrade(c.Request) {
+		h.streamWebSocket(c, cancel, current, updates)
+		return
+	}
+	h.streamEvents(c, current, updates)
+}
+
+func (h *LiveMatchHandler) streamEvents(c *gin.Context, current domain.LiveScore, updates <-chan domain.LiveScore) {
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.SSEvent(liveScoreEvent, current)
+	c.Writer.Flush()
+
+	ticker := time.NewTicker(h.keepAlive)
+	defer ticker.Stop()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case score, ok := <-updates:
+			if !ok {
+				return false
+			}
+			c.SSEvent(liveScoreEvent, score)
+		case <-ticker.C:
+			_, _ = io.WriteString(w, ": keep-alive\n\n")
+		}
+		return true
+	})
+}
+
+func (h *LiveMatchHandler) streamWebSocket(c *gin.Context, cancel context.CancelFunc, current domain.LiveScore, updates <-chan domain.LiveScore) {
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader already replied with the error.
+		return
+	}
+	defer conn.Close()
+	// Spectators only listen, reading detects closed connections (and handles control frames).
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	write := func(score domain.LiveScore) error {
+		_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		return conn.WriteJSON(score)
+	}
+	if err := write(current); err != nil {
+		return
+	}
+	ticker := time.NewTicker(h.keepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case score, ok := <-updates:
+			if !ok || write(score) != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockLiveMatchUseCase struct {
+	mock.Mock
+	updates chan domain.LiveScore
+}
+
+func (m *mockLiveMatchUseCase) StartLiveMatchUseCase(_ context.Context, tournamentId, matchId string, goldenPoint bool) (domain.LiveScore, application.LiveMatchStatus, error) {
+	args := m.Called(tournamentId, matchId, goldenPoint)
+	return args.Get(0).(domain.LiveScore), args.Get(1).(application.LiveMatchStatus), args.Error(2)
+}
+
+func (m *mockLiveMatchUseCase) ScorePointUseCase(_ context.Context, tournamentId, matchId string, couple int) (domain.LiveScore, application.LiveMatchStatus, error) {
+	args := m.Called(tournamentId, matchId, couple)
+	return args.Get(0).(domain.LiveScore), args.Get(1).(application.LiveMatchStatus), args.Error(2)
+}
+
+func (m *mockLiveMatchUseCase) UndoPointUseCase(_ context.Context, tournamentId, matchId string) (domain.LiveScore, application.LiveMatchStatus, error) {
+	args := m.Called(tournamentId, matchId)
+	return args.Get(0).(domain.LiveScore), args.Get(1).(application.LiveMatchStatus), args.Error(2)
+}
+
+func (m *mockLiveMatchUseCase) FindLiveScoreUseCase(_ context.Context, tournamentId, matchId string) (domain.LiveScore, application.LiveMatchStatus, error) {
+	args := m.Called(tournamentId, matchId)
+	return args.Get(0).(domain.LiveScore), args.Get(1).(application.LiveMatchStatus), args.Error(2)
+}
+
+// SubscribeLiveScoreUseCase forwards m.updates until the context is done, as the broker does.
+func (m *mockLiveMatchUseCase) SubscribeLiveScoreUseCase(ctx context.Context, tournamentId, matchId string) (domain.LiveScore, <-chan domain.LiveScore, application.LiveMatchStatus, error) {
+	args := m.Called(tournamentId, matchId)
+	if args.Get(1).(application.LiveMatchStatus) != application.LiveMatchFound {
+		return domain.LiveScore{}, nil, args.Get(1).(application.LiveMatchStatus), args.Error(2)
+	}
+	updates := make(chan domain.LiveScore)
+	go func() {
+		defer close(updates)
+		for {
+			select {
+			case score := <-m.updates:
+				updates <- score
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return args.Get(0).(domain.LiveScore), updates, application.LiveMatchFound, nil
+}
+
+const livePath = "/tournaments/tournament-1/matches/match-1/live"
+
+func newLiveMatchRouter(useCase *mockLiveMatchUseCase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewLiveMatchHandler(useCase)
+	router := gin.New()
+	router.GET("/tournaments/:id/matches/:matchId/live", handler.StreamLiveScore)
+	router.POST("/tournaments/:id/matches/:matchId/live", handler.StartLiveMatch)
+	router.POST("/tournaments/:id/matches/:matchId/live/points", handler.ScorePoint)
+	router.DELETE("/tournaments/:id/matches/:matchId/live/points/last", handler.UndoPoint)
+	return router
+}
+
+func serve(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func liveScore(pointsPlayed int) domain.LiveScore {
+	return domain.LiveScore{TournamentID: "tournament-1", MatchID: "match-1", PointsPlayed: pointsPlayed,
+		Sets: []domain.GameSet{{}}, Game: &domain.LiveGame{PointsCouple1: "15", PointsCouple2: "0"}}
+}
+
+func TestLiveMatchHandler_StartLiveMatch(t *testing.T) {
+	useCase := &mockLiveMatchUseCase{}
+	useCase.On("StartLiveMatchUseCase", "tournament-1", "match-1", false).Return(liveScore(0), application.LiveMatchStarted, nil)
+	useCase.On("StartLiveMatchUseCase", "tournament-1", "match-2", true).Return(domain.LiveScore{}, application.LiveMatchConflict, errors.New("live scoring already started"))
+	useCase.On("StartLiveMatchUseCase", "tournament-1", "match-3", false).Return(domain.LiveScore{}, application.LiveMatchNotFound, nil)
+	router := newLiveMatchRouter(useCase)
+
+	w := serve(router, http.MethodPost, livePath, "")
+	assert.Equal(t, http.StatusCreated, w.Code, "Expected no golden point without body")
+
+	w = serve(router, http.MethodPost, "/tournaments/tournament-1/matches/match-2/live", `{"goldenPoint":true}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = serve(router, http.MethodPost, "/tournaments/tournament-1/matches/match-3/live", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"status":"LiveMatchNotFound"}`, w.Body.String())
+
+	w = serve(router, http.MethodPost, "/tournaments/tournament-1/matches/match-3/live", `{"goldenPoint":`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestLiveMatchHandler_ScoreAndUndoPoint(t *testing.T) {
+	useCase := &mockLiveMatchUseCase{}
+	useCase.On("ScorePointUseCase", "tournament-1", "match-1", domain.Couple1).Return(liveScore(1), application.LiveMatchScored, nil)
+	useCase.On("ScorePointUseCase", "tournament-1", "match-1", 3).Return(domain.LiveScore{}, application.LiveMatchInvalid, errors.New("couple must be 1 or 2"))
+	useCase.On("UndoPointUseCase", "tournament-1", "match-1").Return(liveScore(0), application.LiveMatchUndone, nil)
+	router := newLiveMatchRouter(useCase)
+
+	w := serve(router, http.MethodPost, livePath+"/points", `{"couple":1}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var score domain.LiveScore
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &score))
+	assert.Equal(t, liveScore(1), score)
+
+	w = serve(router, http.MethodPost, livePath+"/points", `{"couple":3}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = serve(router, http.MethodDelete, livePath+"/points/last", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestLiveMatchHandler_StreamEvents(t *testing.T) {
+	useCase := &mockLiveMatchUseCase{updates: make(chan domain.LiveScore)}
+	useCase.On("SubscribeLiveScoreUseCase", "tournament-1", "match-1").Return(liveScore(1), application.LiveMatchFound, nil)
+	server := httptest.NewServer(newLiveMatchRouter(useCase))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+livePath, nil)
+	req.Header.Set("Accept", eventStreamContentType)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, eventStreamContentType, resp.Header.Get("Content-Type"))
+
+	lines := bufio.NewScanner(resp.Body)
+	readEvent := func() domain.LiveScore {
+		var score domain.LiveScore
+		for lines.Scan() {
+			line := lines.Text()
+			if data, ok := strings.CutPrefix(line, "data:"); ok {
+				assert.NoError(t, json.Unmarshal([]byte(data), &score))
+				return score
+			}
+			if line != "" {
+				assert.Equal(t, "event:"+liveScoreEvent, line)
+			}
+		}
+		t.Fatal("stream ended")
+		return score
+	}
+	assert.Equal(t, 1, readEvent().PointsPlayed, "Expected the current score first")
+	useCase.updates <- liveScore(2)
+	assert.Equal(t, 2, readEvent().PointsPlayed)
+}
+
+func TestLiveMatchHandler_StreamNotFound(t *testing.T) {
+	useCase := &mockLiveMatchUseCase{}
+	useCase.On("SubscribeLiveScoreUseCase", "tournament-1", "match-1").Return(domain.LiveScore{}, application.LiveMatchNotFound, nil)
+	req := httptest.NewRequest(http.MethodGet, livePath, nil)
+	req.Header.Set("Accept", eventStreamContentType)
+	w := httptest.NewRecorder()
+
+	newLiveMatchRouter(useCase).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestLiveMatchHandler_CurrentScore(t *testing.T) {
+	useCase := &mockLiveMatchUseCase{}
+	useCase.On("FindLiveScoreUseCase", "tournament-1", "match-1").Return(liveScore(1), application.LiveMatchFound, nil)
+
+	w := serve(newLiveMatchRouter(useCase), http.MethodGet, livePath, "")
+
+	assert.Equal(t, http.StatusOK, w.Code, "Expected the current score without event stream")
+	var score domain.LiveScore
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &score))
+	assert.Equal(t, liveScore(1), score)
+}
+
+func TestLiveMatchHandler_StreamWebSocket(t *testing.T) {
+	useCase := &mockLiveMatchUseCase{updates: make(chan domain.LiveScore)}
+	useCase.On("SubscribeLiveScoreUseCase", "tournament-1", "match-1").Return(liveScore(1), application.LiveMatchFound, nil)
+	server := httptest.NewServer(newLiveMatchRouter(useCase))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+livePath, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	var score domain.LiveScore
+	assert.NoError(t, conn.ReadJSON(&score))
+	assert.Equal(t, 1, score.PointsPlayed)
+	useCase.updates <- liveScore(2)
+	assert.NoError(t, conn.ReadJSON(&score))
+	assert.Equal(t, 2, score.PointsPlayed)
+
+	// Closing the connection ends the subscription.
+	assert.NoError(t, conn.Close())
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case useCase.updates <- liveScore(3):
+		t.Fatal("Expected the subscription to end")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
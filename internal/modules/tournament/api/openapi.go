@@ -0,0 +1,380 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/paguerre3/goddd/internal/modules/common/openapi"
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+const graphQLTag = "graphql"
+
+// graphQLResponse documents the responses of graphql.Schema.Exec.
+type graphQLResponse struct {
+	Data   map[string]any `json:"data,omitempty"`
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path,omitempty"`
+	} `json:"errors,omitempty"`
+}
+
+// DescribeGraphQLRoutes documents the routes of GraphQLHandler registered under path, the schema itself is
+// available through introspection.
+func DescribeGraphQLRoutes(doc *openapi.Document, path string) {
+	doc.Add(http.MethodPost, path, doc.Authenticated(openapi.Operation{
+		Summary:     "Execute a GraphQL query or mutation",
+		Description: "Tournaments, rounds, matches, couples and players. Errors are part of the 200 response.",
+		Tags:        []string{graphQLTag},
+		RequestBody: doc.Body(graphQLRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Result of the operation", graphQLResponse{}),
+			"400": doc.ErrorResponse("Invalid request body"),
+		},
+	}, openapi.Bearer))
+	doc.Add(http.MethodGet, path, openapi.Operation{
+		Summary: "Subscribe to live score updates (websocket)",
+		Description: "Upgrades to a websocket using the graphql-transport-ws subprotocol. The bearer token is sent " +
+			"in the Authorization header or in the payload of connection_init.",
+		Tags:     []string{graphQLTag},
+		Security: openapi.Bearer,
+		Responses: map[string]*openapi.Response{
+			"101": {Description: "Switching to the graphql-transport-ws protocol"},
+			"400": {Description: "Not a websocket request"},
+			"401": doc.ErrorResponse("Invalid bearer token"),
+		},
+	})
+}
+
+const tournamentsTag = "tournaments"
+
+// DescribeLiveMatchRoutes documents the routes of LiveMatchHandler registered under basePath.
+func DescribeLiveMatchRoutes(doc *openapi.Document, basePath string) {
+	const live = "/:id/matches/:matchId/live"
+	add := func(method, path string, operation openapi.Operation) {
+		operation.Tags = []string{tournamentsTag}
+		// Referees, organizers and admins score matches.
+		doc.Add(method, basePath+path, doc.Authenticated(operation, openapi.Bearer))
+	}
+	scoreResponses := func(success string) map[string]*openapi.Response {
+		return map[string]*openapi.Response{
+			"200": doc.Response(success, domain.LiveScore{}),
+			"400": doc.ErrorResponse("Invalid IDs or couple"),
+			"404": doc.StatusResponse("Live match not found"),
+			"409": doc.ErrorResponse("Match already finished, no point to undo or updated concurrently"),
+			"500": doc.ErrorResponse("Internal error"),
+		}
+	}
+
+	doc.Add(http.MethodGet, basePath+live, openapi.Operation{
+		Summary: "Follow the live score of a match",
+		Description: "Anonymous. Sends the current score followed by every update as Server-Sent Events (\"score\" events) " +
+			"when accepting text/event-stream, or as JSON messages once upgraded to a websocket. Otherwise returns the current score.",
+		Tags: []string{tournamentsTag},
+		Responses: map[string]*openapi.Response{
+			"101": {Description: "Switching to a websocket"},
+			"200": {Description: "Current score or stream of score events", Content: map[string]*openapi.MediaType{
+				"application/json":  {Schema: doc.Schema(domain.LiveScore{})},
+				"text/event-stream": {Schema: doc.Schema(domain.LiveScore{})},
+			}},
+			"400": doc.ErrorResponse("Invalid IDs"),
+			"404": doc.StatusResponse("Live match not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPost, live, openapi.Operation{
+		Summary:     "Start live scoring of a match",
+		RequestBody: doc.Body(startLiveMatchRequest{}),
+		Responses: map[string]*openapi.Response{
+			"201": doc.Response("Live scoring started", domain.LiveScore{}),
+			"400": doc.ErrorResponse("Invalid IDs"),
+			"404": doc.StatusResponse("Tournament or match not found"),
+			"409": doc.ErrorResponse("Live scoring already started"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPost, live+"/points", openapi.Operation{
+		Summary:     "Score a point",
+		Description: "The final score is recorded in the tournament once the match is finished.",
+		RequestBody: doc.Body(scorePointRequest{}),
+		Responses:   scoreResponses("Point scored"),
+	})
+	add(http.MethodDelete, live+"/points/last", openapi.Operation{
+		Summary:     "Undo the last point",
+		Description: "Undoing the match point reopens the match and removes its final score.",
+		Responses:   scoreResponses("Point undone"),
+	})
+}
+
+// DescribeScheduleRoutes documents the routes of ScheduleHandler registered under basePath.
+func DescribeScheduleRoutes(doc *openapi.Document, basePath string) {
+	add := func(method, path string, operation openapi.Operation) {
+		operation.Tags = []string{tournamentsTag}
+		doc.Add(method, basePath+path, doc.Authenticated(operation, openapi.Bearer))
+	}
+	scheduleResponses := func(success, conflict string) map[string]*openapi.Response {
+		return map[string]*openapi.Response{
+			"200": doc.Response(success, domain.Schedule{}),
+			"400": doc.ErrorResponse("Invalid request"),
+			"404": doc.StatusResponse("Tournament or match not found"),
+			"409": doc.ErrorResponse(conflict),
+			"500": doc.ErrorResponse("Internal error"),
+		}
+	}
+
+	add(http.MethodPut, "/:id/scheduling", openapi.Operation{
+		Summary:     "Configure venues, courts and match estimates",
+		Description: "Organizers and admins. Courts have availability windows, court IDs are unique across venues.",
+		RequestBody: doc.Body(domain.Scheduling{}),
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Scheduling configured", domain.Scheduling{}),
+			"400": doc.ErrorResponse("Invalid venues, courts or estimates"),
+			"404": doc.StatusResponse("Tournament not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	doc.Add(http.MethodGet, basePath+"/:id/schedule", openapi.Operation{
+		Summary:     "Find the schedule of a tournament",
+		Description: "Anonymous. Scheduled matches sorted by start, with their conflicts.",
+		Tags:        []string{tournamentsTag},
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Schedule", domain.Schedule{}),
+			"400": doc.ErrorResponse("Invalid ID"),
+			"404": doc.StatusResponse("Tournament not found"),
+			"409": doc.ErrorResponse("Scheduling not configured"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPost, "/:id/schedule", openapi.Operation{
+		Summary: "Schedule the matches not started yet",
+		Description: "Organizers and admins. Matches go round after round to the earliest available court, " +
+			"a round starts once the previous one ends and couples rest between their matches.",
+		Responses: scheduleResponses("Schedule", "Scheduling not configured or no court available"),
+	})
+	add(http.MethodPut, "/:id/matches/:matchId/schedule", openapi.Operation{
+		Summary:     "Book a court for a match",
+		Description: "Organizers and admins. Rejected when the match conflicts with another one or the court availability.",
+		RequestBody: doc.Body(assignMatchRequest{}),
+		Responses:   scheduleResponses("Schedule", "Scheduling not configured or conflicting match"),
+	})
+	add(http.MethodPut, "/:id/matches/:matchId/end", openapi.Operation{
+		Summary: "Report the end of a match",
+		Description: "Referees, organizers and admins. The matches not started yet are rescheduled, e.g. after an overrun, " +
+			"conflicts are reported when they no longer fit.",
+		RequestBody: doc.Body(reportMatchEndRequest{}),
+		Responses:   scheduleResponses("Schedule", "Scheduling not configured"),
+	})
+}
+
+// DescribeCategoryRoutes documents the routes of CategoryHandler registered under basePath.
+func DescribeCategoryRoutes(doc *openapi.Document, basePath string) {
+	const categories = "/:id/categories"
+	add := func(method, path string, operation openapi.Operation) {
+		operation.Tags = []string{tournamentsTag}
+		doc.Add(method, basePath+path, doc.Authenticated(operation, openapi.Bearer))
+	}
+
+	add(http.MethodGet, categories, openapi.Operation{
+		Summary: "Find the categories of a tournament with their entries and waitlist",
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Categories", []domain.Category{}),
+			"400": doc.ErrorResponse("Invalid ID"),
+			"404": doc.StatusResponse("Tournament not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPost, categories, openapi.Operation{
+		Summary:     "Add a category",
+		Description: "Organizers and admins. Eligibility bounds the ranking of couples and the age of players on the day of the tournament (from their birth date), gender is open, men, women or mixed.",
+		RequestBody: doc.Body(domain.Category{}),
+		Responses: map[string]*openapi.Response{
+			"201": doc.Response("Category added", domain.Category{}),
+			"400": doc.ErrorResponse("Invalid category"),
+			"404": doc.StatusResponse("Tournament not found"),
+			"409": doc.ErrorResponse("Category already exists"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPost, categories+"/:categoryId/entries", openapi.Operation{
+		Summary:     "Enter a couple in a category",
+		Description: "Organizers and admins. Couples are checked against the current profile of their players, full categories waitlist them.",
+		RequestBody: doc.Body(enterCategoryRequest{}),
+		Responses: map[string]*openapi.Response{
+			"201": doc.Response("Couple entered", domain.CategoryEntry{}),
+			"202": doc.Response("Couple waitlisted", domain.CategoryEntry{}),
+			"400": doc.ErrorResponse("Invalid IDs"),
+			"404": doc.StatusResponse("Tournament, category or couple not found"),
+			"409": doc.ErrorResponse("Couple or one of its players already entered"),
+			"422": doc.ErrorResponse("Couple not eligible, with the rules it doesn't meet"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodDelete, categories+"/:categoryId/entries/:coupleId", openapi.Operation{
+		Summary:     "Withdraw a couple from a category",
+		Description: "Organizers and admins. The first waitlisted couple is promoted when an entered couple withdraws.",
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Couple withdrawn", domain.CategoryEntry{}),
+			"400": doc.ErrorResponse("Invalid IDs"),
+			"404": doc.StatusResponse("Tournament, category or entry not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+}
+
+// transitionSummaries document the transitions of DescribeLifecycleRoutes.
+var transitionSummaries = map[domain.Transition]string{
+	domain.TransitionOpenRegistration:  "Open the registration of a draft tournament",
+	domain.TransitionCloseRegistration: "Close the registration, couples can still withdraw",
+	domain.TransitionPublishDraw:       "Publish the draw, generated from the seeded entries unless rounds were set",
+	domain.TransitionStart:             "Start the tournament, matches can be scored",
+	domain.TransitionFinish:            "Finish the tournament",
+	domain.TransitionCancel:            "Cancel the tournament before it's finished",
+}
+
+// DescribeLifecycleRoutes documents the routes of LifecycleHandler registered under basePath.
+func DescribeLifecycleRoutes(doc *openapi.Document, basePath string) {
+	for _, transition := range domain.Transitions {
+		doc.Add(http.MethodPost, basePath+"/:id/"+string(transition), doc.Authenticated(openapi.Operation{
+			Summary:     transitionSummaries[transition],
+			Description: "Organizers and admins. Emits an event, operations like entering couples or scoring matches depend on the status.",
+			Tags:        []string{tournamentsTag},
+			Responses: map[string]*openapi.Response{
+				"200": doc.Response("Tournament in its new status", domain.Tournament{}),
+				"400": doc.ErrorResponse("Invalid ID"),
+				"404": doc.StatusResponse("Tournament not found"),
+				"409": doc.ErrorResponse("Transition not allowed in the current status or nothing to draw"),
+				"500": doc.ErrorResponse("Internal error"),
+			},
+		}, openapi.Bearer))
+	}
+}
+
+// DescribeHostClubRoutes documents the routes of HostClubHandler registered under basePath.
+func DescribeHostClubRoutes(doc *openapi.Document, basePath string) {
+	doc.Add(http.MethodPut, basePath+"/:id/host-club", doc.Authenticated(openapi.Operation{
+		Summary:     "Assign the club hosting a tournament",
+		Description: "Organizers and admins, until registration closes. The name and city of the club are copied into the tournament.",
+		Tags:        []string{tournamentsTag},
+		RequestBody: doc.Body(assignHostClubRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Host club assigned", domain.Tournament{}),
+			"400": doc.ErrorResponse("Invalid IDs"),
+			"404": doc.StatusResponse("Tournament or club not found"),
+			"409": doc.ErrorResponse("Registration already closed"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	}, openapi.Bearer))
+}
+
+// DescribeResultRoutes documents the routes of ResultHandler registered under basePath.
+func DescribeResultRoutes(doc *openapi.Document, basePath string) {
+	add := func(method, path string, operation openapi.Operation) {
+		operation.Tags = []string{tournamentsTag}
+		doc.Add(method, basePath+path, doc.Authenticated(operation, openapi.Bearer))
+	}
+
+	add(http.MethodPut, "/:id/matches/:matchId/result", openapi.Operation{
+		Summary: "Record the outcome of a match",
+		Description: "Referees, organizers and admins. Completed matches need a decided score, retired and disqualified " +
+			"ones a winner and a partial score, walkovers a winner and no score, cancelled ones neither.",
+		RequestBody: doc.Body(recordResultRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Result recorded", domain.Match{}),
+			"400": doc.ErrorResponse("Invalid outcome, winner or score"),
+			"404": doc.StatusResponse("Tournament or match not found"),
+			"409": doc.ErrorResponse("Tournament not in progress"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPut, "/:id/result-rules", openapi.Operation{
+		Summary:     "Configure how outcomes count in standings and brackets",
+		Description: "Organizers and admins, until the tournament starts. Points per outcome, partial scores and walkover sets, who advances after a cancelled match.",
+		RequestBody: doc.Body(domain.ResultRules{}),
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Rules configured", domain.ResultRules{}),
+			"400": doc.ErrorResponse("Invalid rules"),
+			"404": doc.StatusResponse("Tournament not found"),
+			"409": doc.ErrorResponse("Tournament already started"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	doc.Add(http.MethodGet, basePath+"/:id/standings", openapi.Operation{
+		Summary:     "Find the standings of a tournament",
+		Description: "Anonymous. Couples ranked by points, set and game difference, optionally of the categoryId query parameter.",
+		Tags:        []string{tournamentsTag},
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Standings", []domain.Standing{}),
+			"400": doc.ErrorResponse("Invalid ID"),
+			"404": doc.StatusResponse("Tournament or category not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodPost, "/:id/rounds", openapi.Operation{
+		Summary:     "Advance the brackets to the next round",
+		Description: "Organizers and admins. Couples advancing from consecutive matches of the last round play each other.",
+		Responses: map[string]*openapi.Response{
+			"201": doc.Response("Round added", domain.Round{}),
+			"400": doc.ErrorResponse("Invalid ID"),
+			"404": doc.StatusResponse("Tournament not found"),
+			"409": doc.ErrorResponse("Last round not finished, brackets decided or tournament not in progress"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+}
+
+// DescribeExportRoutes documents the routes of ExportHandler registered under basePath.
+func DescribeExportRoutes(doc *openapi.Document, basePath string) {
+	text := &openapi.MediaType{Schema: &openapi.Schema{Type: "string"}}
+	doc.Add(http.MethodGet, basePath+"/tournaments", doc.Authenticated(openapi.Operation{
+		Summary: "Export tournaments with their match results",
+		Description: "Admins and organizers. One row per match streamed as CSV (default), JSON Lines or XLSX, the first one being the header. " +
+			"A category selects its tournaments and only its matches.",
+		Tags: []string{"exports"},
+		Parameters: []openapi.Parameter{
+			{Name: "format", In: "query", Schema: &openapi.Schema{Type: "string",
+				Enum: []string{string(spreadsheet.FormatCSV), string(spreadsheet.FormatJSONL), string(spreadsheet.FormatXLSX)}}},
+			{Name: "from", In: "query", Description: "Tournaments held since this date (2006-01-02) or RFC 3339 time", Schema: &openapi.Schema{Type: "string"}},
+			{Name: "to", In: "query", Description: "Tournaments held before this RFC 3339 time or until this date (included)", Schema: &openapi.Schema{Type: "string"}},
+			{Name: "tournamentId", In: "query", Schema: &openapi.Schema{Type: "string"}},
+			{Name: "categoryId", In: "query", Schema: &openapi.Schema{Type: "string"}},
+		},
+		Responses: map[string]*openapi.Response{
+			"200": {Description: "Export file (attachment), truncated when it fails midway", Content: map[string]*openapi.MediaType{
+				spreadsheet.CSVContentType:   text,
+				spreadsheet.JSONLContentType: text,
+				spreadsheet.XLSXContentType:  {Schema: &openapi.Schema{Type: "string", Format: "binary"}},
+			}},
+			"400": doc.ErrorResponse("Invalid format or filter"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	}, openapi.Bearer))
+}
+
+// DescribeTournamentRoutes documents the routes of TournamentHandler registered under basePath.
+func DescribeTournamentRoutes(doc *openapi.Document, basePath string) {
+	add := func(method, path string, operation openapi.Operation) {
+		operation.Tags = []string{tournamentsTag}
+		doc.Add(method, basePath+path, doc.Authenticated(operation, openapi.Bearer))
+	}
+
+	add(http.MethodPost, "", openapi.Operation{
+		Summary:     "Create a tournament",
+		Description: "Organizers and admins. The tournament starts as a draft without couples nor rounds.",
+		RequestBody: doc.Body(createTournamentRequest{}),
+		Responses: map[string]*openapi.Response{
+			"201": doc.Response("Tournament created", domain.Tournament{}),
+			"400": doc.ErrorResponse("Invalid title or timestamp"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+	add(http.MethodGet, "/:id", openapi.Operation{
+		Summary:     "Find a tournament by ID",
+		Description: "Authenticated, couples and categories hold personal data of players.",
+		Responses: map[string]*openapi.Response{
+			"200": doc.Response("Tournament with its couples, rounds and categories", domain.Tournament{}),
+			"400": doc.ErrorResponse("Invalid ID"),
+			"404": doc.StatusResponse("Tournament not found"),
+			"500": doc.ErrorResponse("Internal error"),
+		},
+	})
+}
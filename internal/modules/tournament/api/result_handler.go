@@ -0,0 +1,94 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// ResultHandler lets referees record walkovers, retirements and disqualifications, and organizers configure how
+// they count and advance the brackets. Standings are public.
+type ResultHandler struct {
+	resultUseCase application.ResultUseCase
+}
+
+func NewResultHandler(resultUseCase application.ResultUseCase) *ResultHandler {
+	return &ResultHandler{resultUseCase: resultUseCase}
+}
+
+type recordResultRequest struct {
+	Outcome domain.Outcome `json:"outcome" binding:"required"`
+	// Winner is 1 or 2, derived from the score of completed matches.
+	Winner int    `json:"winner"`
+	Reason string `json:"reason"`
+	// Score is partial for retired and disqualified matches, walkovers and cancelled matches have none.
+	Score *domain.Score `json:"score"`
+}
+
+func (h *ResultHandler) RecordMatchResult(c *gin.Context) {
+	var request recordResultRequest
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	result := domain.Result{Outcome: request.Outcome, Winner: request.Winner, Reason: request.Reason}
+	match, status, err := h.resultUseCase.RecordMatchResultUseCase(c.Request.Context(), c.Param("id"), c.Param("matchId"), result, request.Score)
+	if status == application.ResultRecorded {
+		web.Respond(c, http.StatusOK, match)
+		return
+	}
+	respondResultError(c, status, err)
+}
+
+func (h *ResultHandler) ConfigureResultRules(c *gin.Context) {
+	var request domain.ResultRules
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	rules, status, err := h.resultUseCase.ConfigureResultRulesUseCase(c.Request.Context(), c.Param("id"), request)
+	if status == application.ResultConfigured {
+		web.Respond(c, http.StatusOK, rules)
+		return
+	}
+	respondResultError(c, status, err)
+}
+
+// FindStandings ranks the couples of the "categoryId" query parameter, or of the whole tournament.
+func (h *ResultHandler) FindStandings(c *gin.Context) {
+	standings, status, err := h.resultUseCase.FindStandingsUseCase(c.Request.Context(), c.Param("id"), c.Query("categoryId"))
+	if status == application.ResultFound {
+		web.Respond(c, http.StatusOK, standings)
+		return
+	}
+	respondResultError(c, status, err)
+}
+
+func (h *ResultHandler) AdvanceRound(c *gin.Context) {
+	round, status, err := h.resultUseCase.AdvanceRoundUseCase(c.Request.Context(), c.Param("id"))
+	if status == application.ResultAdvanced {
+		web.Respond(c, http.StatusCreated, round)
+		return
+	}
+	respondResultError(c, status, err)
+}
+
+func respondResultError(c *gin.Context, status application.ResultStatus, err error) {
+	switch status {
+	case application.ResultInvalid:
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+	case application.ResultNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	case application.ResultConflict:
+		web.Respond(c, http.StatusConflict, web.ErrorBody(c, err))
+	default:
+		if err == nil {
+			err = fmt.Errorf("invalid status %d", status)
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+	}
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockResultUseCase struct {
+	mock.Mock
+}
+
+func (m *mockResultUseCase) RecordMatchResultUseCase(_ context.Context, tournamentId, matchId string, result domain.Result, score *domain.Score) (domain.Match, application.ResultStatus, error) {
+	args := m.Called(tournamentId, matchId, result, score)
+	return args.Get(0).(domain.Match), args.Get(1).(application.ResultStatus), args.Error(2)
+}
+
+func (m *mockResultUseCase) ConfigureResultRulesUseCase(_ context.Context, tournamentId string, rules domain.ResultRules) (domain.ResultRules, application.ResultStatus, error) {
+	args := m.Called(tournamentId, rules)
+	return args.Get(0).(domain.ResultRules), args.Get(1).(application.ResultStatus), args.Error(2)
+}
+
+func (m *mockResultUseCase) FindStandingsUseCase(_ context.Context, tournamentId, categoryId string) ([]domain.Standing, application.ResultStatus, error) {
+	args := m.Called(tournamentId, categoryId)
+	return args.Get(0).([]domain.Standing), args.Get(1).(application.ResultStatus), args.Error(2)
+}
+
+func (m *mockResultUseCase) AdvanceRoundUseCase(_ context.Context, tournamentId string) (domain.Round, application.ResultStatus, error) {
+	args := m.Called(tournamentId)
+	return args.Get(0).(domain.Round), args.Get(1).(application.ResultStatus), args.Error(2)
+}
+
+func newResultRouter(useCase *mockResultUseCase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewResultHandler(useCase)
+	router := gin.New()
+	router.PUT("/tournaments/:id/matches/:matchId/result", handler.RecordMatchResult)
+	router.PUT("/tournaments/:id/result-rules", handler.ConfigureResultRules)
+	router.GET("/tournaments/:id/standings", handler.FindStandings)
+	router.POST("/tournaments/:id/rounds", handler.AdvanceRound)
+	return router
+}
+
+func TestResultHandler_RecordMatchResult(t *testing.T) {
+	const path = "/tournaments/tournament-1/matches/match-1/result"
+	retired := domain.Result{Outcome: domain.OutcomeRetired, Winner: domain.Couple2, Reason: "injury"}
+	partial := &domain.Score{Set1: domain.GameSet{GamesCouple1: 6, GamesCouple2: 3}, Set2: domain.GameSet{GamesCouple1: 1, GamesCouple2: 2}}
+	useCase := &mockResultUseCase{}
+	useCase.On("RecordMatchResultUseCase", "tournament-1", "match-1", retired, partial).
+		Return(domain.Match{ID: "match-1", Result: &retired, Score: partial}, application.ResultRecorded, nil)
+	useCase.On("RecordMatchResultUseCase", "tournament-1", "match-1", domain.Result{Outcome: domain.OutcomeWalkover}, (*domain.Score)(nil)).
+		Return(domain.Match{}, application.ResultInvalid, domain.ErrInvalidResult)
+	router := newResultRouter(useCase)
+
+	w := serve(router, http.MethodPut, path, `{"outcome":"retired","winner":2,"reason":"injury","score":{"set1":{"gamesCouple1":6,"gamesCouple2":3},"set2":{"gamesCouple1":1,"gamesCouple2":2}}}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"result":{"outcome":"retired","winner":2,"reason":"injury"}`)
+
+	w = serve(router, http.MethodPut, path, `{"outcome":"walkover"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = serve(router, http.MethodPut, path, `{"winner":1}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "Expected the outcome to be required")
+}
+
+func TestResultHandler_StandingsAndRounds(t *testing.T) {
+	useCase := &mockResultUseCase{}
+	useCase.On("FindStandingsUseCase", "tournament-1", "men-40").
+		Return([]domain.Standing{{Position: 1, CoupleID: "couple-1", Played: 1, Won: 1, Points: 3}}, application.ResultFound, nil)
+	useCase.On("FindStandingsUseCase", "tournament-2", "").Return([]domain.Standing(nil), application.ResultNotFound, nil)
+	useCase.On("AdvanceRoundUseCase", "tournament-1").Return(domain.Round{}, application.ResultConflict, domain.ErrRoundNotFinished)
+	useCase.On("ConfigureResultRulesUseCase", "tournament-1", domain.DefaultResultRules()).Return(domain.DefaultResultRules(), application.ResultConfigured, nil)
+	router := newResultRouter(useCase)
+
+	w := serve(router, http.MethodGet, "/tournaments/tournament-1/standings?categoryId=men-40", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"coupleId":"couple-1"`)
+
+	w = serve(router, http.MethodGet, "/tournaments/tournament-2/standings", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = serve(router, http.MethodPost, "/tournaments/tournament-1/rounds", "")
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = serve(router, http.MethodPut, "/tournaments/tournament-1/result-rules", `{"winPoints":3,"lossPoints":1,"retiredLossPoints":1,
+		"countPartialScores":true,"walkoverSets":true,"disqualifiedLast":true,"cancelledAdvancement":"none"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
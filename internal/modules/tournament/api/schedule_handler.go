@@ -0,0 +1,100 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// ScheduleHandler lets organizers allocate matches to courts and referees report overruns, schedules are public.
+type ScheduleHandler struct {
+	scheduleUseCase application.ScheduleUseCase
+}
+
+func NewScheduleHandler(scheduleUseCase application.ScheduleUseCase) *ScheduleHandler {
+	return &ScheduleHandler{scheduleUseCase: scheduleUseCase}
+}
+
+type assignMatchRequest struct {
+	CourtID string    `json:"courtId" binding:"required"`
+	Start   time.Time `json:"start" binding:"required"`
+}
+
+type reportMatchEndRequest struct {
+	// EndsAt is the actual end of the match, or its expected end while it overruns.
+	EndsAt time.Time `json:"endsAt" binding:"required"`
+}
+
+func (h *ScheduleHandler) ConfigureScheduling(c *gin.Context) {
+	var request domain.Scheduling
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	scheduling, status, err := h.scheduleUseCase.ConfigureSchedulingUseCase(c.Request.Context(), c.Param("id"), request)
+	if status == application.ScheduleConfigured {
+		web.Respond(c, http.StatusOK, scheduling)
+		return
+	}
+	respondScheduleError(c, status, err)
+}
+
+func (h *ScheduleHandler) ScheduleMatches(c *gin.Context) {
+	schedule, status, err := h.scheduleUseCase.ScheduleMatchesUseCase(c.Request.Context(), c.Param("id"))
+	respondSchedule(c, schedule, status, err)
+}
+
+func (h *ScheduleHandler) FindSchedule(c *gin.Context) {
+	schedule, status, err := h.scheduleUseCase.FindScheduleUseCase(c.Request.Context(), c.Param("id"))
+	respondSchedule(c, schedule, status, err)
+}
+
+func (h *ScheduleHandler) AssignMatch(c *gin.Context) {
+	var request assignMatchRequest
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	schedule, status, err := h.scheduleUseCase.AssignMatchUseCase(c.Request.Context(), c.Param("id"), c.Param("matchId"), request.CourtID, request.Start)
+	respondSchedule(c, schedule, status, err)
+}
+
+func (h *ScheduleHandler) ReportMatchEnd(c *gin.Context) {
+	var request reportMatchEndRequest
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	schedule, status, err := h.scheduleUseCase.ReportMatchEndUseCase(c.Request.Context(), c.Param("id"), c.Param("matchId"), request.EndsAt)
+	respondSchedule(c, schedule, status, err)
+}
+
+func respondSchedule(c *gin.Context, schedule domain.Schedule, status application.ScheduleStatus, err error) {
+	switch status {
+	case application.ScheduleUpdated, application.ScheduleFound:
+		web.Respond(c, http.StatusOK, schedule)
+	default:
+		respondScheduleError(c, status, err)
+	}
+}
+
+func respondScheduleError(c *gin.Context, status application.ScheduleStatus, err error) {
+	switch status {
+	case application.ScheduleInvalid:
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+	case application.ScheduleNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	case application.ScheduleConflict:
+		web.Respond(c, http.StatusConflict, web.ErrorBody(c, err))
+	default:
+		if err == nil {
+			err = fmt.Errorf("invalid status %d", status)
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+	}
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockScheduleUseCase struct {
+	mock.Mock
+}
+
+func (m *mockScheduleUseCase) ConfigureSchedulingUseCase(_ context.Context, tournamentId string, scheduling domain.Scheduling) (domain.Scheduling, application.ScheduleStatus, error) {
+	args := m.Called(tournamentId, scheduling)
+	return args.Get(0).(domain.Scheduling), args.Get(1).(application.ScheduleStatus), args.Error(2)
+}
+
+func (m *mockScheduleUseCase) ScheduleMatchesUseCase(_ context.Context, tournamentId string) (domain.Schedule, application.ScheduleStatus, error) {
+	args := m.Called(tournamentId)
+	return args.Get(0).(domain.Schedule), args.Get(1).(application.ScheduleStatus), args.Error(2)
+}
+
+func (m *mockScheduleUseCase) AssignMatchUseCase(_ context.Context, tournamentId, matchId, courtId string, start time.Time) (domain.Schedule, application.ScheduleStatus, error) {
+	args := m.Called(tournamentId, matchId, courtId, start)
+	return args.Get(0).(domain.Schedule), args.Get(1).(application.ScheduleStatus), args.Error(2)
+}
+
+func (m *mockScheduleUseCase) ReportMatchEndUseCase(_ context.Context, tournamentId, matchId string, endsAt time.Time) (domain.Schedule, application.ScheduleStatus, error) {
+	args := m.Called(tournamentId, matchId, endsAt)
+	return args.Get(0).(domain.Schedule), args.Get(1).(application.ScheduleStatus), args.Error(2)
+}
+
+func (m *mockScheduleUseCase) FindScheduleUseCase(_ context.Context, tournamentId string) (domain.Schedule, application.ScheduleStatus, error) {
+	args := m.Called(tournamentId)
+	return args.Get(0).(domain.Schedule), args.Get(1).(application.ScheduleStatus), args.Error(2)
+}
+
+var scheduleStart = time.Date(2026, time.November, 1, 9, 0, 0, 0, time.UTC)
+
+func newScheduleRouter(useCase *mockScheduleUseCase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewScheduleHandler(useCase)
+	router := gin.New()
+	router.PUT("/tournaments/:id/scheduling", handler.ConfigureScheduling)
+	router.GET("/tournaments/:id/schedule", handler.FindSchedule)
+	router.POST("/tournaments/:id/schedule", handler.ScheduleMatches)
+	router.PUT("/tournaments/:id/matches/:matchId/schedule", handler.AssignMatch)
+	router.PUT("/tournaments/:id/matches/:matchId/end", handler.ReportMatchEnd)
+	return router
+}
+
+func testSchedule() domain.Schedule {
+	return domain.Schedule{TournamentID: "tournament-1", Conflicts: []domain.ScheduleConflict{}, Matches: []domain.ScheduledMatch{
+		{MatchID: "match-1", RoundNumber: 1, CourtID: "court-1", Start: scheduleStart, End: scheduleStart.Add(time.Hour)},
+	}}
+}
+
+func TestScheduleHandler_ConfigureScheduling(t *testing.T) {
+	scheduling := domain.Scheduling{MatchMinutes: 60, RestMinutes: 30, Venues: []domain.Venue{{ID: "venue-1", Name: "Central", Courts: []domain.Court{
+		{ID: "court-1", Name: "Court 1", Availability: []domain.TimeWindow{{Start: scheduleStart, End: scheduleStart.Add(5 * time.Hour)}}},
+	}}}}
+	useCase := &mockScheduleUseCase{}
+	useCase.On("ConfigureSchedulingUseCase", "tournament-1", scheduling).Return(scheduling, application.ScheduleConfigured, nil)
+	useCase.On("ConfigureSchedulingUseCase", "tournament-1", domain.Scheduling{}).Return(domain.Scheduling{}, application.ScheduleInvalid, errors.New("invalid matchMinutes: 0"))
+	router := newScheduleRouter(useCase)
+	body, _ := json.Marshal(scheduling)
+
+	w := serve(router, http.MethodPut, "/tournaments/tournament-1/scheduling", string(body))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var configured domain.Scheduling
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &configured))
+	assert.Equal(t, scheduling, configured)
+
+	w = serve(router, http.MethodPut, "/tournaments/tournament-1/scheduling", `{}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestScheduleHandler_ScheduleAndFind(t *testing.T) {
+	useCase := &mockScheduleUseCase{}
+	useCase.On("ScheduleMatchesUseCase", "tournament-1").Return(testSchedule(), application.ScheduleUpdated, nil)
+	useCase.On("ScheduleMatchesUseCase", "tournament-2").Return(domain.Schedule{}, application.ScheduleConflict, domain.ErrSchedulingNotConfigured)
+	useCase.On("FindScheduleUseCase", "tournament-1").Return(testSchedule(), application.ScheduleFound, nil)
+	useCase.On("FindScheduleUseCase", "tournament-3").Return(domain.Schedule{}, application.ScheduleNotFound, nil)
+	router := newScheduleRouter(useCase)
+
+	w := serve(router, http.MethodPost, "/tournaments/tournament-1/schedule", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	var schedule domain.Schedule
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &schedule))
+	assert.Equal(t, testSchedule(), schedule)
+
+	w = serve(router, http.MethodPost, "/tournaments/tournament-2/schedule", "")
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = serve(router, http.MethodGet, "/tournaments/tournament-1/schedule", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serve(router, http.MethodGet, "/tournaments/tournament-3/schedule", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"status":"ScheduleNotFound"}`, w.Body.String())
+}
+
+func TestScheduleHandler_AssignMatchAndReportEnd(t *testing.T) {
+	useCase := &mockScheduleUseCase{}
+	useCase.On("AssignMatchUseCase", "tournament-1", "match-1", "court-1", scheduleStart).Return(testSchedule(), application.ScheduleUpdated, nil)
+	useCase.On("ReportMatchEndUseCase", "tournament-1", "match-1", scheduleStart.Add(75*time.Minute)).Return(testSchedule(), application.ScheduleUpdated, nil)
+	router := newScheduleRouter(useCase)
+
+	w := serve(router, http.MethodPut, "/tournaments/tournament-1/matches/match-1/schedule", `{"courtId":"court-1","start":"2026-11-01T09:00:00Z"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serve(router, http.MethodPut, "/tournaments/tournament-1/matches/match-1/schedule", `{"start":"2026-11-01T09:00:00Z"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "Expected court to be required")
+
+	w = serve(router, http.MethodPut, "/tournaments/tournament-1/matches/match-1/end", `{"endsAt":"2026-11-01T10:15:00Z"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	useCase.AssertExpectations(t)
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+
+	padelplacev1 "github.com/paguerre3/goddd/api/proto/padelplace/v1"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/common/rpc"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TournamentGRPCServer exposes the tournament use cases through gRPC, tournaments are created by organizers
+// (and admins) while any authenticated caller can find them.
+type TournamentGRPCServer struct {
+	padelplacev1.UnimplementedTournamentServiceServer
+	createTournamentUseCase application.CreateTournamentUseCase
+	findTournamentUseCase   application.FindTournamentUseCase
+}
+
+func NewTournamentGRPCServer(createTournamentUseCase application.CreateTournamentUseCase,
+	findTournamentUseCase application.FindTournamentUseCase) *TournamentGRPCServer {
+	return &TournamentGRPCServer{
+		createTournamentUseCase: createTournamentUseCase,
+		findTournamentUseCase:   findTournamentUseCase,
+	}
+}
+
+func (s *TournamentGRPCServer) CreateTournament(ctx context.Context, req *padelplacev1.CreateTournamentRequest) (*padelplacev1.CreateTournamentResponse, error) {
+	if _, err := rpc.RequireRoles(ctx, auth.RoleAdmin, auth.RoleOrganizer); err != nil {
+		return nil, err
+	}
+	if req.GetTimestamp() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing timestamp")
+	}
+	tournament, createStatus, err := s.createTournamentUseCase.CreateTournamentUseCase(ctx, req.GetTitle(), req.GetTimestamp().AsTime())
+	if code := createTournamentCode(createStatus, err); code != codes.OK {
+		return nil, rpc.Error(code, createStatus, err)
+	}
+	return &padelplacev1.CreateTournamentResponse{Tournament: toTournamentMessage(tournament)}, nil
+}
+
+func (s *TournamentGRPCServer) FindTournament(ctx context.Context, req *padelplacev1.FindTournamentRequest) (*padelplacev1.FindTournamentResponse, error) {
+	if _, err := rpc.Claims(ctx); err != nil {
+		return nil, err
+	}
+	tournament, findStatus, err := s.findTournamentUseCase.FindTournamentByIDUseCase(ctx, req.GetId())
+	if code := findTournamentCode(findStatus, err); code != codes.OK {
+		return nil, rpc.Error(code, findStatus, err)
+	}
+	return &padelplacev1.FindTournamentResponse{Tournament: toTournamentMessage(tournament)}, nil
+}
+
+func createTournamentCode(status application.CreateTournamentStatus, err error) codes.Code {
+	switch {
+	case status == application.CreateTournamentInvalid:
+		return codes.InvalidArgument
+	case err != nil:
+		return codes.Internal
+	case status == application.CreateTournamentCreated:
+		return codes.OK
+	default:
+		return codes.Internal
+	}
+}
+
+func findTournamentCode(status application.FindTournamentStatus, err error) codes.Code {
+	switch {
+	case status == application.FindTournamentInvalid:
+		return codes.InvalidArgument
+	case err != nil:
+		return codes.Internal
+	case status == application.FindTournamentNotFound:
+		return codes.NotFound
+	case status == application.FindTournamentFound:
+		return codes.OK
+	default:
+		return codes.Internal
+	}
+}
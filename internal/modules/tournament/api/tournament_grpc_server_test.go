@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	padelplacev1 "github.com/paguerre3/goddd/api/proto/padelplace/v1"
+	"github.com/paguerre3/goddd/internal/modules/common/auth"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockCreateTournamentUseCase struct {
+	mock.Mock
+}
+
+func (m *mockCreateTournamentUseCase) CreateTournamentUseCase(_ context.Context, title string, timestamp time.Time) (domain.Tournament, application.CreateTournamentStatus, error) {
+	args := m.Called(title, timestamp)
+	return args.Get(0).(domain.Tournament), args.Get(1).(application.CreateTournamentStatus), args.Error(2)
+}
+
+type mockFindTournamentUseCase struct {
+	mock.Mock
+}
+
+func (m *mockFindTournamentUseCase) FindTournamentByIDUseCase(_ context.Context, tournamentId string) (domain.Tournament, application.FindTournamentStatus, error) {
+	args := m.Called(tournamentId)
+	return args.Get(0).(domain.Tournament), args.Get(1).(application.FindTournamentStatus), args.Error(2)
+}
+
+func withRoles(roles ...auth.Role) context.Context {
+	return auth.WithClaims(context.Background(), &auth.Claims{Email: "someone@example.com", Roles: roles})
+}
+
+func TestTournamentGRPCServer_CreateTournament(t *testing.T) {
+	createUseCase := &mockCreateTournamentUseCase{}
+	server := NewTournamentGRPCServer(createUseCase, &mockFindTournamentUseCase{})
+	timestamp := time.Date(2026, time.November, 1, 10, 0, 0, 0, time.UTC)
+	created := domain.Tournament{ID: "t-1", Title: "Premier Padel", Timestamp: timestamp}
+	createUseCase.On("CreateTournamentUseCase", "Premier Padel", timestamp).Return(created, application.CreateTournamentCreated, nil)
+	createUseCase.On("CreateTournamentUseCase", "P", timestamp).Return(domain.Tournament{}, application.CreateTournamentInvalid, errors.New("invalid title: P"))
+	createUseCase.On("CreateTournamentUseCase", "Error Padel", timestamp).Return(domain.Tournament{}, application.CreateTournamentPending, errors.New("repo error"))
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		title string
+		code  codes.Code
+	}{
+		{"created", withRoles(auth.RoleOrganizer), "Premier Padel", codes.OK},
+		{"invalid", withRoles(auth.RoleOrganizer), "P", codes.InvalidArgument},
+		{"internal error", withRoles(auth.RoleAdmin), "Error Padel", codes.Internal},
+		{"insufficient role", withRoles(auth.RolePlayer), "Premier Padel", codes.PermissionDenied},
+		{"unauthenticated", context.Background(), "Premier Padel", codes.Unauthenticated},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := server.CreateTournament(test.ctx, &padelplacev1.CreateTournamentRequest{Title: test.title, Timestamp: timestamppb.New(timestamp)})
+
+			assert.Equal(t, test.code, status.Code(err))
+			if test.code == codes.OK {
+				assert.Equal(t, "t-1", res.GetTournament().GetId())
+				assert.Equal(t, timestamp, res.GetTournament().GetTimestamp().AsTime())
+			}
+		})
+	}
+
+	t.Run("missing timestamp", func(t *testing.T) {
+		_, err := server.CreateTournament(withRoles(auth.RoleOrganizer), &padelplacev1.CreateTournamentRequest{Title: "Premier Padel"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestTournamentGRPCServer_FindTournament(t *testing.T) {
+	findUseCase := &mockFindTournamentUseCase{}
+	server := NewTournamentGRPCServer(&mockCreateTournamentUseCase{}, findUseCase)
+	ssn := "123456789"
+	couple1 := domain.PlayerCouple{ID: "c1", Player1: domain.Player{ID: "p1", SocialSecurityNumber: &ssn}, Player2: domain.Player{ID: "p2"}}
+	couple2 := domain.PlayerCouple{ID: "c2", Player1: domain.Player{ID: "p3"}, Player2: domain.Player{ID: "p4"}}
+	found := domain.Tournament{
+		ID:            "t-1",
+		Title:         "Premier Padel",
+		PlayerCouples: []domain.PlayerCouple{couple1, couple2},
+		Rounds: []domain.Round{{Number: 1, Matches: []domain.Match{{
+			ID: "m1", Couple1: couple1, Couple2: couple2,
+			Score: &domain.Score{
+				Set1: domain.GameSet{GamesCouple1: 6, GamesCouple2: 4},
+				Set2: domain.GameSet{GamesCouple1: 7, GamesCouple2: 6, Tiebreak: &domain.Tiebreak{PointsCouple1: 7, PointsCouple2: 5}},
+			},
+		}}}},
+	}
+	findUseCase.On("FindTournamentByIDUseCase", "t-1").Return(found, application.FindTournamentFound, nil)
+	findUseCase.On("FindTournamentByIDUseCase", "t-9").Return(domain.Tournament{}, application.FindTournamentNotFound, nil)
+
+	res, err := server.FindTournament(withRoles(auth.RolePlayer), &padelplacev1.FindTournamentRequest{Id: "t-1"})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetTournament().GetPlayerCouples(), 2)
+	// Tournaments only expose the public profile of players.
+	assert.Nil(t, res.GetTournament().GetPlayerCouples()[0].GetPlayer1().SocialSecurityNumber)
+	match := res.GetTournament().GetRounds()[0].GetMatches()[0]
+	assert.Equal(t, int32(5), match.GetScore().GetSet2().GetTiebreak().GetPointsCouple2())
+	assert.Nil(t, match.GetScore().GetSet3())
+
+	_, err = server.FindTournament(withRoles(auth.RolePlayer), &padelplacev1.FindTournamentRequest{Id: "t-9"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.FindTournament(context.Background(), &padelplacev1.FindTournamentRequest{Id: "t-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
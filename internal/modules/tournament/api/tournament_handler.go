@@ -0,0 +1,72 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/common/web"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+)
+
+// TournamentHandler creates and finds tournaments, as TournamentGRPCServer does.
+type TournamentHandler struct {
+	createTournamentUseCase application.CreateTournamentUseCase
+	findTournamentUseCase   application.FindTournamentUseCase
+}
+
+func NewTournamentHandler(createTournamentUseCase application.CreateTournamentUseCase,
+	findTournamentUseCase application.FindTournamentUseCase) *TournamentHandler {
+	return &TournamentHandler{
+		createTournamentUseCase: createTournamentUseCase,
+		findTournamentUseCase:   findTournamentUseCase,
+	}
+}
+
+type createTournamentRequest struct {
+	Title     string    `json:"title" binding:"required"`
+	Timestamp time.Time `json:"timestamp" binding:"required"`
+}
+
+func (h *TournamentHandler) CreateTournament(c *gin.Context) {
+	var request createTournamentRequest
+	if err := web.Bind(c, &request); err != nil {
+		web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+		return
+	}
+	tournament, status, err := h.createTournamentUseCase.CreateTournamentUseCase(c.Request.Context(), request.Title, request.Timestamp)
+	if err != nil {
+		if status == application.CreateTournamentInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	if status != application.CreateTournamentCreated {
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+		return
+	}
+	web.Respond(c, http.StatusCreated, tournament)
+}
+
+func (h *TournamentHandler) FindTournamentByID(c *gin.Context) {
+	tournament, status, err := h.findTournamentUseCase.FindTournamentByIDUseCase(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if status == application.FindTournamentInvalid {
+			web.Respond(c, http.StatusBadRequest, web.ErrorBody(c, err))
+			return
+		}
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, err))
+		return
+	}
+	switch status {
+	case application.FindTournamentNotFound:
+		web.Respond(c, http.StatusNotFound, gin.H{"status": status.String()})
+	case application.FindTournamentFound:
+		web.Respond(c, http.StatusOK, tournament)
+	default:
+		web.Respond(c, http.StatusInternalServerError, web.ErrorBody(c, fmt.Errorf("invalid status %d", status)))
+	}
+}
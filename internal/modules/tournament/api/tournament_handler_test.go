@@ -0,0 +1,64 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/paguerre3/goddd/internal/modules/tournament/application"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTournamentRouter(createUseCase *mockCreateTournamentUseCase, findUseCase *mockFindTournamentUseCase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewTournamentHandler(createUseCase, findUseCase)
+	router := gin.New()
+	router.POST("/tournaments", handler.CreateTournament)
+	router.GET("/tournaments/:id", handler.FindTournamentByID)
+	return router
+}
+
+func TestTournamentHandler_CreateTournament(t *testing.T) {
+	timestamp := time.Date(2026, time.November, 7, 9, 0, 0, 0, time.UTC)
+	createUseCase := &mockCreateTournamentUseCase{}
+	createUseCase.On("CreateTournamentUseCase", "Open", timestamp).
+		Return(domain.Tournament{ID: "tournament-1", Title: "Open", Timestamp: timestamp}, application.CreateTournamentCreated, nil)
+	createUseCase.On("CreateTournamentUseCase", "Old", time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)).
+		Return(domain.Tournament{}, application.CreateTournamentInvalid, errors.New("timestamp too old"))
+	router := newTournamentRouter(createUseCase, &mockFindTournamentUseCase{})
+
+	w := serve(router, http.MethodPost, "/tournaments", `{"title":"Open","timestamp":"2026-11-07T09:00:00Z"}`)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	var created domain.Tournament
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, domain.Tournament{ID: "tournament-1", Title: "Open", Timestamp: timestamp}, created)
+
+	w = serve(router, http.MethodPost, "/tournaments", `{"title":"Old","timestamp":"2020-01-01T00:00:00Z"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"error":"timestamp too old"}`, w.Body.String())
+
+	w = serve(router, http.MethodPost, "/tournaments", `{"title":"Open"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "Expected timestamp required")
+}
+
+func TestTournamentHandler_FindTournamentByID(t *testing.T) {
+	findUseCase := &mockFindTournamentUseCase{}
+	findUseCase.On("FindTournamentByIDUseCase", "tournament-1").Return(domain.Tournament{ID: "tournament-1", Title: "Open"}, application.FindTournamentFound, nil)
+	findUseCase.On("FindTournamentByIDUseCase", "tournament-2").Return(domain.Tournament{}, application.FindTournamentNotFound, nil)
+	findUseCase.On("FindTournamentByIDUseCase", "tournament-3").Return(domain.Tournament{}, application.FindTournamentPending, errors.New("repo error"))
+	router := newTournamentRouter(&mockCreateTournamentUseCase{}, findUseCase)
+
+	w := serve(router, http.MethodGet, "/tournaments/tournament-1", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"title":"Open"`)
+
+	w = serve(router, http.MethodGet, "/tournaments/tournament-2", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"status":"FindTournamentNotFound"}`, w.Body.String())
+
+	assert.Equal(t, http.StatusInternalServerError, serve(router, http.MethodGet, "/tournaments/tournament-3", "").Code)
+}
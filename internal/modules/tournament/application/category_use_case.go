@@ -0,0 +1,164 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// CategoryUseCase manages the categories of a tournament and the entries of couples, full categories have a
+// waitlist promoting couples when entered ones withdraw.
+type CategoryUseCase interface {
+	AddCategoryUseCase(ctx context.Context, tournamentId string, category domain.Category) (domain.Category, CategoryStatus, error)
+	FindCategoriesUseCase(ctx context.Context, tournamentId string) ([]domain.Category, CategoryStatus, error)
+	EnterCategoryUseCase(ctx context.Context, tournamentId, categoryId, coupleId string) (domain.CategoryEntry, CategoryStatus, error)
+	WithdrawFromCategoryUseCase(ctx context.Context, tournamentId, categoryId, coupleId string) (domain.CategoryEntry, CategoryStatus, error)
+}
+
+type CategoryStatus uint8
+
+const (
+	CategoryPending CategoryStatus = iota
+	CategoryInvalid
+	CategoryNotFound
+	CategoryConflict
+	CategoryNotEligible
+	CategoryAdded
+	CategoryFound
+	CategoryEntered
+	CategoryWaitlisted
+	CategoryWithdrawn
+)
+
+// Implement the Stringer interface.
+func (s CategoryStatus) String() string {
+	return [...]string{"CategoryPending", "CategoryInvalid", "CategoryNotFound", "CategoryConflict", "CategoryNotEligible",
+		"CategoryAdded", "CategoryFound", "CategoryEntered", "CategoryWaitlisted", "CategoryWithdrawn"}[s]
+}
+
+func NewCategoryUseCase(tournamentRepository domain.TournamentRepository, playerCoupleReader domain.PlayerCoupleReader,
+	playerReader domain.PlayerReader) CategoryUseCase {
+	return &categoryService{tournamentRepo: tournamentRepository, playerCoupleReader: playerCoupleReader, playerReader: playerReader}
+}
+
+func (s *categoryService) AddCategoryUseCase(ctx context.Context, tournamentId string, category domain.Category) (domain.Category, CategoryStatus, error) {
+	newCategory, err := domain.NewCategory(category.ID, category.Name, category.Eligibility, category.MaxEntries)
+	if err != nil {
+		return domain.Category{}, CategoryInvalid, err
+	}
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != CategoryFound {
+		return domain.Category{}, status, err
+	}
+	if err := tournament.AddCategory(*newCategory); err != nil {
+		return domain.Category{}, CategoryConflict, err
+	}
+	if err := s.tournamentRepo.Upsert(ctx, &tournament); err != nil {
+		return domain.Category{}, saveStatus(err, CategoryConflict, CategoryPending), err
+	}
+	return *newCategory, CategoryAdded, nil
+}
+
+func (s *categoryService) FindCategoriesUseCase(ctx context.Context, tournamentId string) ([]domain.Category, CategoryStatus, error) {
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != CategoryFound {
+		return nil, status, err
+	}
+	if tournament.Categories == nil {
+		return []domain.Category{}, CategoryFound, nil
+	}
+	return tournament.Categories, CategoryFound, nil
+}
+
+func (s *categoryService) EnterCategoryUseCase(ctx context.Context, tournamentId, categoryId, coupleId string) (domain.CategoryEntry, CategoryStatus, error) {
+	if err := domain.ValidateID(coupleId); err != nil {
+		return domain.CategoryEntry{}, CategoryInvalid, err
+	}
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != CategoryFound {
+		return domain.CategoryEntry{}, status, err
+	}
+	couple, found, err := s.findCouple(ctx, coupleId)
+	if err != nil {
+		return domain.CategoryEntry{}, CategoryPending, err
+	}
+	if !found {
+		return domain.CategoryEntry{}, CategoryNotFound, nil
+	}
+	entry, err := tournament.EnterCategory(categoryId, couple)
+	if err != nil {
+		return domain.CategoryEntry{}, categoryErrorStatus(err), err
+	}
+	if err := s.tournamentRepo.Upsert(ctx, &tournament); err != nil {
+		return domain.CategoryEntry{}, saveStatus(err, CategoryConflict, CategoryPending), err
+	}
+	if entry.Waitlisted {
+		return entry, CategoryWaitlisted, nil
+	}
+	return entry, CategoryEntered, nil
+}
+
+func (s *categoryService) WithdrawFromCategoryUseCase(ctx context.Context, tournamentId, categoryId, coupleId string) (domain.CategoryEntry, CategoryStatus, error) {
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != CategoryFound {
+		return domain.CategoryEntry{}, status, err
+	}
+	entry, err := tournament.WithdrawFromCategory(categoryId, coupleId)
+	if err != nil {
+		return domain.CategoryEntry{}, categoryErrorStatus(err), err
+	}
+	if err := s.tournamentRepo.Upsert(ctx, &tournament); err != nil {
+		return domain.CategoryEntry{}, saveStatus(err, CategoryConflict, CategoryPending), err
+	}
+	return entry, CategoryWithdrawn, nil
+}
+
+func (s *categoryService) findTournament(ctx context.Context, tournamentId string) (domain.Tournament, CategoryStatus, error) {
+	if err := domain.ValidateID(tournamentId); err != nil {
+		return domain.Tournament{}, CategoryInvalid, err
+	}
+	tournament, err := s.tournamentRepo.FindByID(ctx, tournamentId)
+	if err != nil {
+		return domain.Tournament{}, CategoryPending, err
+	}
+	if len(tournament.ID) == 0 {
+		return domain.Tournament{}, CategoryNotFound, nil
+	}
+	return tournament, CategoryFound, nil
+}
+
+// findCouple returns the couple with the current profile of its players (copies embedded in couples go stale).
+func (s *categoryService) findCouple(ctx context.Context, coupleId string) (domain.PlayerCouple, bool, error) {
+	couples, err := s.playerCoupleReader.FindByIDs(ctx, []string{coupleId})
+	if err != nil || len(couples) == 0 {
+		return domain.PlayerCouple{}, false, err
+	}
+	couple := couples[0]
+	players, err := s.playerReader.FindByIDs(ctx, []string{couple.Player1.ID, couple.Player2.ID})
+	if err != nil {
+		return domain.PlayerCouple{}, false, err
+	}
+	for _, player := range players {
+		switch player.ID {
+		case couple.Player1.ID:
+			couple.Player1 = player
+		case couple.Player2.ID:
+			couple.Player2 = player
+		}
+	}
+	return couple, true, nil
+}
+
+func categoryErrorStatus(err error) CategoryStatus {
+	switch {
+	case errors.Is(err, domain.ErrCategoryNotFound), errors.Is(err, domain.ErrNotEntered):
+		return CategoryNotFound
+	case errors.Is(err, domain.ErrNotEligible):
+		return CategoryNotEligible
+	case errors.Is(err, domain.ErrAlreadyEntered), errors.Is(err, domain.ErrCategoryExists), errors.Is(err, domain.ErrOperationNotAllowed):
+		return CategoryConflict
+	default:
+		return CategoryInvalid
+	}
+}
@@ -0,0 +1,223 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPlayerCoupleReader struct {
+	mock.Mock
+}
+
+func (m *mockPlayerCoupleReader) FindByIDs(_ context.Context, ids []string) ([]domain.PlayerCouple, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]domain.PlayerCouple), args.Error(1)
+}
+
+type mockPlayerReader struct {
+	mock.Mock
+}
+
+func (m *mockPlayerReader) FindByIDs(_ context.Context, ids []string) ([]domain.Player, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]domain.Player), args.Error(1)
+}
+
+func tournamentWithCategory(maxEntries int, entries ...domain.PlayerCouple) domain.Tournament {
+	minAge := 40
+	category, _ := domain.NewCategory("men-40", "Men +40", domain.Eligibility{MinAge: &minAge, Gender: domain.CategoryMen}, maxEntries)
+	category.Entries = append(category.Entries, entries...)
+	return domain.Tournament{ID: "tournament-1", Title: "Premier Padel", Timestamp: time.Date(2026, time.November, 7, 9, 0, 0, 0, time.UTC),
+		Status: domain.StatusRegistrationOpen, Categories: []domain.Category{*category}}
+}
+
+// coupleReaders read couple-1 with stale embedded players while their current profile is 45 on the day of the
+// tournament, of the given gender.
+func coupleReaders(gender domain.Gender) (*mockPlayerCoupleReader, *mockPlayerReader) {
+	playerCoupleReader := &mockPlayerCoupleReader{}
+	playerCoupleReader.On("FindByIDs", []string{"couple-1"}).Return([]domain.PlayerCouple{
+		{ID: "couple-1", Player1: domain.Player{ID: "player-1"}, Player2: domain.Player{ID: "player-2"}},
+	}, nil)
+	playerReader := &mockPlayerReader{}
+	playerReader.On("FindByIDs", []string{"player-1", "player-2"}).Return([]domain.Player{
+		{ID: "player-2", BirthDate: "1981-03-02", Gender: gender},
+		{ID: "player-1", BirthDate: "1981-03-02", Gender: domain.GenderMale},
+	}, nil)
+	return playerCoupleReader, playerReader
+}
+
+func TestAddCategoryUseCase(t *testing.T) {
+	t.Run("Added", func(t *testing.T) {
+		// Arrange
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{ID: "tournament-1"}, nil)
+		repo.On("Upsert", mock.MatchedBy(func(tournament *domain.Tournament) bool { return len(tournament.Categories) == 1 })).Return(nil)
+		useCase := NewCategoryUseCase(repo, &mockPlayerCoupleReader{}, &mockPlayerReader{})
+
+		// Act
+		category, status, err := useCase.AddCategoryUseCase(context.Background(), "tournament-1", domain.Category{ID: "open-1", Name: "Open", MaxEntries: 8})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, CategoryAdded, status)
+		assert.Equal(t, domain.CategoryOpen, category.Eligibility.Gender)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithCategory(8), nil)
+		useCase := NewCategoryUseCase(repo, &mockPlayerCoupleReader{}, &mockPlayerReader{})
+
+		_, status, err := useCase.AddCategoryUseCase(context.Background(), "tournament-1", domain.Category{ID: "men-40", Name: "Men", MaxEntries: 8})
+
+		assert.ErrorIs(t, err, domain.ErrCategoryExists)
+		assert.Equal(t, CategoryConflict, status)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		useCase := NewCategoryUseCase(&mockTournamentRepository{}, &mockPlayerCoupleReader{}, &mockPlayerReader{})
+
+		_, status, err := useCase.AddCategoryUseCase(context.Background(), "tournament-1", domain.Category{ID: "open-1", Name: "Open"})
+
+		assert.Error(t, err)
+		assert.Equal(t, CategoryInvalid, status)
+	})
+}
+
+func TestEnterCategoryUseCase(t *testing.T) {
+	t.Run("Entered", func(t *testing.T) {
+		// Arrange
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithCategory(8), nil)
+		repo.On("Upsert", mock.MatchedBy(func(tournament *domain.Tournament) bool {
+			entries := tournament.Categories[0].Entries
+			return len(entries) == 1 && *entries[0].Player1.AgeOn(tournament.Timestamp) == 45
+		})).Return(nil)
+		playerCoupleReader, playerReader := coupleReaders(domain.GenderMale)
+		useCase := NewCategoryUseCase(repo, playerCoupleReader, playerReader)
+
+		// Act
+		entry, status, err := useCase.EnterCategoryUseCase(context.Background(), "tournament-1", "men-40", "couple-1")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, CategoryEntered, status)
+		assert.Equal(t, domain.CategoryEntry{CategoryID: "men-40", CoupleID: "couple-1", Position: 1}, entry)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Waitlisted", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithCategory(2, domain.PlayerCouple{ID: "couple-2"}, domain.PlayerCouple{ID: "couple-3"}), nil)
+		repo.On("Upsert", mock.Anything).Return(nil)
+		playerCoupleReader, playerReader := coupleReaders(domain.GenderMale)
+		useCase := NewCategoryUseCase(repo, playerCoupleReader, playerReader)
+
+		entry, status, err := useCase.EnterCategoryUseCase(context.Background(), "tournament-1", "men-40", "couple-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, CategoryWaitlisted, status)
+		assert.True(t, entry.Waitlisted)
+		assert.Equal(t, 1, entry.Position)
+	})
+
+	t.Run("NotEligible", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithCategory(8), nil)
+		playerCoupleReader, playerReader := coupleReaders(domain.GenderFemale)
+		useCase := NewCategoryUseCase(repo, playerCoupleReader, playerReader)
+
+		_, status, err := useCase.EnterCategoryUseCase(context.Background(), "tournament-1", "men-40", "couple-1")
+
+		assert.ErrorIs(t, err, domain.ErrNotEligible)
+		assert.Equal(t, CategoryNotEligible, status)
+		repo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("Entered meanwhile", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithCategory(8), nil)
+		repo.On("Upsert", mock.Anything).Return(domain.ErrTournamentConflict)
+		playerCoupleReader, playerReader := coupleReaders(domain.GenderMale)
+		useCase := NewCategoryUseCase(repo, playerCoupleReader, playerReader)
+
+		_, status, err := useCase.EnterCategoryUseCase(context.Background(), "tournament-1", "men-40", "couple-1")
+
+		assert.ErrorIs(t, err, domain.ErrTournamentConflict)
+		assert.Equal(t, CategoryConflict, status)
+	})
+
+	t.Run("CoupleNotFound", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithCategory(8), nil)
+		playerCoupleReader := &mockPlayerCoupleReader{}
+		playerCoupleReader.On("FindByIDs", []string{"couple-9"}).Return([]domain.PlayerCouple{}, nil)
+		useCase := NewCategoryUseCase(repo, playerCoupleReader, &mockPlayerReader{})
+
+		_, status, err := useCase.EnterCategoryUseCase(context.Background(), "tournament-1", "men-40", "couple-9")
+
+		assert.NoError(t, err)
+		assert.Equal(t, CategoryNotFound, status)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithCategory(8), nil)
+		playerCoupleReader := &mockPlayerCoupleReader{}
+		playerCoupleReader.On("FindByIDs", []string{"couple-1"}).Return([]domain.PlayerCouple{}, errors.New("db error"))
+		useCase := NewCategoryUseCase(repo, playerCoupleReader, &mockPlayerReader{})
+
+		_, status, err := useCase.EnterCategoryUseCase(context.Background(), "tournament-1", "men-40", "couple-1")
+
+		assert.Error(t, err)
+		assert.Equal(t, CategoryPending, status)
+	})
+}
+
+func TestWithdrawFromCategoryUseCase(t *testing.T) {
+	// Arrange
+	tournament := tournamentWithCategory(2, domain.PlayerCouple{ID: "couple-1"}, domain.PlayerCouple{ID: "couple-3"})
+	tournament.Categories[0].Waitlist = []domain.PlayerCouple{{ID: "couple-2"}}
+	repo := &mockTournamentRepository{}
+	repo.On("FindByID", "tournament-1").Return(tournament, nil)
+	repo.On("Upsert", mock.Anything).Return(nil)
+	useCase := NewCategoryUseCase(repo, &mockPlayerCoupleReader{}, &mockPlayerReader{})
+
+	// Act
+	entry, status, err := useCase.WithdrawFromCategoryUseCase(context.Background(), "tournament-1", "men-40", "couple-1")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, CategoryWithdrawn, status)
+	assert.Equal(t, "couple-2", entry.Promoted.ID, "Expected the waitlisted couple to be promoted")
+
+	_, status, err = useCase.WithdrawFromCategoryUseCase(context.Background(), "tournament-1", "women-40", "couple-1")
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+	assert.Equal(t, CategoryNotFound, status)
+}
+
+func TestFindCategoriesUseCase(t *testing.T) {
+	// Arrange
+	repo := &mockTournamentRepository{}
+	repo.On("FindByID", "tournament-1").Return(tournamentWithCategory(8), nil)
+	repo.On("FindByID", "tournament-2").Return(domain.Tournament{ID: "tournament-2"}, nil)
+	useCase := NewCategoryUseCase(repo, &mockPlayerCoupleReader{}, &mockPlayerReader{})
+
+	// Act
+	categories, status, err := useCase.FindCategoriesUseCase(context.Background(), "tournament-1")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, CategoryFound, status)
+	assert.Len(t, categories, 1)
+
+	categories, _, _ = useCase.FindCategoriesUseCase(context.Background(), "tournament-2")
+	assert.Equal(t, []domain.Category{}, categories)
+}
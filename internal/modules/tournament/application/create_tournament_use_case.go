@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+type CreateTournamentUseCase interface {
+	CreateTournamentUseCase(ctx context.Context, title string, timestamp time.Time) (domain.Tournament, CreateTournamentStatus, error)
+}
+
+type CreateTournamentStatus uint8
+
+const (
+	CreateTournamentPending CreateTournamentStatus = iota
+	CreateTournamentInvalid
+	CreateTournamentCreated
+)
+
+// Implement the Stringer interface.
+func (s CreateTournamentStatus) String() string {
+	return [...]string{"CreateTournamentPending", "CreateTournamentInvalid", "CreateTournamentCreated"}[s]
+}
+
+func NewCreateTournamentUseCase(tournamentRepository domain.TournamentRepository) CreateTournamentUseCase {
+	return &tournamentService{tournamentRepo: tournamentRepository}
+}
+
+// CreateTournamentUseCase creates a tournament without couples nor rounds, i.e. they are registered later on.
+func (s *tournamentService) CreateTournamentUseCase(ctx context.Context, title string, timestamp time.Time) (domain.Tournament, CreateTournamentStatus, error) {
+	tournament, err := domain.NewTournament(title, timestamp, nil, nil)
+	if err != nil {
+		return domain.Tournament{}, CreateTournamentInvalid, err
+	}
+	if err = s.tournamentRepo.Upsert(ctx, tournament); err != nil {
+		return domain.Tournament{}, CreateTournamentPending, err
+	}
+	return *tournament, CreateTournamentCreated, nil
+}
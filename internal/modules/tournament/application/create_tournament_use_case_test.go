@@ -0,0 +1,86 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const mockId = "mock-id"
+
+type mockTournamentRepository struct {
+	mock.Mock
+}
+
+func (m *mockTournamentRepository) Upsert(_ context.Context, tournament *domain.Tournament) error {
+	args := m.Called(tournament)
+	if args.Error(0) == nil && tournament.ID == "" {
+		tournament.ID = mockId
+	}
+	return args.Error(0)
+}
+
+func (m *mockTournamentRepository) FindByID(_ context.Context, id string) (domain.Tournament, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Tournament), args.Error(1)
+}
+
+// ForEach streams the tournaments returned by the expectation.
+func (m *mockTournamentRepository) ForEach(_ context.Context, filter domain.TournamentFilter, fn func(domain.Tournament) error) error {
+	args := m.Called(filter)
+	for _, tournament := range args.Get(0).([]domain.Tournament) {
+		if err := fn(tournament); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *mockTournamentRepository) Delete(_ context.Context, id string) error {
+	return m.Called(id).Error(0)
+}
+
+func TestCreateTournamentUseCase(t *testing.T) {
+	timestamp := time.Now().Add(24 * time.Hour)
+
+	t.Run("Created", func(t *testing.T) {
+		// Arrange
+		repo := &mockTournamentRepository{}
+		repo.On("Upsert", mock.Anything).Return(nil)
+		useCase := NewCreateTournamentUseCase(repo)
+
+		// Act
+		tournament, status, err := useCase.CreateTournamentUseCase(context.Background(), "Premier Padel", timestamp)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, CreateTournamentCreated, status)
+		assert.Equal(t, domain.Tournament{ID: mockId, Title: "Premier Padel", Timestamp: timestamp, Status: domain.StatusDraft}, tournament)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		useCase := NewCreateTournamentUseCase(&mockTournamentRepository{})
+
+		_, status, err := useCase.CreateTournamentUseCase(context.Background(), "P", timestamp)
+
+		assert.Error(t, err)
+		assert.Equal(t, CreateTournamentInvalid, status)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		expectedErr := errors.New("upsert error")
+		repo.On("Upsert", mock.Anything).Return(expectedErr)
+		useCase := NewCreateTournamentUseCase(repo)
+
+		_, status, err := useCase.CreateTournamentUseCase(context.Background(), "Premier Padel", timestamp)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, CreateTournamentPending, status)
+	})
+}
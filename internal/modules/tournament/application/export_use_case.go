@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// ExportUseCase streams tournaments with their match results flattened, one row per match.
+type ExportUseCase interface {
+	ExportTournamentsUseCase(ctx context.Context, filter domain.TournamentFilter, rows spreadsheet.Writer) (ExportStatus, error)
+}
+
+type ExportStatus uint8
+
+const (
+	ExportPending ExportStatus = iota
+	ExportInvalid
+	ExportExported
+)
+
+// Implement the Stringer interface.
+func (s ExportStatus) String() string {
+	return [...]string{"ExportPending", "ExportInvalid", "ExportExported"}[s]
+}
+
+var matchExportHeader = []any{"tournamentId", "tournamentTitle", "tournamentTimestamp", "tournamentStatus",
+	"categoryId", "round", "matchId", "matchTimestamp", "courtId",
+	"couple1Id", "couple1Players", "couple2Id", "couple2Players",
+	"set1", "set2", "set3", "outcome", "winnerCoupleId", "reason"}
+
+func NewExportUseCase(tournamentRepository domain.TournamentRepository) ExportUseCase {
+	return &tournamentService{tournamentRepo: tournamentRepository}
+}
+
+// ExportTournamentsUseCase writes nothing when the filter is invalid, otherwise the header and one row per match of
+// the selected tournaments (and category). Rows already written are kept when it fails midway.
+func (s *tournamentService) ExportTournamentsUseCase(ctx context.Context, filter domain.TournamentFilter, rows spreadsheet.Writer) (ExportStatus, error) {
+	if err := filter.Validate(); err != nil {
+		return ExportInvalid, err
+	}
+	if err := rows.Write(matchExportHeader...); err != nil {
+		return ExportPending, err
+	}
+	err := s.tournamentRepo.ForEach(ctx, filter, func(tournament domain.Tournament) error {
+		for _, round := range tournament.Rounds {
+			for _, match := range round.Matches {
+				if len(filter.CategoryID) > 0 && match.CategoryID != filter.CategoryID {
+					continue
+				}
+				if err := rows.Write(matchExportRow(tournament, round.Number, match)...); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return ExportPending, err
+	}
+	return ExportExported, nil
+}
+
+func matchExportRow(tournament domain.Tournament, round int, match domain.Match) []any {
+	row := []any{tournament.ID, tournament.Title, tournament.Timestamp, string(tournament.CurrentStatus()),
+		match.CategoryID, round, match.ID, match.Timestamp, match.CourtID,
+		match.Couple1.ID, couplePlayers(match.Couple1), match.Couple2.ID, couplePlayers(match.Couple2)}
+	var sets [3]any
+	if match.Score != nil {
+		sets[0], sets[1] = formatSet(&match.Score.Set1), formatSet(&match.Score.Set2)
+		if match.Score.Set3 != nil {
+			sets[2] = formatSet(match.Score.Set3)
+		}
+	}
+	row = append(row, sets[:]...)
+	result, decided := match.Decided()
+	if !decided {
+		return append(row, nil, nil, nil)
+	}
+	var winner any
+	switch result.Winner {
+	case domain.Couple1:
+		winner = match.Couple1.ID
+	case domain.Couple2:
+		winner = match.Couple2.ID
+	}
+	return append(row, string(result.Outcome), winner, result.Reason)
+}
+
+// couplePlayers returns the names of the players, e.g. "John Doe / Jane Roe", empty for couples not drawn yet.
+func couplePlayers(couple domain.PlayerCouple) string {
+	player1 := strings.TrimSpace(couple.Player1.FirstName + " " + couple.Player1.LastName)
+	player2 := strings.TrimSpace(couple.Player2.FirstName + " " + couple.Player2.LastName)
+	if len(player1) == 0 && len(player2) == 0 {
+		return ""
+	}
+	return player1 + " / " + player2
+}
+
+// formatSet returns the games of the set, e.g. "6-4", followed by its tiebreak points, e.g. "7-6 (7-5)".
+func formatSet(set *domain.GameSet) string {
+	games := fmt.Sprintf("%d-%d", set.GamesCouple1, set.GamesCouple2)
+	if set.Tiebreak == nil {
+		return games
+	}
+	return fmt.Sprintf("%s (%d-%d)", games, set.Tiebreak.PointsCouple1, set.Tiebreak.PointsCouple2)
+}
@@ -0,0 +1,74 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/spreadsheet"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportTournamentsUseCase(t *testing.T) {
+	timestamp := time.Date(2026, time.October, 3, 10, 0, 0, 0, time.UTC)
+	john := domain.Player{FirstName: "John", LastName: "Doe"}
+	jane := domain.Player{FirstName: "Jane", LastName: "Roe"}
+	tournament := domain.Tournament{ID: "tournament-1", Title: "Premier Padel", Timestamp: timestamp, Status: domain.StatusInProgress,
+		Rounds: []domain.Round{{Number: 1, Matches: []domain.Match{
+			{ID: "R1-M01", CategoryID: "men-40", Timestamp: timestamp, CourtID: "court-1",
+				Couple1: domain.PlayerCouple{ID: "couple-1", Player1: john, Player2: jane},
+				Couple2: domain.PlayerCouple{ID: "couple-2", Player1: jane, Player2: john},
+				Score: &domain.Score{Set1: domain.GameSet{GamesCouple1: 6, GamesCouple2: 4},
+					Set2: domain.GameSet{GamesCouple1: 7, GamesCouple2: 6, Tiebreak: &domain.Tiebreak{PointsCouple1: 7, PointsCouple2: 5}}}},
+			{ID: "R1-M02", CategoryID: "men-40", Timestamp: timestamp,
+				Couple1: domain.PlayerCouple{ID: "couple-3"}, Couple2: domain.PlayerCouple{ID: "couple-4"},
+				Result: &domain.Result{Outcome: domain.OutcomeWalkover, Winner: domain.Couple2, Reason: "no show"}},
+			{ID: "R1-M03", CategoryID: "mixed", Timestamp: timestamp},
+		}}}}
+	filter := domain.TournamentFilter{TournamentID: "tournament-1", CategoryID: "men-40"}
+	repo := &mockTournamentRepository{}
+	repo.On("ForEach", filter).Return([]domain.Tournament{tournament}, nil)
+	var buffer bytes.Buffer
+	rows, _ := spreadsheet.NewWriter(spreadsheet.FormatCSV, &buffer)
+
+	status, err := NewExportUseCase(repo).ExportTournamentsUseCase(context.Background(), filter, rows)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ExportExported, status)
+	assert.NoError(t, rows.Close())
+	assert.Equal(t, "tournamentId,tournamentTitle,tournamentTimestamp,tournamentStatus,categoryId,round,matchId,matchTimestamp,courtId,"+
+		"couple1Id,couple1Players,couple2Id,couple2Players,set1,set2,set3,outcome,winnerCoupleId,reason\n"+
+		"tournament-1,Premier Padel,2026-10-03T10:00:00Z,InProgress,men-40,1,R1-M01,2026-10-03T10:00:00Z,court-1,"+
+		"couple-1,John Doe / Jane Roe,couple-2,Jane Roe / John Doe,6-4,7-6 (7-5),,completed,couple-1,\n"+
+		"tournament-1,Premier Padel,2026-10-03T10:00:00Z,InProgress,men-40,1,R1-M02,2026-10-03T10:00:00Z,,"+
+		"couple-3,,couple-4,,,,,walkover,couple-4,no show\n", buffer.String())
+}
+
+func TestExportTournamentsUseCase_Errors(t *testing.T) {
+	t.Run("Invalid", func(t *testing.T) {
+		var buffer bytes.Buffer
+		rows, _ := spreadsheet.NewWriter(spreadsheet.FormatCSV, &buffer)
+
+		status, err := NewExportUseCase(&mockTournamentRepository{}).ExportTournamentsUseCase(context.Background(),
+			domain.TournamentFilter{TournamentID: "t"}, rows)
+
+		assert.Error(t, err)
+		assert.Equal(t, ExportInvalid, status)
+		assert.NoError(t, rows.Close())
+		assert.Empty(t, buffer.String(), "Expected nothing to be written")
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("ForEach", domain.TournamentFilter{}).Return([]domain.Tournament{}, errors.New("db error"))
+		rows, _ := spreadsheet.NewWriter(spreadsheet.FormatJSONL, &bytes.Buffer{})
+
+		status, err := NewExportUseCase(repo).ExportTournamentsUseCase(context.Background(), domain.TournamentFilter{}, rows)
+
+		assert.Error(t, err)
+		assert.Equal(t, ExportPending, status)
+	})
+}
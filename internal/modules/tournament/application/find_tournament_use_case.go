@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+type FindTournamentUseCase interface {
+	FindTournamentByIDUseCase(ctx context.Context, tournamentId string) (domain.Tournament, FindTournamentStatus, error)
+}
+
+type FindTournamentStatus uint8
+
+const (
+	FindTournamentPending FindTournamentStatus = iota
+	FindTournamentInvalid
+	FindTournamentNotFound
+	FindTournamentFound
+)
+
+// Implement the Stringer interface.
+func (s FindTournamentStatus) String() string {
+	return [...]string{"FindTournamentPending", "FindTournamentInvalid", "FindTournamentNotFound", "FindTournamentFound"}[s]
+}
+
+func NewFindTournamentUseCase(tournamentRepository domain.TournamentRepository) FindTournamentUseCase {
+	return &tournamentService{tournamentRepo: tournamentRepository}
+}
+
+func (s *tournamentService) FindTournamentByIDUseCase(ctx context.Context, tournamentId string) (domain.Tournament, FindTournamentStatus, error) {
+	if err := domain.ValidateID(tournamentId); err != nil {
+		return domain.Tournament{}, FindTournamentInvalid, err
+	}
+	tournament, err := s.tournamentRepo.FindByID(ctx, tournamentId)
+	if err != nil {
+		return tournament, FindTournamentPending, err
+	}
+	if len(tournament.ID) == 0 {
+		return tournament, FindTournamentNotFound, nil
+	}
+	return tournament, FindTournamentFound, nil
+}
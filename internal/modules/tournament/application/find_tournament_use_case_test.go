@@ -0,0 +1,37 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindTournamentByIDUseCase(t *testing.T) {
+	repo := &mockTournamentRepository{}
+	useCase := NewFindTournamentUseCase(repo)
+	found := domain.Tournament{ID: "t-1", Title: "Premier Padel"}
+	repo.On("FindByID", found.ID).Return(found, nil)
+	repo.On("FindByID", "not-found-id").Return(domain.Tournament{}, nil)
+	repo.On("FindByID", "error-id").Return(domain.Tournament{}, errors.New("repo error"))
+
+	tournament, status, err := useCase.FindTournamentByIDUseCase(context.Background(), found.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, FindTournamentFound, status)
+	assert.Equal(t, found, tournament)
+
+	_, status, err = useCase.FindTournamentByIDUseCase(context.Background(), "not-found-id")
+	assert.NoError(t, err)
+	assert.Equal(t, FindTournamentNotFound, status)
+
+	_, status, err = useCase.FindTournamentByIDUseCase(context.Background(), "i")
+	assert.Error(t, err)
+	assert.Equal(t, FindTournamentInvalid, status)
+
+	_, status, err = useCase.FindTournamentByIDUseCase(context.Background(), "error-id")
+	assert.Error(t, err)
+	assert.Equal(t, FindTournamentPending, status)
+	assert.Equal(t, "FindTournamentPending", status.String())
+}
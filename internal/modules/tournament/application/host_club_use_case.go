@@ -0,0 +1,61 @@
+package application
+
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// HostClubUseCase assigns the club hosting a tournament, clubs being managed by the club module.
+type HostClubUseCase interface {
+	AssignHostClubUseCase(ctx context.Context, tournamentId, clubId string) (domain.Tournament, HostClubStatus, error)
+}
+
+type HostClubStatus uint8
+
+const (
+	HostClubPending HostClubStatus = iota
+	HostClubInvalid
+	HostClubNotFound
+	HostClubConflict
+	HostClubAssigned
+)
+
+// Implement the Stringer interface.
+func (s HostClubStatus) String() string {
+	return [...]string{"HostClubPending", "HostClubInvalid", "HostClubNotFound", "HostClubConflict", "HostClubAssigned"}[s]
+}
+
+func NewHostClubUseCase(tournamentRepository domain.TournamentRepository, clubReader domain.ClubReader) HostClubUseCase {
+	return &hostClubService{tournamentRepo: tournamentRepository, clubReader: clubReader}
+}
+
+// AssignHostClubUseCase copies the current name and city of the club into the tournament.
+func (s *hostClubService) AssignHostClubUseCase(ctx context.Context, tournamentId, clubId string) (domain.Tournament, HostClubStatus, error) {
+	for _, id := range []string{tournamentId, clubId} {
+		if err := domain.ValidateID(id); err != nil {
+			return domain.Tournament{}, HostClubInvalid, err
+		}
+	}
+	tournament, err := s.tournamentRepo.FindByID(ctx, tournamentId)
+	if err != nil {
+		return domain.Tournament{}, HostClubPending, err
+	}
+	if len(tournament.ID) == 0 {
+		return domain.Tournament{}, HostClubNotFound, nil
+	}
+	club, err := s.clubReader.FindByID(ctx, clubId)
+	if err != nil {
+		return domain.Tournament{}, HostClubPending, err
+	}
+	if len(club.ID) == 0 {
+		return domain.Tournament{}, HostClubNotFound, nil
+	}
+	if err := tournament.AssignHostClub(club); err != nil {
+		return domain.Tournament{}, HostClubConflict, err
+	}
+	if err := s.tournamentRepo.Upsert(ctx, &tournament); err != nil {
+		return domain.Tournament{}, saveStatus(err, HostClubConflict, HostClubPending), err
+	}
+	return tournament, HostClubAssigned, nil
+}
@@ -0,0 +1,81 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockClubReader struct {
+	mock.Mock
+}
+
+func (m *mockClubReader) FindByID(_ context.Context, id string) (domain.HostClub, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.HostClub), args.Error(1)
+}
+
+func TestAssignHostClubUseCase(t *testing.T) {
+	club := domain.HostClub{ID: "club-1", Name: "Club Norte", City: "Buenos Aires"}
+
+	t.Run("Assigned", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{ID: "tournament-1"}, nil)
+		repo.On("Upsert", mock.MatchedBy(func(tournament *domain.Tournament) bool {
+			return tournament.HostClub != nil && *tournament.HostClub == club
+		})).Return(nil)
+		reader := &mockClubReader{}
+		reader.On("FindByID", "club-1").Return(club, nil)
+
+		tournament, status, err := NewHostClubUseCase(repo, reader).AssignHostClubUseCase(context.Background(), "tournament-1", "club-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, HostClubAssigned, status)
+		assert.Equal(t, &club, tournament.HostClub)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Club not found", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{ID: "tournament-1"}, nil)
+		reader := &mockClubReader{}
+		reader.On("FindByID", "club-2").Return(domain.HostClub{}, nil)
+
+		_, status, err := NewHostClubUseCase(repo, reader).AssignHostClubUseCase(context.Background(), "tournament-1", "club-2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, HostClubNotFound, status)
+		repo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{ID: "tournament-1", Status: domain.StatusInProgress}, nil)
+		reader := &mockClubReader{}
+		reader.On("FindByID", "club-1").Return(club, nil)
+
+		_, status, err := NewHostClubUseCase(repo, reader).AssignHostClubUseCase(context.Background(), "tournament-1", "club-1")
+
+		assert.ErrorIs(t, err, domain.ErrOperationNotAllowed)
+		assert.Equal(t, HostClubConflict, status)
+	})
+
+	t.Run("Invalid and pending", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{ID: "tournament-1"}, nil)
+		reader := &mockClubReader{}
+		reader.On("FindByID", "club-1").Return(domain.HostClub{}, errors.New("read error"))
+		useCase := NewHostClubUseCase(repo, reader)
+
+		_, status, _ := useCase.AssignHostClubUseCase(context.Background(), "tournament-1", "c")
+		assert.Equal(t, HostClubInvalid, status)
+
+		_, status, err := useCase.AssignHostClubUseCase(context.Background(), "tournament-1", "club-1")
+		assert.EqualError(t, err, "read error")
+		assert.Equal(t, HostClubPending, status)
+	})
+}
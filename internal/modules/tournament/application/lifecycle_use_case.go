@@ -0,0 +1,69 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// tournamentEventsTopic is the topic of every tournament event, subscribers filter by TournamentID.
+const tournamentEventsTopic = "tournaments"
+
+// TransitionTournamentUseCase moves a tournament through its lifecycle, e.g. from Draft to RegistrationOpen.
+type TransitionTournamentUseCase interface {
+	TransitionTournamentUseCase(ctx context.Context, tournamentId string, transition domain.Transition) (domain.Tournament, TournamentLifecycleStatus, error)
+}
+
+type TournamentLifecycleStatus uint8
+
+const (
+	TournamentLifecyclePending TournamentLifecycleStatus = iota
+	TournamentLifecycleInvalid
+	TournamentLifecycleNotFound
+	TournamentLifecycleConflict
+	TournamentLifecycleTransitioned
+)
+
+// Implement the Stringer interface.
+func (s TournamentLifecycleStatus) String() string {
+	return [...]string{"TournamentLifecyclePending", "TournamentLifecycleInvalid", "TournamentLifecycleNotFound",
+		"TournamentLifecycleConflict", "TournamentLifecycleTransitioned"}[s]
+}
+
+func NewTransitionTournamentUseCase(tournamentRepository domain.TournamentRepository, eventBroker pubsub.Broker[domain.TournamentEvent]) TransitionTournamentUseCase {
+	return &lifecycleService{tournamentRepo: tournamentRepository, eventBroker: eventBroker, now: time.Now}
+}
+
+// SubscribeTournamentEvents returns the events of every tournament until ctx is done.
+func SubscribeTournamentEvents(ctx context.Context, eventBroker pubsub.Broker[domain.TournamentEvent]) <-chan domain.TournamentEvent {
+	return eventBroker.Subscribe(ctx, tournamentEventsTopic)
+}
+
+// TransitionTournamentUseCase applies the transition and publishes its event once the tournament is saved.
+func (s *lifecycleService) TransitionTournamentUseCase(ctx context.Context, tournamentId string, transition domain.Transition) (domain.Tournament, TournamentLifecycleStatus, error) {
+	if err := domain.ValidateID(tournamentId); err != nil {
+		return domain.Tournament{}, TournamentLifecycleInvalid, err
+	}
+	tournament, err := s.tournamentRepo.FindByID(ctx, tournamentId)
+	if err != nil {
+		return domain.Tournament{}, TournamentLifecyclePending, err
+	}
+	if len(tournament.ID) == 0 {
+		return domain.Tournament{}, TournamentLifecycleNotFound, nil
+	}
+	event, err := tournament.Apply(transition, s.now())
+	switch {
+	case errors.Is(err, domain.ErrUnknownTransition):
+		return domain.Tournament{}, TournamentLifecycleInvalid, err
+	case err != nil:
+		return domain.Tournament{}, TournamentLifecycleConflict, err
+	}
+	if err := s.tournamentRepo.Upsert(ctx, &tournament); err != nil {
+		return domain.Tournament{}, saveStatus(err, TournamentLifecycleConflict, TournamentLifecyclePending), err
+	}
+	s.eventBroker.Publish(tournamentEventsTopic, event)
+	return tournament, TournamentLifecycleTransitioned, nil
+}
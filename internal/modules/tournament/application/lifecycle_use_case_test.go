@@ -0,0 +1,117 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransitionTournamentUseCase(t *testing.T) {
+	draft := func() domain.Tournament {
+		return domain.Tournament{ID: "tournament-1", Title: "Premier Padel", Status: domain.StatusDraft}
+	}
+
+	t.Run("Transitioned", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(draft(), nil)
+		repo.On("Upsert", mock.MatchedBy(func(tournament *domain.Tournament) bool {
+			return tournament.Status == domain.StatusRegistrationOpen
+		})).Return(nil)
+		broker := pubsub.NewMemoryBroker[domain.TournamentEvent]()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := SubscribeTournamentEvents(ctx, broker)
+
+		tournament, status, err := NewTransitionTournamentUseCase(repo, broker).TransitionTournamentUseCase(context.Background(), "tournament-1", domain.TransitionOpenRegistration)
+
+		assert.NoError(t, err)
+		assert.Equal(t, TournamentLifecycleTransitioned, status)
+		assert.Equal(t, domain.StatusRegistrationOpen, tournament.Status)
+		repo.AssertExpectations(t)
+		select {
+		case event := <-events:
+			assert.Equal(t, "RegistrationOpened", event.Type)
+			assert.Equal(t, domain.StatusDraft, event.From)
+			assert.Equal(t, domain.StatusRegistrationOpen, event.To)
+		case <-time.After(time.Second):
+			t.Fatal("tournament event not published")
+		}
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(draft(), nil)
+
+		_, status, err := NewTransitionTournamentUseCase(repo, pubsub.NewMemoryBroker[domain.TournamentEvent]()).
+			TransitionTournamentUseCase(context.Background(), "tournament-1", domain.TransitionStart)
+
+		assert.ErrorIs(t, err, domain.ErrTransitionNotAllowed)
+		assert.Equal(t, TournamentLifecycleConflict, status)
+		repo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("Saved meanwhile", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		open := draft()
+		open.Status = domain.StatusRegistrationOpen
+		repo.On("FindByID", "tournament-1").Return(open, nil)
+		repo.On("Upsert", mock.Anything).Return(domain.ErrTournamentConflict)
+		broker := pubsub.NewMemoryBroker[domain.TournamentEvent]()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := SubscribeTournamentEvents(ctx, broker)
+
+		_, status, err := NewTransitionTournamentUseCase(repo, broker).
+			TransitionTournamentUseCase(context.Background(), "tournament-1", domain.TransitionCloseRegistration)
+
+		assert.ErrorIs(t, err, domain.ErrTournamentConflict)
+		assert.Equal(t, TournamentLifecycleConflict, status)
+		select {
+		case event := <-events:
+			t.Fatalf("unexpected event %v", event)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(draft(), nil)
+		useCase := NewTransitionTournamentUseCase(repo, pubsub.NewMemoryBroker[domain.TournamentEvent]())
+
+		_, status, err := useCase.TransitionTournamentUseCase(context.Background(), "tournament-1", "reopen")
+		assert.ErrorIs(t, err, domain.ErrUnknownTransition)
+		assert.Equal(t, TournamentLifecycleInvalid, status)
+
+		_, status, _ = useCase.TransitionTournamentUseCase(context.Background(), "t", domain.TransitionStart)
+		assert.Equal(t, TournamentLifecycleInvalid, status)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{}, nil)
+
+		_, status, err := NewTransitionTournamentUseCase(repo, pubsub.NewMemoryBroker[domain.TournamentEvent]()).
+			TransitionTournamentUseCase(context.Background(), "tournament-1", domain.TransitionStart)
+
+		assert.NoError(t, err)
+		assert.Equal(t, TournamentLifecycleNotFound, status)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(draft(), nil)
+		repo.On("Upsert", mock.Anything).Return(errors.New("db error"))
+
+		_, status, err := NewTransitionTournamentUseCase(repo, pubsub.NewMemoryBroker[domain.TournamentEvent]()).
+			TransitionTournamentUseCase(context.Background(), "tournament-1", domain.TransitionOpenRegistration)
+
+		assert.Error(t, err)
+		assert.Equal(t, TournamentLifecyclePending, status)
+	})
+}
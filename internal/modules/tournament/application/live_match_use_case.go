@@ -0,0 +1,250 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// LiveMatchUseCase scores matches point by point, the final score is recorded in the tournament once the
+// match is finished (and removed again when the match point is undone).
+type LiveMatchUseCase interface {
+	StartLiveMatchUseCase(ctx context.Context, tournamentId, matchId string, goldenPoint bool) (domain.LiveScore, LiveMatchStatus, error)
+	ScorePointUseCase(ctx context.Context, tournamentId, matchId string, couple int) (domain.LiveScore, LiveMatchStatus, error)
+	UndoPointUseCase(ctx context.Context, tournamentId, matchId string) (domain.LiveScore, LiveMatchStatus, error)
+	FindLiveScoreUseCase(ctx context.Context, tournamentId, matchId string) (domain.LiveScore, LiveMatchStatus, error)
+	// SubscribeLiveScoreUseCase returns the current score and its updates until the context is done.
+	SubscribeLiveScoreUseCase(ctx context.Context, tournamentId, matchId string) (domain.LiveScore, <-chan domain.LiveScore, LiveMatchStatus, error)
+}
+
+type LiveMatchStatus uint8
+
+const (
+	LiveMatchPending LiveMatchStatus = iota
+	LiveMatchInvalid
+	LiveMatchNotFound
+	LiveMatchConflict
+	LiveMatchStarted
+	LiveMatchScored
+	LiveMatchUndone
+	LiveMatchFound
+)
+
+// Implement the Stringer interface.
+func (s LiveMatchStatus) String() string {
+	return [...]string{"LiveMatchPending", "LiveMatchInvalid", "LiveMatchNotFound", "LiveMatchConflict", "LiveMatchStarted",
+		"LiveMatchScored", "LiveMatchUndone", "LiveMatchFound"}[s]
+}
+
+func NewLiveMatchUseCase(tournamentRepository domain.TournamentRepository, liveMatchRepository domain.LiveMatchRepository,
+	liveBroker pubsub.Broker[domain.LiveScore], scoreBroker pubsub.Broker[domain.ScoreUpdate]) LiveMatchUseCase {
+	return &liveMatchService{
+		tournamentRepo: tournamentRepository,
+		liveMatchRepo:  liveMatchRepository,
+		liveBroker:     liveBroker,
+		scoreBroker:    scoreBroker,
+		now:            time.Now,
+	}
+}
+
+// finalScoreAttempts bounds the saves of a final score while other saves of the tournament happen.
+const finalScoreAttempts = 3
+
+func liveScoreTopic(tournamentId, matchId string) string {
+	return tournamentId + "/" + matchId
+}
+
+func validateLiveMatchIDs(tournamentId, matchId string) error {
+	for _, id := range []string{tournamentId, matchId} {
+		if err := domain.ValidateID(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *liveMatchService) StartLiveMatchUseCase(ctx context.Context, tournamentId, matchId string, goldenPoint bool) (domain.LiveScore, LiveMatchStatus, error) {
+	liveMatch, err := domain.NewLiveMatch(tournamentId, matchId, goldenPoint, s.now())
+	if err != nil {
+		return domain.LiveScore{}, LiveMatchInvalid, err
+	}
+	tournament, err := s.tournamentRepo.FindByID(ctx, tournamentId)
+	if err != nil {
+		return domain.LiveScore{}, LiveMatchPending, err
+	}
+	if len(tournament.ID) == 0 {
+		return domain.LiveScore{}, LiveMatchNotFound, nil
+	}
+	if _, err := tournament.FindMatch(matchId); err != nil {
+		return domain.LiveScore{}, LiveMatchNotFound, nil
+	}
+	if err := tournament.Allows(domain.OperationScoreMatches); err != nil {
+		return domain.LiveScore{}, LiveMatchConflict, err
+	}
+	existing, err := s.liveMatchRepo.FindByMatchID(ctx, tournamentId, matchId)
+	if err != nil {
+		return domain.LiveScore{}, LiveMatchPending, err
+	}
+	if len(existing.ID) > 0 {
+		return existing.Score(), LiveMatchConflict, errors.New("live scoring already started")
+	}
+	if err := s.liveMatchRepo.Upsert(ctx, liveMatch); err != nil {
+		return domain.LiveScore{}, LiveMatchPending, err
+	}
+	score := liveMatch.Score()
+	s.liveBroker.Publish(liveScoreTopic(tournamentId, matchId), score)
+	return score, LiveMatchStarted, nil
+}
+
+func (s *liveMatchService) ScorePointUseCase(ctx context.Context, tournamentId, matchId string, couple int) (domain.LiveScore, LiveMatchStatus, error) {
+	tournament, liveMatch, status, err := s.findScorableLiveMatch(ctx, tournamentId, matchId)
+	if status != LiveMatchFound {
+		return domain.LiveScore{}, status, err
+	}
+	err = liveMatch.ScorePoint(couple, s.now())
+	if errors.Is(err, domain.ErrMatchFinished) {
+		return liveMatch.Score(), LiveMatchConflict, err
+	}
+	if err != nil {
+		return domain.LiveScore{}, LiveMatchInvalid, err
+	}
+	if status, err := s.savePoint(ctx, s.liveMatchRepo.AppendPoint, &liveMatch); err != nil {
+		return domain.LiveScore{}, status, err
+	}
+	score := liveMatch.Score()
+	if score.Finished {
+		finalScore, _ := score.FinalScore()
+		if err := s.recordFinalScore(ctx, &tournament, matchId, finalScore); err != nil {
+			return domain.LiveScore{}, saveStatus(err, LiveMatchConflict, LiveMatchPending), err
+		}
+	}
+	s.liveBroker.Publish(liveScoreTopic(tournamentId, matchId), score)
+	return score, LiveMatchScored, nil
+}
+
+func (s *liveMatchService) UndoPointUseCase(ctx context.Context, tournamentId, matchId string) (domain.LiveScore, LiveMatchStatus, error) {
+	tournament, liveMatch, status, err := s.findScorableLiveMatch(ctx, tournamentId, matchId)
+	if status != LiveMatchFound {
+		return domain.LiveScore{}, status, err
+	}
+	wasFinished := liveMatch.Score().Finished
+	if err := liveMatch.UndoPoint(s.now()); err != nil {
+		return liveMatch.Score(), LiveMatchConflict, err
+	}
+	if status, err := s.savePoint(ctx, s.liveMatchRepo.RemoveLastPoint, &liveMatch); err != nil {
+		return domain.LiveScore{}, status, err
+	}
+	if wasFinished {
+		// The match is reopened, i.e. it has no final score anymore.
+		if err := s.recordFinalScore(ctx, &tournament, matchId, nil); err != nil {
+			return domain.LiveScore{}, saveStatus(err, LiveMatchConflict, LiveMatchPending), err
+		}
+	}
+	score := liveMatch.Score()
+	s.liveBroker.Publish(liveScoreTopic(tournamentId, matchId), score)
+	return score, LiveMatchUndone, nil
+}
+
+func (s *liveMatchService) FindLiveScoreUseCase(ctx context.Context, tournamentId, matchId string) (domain.LiveScore, LiveMatchStatus, error) {
+	liveMatch, status, err := s.findLiveMatch(ctx, tournamentId, matchId)
+	if status != LiveMatchFound {
+		return domain.LiveScore{}, status, err
+	}
+	return liveMatch.Score(), LiveMatchFound, nil
+}
+
+func (s *liveMatchService) SubscribeLiveScoreUseCase(ctx context.Context, tournamentId, matchId string) (domain.LiveScore, <-chan domain.LiveScore, LiveMatchStatus, error) {
+	if err := validateLiveMatchIDs(tournamentId, matchId); err != nil {
+		return domain.LiveScore{}, nil, LiveMatchInvalid, err
+	}
+	// Subscribed before reading the current score so no point is missed in between, the subscription ends with
+	// the context even when the live match isn't found.
+	updates := s.liveBroker.Subscribe(ctx, liveScoreTopic(tournamentId, matchId))
+	liveMatch, status, err := s.findLiveMatch(ctx, tournamentId, matchId)
+	if status != LiveMatchFound {
+		return domain.LiveScore{}, nil, status, err
+	}
+	return liveMatch.Score(), updates, LiveMatchFound, nil
+}
+
+func (s *liveMatchService) findLiveMatch(ctx context.Context, tournamentId, matchId string) (domain.LiveMatch, LiveMatchStatus, error) {
+	if err := validateLiveMatchIDs(tournamentId, matchId); err != nil {
+		return domain.LiveMatch{}, LiveMatchInvalid, err
+	}
+	liveMatch, err := s.liveMatchRepo.FindByMatchID(ctx, tournamentId, matchId)
+	if err != nil {
+		return domain.LiveMatch{}, LiveMatchPending, err
+	}
+	if len(liveMatch.ID) == 0 {
+		return domain.LiveMatch{}, LiveMatchNotFound, nil
+	}
+	return liveMatch, LiveMatchFound, nil
+}
+
+// findScorableLiveMatch also finds the tournament of the live match, checking its matches can be scored before any
+// point is saved so the final score can be recorded (or cleared) in the tournament.
+func (s *liveMatchService) findScorableLiveMatch(ctx context.Context, tournamentId, matchId string) (domain.Tournament, domain.LiveMatch, LiveMatchStatus, error) {
+	if err := validateLiveMatchIDs(tournamentId, matchId); err != nil {
+		return domain.Tournament{}, domain.LiveMatch{}, LiveMatchInvalid, err
+	}
+	tournament, err := s.tournamentRepo.FindByID(ctx, tournamentId)
+	if err != nil {
+		return domain.Tournament{}, domain.LiveMatch{}, LiveMatchPending, err
+	}
+	if len(tournament.ID) == 0 {
+		return domain.Tournament{}, domain.LiveMatch{}, LiveMatchNotFound, nil
+	}
+	if err := tournament.Allows(domain.OperationScoreMatches); err != nil {
+		return domain.Tournament{}, domain.LiveMatch{}, LiveMatchConflict, err
+	}
+	liveMatch, status, err := s.findLiveMatch(ctx, tournamentId, matchId)
+	return tournament, liveMatch, status, err
+}
+
+func (s *liveMatchService) savePoint(ctx context.Context, save func(context.Context, *domain.LiveMatch) error, liveMatch *domain.LiveMatch) (LiveMatchStatus, error) {
+	err := save(ctx, liveMatch)
+	if errors.Is(err, domain.ErrLiveMatchConflict) {
+		return LiveMatchConflict, err
+	}
+	if err != nil {
+		return LiveMatchPending, err
+	}
+	return LiveMatchPending, nil
+}
+
+// recordFinalScore sets the final score of the match in the tournament, or clears it when finalScore is nil. The
+// point being saved already, the tournament is read again when saved meanwhile (e.g. with the final score of another
+// match) up to finalScoreAttempts times.
+func (s *liveMatchService) recordFinalScore(ctx context.Context, tournament *domain.Tournament, matchId string, finalScore *domain.Score) error {
+	for attempt := 1; ; attempt++ {
+		err := s.saveFinalScore(ctx, tournament, matchId, finalScore)
+		if !errors.Is(err, domain.ErrTournamentConflict) || attempt == finalScoreAttempts {
+			return err
+		}
+		if *tournament, err = s.tournamentRepo.FindByID(ctx, tournament.ID); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *liveMatchService) saveFinalScore(ctx context.Context, tournament *domain.Tournament, matchId string, finalScore *domain.Score) error {
+	var err error
+	if finalScore != nil {
+		_, err = tournament.RecordScore(matchId, *finalScore)
+	} else {
+		err = tournament.ClearScore(matchId)
+	}
+	if err != nil {
+		return err
+	}
+	if err := s.tournamentRepo.Upsert(ctx, tournament); err != nil {
+		return err
+	}
+	if finalScore != nil {
+		s.scoreBroker.Publish(tournament.ID, domain.ScoreUpdate{TournamentID: tournament.ID, MatchID: matchId, Score: *finalScore, UpdatedAt: s.now()})
+	}
+	return nil
+}
@@ -0,0 +1,419 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockLiveMatchRepository struct {
+	mock.Mock
+}
+
+func (m *mockLiveMatchRepository) Upsert(_ context.Context, liveMatch *domain.LiveMatch) error {
+	args := m.Called(liveMatch)
+	if args.Error(0) == nil && liveMatch.ID == "" {
+		liveMatch.ID = mockId
+	}
+	return args.Error(0)
+}
+
+func (m *mockLiveMatchRepository) FindByMatchID(_ context.Context, tournamentID, matchID string) (domain.LiveMatch, error) {
+	args := m.Called(tournamentID, matchID)
+	return args.Get(0).(domain.LiveMatch), args.Error(1)
+}
+
+func (m *mockLiveMatchRepository) AppendPoint(_ context.Context, liveMatch *domain.LiveMatch) error {
+	return m.Called(liveMatch.Points).Error(0)
+}
+
+func (m *mockLiveMatchRepository) RemoveLastPoint(_ context.Context, liveMatch *domain.LiveMatch) error {
+	return m.Called(liveMatch.Points).Error(0)
+}
+
+var liveNow = time.Date(2026, time.November, 1, 10, 0, 0, 0, time.UTC)
+
+func liveClock() time.Time {
+	return liveNow
+}
+
+// liveMatchAt returns a live match at match point for couple 1 (6-0, 5-0, 40-0).
+func liveMatchAt(matchPoint bool) domain.LiveMatch {
+	points := make([]int, 0, 4*11+3)
+	for i := 0; i < 4*11+3; i++ {
+		points = append(points, domain.Couple1)
+	}
+	if !matchPoint {
+		points = []int{domain.Couple1}
+	}
+	return domain.LiveMatch{ID: "live-1", TournamentID: "tournament-1", MatchID: "match-1", Points: points, StartedAt: liveNow}
+}
+
+func receive[T any](t *testing.T, messages <-chan T) T {
+	select {
+	case message := <-messages:
+		return message
+	case <-time.After(time.Second):
+		t.Fatal("message not published")
+	}
+	var zero T
+	return zero
+}
+
+func TestLiveMatchUseCase_Start(t *testing.T) {
+	t.Run("Started", func(t *testing.T) {
+		// Arrange
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(domain.LiveMatch{}, nil)
+		liveMatchRepo.On("Upsert", mock.Anything).Return(nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		// Act
+		score, status, err := useCase.StartLiveMatchUseCase(context.Background(), "tournament-1", "match-1", true)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, LiveMatchStarted, status)
+		assert.True(t, score.GoldenPoint)
+		assert.Equal(t, &domain.LiveGame{PointsCouple1: "0", PointsCouple2: "0"}, score.Game)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(false), nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.StartLiveMatchUseCase(context.Background(), "tournament-1", "match-1", true)
+
+		assert.Error(t, err)
+		assert.Equal(t, LiveMatchConflict, status)
+		liveMatchRepo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: &mockLiveMatchRepository{},
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.StartLiveMatchUseCase(context.Background(), "tournament-1", "match-9", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, LiveMatchNotFound, status)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		useCase := &liveMatchService{tournamentRepo: &mockTournamentRepository{}, liveMatchRepo: &mockLiveMatchRepository{},
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.StartLiveMatchUseCase(context.Background(), "t", "match-1", false)
+
+		assert.Error(t, err)
+		assert.Equal(t, LiveMatchInvalid, status)
+	})
+}
+
+func TestLiveMatchUseCase_ScorePoint(t *testing.T) {
+	t.Run("Scored", func(t *testing.T) {
+		// Arrange
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		liveBroker := pubsub.NewMemoryBroker[domain.LiveScore]()
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(false), nil)
+		liveMatchRepo.On("AppendPoint", []int{domain.Couple1, domain.Couple2}).Return(nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		updates := liveBroker.Subscribe(ctx, "tournament-1/match-1")
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: liveBroker, scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		// Act
+		score, status, err := useCase.ScorePointUseCase(context.Background(), "tournament-1", "match-1", domain.Couple2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, LiveMatchScored, status)
+		assert.Equal(t, &domain.LiveGame{PointsCouple1: "15", PointsCouple2: "15"}, score.Game)
+		assert.Equal(t, score, receive(t, updates))
+		tournamentRepo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("Match point records the final score", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		scoreBroker := pubsub.NewMemoryBroker[domain.ScoreUpdate]()
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(true), nil)
+		liveMatchRepo.On("AppendPoint", mock.Anything).Return(nil)
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		tournamentRepo.On("Upsert", mock.Anything).Return(nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		scoreUpdates := scoreBroker.Subscribe(ctx, "tournament-1")
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: scoreBroker, now: liveClock}
+
+		score, status, err := useCase.ScorePointUseCase(context.Background(), "tournament-1", "match-1", domain.Couple1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, LiveMatchScored, status)
+		assert.True(t, score.Finished)
+		final := domain.Score{Set1: domain.GameSet{GamesCouple1: 6}, Set2: domain.GameSet{GamesCouple1: 6}}
+		saved := tournamentRepo.Calls[1].Arguments.Get(0).(*domain.Tournament)
+		assert.Equal(t, &final, saved.Rounds[0].Matches[0].Score)
+		assert.Equal(t, final, receive(t, scoreUpdates).Score)
+	})
+
+	t.Run("Match point saved after another final score", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(true), nil)
+		liveMatchRepo.On("AppendPoint", mock.Anything).Return(nil)
+		saved := tournamentWithMatch()
+		saved.Version = 1
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil).Once()
+		tournamentRepo.On("FindByID", "tournament-1").Return(saved, nil).Once()
+		tournamentRepo.On("Upsert", mock.MatchedBy(func(tournament *domain.Tournament) bool { return tournament.Version == 0 })).
+			Return(domain.ErrTournamentConflict)
+		tournamentRepo.On("Upsert", mock.MatchedBy(func(tournament *domain.Tournament) bool { return tournament.Version == 1 })).
+			Return(nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.ScorePointUseCase(context.Background(), "tournament-1", "match-1", domain.Couple1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, LiveMatchScored, status)
+		tournamentRepo.AssertExpectations(t)
+		final := domain.Score{Set1: domain.GameSet{GamesCouple1: 6}, Set2: domain.GameSet{GamesCouple1: 6}}
+		retried := tournamentRepo.Calls[len(tournamentRepo.Calls)-1].Arguments.Get(0).(*domain.Tournament)
+		assert.Equal(t, 1, retried.Version, "Expected the tournament read again")
+		assert.Equal(t, &final, retried.Rounds[0].Matches[0].Score)
+	})
+
+	t.Run("Match point saved meanwhile", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(true), nil)
+		liveMatchRepo.On("AppendPoint", mock.Anything).Return(nil)
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		tournamentRepo.On("Upsert", mock.Anything).Return(domain.ErrTournamentConflict)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.ScorePointUseCase(context.Background(), "tournament-1", "match-1", domain.Couple1)
+
+		assert.ErrorIs(t, err, domain.ErrTournamentConflict)
+		assert.Equal(t, LiveMatchConflict, status)
+		tournamentRepo.AssertNumberOfCalls(t, "Upsert", finalScoreAttempts)
+	})
+
+	t.Run("Finished", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		finished := liveMatchAt(true)
+		finished.Points = append(finished.Points, domain.Couple1)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(finished, nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.ScorePointUseCase(context.Background(), "tournament-1", "match-1", domain.Couple2)
+
+		assert.ErrorIs(t, err, domain.ErrMatchFinished)
+		assert.Equal(t, LiveMatchConflict, status)
+	})
+
+	t.Run("Concurrent point", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(false), nil)
+		liveMatchRepo.On("AppendPoint", mock.Anything).Return(domain.ErrLiveMatchConflict)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.ScorePointUseCase(context.Background(), "tournament-1", "match-1", domain.Couple2)
+
+		assert.ErrorIs(t, err, domain.ErrLiveMatchConflict)
+		assert.Equal(t, LiveMatchConflict, status)
+	})
+
+	t.Run("Invalid couple", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(false), nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.ScorePointUseCase(context.Background(), "tournament-1", "match-1", 3)
+
+		assert.Error(t, err)
+		assert.Equal(t, LiveMatchInvalid, status)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(domain.LiveMatch{}, nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.ScorePointUseCase(context.Background(), "tournament-1", "match-1", domain.Couple1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, LiveMatchNotFound, status)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(domain.LiveMatch{}, errors.New("db error"))
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.ScorePointUseCase(context.Background(), "tournament-1", "match-1", domain.Couple1)
+
+		assert.Error(t, err)
+		assert.Equal(t, LiveMatchPending, status)
+	})
+
+	t.Run("Tournament not in progress", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		finished := tournamentWithMatch()
+		finished.Status = domain.StatusFinished
+		tournamentRepo.On("FindByID", "tournament-1").Return(finished, nil)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(true), nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.ScorePointUseCase(context.Background(), "tournament-1", "match-1", domain.Couple1)
+
+		assert.ErrorIs(t, err, domain.ErrOperationNotAllowed)
+		assert.Equal(t, LiveMatchConflict, status)
+		liveMatchRepo.AssertNotCalled(t, "AppendPoint", mock.Anything)
+	})
+}
+
+func TestLiveMatchUseCase_UndoPoint(t *testing.T) {
+	t.Run("Undone", func(t *testing.T) {
+		// Arrange
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(false), nil)
+		liveMatchRepo.On("RemoveLastPoint", []int{}).Return(nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		// Act
+		score, status, err := useCase.UndoPointUseCase(context.Background(), "tournament-1", "match-1")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, LiveMatchUndone, status)
+		assert.Equal(t, 0, score.PointsPlayed)
+	})
+
+	t.Run("Undoing the match point clears the final score", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		finished := liveMatchAt(true)
+		finished.Points = append(finished.Points, domain.Couple1)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(finished, nil)
+		liveMatchRepo.On("RemoveLastPoint", mock.Anything).Return(nil)
+		scored := tournamentWithMatch()
+		scored.Rounds[0].Matches[0].Score = &domain.Score{Set1: domain.GameSet{GamesCouple1: 6}, Set2: domain.GameSet{GamesCouple1: 6}}
+		tournamentRepo.On("FindByID", "tournament-1").Return(scored, nil)
+		tournamentRepo.On("Upsert", mock.Anything).Return(nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		score, status, err := useCase.UndoPointUseCase(context.Background(), "tournament-1", "match-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, LiveMatchUndone, status)
+		assert.False(t, score.Finished)
+		saved := tournamentRepo.Calls[1].Arguments.Get(0).(*domain.Tournament)
+		assert.Nil(t, saved.Rounds[0].Matches[0].Score)
+	})
+
+	t.Run("No point", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		tournamentRepo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		empty := liveMatchAt(false)
+		empty.Points = []int{}
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(empty, nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.UndoPointUseCase(context.Background(), "tournament-1", "match-1")
+
+		assert.ErrorIs(t, err, domain.ErrNoPointToUndo)
+		assert.Equal(t, LiveMatchConflict, status)
+	})
+
+	t.Run("Tournament not in progress", func(t *testing.T) {
+		tournamentRepo := &mockTournamentRepository{}
+		liveMatchRepo := &mockLiveMatchRepository{}
+		finished := tournamentWithMatch()
+		finished.Status = domain.StatusFinished
+		tournamentRepo.On("FindByID", "tournament-1").Return(finished, nil)
+		liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(true), nil)
+		useCase := &liveMatchService{tournamentRepo: tournamentRepo, liveMatchRepo: liveMatchRepo,
+			liveBroker: pubsub.NewMemoryBroker[domain.LiveScore](), scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+		_, status, err := useCase.UndoPointUseCase(context.Background(), "tournament-1", "match-1")
+
+		assert.ErrorIs(t, err, domain.ErrOperationNotAllowed)
+		assert.Equal(t, LiveMatchConflict, status)
+		liveMatchRepo.AssertNotCalled(t, "RemoveLastPoint", mock.Anything)
+	})
+}
+
+func TestLiveMatchUseCase_Subscribe(t *testing.T) {
+	liveMatchRepo := &mockLiveMatchRepository{}
+	liveBroker := pubsub.NewMemoryBroker[domain.LiveScore]()
+	liveMatchRepo.On("FindByMatchID", "tournament-1", "match-1").Return(liveMatchAt(false), nil)
+	liveMatchRepo.On("FindByMatchID", "tournament-1", "match-9").Return(domain.LiveMatch{}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	useCase := &liveMatchService{tournamentRepo: &mockTournamentRepository{}, liveMatchRepo: liveMatchRepo,
+		liveBroker: liveBroker, scoreBroker: pubsub.NewMemoryBroker[domain.ScoreUpdate](), now: liveClock}
+
+	current, updates, status, err := useCase.SubscribeLiveScoreUseCase(ctx, "tournament-1", "match-1")
+	assert.NoError(t, err)
+	assert.Equal(t, LiveMatchFound, status)
+	assert.Equal(t, 1, current.PointsPlayed)
+
+	liveBroker.Publish("tournament-1/match-1", domain.LiveScore{PointsPlayed: 2})
+	assert.Equal(t, 2, receive(t, updates).PointsPlayed)
+	cancel()
+	_, open := <-updates
+	assert.False(t, open)
+
+	_, updates, status, err = useCase.SubscribeLiveScoreUseCase(context.Background(), "tournament-1", "match-9")
+	assert.NoError(t, err)
+	assert.Equal(t, LiveMatchNotFound, status)
+	assert.Nil(t, updates)
+
+	found, status, _ := useCase.FindLiveScoreUseCase(context.Background(), "tournament-1", "match-1")
+	assert.Equal(t, LiveMatchFound, status)
+	assert.Equal(t, current, found)
+}
@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+type RecordMatchScoreUseCase interface {
+	RecordMatchScoreUseCase(ctx context.Context, tournamentId, matchId string, score domain.Score) (domain.Match, RecordMatchScoreStatus, error)
+}
+
+type RecordMatchScoreStatus uint8
+
+const (
+	RecordMatchScorePending RecordMatchScoreStatus = iota
+	RecordMatchScoreInvalid
+	RecordMatchScoreNotFound
+	RecordMatchScoreRecorded
+	// RecordMatchScoreConflict is returned while the tournament isn't in progress, or when it was saved meanwhile.
+	RecordMatchScoreConflict
+)
+
+// Implement the Stringer interface.
+func (s RecordMatchScoreStatus) String() string {
+	return [...]string{"RecordMatchScorePending", "RecordMatchScoreInvalid", "RecordMatchScoreNotFound", "RecordMatchScoreRecorded", "RecordMatchScoreConflict"}[s]
+}
+
+func NewRecordMatchScoreUseCase(tournamentRepository domain.TournamentRepository, scoreBroker pubsub.Broker[domain.ScoreUpdate]) RecordMatchScoreUseCase {
+	return &scoreService{tournamentRepo: tournamentRepository, scoreBroker: scoreBroker, now: time.Now}
+}
+
+// RecordMatchScoreUseCase sets (or corrects) the score of a match and publishes it to score subscribers.
+func (s *scoreService) RecordMatchScoreUseCase(ctx context.Context, tournamentId, matchId string, score domain.Score) (domain.Match, RecordMatchScoreStatus, error) {
+	for _, id := range []string{tournamentId, matchId} {
+		if err := domain.ValidateID(id); err != nil {
+			return domain.Match{}, RecordMatchScoreInvalid, err
+		}
+	}
+	if err := domain.ValidateScore(score); err != nil {
+		return domain.Match{}, RecordMatchScoreInvalid, err
+	}
+	tournament, err := s.tournamentRepo.FindByID(ctx, tournamentId)
+	if err != nil {
+		return domain.Match{}, RecordMatchScorePending, err
+	}
+	if len(tournament.ID) == 0 {
+		return domain.Match{}, RecordMatchScoreNotFound, nil
+	}
+	match, err := tournament.RecordScore(matchId, score)
+	if errors.Is(err, domain.ErrMatchNotFound) {
+		return domain.Match{}, RecordMatchScoreNotFound, nil
+	}
+	if errors.Is(err, domain.ErrOperationNotAllowed) {
+		return domain.Match{}, RecordMatchScoreConflict, err
+	}
+	if err != nil {
+		return domain.Match{}, RecordMatchScorePending, err
+	}
+	if err = s.tournamentRepo.Upsert(ctx, &tournament); err != nil {
+		return domain.Match{}, saveStatus(err, RecordMatchScoreConflict, RecordMatchScorePending), err
+	}
+	s.scoreBroker.Publish(tournamentId, domain.ScoreUpdate{TournamentID: tournamentId, MatchID: matchId, Score: score, UpdatedAt: s.now()})
+	return *match, RecordMatchScoreRecorded, nil
+}
@@ -0,0 +1,107 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func tournamentWithMatch() domain.Tournament {
+	return domain.Tournament{
+		ID:     "tournament-1",
+		Title:  "Premier Padel",
+		Status: domain.StatusInProgress,
+		Rounds: []domain.Round{{Number: 1, Matches: []domain.Match{{
+			ID:      "match-1",
+			Couple1: domain.PlayerCouple{ID: "couple-1"},
+			Couple2: domain.PlayerCouple{ID: "couple-2"},
+		}}}},
+	}
+}
+
+func TestRecordMatchScoreUseCase(t *testing.T) {
+	score := domain.Score{Set1: domain.GameSet{GamesCouple1: 6, GamesCouple2: 4}, Set2: domain.GameSet{GamesCouple1: 6, GamesCouple2: 2}}
+
+	t.Run("Recorded", func(t *testing.T) {
+		// Arrange
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		repo.On("Upsert", mock.Anything).Return(nil)
+		broker := pubsub.NewMemoryBroker[domain.ScoreUpdate]()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		updates := broker.Subscribe(ctx, "tournament-1")
+		useCase := NewRecordMatchScoreUseCase(repo, broker)
+
+		// Act
+		match, status, err := useCase.RecordMatchScoreUseCase(context.Background(), "tournament-1", "match-1", score)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, RecordMatchScoreRecorded, status)
+		assert.Equal(t, &score, match.Score)
+		saved := repo.Calls[1].Arguments.Get(0).(*domain.Tournament)
+		assert.Equal(t, &score, saved.Rounds[0].Matches[0].Score)
+		select {
+		case update := <-updates:
+			assert.Equal(t, "match-1", update.MatchID)
+			assert.Equal(t, score, update.Score)
+		case <-time.After(time.Second):
+			t.Fatal("score update not published")
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		useCase := NewRecordMatchScoreUseCase(&mockTournamentRepository{}, pubsub.NewMemoryBroker[domain.ScoreUpdate]())
+
+		_, status, err := useCase.RecordMatchScoreUseCase(context.Background(), "tournament-1", "match-1",
+			domain.Score{Set1: domain.GameSet{GamesCouple1: 25}})
+
+		assert.Error(t, err)
+		assert.Equal(t, RecordMatchScoreInvalid, status)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		useCase := NewRecordMatchScoreUseCase(repo, pubsub.NewMemoryBroker[domain.ScoreUpdate]())
+
+		_, status, err := useCase.RecordMatchScoreUseCase(context.Background(), "tournament-1", "match-9", score)
+
+		assert.NoError(t, err)
+		assert.Equal(t, RecordMatchScoreNotFound, status)
+		repo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		finished := tournamentWithMatch()
+		finished.Status = domain.StatusFinished
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(finished, nil)
+		useCase := NewRecordMatchScoreUseCase(repo, pubsub.NewMemoryBroker[domain.ScoreUpdate]())
+
+		_, status, err := useCase.RecordMatchScoreUseCase(context.Background(), "tournament-1", "match-1", score)
+
+		assert.ErrorIs(t, err, domain.ErrOperationNotAllowed)
+		assert.Equal(t, RecordMatchScoreConflict, status)
+		repo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		repo.On("Upsert", mock.Anything).Return(errors.New("db error"))
+		useCase := NewRecordMatchScoreUseCase(repo, pubsub.NewMemoryBroker[domain.ScoreUpdate]())
+
+		_, status, err := useCase.RecordMatchScoreUseCase(context.Background(), "tournament-1", "match-1", score)
+
+		assert.Error(t, err)
+		assert.Equal(t, RecordMatchScorePending, status)
+	})
+}
@@ -0,0 +1,136 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// ResultUseCase records how matches end (walkovers, retirements...) and derives standings and brackets from them.
+type ResultUseCase interface {
+	// RecordMatchResultUseCase sets the outcome of a match with its (partial) score and publishes it to score subscribers.
+	RecordMatchResultUseCase(ctx context.Context, tournamentId, matchId string, result domain.Result, score *domain.Score) (domain.Match, ResultStatus, error)
+	ConfigureResultRulesUseCase(ctx context.Context, tournamentId string, rules domain.ResultRules) (domain.ResultRules, ResultStatus, error)
+	// FindStandingsUseCase ranks the couples of the category, or of the whole tournament when categoryId is empty.
+	FindStandingsUseCase(ctx context.Context, tournamentId, categoryId string) ([]domain.Standing, ResultStatus, error)
+	// AdvanceRoundUseCase adds the next round of the brackets once the last one is decided.
+	AdvanceRoundUseCase(ctx context.Context, tournamentId string) (domain.Round, ResultStatus, error)
+}
+
+type ResultStatus uint8
+
+const (
+	ResultPending ResultStatus = iota
+	ResultInvalid
+	ResultNotFound
+	ResultConflict
+	ResultRecorded
+	ResultConfigured
+	ResultAdvanced
+	ResultFound
+)
+
+// Implement the Stringer interface.
+func (s ResultStatus) String() string {
+	return [...]string{"ResultPending", "ResultInvalid", "ResultNotFound", "ResultConflict", "ResultRecorded",
+		"ResultConfigured", "ResultAdvanced", "ResultFound"}[s]
+}
+
+func NewResultUseCase(tournamentRepository domain.TournamentRepository, scoreBroker pubsub.Broker[domain.ScoreUpdate]) ResultUseCase {
+	return &resultService{tournamentRepo: tournamentRepository, scoreBroker: scoreBroker, now: time.Now}
+}
+
+func (s *resultService) RecordMatchResultUseCase(ctx context.Context, tournamentId, matchId string, result domain.Result, score *domain.Score) (domain.Match, ResultStatus, error) {
+	if err := domain.ValidateID(matchId); err != nil {
+		return domain.Match{}, ResultInvalid, err
+	}
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != ResultFound {
+		return domain.Match{}, status, err
+	}
+	match, err := tournament.RecordResult(matchId, result, score)
+	if err != nil {
+		return domain.Match{}, resultErrorStatus(err), err
+	}
+	if err := s.tournamentRepo.Upsert(ctx, &tournament); err != nil {
+		return domain.Match{}, saveStatus(err, ResultConflict, ResultPending), err
+	}
+	update := domain.ScoreUpdate{TournamentID: tournamentId, MatchID: matchId, Result: match.Result, UpdatedAt: s.now()}
+	if score != nil {
+		update.Score = *score
+	}
+	s.scoreBroker.Publish(tournamentId, update)
+	return *match, ResultRecorded, nil
+}
+
+func (s *resultService) ConfigureResultRulesUseCase(ctx context.Context, tournamentId string, rules domain.ResultRules) (domain.ResultRules, ResultStatus, error) {
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != ResultFound {
+		return domain.ResultRules{}, status, err
+	}
+	if err := tournament.ConfigureResultRules(rules); err != nil {
+		return domain.ResultRules{}, resultErrorStatus(err), err
+	}
+	if err := s.tournamentRepo.Upsert(ctx, &tournament); err != nil {
+		return domain.ResultRules{}, saveStatus(err, ResultConflict, ResultPending), err
+	}
+	return rules, ResultConfigured, nil
+}
+
+func (s *resultService) FindStandingsUseCase(ctx context.Context, tournamentId, categoryId string) ([]domain.Standing, ResultStatus, error) {
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != ResultFound {
+		return nil, status, err
+	}
+	if categoryId != "" {
+		if _, err := tournament.FindCategory(categoryId); err != nil {
+			return nil, ResultNotFound, nil
+		}
+	}
+	return tournament.Standings(categoryId), ResultFound, nil
+}
+
+func (s *resultService) AdvanceRoundUseCase(ctx context.Context, tournamentId string) (domain.Round, ResultStatus, error) {
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != ResultFound {
+		return domain.Round{}, status, err
+	}
+	round, err := tournament.AdvanceRound()
+	if err != nil {
+		return domain.Round{}, resultErrorStatus(err), err
+	}
+	if err := s.tournamentRepo.Upsert(ctx, &tournament); err != nil {
+		return domain.Round{}, saveStatus(err, ResultConflict, ResultPending), err
+	}
+	return *round, ResultAdvanced, nil
+}
+
+func (s *resultService) findTournament(ctx context.Context, tournamentId string) (domain.Tournament, ResultStatus, error) {
+	if err := domain.ValidateID(tournamentId); err != nil {
+		return domain.Tournament{}, ResultInvalid, err
+	}
+	tournament, err := s.tournamentRepo.FindByID(ctx, tournamentId)
+	if err != nil {
+		return domain.Tournament{}, ResultPending, err
+	}
+	if len(tournament.ID) == 0 {
+		return domain.Tournament{}, ResultNotFound, nil
+	}
+	return tournament, ResultFound, nil
+}
+
+// resultErrorStatus maps domain errors, the other ones being validation errors (e.g. the score or rules).
+func resultErrorStatus(err error) ResultStatus {
+	switch {
+	case errors.Is(err, domain.ErrMatchNotFound):
+		return ResultNotFound
+	case errors.Is(err, domain.ErrOperationNotAllowed), errors.Is(err, domain.ErrRoundNotFinished),
+		errors.Is(err, domain.ErrBracketDecided), errors.Is(err, domain.ErrNothingToDraw):
+		return ResultConflict
+	default:
+		return ResultInvalid
+	}
+}
@@ -0,0 +1,179 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newResultUseCase(repo *mockTournamentRepository) ResultUseCase {
+	return NewResultUseCase(repo, pubsub.NewMemoryBroker[domain.ScoreUpdate]())
+}
+
+func TestRecordMatchResultUseCase(t *testing.T) {
+	walkover := domain.Result{Outcome: domain.OutcomeWalkover, Winner: domain.Couple1, Reason: "no show"}
+
+	t.Run("Recorded", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		repo.On("Upsert", mock.MatchedBy(func(tournament *domain.Tournament) bool {
+			return tournament.Rounds[0].Matches[0].Result != nil
+		})).Return(nil)
+		broker := pubsub.NewMemoryBroker[domain.ScoreUpdate]()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		updates := broker.Subscribe(ctx, "tournament-1")
+
+		match, status, err := NewResultUseCase(repo, broker).RecordMatchResultUseCase(context.Background(), "tournament-1", "match-1", walkover, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, ResultRecorded, status)
+		assert.Equal(t, &walkover, match.Result)
+		repo.AssertExpectations(t)
+		select {
+		case update := <-updates:
+			assert.Equal(t, &walkover, update.Result)
+		case <-time.After(time.Second):
+			t.Fatal("score update not published")
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+
+		_, status, err := newResultUseCase(repo).RecordMatchResultUseCase(context.Background(), "tournament-1", "match-1",
+			domain.Result{Outcome: domain.OutcomeCancelled, Winner: domain.Couple2}, nil)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidResult)
+		assert.Equal(t, ResultInvalid, status)
+		repo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+
+		_, status, _ := newResultUseCase(repo).RecordMatchResultUseCase(context.Background(), "tournament-1", "match-9", walkover, nil)
+
+		assert.Equal(t, ResultNotFound, status)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		finished := tournamentWithMatch()
+		finished.Status = domain.StatusFinished
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(finished, nil)
+
+		_, status, err := newResultUseCase(repo).RecordMatchResultUseCase(context.Background(), "tournament-1", "match-1", walkover, nil)
+
+		assert.ErrorIs(t, err, domain.ErrOperationNotAllowed)
+		assert.Equal(t, ResultConflict, status)
+	})
+}
+
+func TestConfigureResultRulesUseCase(t *testing.T) {
+	rules := domain.ResultRules{WinPoints: 2, LossPoints: 1, CancelledAdvancement: domain.AdvanceBetterSeed}
+
+	t.Run("Configured", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{ID: "tournament-1", Status: domain.StatusDraft}, nil)
+		repo.On("Upsert", mock.MatchedBy(func(tournament *domain.Tournament) bool {
+			return tournament.Rules() == rules
+		})).Return(nil)
+
+		configured, status, err := newResultUseCase(repo).ConfigureResultRulesUseCase(context.Background(), "tournament-1", rules)
+
+		assert.NoError(t, err)
+		assert.Equal(t, ResultConfigured, status)
+		assert.Equal(t, rules, configured)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{ID: "tournament-1", Status: domain.StatusDraft}, nil)
+
+		_, status, err := newResultUseCase(repo).ConfigureResultRulesUseCase(context.Background(), "tournament-1", domain.ResultRules{})
+
+		assert.Error(t, err)
+		assert.Equal(t, ResultInvalid, status)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+
+		_, status, err := newResultUseCase(repo).ConfigureResultRulesUseCase(context.Background(), "tournament-1", rules)
+
+		assert.ErrorIs(t, err, domain.ErrOperationNotAllowed)
+		assert.Equal(t, ResultConflict, status)
+	})
+}
+
+func TestFindStandingsUseCase(t *testing.T) {
+	scored := tournamentWithMatch()
+	_, _ = scored.RecordResult("match-1", domain.Result{Outcome: domain.OutcomeRetired, Winner: domain.Couple2}, nil)
+	repo := &mockTournamentRepository{}
+	repo.On("FindByID", "tournament-1").Return(scored, nil)
+	repo.On("FindByID", "tournament-2").Return(domain.Tournament{}, nil)
+	useCase := newResultUseCase(repo)
+
+	standings, status, err := useCase.FindStandingsUseCase(context.Background(), "tournament-1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, ResultFound, status)
+	assert.Equal(t, []string{"couple-2", "couple-1"}, []string{standings[0].CoupleID, standings[1].CoupleID})
+
+	_, status, _ = useCase.FindStandingsUseCase(context.Background(), "tournament-1", "men-40")
+	assert.Equal(t, ResultNotFound, status, "Expected unknown categories not to be found")
+
+	_, status, _ = useCase.FindStandingsUseCase(context.Background(), "tournament-2", "")
+	assert.Equal(t, ResultNotFound, status)
+}
+
+func TestAdvanceRoundUseCase(t *testing.T) {
+	t.Run("Conflict", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+
+		_, status, err := newResultUseCase(repo).AdvanceRoundUseCase(context.Background(), "tournament-1")
+
+		assert.ErrorIs(t, err, domain.ErrRoundNotFinished)
+		assert.Equal(t, ResultConflict, status)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{}, errors.New("db error"))
+
+		_, status, err := newResultUseCase(repo).AdvanceRoundUseCase(context.Background(), "tournament-1")
+
+		assert.Error(t, err)
+		assert.Equal(t, ResultPending, status)
+	})
+
+	t.Run("Advanced", func(t *testing.T) {
+		tournament := domain.Tournament{ID: "tournament-1", Status: domain.StatusInProgress, Rounds: []domain.Round{{Number: 1, Matches: []domain.Match{
+			{ID: "R1-M01", Couple1: domain.PlayerCouple{ID: "couple-1"}, Couple2: domain.PlayerCouple{ID: "couple-2"}},
+			{ID: "R1-M02", Couple1: domain.PlayerCouple{ID: "couple-3"}, Couple2: domain.PlayerCouple{ID: "couple-4"}},
+		}}}}
+		_, _ = tournament.RecordResult("R1-M01", domain.Result{Outcome: domain.OutcomeDisqualified, Winner: domain.Couple2}, nil)
+		_, _ = tournament.RecordResult("R1-M02", domain.Result{Outcome: domain.OutcomeWalkover, Winner: domain.Couple1}, nil)
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournament, nil)
+		repo.On("Upsert", mock.Anything).Return(nil)
+
+		round, status, err := newResultUseCase(repo).AdvanceRoundUseCase(context.Background(), "tournament-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, ResultAdvanced, status)
+		assert.Equal(t, 2, round.Number)
+		assert.Equal(t, []string{"couple-2", "couple-3"}, []string{round.Matches[0].Couple1.ID, round.Matches[0].Couple2.ID})
+	})
+}
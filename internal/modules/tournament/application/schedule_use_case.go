@@ -0,0 +1,169 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+// ScheduleUseCase allocates the matches of a tournament to the courts of its venues.
+type ScheduleUseCase interface {
+	ConfigureSchedulingUseCase(ctx context.Context, tournamentId string, scheduling domain.Scheduling) (domain.Scheduling, ScheduleStatus, error)
+	// ScheduleMatchesUseCase (re)schedules every match not started yet.
+	ScheduleMatchesUseCase(ctx context.Context, tournamentId string) (domain.Schedule, ScheduleStatus, error)
+	// AssignMatchUseCase books a court manually, it's rejected when the match ends up in conflict.
+	AssignMatchUseCase(ctx context.Context, tournamentId, matchId, courtId string, start time.Time) (domain.Schedule, ScheduleStatus, error)
+	// ReportMatchEndUseCase sets the actual (or expected) end of a match and reschedules the matches not started yet.
+	ReportMatchEndUseCase(ctx context.Context, tournamentId, matchId string, endsAt time.Time) (domain.Schedule, ScheduleStatus, error)
+	FindScheduleUseCase(ctx context.Context, tournamentId string) (domain.Schedule, ScheduleStatus, error)
+}
+
+type ScheduleStatus uint8
+
+const (
+	SchedulePending ScheduleStatus = iota
+	ScheduleInvalid
+	ScheduleNotFound
+	ScheduleConflict
+	ScheduleConfigured
+	ScheduleUpdated
+	ScheduleFound
+)
+
+// Implement the Stringer interface.
+func (s ScheduleStatus) String() string {
+	return [...]string{"SchedulePending", "ScheduleInvalid", "ScheduleNotFound", "ScheduleConflict", "ScheduleConfigured",
+		"ScheduleUpdated", "ScheduleFound"}[s]
+}
+
+func NewScheduleUseCase(tournamentRepository domain.TournamentRepository) ScheduleUseCase {
+	return &scheduleService{tournamentRepo: tournamentRepository, now: time.Now}
+}
+
+func (s *scheduleService) ConfigureSchedulingUseCase(ctx context.Context, tournamentId string, scheduling domain.Scheduling) (domain.Scheduling, ScheduleStatus, error) {
+	validated, err := domain.NewScheduling(scheduling.Venues, scheduling.MatchMinutes, scheduling.RestMinutes)
+	if err != nil {
+		return domain.Scheduling{}, ScheduleInvalid, err
+	}
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != ScheduleFound {
+		return domain.Scheduling{}, status, err
+	}
+	if err := tournament.ConfigureScheduling(validated); err != nil {
+		return domain.Scheduling{}, ScheduleConflict, err
+	}
+	if err := s.tournamentRepo.Upsert(ctx, &tournament); err != nil {
+		return domain.Scheduling{}, saveStatus(err, ScheduleConflict, SchedulePending), err
+	}
+	return *validated, ScheduleConfigured, nil
+}
+
+func (s *scheduleService) ScheduleMatchesUseCase(ctx context.Context, tournamentId string) (domain.Schedule, ScheduleStatus, error) {
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != ScheduleFound {
+		return domain.Schedule{}, status, err
+	}
+	if err := tournament.ScheduleMatches(s.scheduleFrom(tournament)); err != nil {
+		return domain.Schedule{}, scheduleErrorStatus(err), err
+	}
+	return s.saveSchedule(ctx, &tournament)
+}
+
+func (s *scheduleService) AssignMatchUseCase(ctx context.Context, tournamentId, matchId, courtId string, start time.Time) (domain.Schedule, ScheduleStatus, error) {
+	if err := domain.ValidateID(matchId); err != nil {
+		return domain.Schedule{}, ScheduleInvalid, err
+	}
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != ScheduleFound {
+		return domain.Schedule{}, status, err
+	}
+	if _, err := tournament.AssignMatch(matchId, courtId, start); err != nil {
+		return domain.Schedule{}, scheduleErrorStatus(err), err
+	}
+	for _, conflict := range tournament.ScheduleConflicts() {
+		for _, id := range conflict.MatchIDs {
+			if id == matchId {
+				return domain.Schedule{}, ScheduleConflict, fmt.Errorf("%s conflict with matches %v", conflict.Kind, conflict.MatchIDs)
+			}
+		}
+	}
+	return s.saveSchedule(ctx, &tournament)
+}
+
+func (s *scheduleService) ReportMatchEndUseCase(ctx context.Context, tournamentId, matchId string, endsAt time.Time) (domain.Schedule, ScheduleStatus, error) {
+	if err := domain.ValidateID(matchId); err != nil {
+		return domain.Schedule{}, ScheduleInvalid, err
+	}
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != ScheduleFound {
+		return domain.Schedule{}, status, err
+	}
+	if _, err := tournament.ReportMatchEnd(matchId, endsAt); err != nil {
+		return domain.Schedule{}, scheduleErrorStatus(err), err
+	}
+	// The reported end is kept even when the remaining matches no longer fit, conflicts are then part of the schedule.
+	if err := tournament.ScheduleMatches(s.scheduleFrom(tournament)); err != nil && !errors.Is(err, domain.ErrNoCourtAvailable) {
+		return domain.Schedule{}, scheduleErrorStatus(err), err
+	}
+	return s.saveSchedule(ctx, &tournament)
+}
+
+func (s *scheduleService) FindScheduleUseCase(ctx context.Context, tournamentId string) (domain.Schedule, ScheduleStatus, error) {
+	tournament, status, err := s.findTournament(ctx, tournamentId)
+	if status != ScheduleFound {
+		return domain.Schedule{}, status, err
+	}
+	schedule, err := tournament.Schedule()
+	if err != nil {
+		return domain.Schedule{}, scheduleErrorStatus(err), err
+	}
+	return schedule, ScheduleFound, nil
+}
+
+// scheduleFrom is now, or the start of the tournament when it hasn't started yet.
+func (s *scheduleService) scheduleFrom(tournament domain.Tournament) time.Time {
+	if now := s.now(); now.After(tournament.Timestamp) {
+		return now
+	}
+	return tournament.Timestamp
+}
+
+func (s *scheduleService) findTournament(ctx context.Context, tournamentId string) (domain.Tournament, ScheduleStatus, error) {
+	if err := domain.ValidateID(tournamentId); err != nil {
+		return domain.Tournament{}, ScheduleInvalid, err
+	}
+	tournament, err := s.tournamentRepo.FindByID(ctx, tournamentId)
+	if err != nil {
+		return domain.Tournament{}, SchedulePending, err
+	}
+	if len(tournament.ID) == 0 {
+		return domain.Tournament{}, ScheduleNotFound, nil
+	}
+	return tournament, ScheduleFound, nil
+}
+
+func (s *scheduleService) saveSchedule(ctx context.Context, tournament *domain.Tournament) (domain.Schedule, ScheduleStatus, error) {
+	if err := s.tournamentRepo.Upsert(ctx, tournament); err != nil {
+		return domain.Schedule{}, saveStatus(err, ScheduleConflict, SchedulePending), err
+	}
+	schedule, err := tournament.Schedule()
+	if err != nil {
+		return domain.Schedule{}, SchedulePending, err
+	}
+	return schedule, ScheduleUpdated, nil
+}
+
+func scheduleErrorStatus(err error) ScheduleStatus {
+	switch {
+	case errors.Is(err, domain.ErrMatchNotFound):
+		return ScheduleNotFound
+	case errors.Is(err, domain.ErrSchedulingNotConfigured), errors.Is(err, domain.ErrNoCourtAvailable),
+		errors.Is(err, domain.ErrOperationNotAllowed):
+		return ScheduleConflict
+	default:
+		return ScheduleInvalid
+	}
+}
@@ -0,0 +1,215 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var scheduleNow = time.Date(2026, time.November, 1, 9, 0, 0, 0, time.UTC)
+
+// tournamentToSchedule has 2 matches of the same couple and a single court available from 9:00 to 12:00.
+func tournamentToSchedule(configured bool) domain.Tournament {
+	tournament := domain.Tournament{ID: "tournament-1", Title: "Premier Padel", Timestamp: scheduleNow, Status: domain.StatusInProgress, Rounds: []domain.Round{{Number: 1, Matches: []domain.Match{
+		{ID: "match-1", Couple1: domain.PlayerCouple{ID: "couple-1"}, Couple2: domain.PlayerCouple{ID: "couple-2"}},
+		{ID: "match-2", Couple1: domain.PlayerCouple{ID: "couple-1"}, Couple2: domain.PlayerCouple{ID: "couple-3"}},
+	}}}}
+	if configured {
+		tournament.Scheduling = &domain.Scheduling{MatchMinutes: 60, RestMinutes: 30, Venues: []domain.Venue{{ID: "venue-1", Courts: []domain.Court{
+			{ID: "court-1", Availability: []domain.TimeWindow{{Start: scheduleNow, End: scheduleNow.Add(3 * time.Hour)}}},
+		}}}}
+	}
+	return tournament
+}
+
+// scheduledTournament returns a new tournament (rounds aren't shared between tests) scheduled from scheduleNow.
+func scheduledTournament() domain.Tournament {
+	tournament := tournamentToSchedule(true)
+	_ = tournament.ScheduleMatches(scheduleNow)
+	return tournament
+}
+
+func newScheduleUseCase(repo *mockTournamentRepository) ScheduleUseCase {
+	return &scheduleService{tournamentRepo: repo, now: func() time.Time { return scheduleNow }}
+}
+
+func TestConfigureSchedulingUseCase(t *testing.T) {
+	scheduling := *tournamentToSchedule(true).Scheduling
+
+	t.Run("Configured", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentToSchedule(false), nil)
+		repo.On("Upsert", mock.MatchedBy(func(tournament *domain.Tournament) bool {
+			return tournament.Scheduling != nil && tournament.Scheduling.MatchMinutes == 60
+		})).Return(nil)
+
+		configured, status, err := newScheduleUseCase(repo).ConfigureSchedulingUseCase(context.Background(), "tournament-1", scheduling)
+
+		assert.NoError(t, err)
+		assert.Equal(t, ScheduleConfigured, status)
+		assert.Equal(t, scheduling, configured)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, status, err := newScheduleUseCase(&mockTournamentRepository{}).ConfigureSchedulingUseCase(context.Background(), "tournament-1", domain.Scheduling{MatchMinutes: 60})
+
+		assert.Error(t, err)
+		assert.Equal(t, ScheduleInvalid, status)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{}, nil)
+
+		_, status, err := newScheduleUseCase(repo).ConfigureSchedulingUseCase(context.Background(), "tournament-1", scheduling)
+
+		assert.NoError(t, err)
+		assert.Equal(t, ScheduleNotFound, status)
+	})
+}
+
+func TestScheduleMatchesUseCase(t *testing.T) {
+	t.Run("Updated", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentToSchedule(true), nil)
+		repo.On("Upsert", mock.Anything).Return(nil)
+
+		schedule, status, err := newScheduleUseCase(repo).ScheduleMatchesUseCase(context.Background(), "tournament-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, ScheduleUpdated, status)
+		assert.Equal(t, []domain.ScheduledMatch{
+			{MatchID: "match-1", RoundNumber: 1, CourtID: "court-1", Start: scheduleNow, End: scheduleNow.Add(time.Hour)},
+			{MatchID: "match-2", RoundNumber: 1, CourtID: "court-1", Start: scheduleNow.Add(90 * time.Minute), End: scheduleNow.Add(150 * time.Minute)},
+		}, schedule.Matches)
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentToSchedule(false), nil)
+
+		_, status, err := newScheduleUseCase(repo).ScheduleMatchesUseCase(context.Background(), "tournament-1")
+
+		assert.ErrorIs(t, err, domain.ErrSchedulingNotConfigured)
+		assert.Equal(t, ScheduleConflict, status)
+		repo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(domain.Tournament{}, errors.New("db error"))
+
+		_, status, err := newScheduleUseCase(repo).ScheduleMatchesUseCase(context.Background(), "tournament-1")
+
+		assert.Error(t, err)
+		assert.Equal(t, SchedulePending, status)
+	})
+}
+
+func TestAssignMatchUseCase(t *testing.T) {
+	t.Run("Updated", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(scheduledTournament(), nil)
+		repo.On("Upsert", mock.Anything).Return(nil)
+
+		schedule, status, err := newScheduleUseCase(repo).AssignMatchUseCase(context.Background(), "tournament-1", "match-2", "court-1", scheduleNow.Add(2*time.Hour))
+
+		assert.NoError(t, err)
+		assert.Equal(t, ScheduleUpdated, status)
+		assert.Equal(t, scheduleNow.Add(2*time.Hour), schedule.Matches[1].Start)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(scheduledTournament(), nil)
+
+		_, status, err := newScheduleUseCase(repo).AssignMatchUseCase(context.Background(), "tournament-1", "match-2", "court-1", scheduleNow.Add(30*time.Minute))
+
+		assert.Error(t, err)
+		assert.Equal(t, ScheduleConflict, status)
+		repo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("CourtNotFound", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(scheduledTournament(), nil)
+
+		_, status, err := newScheduleUseCase(repo).AssignMatchUseCase(context.Background(), "tournament-1", "match-2", "court-9", scheduleNow)
+
+		assert.ErrorIs(t, err, domain.ErrCourtNotFound)
+		assert.Equal(t, ScheduleInvalid, status)
+	})
+
+	t.Run("MatchNotFound", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(scheduledTournament(), nil)
+
+		_, status, _ := newScheduleUseCase(repo).AssignMatchUseCase(context.Background(), "tournament-1", "match-9", "court-1", scheduleNow)
+
+		assert.Equal(t, ScheduleNotFound, status)
+	})
+}
+
+func TestReportMatchEndUseCase(t *testing.T) {
+	t.Run("Rescheduled", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(scheduledTournament(), nil)
+		repo.On("Upsert", mock.Anything).Return(nil)
+		useCase := &scheduleService{tournamentRepo: repo, now: func() time.Time { return scheduleNow.Add(50 * time.Minute) }}
+
+		schedule, status, err := useCase.ReportMatchEndUseCase(context.Background(), "tournament-1", "match-1", scheduleNow.Add(75*time.Minute))
+
+		assert.NoError(t, err)
+		assert.Equal(t, ScheduleUpdated, status)
+		assert.Equal(t, scheduleNow.Add(75*time.Minute), schedule.Matches[0].End)
+		assert.Equal(t, scheduleNow.Add(105*time.Minute), schedule.Matches[1].Start, "Expected the next match to be delayed")
+		assert.Empty(t, schedule.Conflicts)
+	})
+
+	t.Run("NoCourtAvailable", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(scheduledTournament(), nil)
+		repo.On("Upsert", mock.Anything).Return(nil)
+		useCase := &scheduleService{tournamentRepo: repo, now: func() time.Time { return scheduleNow.Add(50 * time.Minute) }}
+
+		schedule, status, err := useCase.ReportMatchEndUseCase(context.Background(), "tournament-1", "match-1", scheduleNow.Add(2*time.Hour))
+
+		assert.NoError(t, err)
+		assert.Equal(t, ScheduleUpdated, status)
+		assert.NotEmpty(t, schedule.Conflicts, "Expected the overrun to be kept with its conflicts")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(scheduledTournament(), nil)
+
+		_, status, err := newScheduleUseCase(repo).ReportMatchEndUseCase(context.Background(), "tournament-1", "match-1", scheduleNow.Add(-time.Hour))
+
+		assert.Error(t, err)
+		assert.Equal(t, ScheduleInvalid, status)
+	})
+}
+
+func TestFindScheduleUseCase(t *testing.T) {
+	repo := &mockTournamentRepository{}
+	repo.On("FindByID", "tournament-1").Return(tournamentToSchedule(true), nil)
+	repo.On("FindByID", "tournament-2").Return(domain.Tournament{}, nil)
+	useCase := newScheduleUseCase(repo)
+
+	schedule, status, err := useCase.FindScheduleUseCase(context.Background(), "tournament-1")
+	assert.NoError(t, err)
+	assert.Equal(t, ScheduleFound, status)
+	assert.Empty(t, schedule.Matches, "Expected no match scheduled yet")
+
+	_, status, _ = useCase.FindScheduleUseCase(context.Background(), "tournament-2")
+	assert.Equal(t, ScheduleNotFound, status)
+
+	_, status, _ = useCase.FindScheduleUseCase(context.Background(), "t")
+	assert.Equal(t, ScheduleInvalid, status)
+}
@@ -0,0 +1,46 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+type SubscribeScoreUpdatesUseCase interface {
+	// SubscribeScoreUpdatesUseCase returns the score updates of the tournament until the context is done.
+	SubscribeScoreUpdatesUseCase(ctx context.Context, tournamentId string) (<-chan domain.ScoreUpdate, SubscribeScoreUpdatesStatus, error)
+}
+
+type SubscribeScoreUpdatesStatus uint8
+
+const (
+	SubscribeScoreUpdatesPending SubscribeScoreUpdatesStatus = iota
+	SubscribeScoreUpdatesInvalid
+	SubscribeScoreUpdatesNotFound
+	SubscribeScoreUpdatesSubscribed
+)
+
+// Implement the Stringer interface.
+func (s SubscribeScoreUpdatesStatus) String() string {
+	return [...]string{"SubscribeScoreUpdatesPending", "SubscribeScoreUpdatesInvalid", "SubscribeScoreUpdatesNotFound", "SubscribeScoreUpdatesSubscribed"}[s]
+}
+
+func NewSubscribeScoreUpdatesUseCase(tournamentRepository domain.TournamentRepository, scoreBroker pubsub.Broker[domain.ScoreUpdate]) SubscribeScoreUpdatesUseCase {
+	return &scoreService{tournamentRepo: tournamentRepository, scoreBroker: scoreBroker, now: time.Now}
+}
+
+func (s *scoreService) SubscribeScoreUpdatesUseCase(ctx context.Context, tournamentId string) (<-chan domain.ScoreUpdate, SubscribeScoreUpdatesStatus, error) {
+	if err := domain.ValidateID(tournamentId); err != nil {
+		return nil, SubscribeScoreUpdatesInvalid, err
+	}
+	tournament, err := s.tournamentRepo.FindByID(ctx, tournamentId)
+	if err != nil {
+		return nil, SubscribeScoreUpdatesPending, err
+	}
+	if len(tournament.ID) == 0 {
+		return nil, SubscribeScoreUpdatesNotFound, nil
+	}
+	return s.scoreBroker.Subscribe(ctx, tournamentId), SubscribeScoreUpdatesSubscribed, nil
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribeScoreUpdatesUseCase(t *testing.T) {
+	t.Run("Subscribed", func(t *testing.T) {
+		// Arrange
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-1").Return(tournamentWithMatch(), nil)
+		broker := pubsub.NewMemoryBroker[domain.ScoreUpdate]()
+		useCase := NewSubscribeScoreUpdatesUseCase(repo, broker)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// Act
+		updates, status, err := useCase.SubscribeScoreUpdatesUseCase(ctx, "tournament-1")
+		broker.Publish("tournament-1", domain.ScoreUpdate{TournamentID: "tournament-1", MatchID: "match-1"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, SubscribeScoreUpdatesSubscribed, status)
+		assert.Equal(t, "match-1", (<-updates).MatchID)
+		cancel()
+		_, open := <-updates
+		assert.False(t, open)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := &mockTournamentRepository{}
+		repo.On("FindByID", "tournament-9").Return(domain.Tournament{}, nil)
+		useCase := NewSubscribeScoreUpdatesUseCase(repo, pubsub.NewMemoryBroker[domain.ScoreUpdate]())
+
+		updates, status, err := useCase.SubscribeScoreUpdatesUseCase(context.Background(), "tournament-9")
+
+		assert.NoError(t, err)
+		assert.Equal(t, SubscribeScoreUpdatesNotFound, status)
+		assert.Nil(t, updates)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		useCase := NewSubscribeScoreUpdatesUseCase(&mockTournamentRepository{}, pubsub.NewMemoryBroker[domain.ScoreUpdate]())
+
+		_, status, err := useCase.SubscribeScoreUpdatesUseCase(context.Background(), "t")
+
+		assert.Error(t, err)
+		assert.Equal(t, SubscribeScoreUpdatesInvalid, status)
+	})
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"errors"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/common/pubsub"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+)
+
+type tournamentService struct {
+	tournamentRepo domain.TournamentRepository
+}
+
+// scoreService publishes score updates on the topic of their tournament ID.
+type scoreService struct {
+	tournamentRepo domain.TournamentRepository
+	scoreBroker    pubsub.Broker[domain.ScoreUpdate]
+	now            func() time.Time
+}
+
+// liveMatchService broadcasts live scores on the topic of their match (see liveScoreTopic) and publishes final
+// scores as score updates.
+type liveMatchService struct {
+	tournamentRepo domain.TournamentRepository
+	liveMatchRepo  domain.LiveMatchRepository
+	liveBroker     pubsub.Broker[domain.LiveScore]
+	scoreBroker    pubsub.Broker[domain.ScoreUpdate]
+	now            func() time.Time
+}
+
+type scheduleService struct {
+	tournamentRepo domain.TournamentRepository
+	now            func() time.Time
+}
+
+// categoryService reads couples and their players through the anti-corruption layer, i.e. eligibility is checked
+// against current profiles.
+type categoryService struct {
+	tournamentRepo     domain.TournamentRepository
+	playerCoupleReader domain.PlayerCoupleReader
+	playerReader       domain.PlayerReader
+}
+
+// lifecycleService publishes the events of transitions on the tournamentEventsTopic.
+type lifecycleService struct {
+	tournamentRepo domain.TournamentRepository
+	eventBroker    pubsub.Broker[domain.TournamentEvent]
+	now            func() time.Time
+}
+
+// resultService publishes the results of matches as score updates, like scoreService.
+type resultService struct {
+	tournamentRepo domain.TournamentRepository
+	scoreBroker    pubsub.Broker[domain.ScoreUpdate]
+	now            func() time.Time
+}
+
+// hostClubService reads clubs through the anti-corruption layer, i.e. tournaments keep a copy of their host club.
+type hostClubService struct {
+	tournamentRepo domain.TournamentRepository
+	clubReader     domain.ClubReader
+}
+
+// saveStatus is the status of a failed save of the tournament, conflict when it was saved by someone else since it
+// was read (see domain.TournamentRepository).
+func saveStatus[S any](err error, conflict, pending S) S {
+	if errors.Is(err, domain.ErrTournamentConflict) {
+		return conflict
+	}
+	return pending
+}
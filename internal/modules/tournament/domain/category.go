@@ -0,0 +1,274 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	minRanking         = 1
+	maxRanking         = 8
+	minCategoryEntries = 2
+	maxCategoryEntries = 256
+	minPlayerAge       = 3
+	maxPlayerAge       = 100
+)
+
+// Gender of players as read from their profile (empty when unknown).
+type Gender string
+
+const (
+	GenderMale   Gender = "male"
+	GenderFemale Gender = "female"
+)
+
+// CategoryGender is the gender composition of the couples of a category.
+type CategoryGender string
+
+const (
+	// CategoryOpen accepts any couple.
+	CategoryOpen  CategoryGender = "open"
+	CategoryMen   CategoryGender = "men"
+	CategoryWomen CategoryGender = "women"
+	CategoryMixed CategoryGender = "mixed"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrCategoryExists   = errors.New("category already exists")
+	ErrAlreadyEntered   = errors.New("couple or player already entered in the category")
+	ErrNotEntered       = errors.New("couple not entered in the category")
+	// ErrNotEligible is wrapped with the rules the couple doesn't meet.
+	ErrNotEligible = errors.New("couple not eligible")
+)
+
+// Category groups the couples competing together, e.g. "Mixed level 3" or "Men +40".
+type Category struct {
+	ID          string      `bson:"_id" json:"id"`
+	Name        string      `bson:"name" json:"name"`
+	Eligibility Eligibility `bson:"eligibility" json:"eligibility"`
+	// MaxEntries caps the entries, further couples go to the waitlist.
+	MaxEntries int            `bson:"maxEntries" json:"maxEntries"`
+	Entries    []PlayerCouple `bson:"entries" json:"entries"`
+	// Waitlist is in order of arrival, its first couple is promoted when an entered couple withdraws.
+	Waitlist []PlayerCouple `bson:"waitlist" json:"waitlist"`
+}
+
+// Eligibility rules of a category, nil bounds aren't checked.
+type Eligibility struct {
+	// MinRanking and MaxRanking bound PlayerCouple.Ranking (level 1 to 8).
+	MinRanking *int `bson:"minRanking,omitempty" json:"minRanking,omitempty"`
+	MaxRanking *int `bson:"maxRanking,omitempty" json:"maxRanking,omitempty"`
+	// MinAge and MaxAge bound the age of both players on the day of the tournament, e.g. MinAge 40 for "+40".
+	MinAge *int           `bson:"minAge,omitempty" json:"minAge,omitempty"`
+	MaxAge *int           `bson:"maxAge,omitempty" json:"maxAge,omitempty"`
+	Gender CategoryGender `bson:"gender" json:"gender"`
+}
+
+// CategoryEntry is the result of entering (or withdrawing from) a category.
+type CategoryEntry struct {
+	CategoryID string `json:"categoryId"`
+	CoupleID   string `json:"coupleId"`
+	Waitlisted bool   `json:"waitlisted"`
+	// Position is 1 based, in the entries or the waitlist.
+	Position int `json:"position,omitempty"`
+	// Promoted is the couple moved from the waitlist to the entries after a withdrawal.
+	Promoted *PlayerCouple `json:"promoted,omitempty"`
+}
+
+// NewCategory validates the category, its gender composition being open unless set.
+func NewCategory(id, name string, eligibility Eligibility, maxEntries int) (*Category, error) {
+	if err := ValidateID(id); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, errors.New("name cannot be empty")
+	}
+	if maxEntries < minCategoryEntries || maxEntries > maxCategoryEntries {
+		return nil, fmt.Errorf("invalid maxEntries: %d", maxEntries)
+	}
+	if eligibility.Gender == "" {
+		eligibility.Gender = CategoryOpen
+	}
+	if err := eligibility.validate(); err != nil {
+		return nil, err
+	}
+	return &Category{
+		ID:          id,
+		Name:        name,
+		Eligibility: eligibility,
+		MaxEntries:  maxEntries,
+		Entries:     []PlayerCouple{},
+		Waitlist:    []PlayerCouple{},
+	}, nil
+}
+
+func (e Eligibility) validate() error {
+	if err := validateRange("ranking", e.MinRanking, e.MaxRanking, minRanking, maxRanking); err != nil {
+		return err
+	}
+	if err := validateRange("age", e.MinAge, e.MaxAge, minPlayerAge, maxPlayerAge); err != nil {
+		return err
+	}
+	switch e.Gender {
+	case CategoryOpen, CategoryMen, CategoryWomen, CategoryMixed:
+		return nil
+	default:
+		return fmt.Errorf("invalid gender: %q", e.Gender)
+	}
+}
+
+func validateRange(name string, lower, upper *int, min, max int) error {
+	for _, bound := range []*int{lower, upper} {
+		if bound != nil && (*bound < min || *bound > max) {
+			return fmt.Errorf("invalid %s: %d", name, *bound)
+		}
+	}
+	if lower != nil && upper != nil && *lower > *upper {
+		return fmt.Errorf("invalid %s range: %d-%d", name, *lower, *upper)
+	}
+	return nil
+}
+
+// Check returns the rules the couple doesn't meet on the date, wrapped in ErrNotEligible, or nil when it's eligible.
+func (e Eligibility) Check(couple PlayerCouple, date time.Time) error {
+	var reasons []string
+	if e.MinRanking != nil || e.MaxRanking != nil {
+		if couple.Ranking == nil || !inRange(*couple.Ranking, e.MinRanking, e.MaxRanking) {
+			reasons = append(reasons, "ranking out of range")
+		}
+	}
+	if e.MinAge != nil || e.MaxAge != nil {
+		for _, player := range []Player{couple.Player1, couple.Player2} {
+			// Unknown birth dates (e.g. of players migrated with their age only) are told apart so players give theirs.
+			if age := player.AgeOn(date); age == nil {
+				reasons = append(reasons, fmt.Sprintf("birth date of player %s must be known", player.ID))
+			} else if !inRange(*age, e.MinAge, e.MaxAge) {
+				reasons = append(reasons, fmt.Sprintf("age of player %s out of range", player.ID))
+			}
+		}
+	}
+	if reason := e.checkGender(couple.Player1.Gender, couple.Player2.Gender); reason != "" {
+		reasons = append(reasons, reason)
+	}
+	if len(reasons) > 0 {
+		return fmt.Errorf("%w: %s", ErrNotEligible, strings.Join(reasons, ", "))
+	}
+	return nil
+}
+
+func (e Eligibility) checkGender(gender1, gender2 Gender) string {
+	if e.Gender == CategoryOpen {
+		return ""
+	}
+	if gender1 == "" || gender2 == "" {
+		return "gender of both players must be known"
+	}
+	switch {
+	case e.Gender == CategoryMen && (gender1 != GenderMale || gender2 != GenderMale):
+		return "both players must be male"
+	case e.Gender == CategoryWomen && (gender1 != GenderFemale || gender2 != GenderFemale):
+		return "both players must be female"
+	case e.Gender == CategoryMixed && gender1 == gender2:
+		return "players must be of different gender"
+	}
+	return ""
+}
+
+func inRange(value int, lower, upper *int) bool {
+	return (lower == nil || value >= *lower) && (upper == nil || value <= *upper)
+}
+
+// FindCategory returns the category of the tournament.
+func (t *Tournament) FindCategory(categoryID string) (*Category, error) {
+	for c := range t.Categories {
+		if t.Categories[c].ID == categoryID {
+			return &t.Categories[c], nil
+		}
+	}
+	return nil, ErrCategoryNotFound
+}
+
+func (t *Tournament) AddCategory(category Category) error {
+	if err := t.Allows(OperationManageCategories); err != nil {
+		return err
+	}
+	if _, err := t.FindCategory(category.ID); err == nil {
+		return ErrCategoryExists
+	}
+	t.Categories = append(t.Categories, category)
+	return nil
+}
+
+// EnterCategory enters an eligible couple in the category, or adds it to the waitlist once the category is full.
+func (t *Tournament) EnterCategory(categoryID string, couple PlayerCouple) (CategoryEntry, error) {
+	if err := t.Allows(OperationEnterCouples); err != nil {
+		return CategoryEntry{}, err
+	}
+	category, err := t.FindCategory(categoryID)
+	if err != nil {
+		return CategoryEntry{}, err
+	}
+	if category.hasPlayerOf(couple) {
+		return CategoryEntry{}, ErrAlreadyEntered
+	}
+	if err := category.Eligibility.Check(couple, t.Timestamp); err != nil {
+		return CategoryEntry{}, err
+	}
+	entry := CategoryEntry{CategoryID: categoryID, CoupleID: couple.ID}
+	if len(category.Entries) < category.MaxEntries {
+		category.Entries = append(category.Entries, couple)
+		entry.Position = len(category.Entries)
+	} else {
+		category.Waitlist = append(category.Waitlist, couple)
+		entry.Waitlisted, entry.Position = true, len(category.Waitlist)
+	}
+	return entry, nil
+}
+
+// WithdrawFromCategory removes the couple from the entries (promoting the first waitlisted couple) or the waitlist.
+func (t *Tournament) WithdrawFromCategory(categoryID, coupleID string) (CategoryEntry, error) {
+	if err := t.Allows(OperationWithdrawCouples); err != nil {
+		return CategoryEntry{}, err
+	}
+	category, err := t.FindCategory(categoryID)
+	if err != nil {
+		return CategoryEntry{}, err
+	}
+	entry := CategoryEntry{CategoryID: categoryID, CoupleID: coupleID}
+	byID := func(couple PlayerCouple) bool { return couple.ID == coupleID }
+	if i := slices.IndexFunc(category.Entries, byID); i >= 0 {
+		category.Entries = slices.Delete(category.Entries, i, i+1)
+		if len(category.Waitlist) > 0 && len(category.Entries) < category.MaxEntries {
+			promoted := category.Waitlist[0]
+			category.Waitlist = category.Waitlist[1:]
+			category.Entries = append(category.Entries, promoted)
+			entry.Promoted = &promoted
+		}
+		return entry, nil
+	}
+	if i := slices.IndexFunc(category.Waitlist, byID); i >= 0 {
+		category.Waitlist = slices.Delete(category.Waitlist, i, i+1)
+		entry.Waitlisted = true
+		return entry, nil
+	}
+	return CategoryEntry{}, ErrNotEntered
+}
+
+// hasPlayerOf tells if the couple, or any of its players with another partner, already entered the category.
+func (c *Category) hasPlayerOf(couple PlayerCouple) bool {
+	for _, entered := range slices.Concat(c.Entries, c.Waitlist) {
+		if entered.ID == couple.ID {
+			return true
+		}
+		for _, player := range []Player{entered.Player1, entered.Player2} {
+			if player.ID != "" && (player.ID == couple.Player1.ID || player.ID == couple.Player2.ID) {
+				return true
+			}
+		}
+	}
+	return false
+}
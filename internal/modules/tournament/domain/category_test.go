@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func categoryCouple(id string, ranking int, player1, player2 Player) PlayerCouple {
+	return PlayerCouple{ID: id, Player1: player1, Player2: player2, Ranking: intPtr(ranking)}
+}
+
+// tournamentDay is the date eligibility is checked on.
+var tournamentDay = time.Date(2026, time.November, 7, 9, 0, 0, 0, time.UTC)
+
+// categoryPlayer turns age on tournamentDay.
+func categoryPlayer(id string, age int, gender Gender) Player {
+	birthDate := time.Date(tournamentDay.Year()-age, tournamentDay.Month(), tournamentDay.Day(), 0, 0, 0, 0, time.UTC)
+	return Player{ID: id, BirthDate: birthDate.Format(time.DateOnly), Gender: gender}
+}
+
+func TestNewCategory(t *testing.T) {
+	category, err := NewCategory("mixed-3", "Mixed level 3", Eligibility{MinRanking: intPtr(3), MaxRanking: intPtr(4)}, 16)
+	assert.NoError(t, err)
+	assert.Equal(t, CategoryOpen, category.Eligibility.Gender, "Expected open gender by default")
+	assert.Empty(t, category.Entries)
+
+	for name, eligibility := range map[string]Eligibility{
+		"ranking out of bounds":  {MaxRanking: intPtr(9)},
+		"inverted ranking range": {MinRanking: intPtr(5), MaxRanking: intPtr(3)},
+		"inverted age range":     {MinAge: intPtr(50), MaxAge: intPtr(40)},
+		"unknown gender":         {Gender: "juniors"},
+	} {
+		_, err := NewCategory("category-1", "Category", eligibility, 16)
+		assert.Error(t, err, name)
+	}
+	_, err = NewCategory("category-1", "Category", Eligibility{}, 1)
+	assert.Error(t, err, "Expected error for too few entries")
+	_, err = NewCategory("c", "Category", Eligibility{}, 16)
+	assert.Error(t, err, "Expected error for invalid ID")
+	_, err = NewCategory("category-1", " ", Eligibility{}, 16)
+	assert.Error(t, err, "Expected error for empty name")
+}
+
+func TestEligibility_Check(t *testing.T) {
+	men40 := Eligibility{MinAge: intPtr(40), Gender: CategoryMen}
+	mixed := Eligibility{MinRanking: intPtr(3), MaxRanking: intPtr(4), Gender: CategoryMixed}
+
+	assert.NoError(t, men40.Check(categoryCouple("couple-1", 5, categoryPlayer("p1", 41, GenderMale), categoryPlayer("p2", 45, GenderMale)), tournamentDay))
+	assert.ErrorIs(t, men40.Check(categoryCouple("couple-2", 5, categoryPlayer("p1", 41, GenderMale), categoryPlayer("p3", 39, GenderMale)), tournamentDay), ErrNotEligible)
+	assert.ErrorContains(t, men40.Check(categoryCouple("couple-3", 5, categoryPlayer("p1", 41, GenderMale), categoryPlayer("p4", 41, GenderFemale)), tournamentDay), "both players must be male")
+	assert.ErrorContains(t, men40.Check(categoryCouple("couple-4", 5, categoryPlayer("p1", 41, GenderMale), Player{ID: "p5"}), tournamentDay),
+		"birth date of player p5 must be known, gender of both players must be known")
+	assert.NoError(t, mixed.Check(categoryCouple("couple-4", 3, categoryPlayer("p1", 30, GenderMale), Player{ID: "p5", Gender: GenderFemale}), tournamentDay),
+		"Expected unknown birth dates allowed without age limits")
+
+	assert.NoError(t, mixed.Check(categoryCouple("couple-5", 3, categoryPlayer("p1", 30, GenderMale), categoryPlayer("p4", 30, GenderFemale)), tournamentDay))
+	assert.ErrorContains(t, mixed.Check(categoryCouple("couple-6", 5, categoryPlayer("p1", 30, GenderMale), categoryPlayer("p4", 30, GenderFemale)), tournamentDay), "ranking out of range")
+	assert.ErrorContains(t, mixed.Check(PlayerCouple{ID: "couple-7", Player1: categoryPlayer("p1", 30, GenderMale), Player2: categoryPlayer("p4", 30, GenderFemale)}, tournamentDay),
+		"ranking out of range", "Expected unranked couples to be rejected")
+	turning40 := categoryPlayer("p6", 40, GenderMale)
+	turning40.BirthDate = tournamentDay.AddDate(-40, 0, 1).Format(time.DateOnly)
+	assert.ErrorContains(t, men40.Check(categoryCouple("couple-9", 5, categoryPlayer("p1", 41, GenderMale), turning40), tournamentDay),
+		"age of player p6 out of range", "Expected ages on the day of the tournament")
+	assert.NoError(t, men40.Check(categoryCouple("couple-9", 5, categoryPlayer("p1", 41, GenderMale), turning40), tournamentDay.AddDate(0, 0, 1)))
+	assert.ErrorContains(t, mixed.Check(categoryCouple("couple-8", 3, categoryPlayer("p1", 30, GenderMale), categoryPlayer("p2", 30, GenderMale)), tournamentDay), "different gender")
+}
+
+func TestTournament_EnterAndWithdrawCategory(t *testing.T) {
+	category, _ := NewCategory("open-1", "Open", Eligibility{}, 2)
+	tournament := Tournament{ID: "tournament-1", Status: StatusRegistrationOpen}
+	assert.NoError(t, tournament.AddCategory(*category))
+	assert.ErrorIs(t, tournament.AddCategory(*category), ErrCategoryExists)
+
+	couples := []PlayerCouple{
+		categoryCouple("couple-1", 1, Player{ID: "p1"}, Player{ID: "p2"}),
+		categoryCouple("couple-2", 1, Player{ID: "p3"}, Player{ID: "p4"}),
+		categoryCouple("couple-3", 1, Player{ID: "p5"}, Player{ID: "p6"}),
+		categoryCouple("couple-4", 1, Player{ID: "p7"}, Player{ID: "p8"}),
+	}
+	for i, couple := range couples {
+		entry, err := tournament.EnterCategory("open-1", couple)
+		assert.NoError(t, err)
+		assert.Equal(t, i >= 2, entry.Waitlisted, "Expected couples over the cap to be waitlisted")
+		assert.Equal(t, i%2+1, entry.Position)
+	}
+	_, err := tournament.EnterCategory("open-1", categoryCouple("couple-5", 1, Player{ID: "p1"}, Player{ID: "p9"}))
+	assert.ErrorIs(t, err, ErrAlreadyEntered, "Expected a player to enter a category once")
+	_, err = tournament.EnterCategory("men-1", couples[0])
+	assert.ErrorIs(t, err, ErrCategoryNotFound)
+
+	// The first waitlisted couple is promoted.
+	entry, err := tournament.WithdrawFromCategory("open-1", "couple-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "couple-3", entry.Promoted.ID)
+	assert.Equal(t, []PlayerCouple{couples[1], couples[2]}, tournament.Categories[0].Entries)
+	assert.Equal(t, []PlayerCouple{couples[3]}, tournament.Categories[0].Waitlist)
+
+	entry, err = tournament.WithdrawFromCategory("open-1", "couple-4")
+	assert.NoError(t, err)
+	assert.True(t, entry.Waitlisted)
+	assert.Nil(t, entry.Promoted)
+	assert.Empty(t, tournament.Categories[0].Waitlist)
+
+	_, err = tournament.WithdrawFromCategory("open-1", "couple-4")
+	assert.ErrorIs(t, err, ErrNotEntered)
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// TournamentFilter selects the tournaments held in [From, To), a tournament or the ones with a category (only
+// its matches being selected). The zero value selects every tournament.
+type TournamentFilter struct {
+	From         *time.Time
+	To           *time.Time
+	TournamentID string
+	CategoryID   string
+}
+
+func (f TournamentFilter) Validate() error {
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return errors.New("invalid date range: from must be before to")
+	}
+	if len(f.TournamentID) > 0 {
+		if err := ValidateID(f.TournamentID); err != nil {
+			return err
+		}
+	}
+	if len(f.CategoryID) > 0 {
+		return ValidateID(f.CategoryID)
+	}
+	return nil
+}
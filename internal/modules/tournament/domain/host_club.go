@@ -0,0 +1,18 @@
+package domain
+
+// HostClub is the copy of the club hosting the tournament, owned by the club module and read through ClubReader
+// (anti-corruption layer), i.e. venues and memberships aren't part of tournaments.
+type HostClub struct {
+	ID   string `bson:"_id" json:"id"`
+	Name string `bson:"name" json:"name"`
+	City string `bson:"city,omitempty" json:"city,omitempty"`
+}
+
+// AssignHostClub sets (or replaces) the club hosting the tournament, until registration closes.
+func (t *Tournament) AssignHostClub(club HostClub) error {
+	if err := t.Allows(OperationAssignHostClub); err != nil {
+		return err
+	}
+	t.HostClub = &club
+	return nil
+}
@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTournament_AssignHostClub(t *testing.T) {
+	tournament := &Tournament{ID: "tournament-1"}
+	assert.NoError(t, tournament.AssignHostClub(HostClub{ID: "club-1", Name: "Club Norte"}))
+	assert.NoError(t, tournament.AssignHostClub(HostClub{ID: "club-2", Name: "Club Sur"}), "Expected drafts to replace their host club")
+	assert.Equal(t, &HostClub{ID: "club-2", Name: "Club Sur"}, tournament.HostClub)
+
+	tournament.Status = StatusRegistrationClosed
+	assert.ErrorIs(t, tournament.AssignHostClub(HostClub{ID: "club-1"}), ErrOperationNotAllowed)
+	assert.Equal(t, "club-2", tournament.HostClub.ID)
+}
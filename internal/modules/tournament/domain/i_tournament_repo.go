@@ -0,0 +1,41 @@
+package domain
+
+import "context"
+
+// interfaces to be used by infrastructure layer:
+type TournamentRepository interface {
+	// Upsert saves the tournament when its Version is the stored one, failing with ErrTournamentConflict otherwise,
+	// i.e. the whole aggregate is replaced as read.
+	Upsert(ctx context.Context, tournament *Tournament) error
+	FindByID(ctx context.Context, id string) (Tournament, error)
+	// ForEach streams the tournaments selected by the filter in timestamp order through a cursor, i.e. without
+	// loading them, stopping at the first error of fn.
+	ForEach(ctx context.Context, filter TournamentFilter, fn func(Tournament) error) error
+	Delete(ctx context.Context, id string) error
+}
+
+// PlayerReader reads the current public profile of players owned by the player-couple module (anti-corruption
+// layer), i.e. tournaments embed copies taken at registration time.
+type PlayerReader interface {
+	FindByIDs(ctx context.Context, ids []string) ([]Player, error)
+}
+
+// PlayerCoupleReader reads the current couples owned by the player-couple module (anti-corruption layer).
+type PlayerCoupleReader interface {
+	FindByIDs(ctx context.Context, ids []string) ([]PlayerCouple, error)
+}
+
+// ClubReader reads the clubs owned by the club module (anti-corruption layer), returning an empty HostClub when
+// not found.
+type ClubReader interface {
+	FindByID(ctx context.Context, id string) (HostClub, error)
+}
+
+// LiveMatchRepository persists live matches point by point, i.e. AppendPoint and RemoveLastPoint only write the
+// last change and fail with ErrLiveMatchConflict when another referee changed the match meanwhile.
+type LiveMatchRepository interface {
+	Upsert(ctx context.Context, liveMatch *LiveMatch) error
+	FindByMatchID(ctx context.Context, tournamentID, matchID string) (LiveMatch, error)
+	AppendPoint(ctx context.Context, liveMatch *LiveMatch) error
+	RemoveLastPoint(ctx context.Context, liveMatch *LiveMatch) error
+}
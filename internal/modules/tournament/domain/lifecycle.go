@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// TournamentStatus is the state of the tournament lifecycle:
+// Draft → RegistrationOpen → RegistrationClosed → DrawPublished → InProgress → Finished, or Cancelled before finishing.
+type TournamentStatus string
+
+const (
+	StatusDraft              TournamentStatus = "Draft"
+	StatusRegistrationOpen   TournamentStatus = "RegistrationOpen"
+	StatusRegistrationClosed TournamentStatus = "RegistrationClosed"
+	StatusDrawPublished      TournamentStatus = "DrawPublished"
+	StatusInProgress         TournamentStatus = "InProgress"
+	StatusFinished           TournamentStatus = "Finished"
+	StatusCancelled          TournamentStatus = "Cancelled"
+)
+
+// Transition is a command changing the status of a tournament.
+type Transition string
+
+const (
+	TransitionOpenRegistration  Transition = "open-registration"
+	TransitionCloseRegistration Transition = "close-registration"
+	TransitionPublishDraw       Transition = "publish-draw"
+	TransitionStart             Transition = "start"
+	TransitionFinish            Transition = "finish"
+	TransitionCancel            Transition = "cancel"
+)
+
+// Transitions in lifecycle order.
+var Transitions = []Transition{TransitionOpenRegistration, TransitionCloseRegistration, TransitionPublishDraw,
+	TransitionStart, TransitionFinish, TransitionCancel}
+
+// Operation is a change of the tournament only allowed in some statuses.
+type Operation string
+
+const (
+	OperationManageCategories Operation = "manage categories"
+	OperationEnterCouples     Operation = "enter couples"
+	OperationWithdrawCouples  Operation = "withdraw couples"
+	OperationConfigureVenues  Operation = "configure venues"
+	OperationScheduleMatches  Operation = "schedule matches"
+	OperationScoreMatches     Operation = "score matches"
+	OperationConfigureRules   Operation = "configure result rules"
+	OperationAdvanceRounds    Operation = "advance rounds"
+	OperationAssignHostClub   Operation = "assign host club"
+)
+
+var (
+	ErrTransitionNotAllowed = errors.New("transition not allowed")
+	ErrOperationNotAllowed  = errors.New("operation not allowed")
+	ErrNothingToDraw        = errors.New("no couples to draw")
+	ErrUnknownTransition    = errors.New("unknown transition")
+)
+
+var transitions = map[Transition]struct {
+	from  []TournamentStatus
+	to    TournamentStatus
+	event string
+}{
+	TransitionOpenRegistration:  {[]TournamentStatus{StatusDraft}, StatusRegistrationOpen, "RegistrationOpened"},
+	TransitionCloseRegistration: {[]TournamentStatus{StatusRegistrationOpen}, StatusRegistrationClosed, "RegistrationClosed"},
+	TransitionPublishDraw:       {[]TournamentStatus{StatusRegistrationClosed}, StatusDrawPublished, "DrawPublished"},
+	TransitionStart:             {[]TournamentStatus{StatusDrawPublished}, StatusInProgress, "TournamentStarted"},
+	TransitionFinish:            {[]TournamentStatus{StatusInProgress}, StatusFinished, "TournamentFinished"},
+	TransitionCancel: {[]TournamentStatus{StatusDraft, StatusRegistrationOpen, StatusRegistrationClosed, StatusDrawPublished,
+		StatusInProgress}, StatusCancelled, "TournamentCancelled"},
+}
+
+var allowedOperations = map[TournamentStatus][]Operation{
+	StatusDraft: {OperationManageCategories, OperationConfigureVenues, OperationConfigureRules, OperationAssignHostClub},
+	StatusRegistrationOpen: {OperationManageCategories, OperationEnterCouples, OperationWithdrawCouples, OperationConfigureVenues,
+		OperationConfigureRules, OperationAssignHostClub},
+	StatusRegistrationClosed: {OperationWithdrawCouples, OperationConfigureVenues, OperationConfigureRules},
+	StatusDrawPublished:      {OperationConfigureVenues, OperationScheduleMatches, OperationConfigureRules},
+	StatusInProgress:         {OperationConfigureVenues, OperationScheduleMatches, OperationScoreMatches, OperationAdvanceRounds},
+}
+
+// TournamentEvent is emitted by every transition.
+type TournamentEvent struct {
+	TournamentID string           `json:"tournamentId"`
+	Type         string           `json:"type"`
+	From         TournamentStatus `json:"from"`
+	To           TournamentStatus `json:"to"`
+	OccurredAt   time.Time        `json:"occurredAt"`
+}
+
+// CurrentStatus is the status of the tournament, tournaments created before the lifecycle are drafts.
+func (t *Tournament) CurrentStatus() TournamentStatus {
+	if t.Status == "" {
+		return StatusDraft
+	}
+	return t.Status
+}
+
+// Allows returns ErrOperationNotAllowed (wrapped) when the operation isn't allowed in the current status.
+func (t *Tournament) Allows(operation Operation) error {
+	if !slices.Contains(allowedOperations[t.CurrentStatus()], operation) {
+		return fmt.Errorf("%w: cannot %s while %s", ErrOperationNotAllowed, operation, t.CurrentStatus())
+	}
+	return nil
+}
+
+// Apply changes the status of the tournament and returns the emitted event. Publishing the draw generates it
+// unless rounds were already set.
+func (t *Tournament) Apply(transition Transition, at time.Time) (TournamentEvent, error) {
+	rule, ok := transitions[transition]
+	if !ok {
+		return TournamentEvent{}, fmt.Errorf("%w: %s", ErrUnknownTransition, transition)
+	}
+	from := t.CurrentStatus()
+	if !slices.Contains(rule.from, from) {
+		return TournamentEvent{}, fmt.Errorf("%w: cannot %s while %s", ErrTransitionNotAllowed, transition, from)
+	}
+	if transition == TransitionPublishDraw && len(t.Rounds) == 0 {
+		if err := t.generateDraw(); err != nil {
+			return TournamentEvent{}, err
+		}
+	}
+	t.Status = rule.to
+	return TournamentEvent{TournamentID: t.ID, Type: rule.event, From: from, To: rule.to, OccurredAt: at}, nil
+}
+
+// generateDraw sets the first round of every category (or of the tournament couples without categories), seeding
+// couples by ranking: the best one plays the worst one, the middle one is exempt (bye) when the count is odd.
+func (t *Tournament) generateDraw() error {
+	var matches []Match
+	draw := func(prefix, categoryID string, couples []PlayerCouple) {
+		seeded := slices.Clone(couples)
+		// Level 1 is the best ranking, unranked couples go last.
+		slices.SortStableFunc(seeded, func(a, b PlayerCouple) int {
+			return cmp.Compare(rankingOrder(a), rankingOrder(b))
+		})
+		for i := 0; i < len(seeded)/2; i++ {
+			matches = append(matches, Match{
+				ID:         prefix + MatchCode(1, i+1),
+				Timestamp:  t.Timestamp,
+				CategoryID: categoryID,
+				Couple1:    seeded[i],
+				Couple2:    seeded[len(seeded)-1-i],
+			})
+		}
+	}
+	if len(t.Categories) == 0 {
+		draw("", "", t.PlayerCouples)
+	}
+	for _, category := range t.Categories {
+		draw(category.ID+"-", category.ID, category.Entries)
+	}
+	if len(matches) == 0 {
+		return ErrNothingToDraw
+	}
+	t.Rounds = []Round{{Number: 1, Matches: matches}}
+	return nil
+}
+
+func rankingOrder(couple PlayerCouple) int {
+	if couple.Ranking == nil {
+		return maxRanking + 1
+	}
+	return *couple.Ranking
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTournament_Apply(t *testing.T) {
+	at := time.Date(2026, time.November, 1, 9, 0, 0, 0, time.UTC)
+	tournament := Tournament{ID: "tournament-1", PlayerCouples: []PlayerCouple{
+		categoryCouple("couple-1", 3, Player{ID: "p1"}, Player{ID: "p2"}),
+		categoryCouple("couple-2", 1, Player{ID: "p3"}, Player{ID: "p4"}),
+		{ID: "couple-3", Player1: Player{ID: "p5"}, Player2: Player{ID: "p6"}},
+		categoryCouple("couple-4", 2, Player{ID: "p7"}, Player{ID: "p8"}),
+	}}
+	assert.Equal(t, StatusDraft, tournament.CurrentStatus(), "Expected tournaments without status to be drafts")
+
+	_, err := tournament.Apply(TransitionStart, at)
+	assert.ErrorIs(t, err, ErrTransitionNotAllowed)
+	_, err = tournament.Apply("reopen", at)
+	assert.ErrorIs(t, err, ErrUnknownTransition)
+
+	for _, transition := range []Transition{TransitionOpenRegistration, TransitionCloseRegistration, TransitionPublishDraw, TransitionStart} {
+		_, err := tournament.Apply(transition, at)
+		assert.NoError(t, err, "Expected %s to be allowed", transition)
+	}
+	assert.Equal(t, StatusInProgress, tournament.Status)
+	// Seeded draw: the best couple plays the worst one (unranked), the second one plays the third one.
+	matches := tournament.Rounds[0].Matches
+	assert.Len(t, matches, 2)
+	assert.Equal(t, "R1-M01", matches[0].ID)
+	assert.Equal(t, []string{"couple-2", "couple-3"}, []string{matches[0].Couple1.ID, matches[0].Couple2.ID})
+	assert.Equal(t, []string{"couple-4", "couple-1"}, []string{matches[1].Couple1.ID, matches[1].Couple2.ID})
+
+	event, err := tournament.Apply(TransitionFinish, at)
+	assert.NoError(t, err)
+	assert.Equal(t, TournamentEvent{TournamentID: "tournament-1", Type: "TournamentFinished", From: StatusInProgress, To: StatusFinished, OccurredAt: at}, event)
+	_, err = tournament.Apply(TransitionCancel, at)
+	assert.ErrorIs(t, err, ErrTransitionNotAllowed, "Expected finished tournaments not to be cancelled")
+}
+
+func TestTournament_Apply_PublishDrawByCategory(t *testing.T) {
+	tournament := Tournament{ID: "tournament-1", Status: StatusRegistrationClosed, Categories: []Category{
+		{ID: "open", Entries: []PlayerCouple{couple("couple-1"), couple("couple-2"), couple("couple-3")}},
+		{ID: "empty"},
+	}}
+
+	_, err := tournament.Apply(TransitionPublishDraw, time.Now())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Match{{ID: "open-R1-M01", CategoryID: "open", Couple1: couple("couple-1"), Couple2: couple("couple-3")}},
+		tournament.Rounds[0].Matches, "Expected the middle couple to get a bye")
+
+	empty := Tournament{ID: "tournament-2", Status: StatusRegistrationClosed}
+	_, err = empty.Apply(TransitionPublishDraw, time.Now())
+	assert.ErrorIs(t, err, ErrNothingToDraw)
+	assert.Equal(t, StatusRegistrationClosed, empty.Status)
+}
+
+func TestTournament_Allows(t *testing.T) {
+	tournament := Tournament{Status: StatusRegistrationClosed}
+	assert.NoError(t, tournament.Allows(OperationWithdrawCouples))
+	assert.ErrorIs(t, tournament.Allows(OperationEnterCouples), ErrOperationNotAllowed)
+
+	_, err := tournament.RecordScore("m1", Score{})
+	assert.ErrorIs(t, err, ErrOperationNotAllowed, "Expected scores to be recorded in progress only")
+	assert.ErrorIs(t, (&Tournament{Status: StatusCancelled}).AddCategory(Category{ID: "open"}), ErrOperationNotAllowed)
+}
@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Couples of a match as scored by referees.
+const (
+	Couple1 = 1
+	Couple2 = 2
+)
+
+const (
+	gamesPerSet     = 6
+	tiebreakPoints  = 7
+	setsToWin       = 2
+	pointsToWinGame = 4
+)
+
+var (
+	ErrMatchFinished        = errors.New("match already finished")
+	ErrNoPointToUndo        = errors.New("no point to undo")
+	ErrLiveMatchConflict    = errors.New("live match updated concurrently")
+	gamePointLabels         = [...]string{"0", "15", "30", "40"}
+	advantageLabel          = "AD"
+	errInvalidScoringCouple = fmt.Errorf("couple must be %d or %d", Couple1, Couple2)
+)
+
+// LiveMatch is the point by point log of a match scored by referees, the score is derived from the log so
+// undoing a point is simply removing the last one. Matches are best of 3 sets with a tiebreak at 6-6.
+type LiveMatch struct {
+	ID           string `bson:"_id" json:"id"`
+	TournamentID string `bson:"tournamentId" json:"tournamentId"`
+	MatchID      string `bson:"matchId" json:"matchId"`
+	// GoldenPoint decides games at deuce with a single point (no advantages).
+	GoldenPoint bool      `bson:"goldenPoint" json:"goldenPoint"`
+	Points      []int     `bson:"points" json:"points"`
+	StartedAt   time.Time `bson:"startedAt" json:"startedAt"`
+	UpdatedAt   time.Time `bson:"updatedAt" json:"updatedAt"`
+}
+
+// LiveScore is the state of a live match after its last point.
+type LiveScore struct {
+	TournamentID string `json:"tournamentId"`
+	MatchID      string `json:"matchId"`
+	GoldenPoint  bool   `json:"goldenPoint"`
+	// Sets holds the finished sets followed by the current one.
+	Sets []GameSet `json:"sets"`
+	// Game is the current game, nil once the match is finished.
+	Game         *LiveGame `json:"game,omitempty"`
+	PointsPlayed int       `json:"pointsPlayed"`
+	Finished     bool      `json:"finished"`
+	Winner       int       `json:"winner,omitempty"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// LiveGame holds the points of the current game, i.e. "0", "15", "30", "40" or "AD", or the points count
+// of a tiebreak.
+type LiveGame struct {
+	PointsCouple1 string `json:"pointsCouple1"`
+	PointsCouple2 string `json:"pointsCouple2"`
+	Tiebreak      bool   `json:"tiebreak"`
+}
+
+func NewLiveMatch(tournamentID, matchID string, goldenPoint bool, startedAt time.Time) (*LiveMatch, error) {
+	for _, id := range []string{tournamentID, matchID} {
+		if err := ValidateID(id); err != nil {
+			return nil, err
+		}
+	}
+	return &LiveMatch{
+		//ID:          auto generated ID set in the repository.
+		TournamentID: tournamentID,
+		MatchID:      matchID,
+		GoldenPoint:  goldenPoint,
+		Points:       []int{},
+		StartedAt:    startedAt,
+		UpdatedAt:    startedAt,
+	}, nil
+}
+
+// ScorePoint adds a point won by the couple (Couple1 or Couple2).
+func (m *LiveMatch) ScorePoint(couple int, at time.Time) error {
+	if couple != Couple1 && couple != Couple2 {
+		return errInvalidScoringCouple
+	}
+	if m.Score().Finished {
+		return ErrMatchFinished
+	}
+	m.Points = append(m.Points, couple)
+	m.UpdatedAt = at
+	return nil
+}
+
+// UndoPoint removes the last point, reopening the match when it was the match point.
+func (m *LiveMatch) UndoPoint(at time.Time) error {
+	if len(m.Points) == 0 {
+		return ErrNoPointToUndo
+	}
+	m.Points = m.Points[:len(m.Points)-1]
+	m.UpdatedAt = at
+	return nil
+}
+
+// Score replays the points of the match.
+func (m *LiveMatch) Score() LiveScore {
+	state := liveState{goldenPoint: m.GoldenPoint}
+	for _, couple := range m.Points {
+		state.point(couple - 1)
+	}
+	return state.toLiveScore(*m)
+}
+
+// FinalScore returns the score of a finished match.
+func (s LiveScore) FinalScore() (*Score, error) {
+	if !s.Finished {
+		return nil, errors.New("match not finished")
+	}
+	score := &Score{Set1: s.Sets[0], Set2: s.Sets[1]}
+	if len(s.Sets) > 2 {
+		score.Set3 = &s.Sets[2]
+	}
+	return score, nil
+}
+
+// liveState is the state machine of the score, indexes are 0 for couple 1 and 1 for couple 2.
+type liveState struct {
+	goldenPoint bool
+	sets        []GameSet
+	setsWon     [2]int
+	games       [2]int
+	points      [2]int
+	tiebreak    bool
+	winner      int
+}
+
+func (s *liveState) point(couple int) {
+	s.points[couple]++
+	other := 1 - couple
+	if s.tiebreak {
+		if s.points[couple] >= tiebreakPoints && s.points[couple]-s.points[other] >= 2 {
+			s.games[couple]++
+			s.winSet(couple, &Tiebreak{PointsCouple1: s.points[0], PointsCouple2: s.points[1]})
+		}
+		return
+	}
+	// With golden point the deciding point at 40-40 wins the game.
+	if s.points[couple] >= pointsToWinGame && (s.goldenPoint || s.points[couple]-s.points[other] >= 2) {
+		s.points = [2]int{}
+		s.games[couple]++
+		switch {
+		case s.games[couple] >= gamesPerSet && s.games[couple]-s.games[other] >= 2:
+			s.winSet(couple, nil)
+		case s.games[couple] == gamesPerSet && s.games[other] == gamesPerSet:
+			s.tiebreak = true
+		}
+	}
+}
+
+func (s *liveState) winSet(couple int, tiebreak *Tiebreak) {
+	s.sets = append(s.sets, GameSet{GamesCouple1: s.games[0], GamesCouple2: s.games[1], Tiebreak: tiebreak})
+	s.setsWon[couple]++
+	s.games, s.points, s.tiebreak = [2]int{}, [2]int{}, false
+	if s.setsWon[couple] == setsToWin {
+		s.winner = couple + 1
+	}
+}
+
+func (s *liveState) toLiveScore(m LiveMatch) LiveScore {
+	score := LiveScore{
+		TournamentID: m.TournamentID,
+		MatchID:      m.MatchID,
+		GoldenPoint:  m.GoldenPoint,
+		Sets:         append([]GameSet{}, s.sets...),
+		PointsPlayed: len(m.Points),
+		Finished:     s.winner != 0,
+		Winner:       s.winner,
+		UpdatedAt:    m.UpdatedAt,
+	}
+	if score.Finished {
+		return score
+	}
+	current := GameSet{GamesCouple1: s.games[0], GamesCouple2: s.games[1]}
+	if s.tiebreak {
+		current.Tiebreak = &Tiebreak{PointsCouple1: s.points[0], PointsCouple2: s.points[1]}
+		score.Game = &LiveGame{PointsCouple1: strconv.Itoa(s.points[0]), PointsCouple2: strconv.Itoa(s.points[1]), Tiebreak: true}
+	} else {
+		score.Game = s.gamePoints()
+	}
+	score.Sets = append(score.Sets, current)
+	return score
+}
+
+func (s *liveState) gamePoints() *LiveGame {
+	label := func(couple int) string {
+		other := 1 - couple
+		if s.points[couple] >= pointsToWinGame-1 && s.points[other] >= pointsToWinGame-1 {
+			if s.points[couple] > s.points[other] {
+				return advantageLabel
+			}
+			return gamePointLabels[pointsToWinGame-1]
+		}
+		return gamePointLabels[s.points[couple]]
+	}
+	return &LiveGame{PointsCouple1: label(0), PointsCouple2: label(1)}
+}
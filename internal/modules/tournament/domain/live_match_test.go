@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var liveAt = time.Date(2026, time.November, 1, 10, 0, 0, 0, time.UTC)
+
+func newTestLiveMatch(t *testing.T, goldenPoint bool) *LiveMatch {
+	liveMatch, err := NewLiveMatch("tournament-1", "match-1", goldenPoint, liveAt)
+	assert.NoError(t, err)
+	return liveMatch
+}
+
+// score adds the points in order, e.g. score(m, 1, 1, 2) is 30-15.
+func score(t *testing.T, m *LiveMatch, couples ...int) {
+	for _, couple := range couples {
+		assert.NoError(t, m.ScorePoint(couple, liveAt))
+	}
+}
+
+func repeat(couple, times int) []int {
+	points := make([]int, times)
+	for i := range points {
+		points[i] = couple
+	}
+	return points
+}
+
+// games returns the points of the games won in order by the couples.
+func games(couples ...int) []int {
+	var points []int
+	for _, couple := range couples {
+		points = append(points, repeat(couple, 4)...)
+	}
+	return points
+}
+
+func TestNewLiveMatch(t *testing.T) {
+	_, err := NewLiveMatch("t", "match-1", false, liveAt)
+	assert.Error(t, err)
+
+	liveMatch := newTestLiveMatch(t, false)
+	assert.Equal(t, LiveScore{
+		TournamentID: "tournament-1", MatchID: "match-1",
+		Sets:      []GameSet{{}},
+		Game:      &LiveGame{PointsCouple1: "0", PointsCouple2: "0"},
+		UpdatedAt: liveAt,
+	}, liveMatch.Score())
+}
+
+func TestLiveMatch_GamePoints(t *testing.T) {
+	liveMatch := newTestLiveMatch(t, false)
+
+	score(t, liveMatch, Couple1, Couple1, Couple2)
+	assert.Equal(t, &LiveGame{PointsCouple1: "30", PointsCouple2: "15"}, liveMatch.Score().Game)
+
+	score(t, liveMatch, Couple2, Couple2, Couple1)
+	assert.Equal(t, &LiveGame{PointsCouple1: "40", PointsCouple2: "40"}, liveMatch.Score().Game, "Expected deuce")
+
+	score(t, liveMatch, Couple2)
+	assert.Equal(t, &LiveGame{PointsCouple1: "40", PointsCouple2: "AD"}, liveMatch.Score().Game)
+
+	score(t, liveMatch, Couple1)
+	assert.Equal(t, &LiveGame{PointsCouple1: "40", PointsCouple2: "40"}, liveMatch.Score().Game, "Expected back to deuce")
+
+	score(t, liveMatch, Couple1, Couple1)
+	assert.Equal(t, []GameSet{{GamesCouple1: 1}}, liveMatch.Score().Sets)
+	assert.Equal(t, &LiveGame{PointsCouple1: "0", PointsCouple2: "0"}, liveMatch.Score().Game)
+}
+
+func TestLiveMatch_GoldenPoint(t *testing.T) {
+	liveMatch := newTestLiveMatch(t, true)
+
+	score(t, liveMatch, Couple1, Couple1, Couple1, Couple2, Couple2, Couple2)
+	assert.Equal(t, &LiveGame{PointsCouple1: "40", PointsCouple2: "40"}, liveMatch.Score().Game)
+
+	score(t, liveMatch, Couple2)
+	assert.Equal(t, []GameSet{{GamesCouple2: 1}}, liveMatch.Score().Sets, "Expected the golden point to decide the game")
+}
+
+func TestLiveMatch_SetAndTiebreak(t *testing.T) {
+	liveMatch := newTestLiveMatch(t, false)
+
+	// 6-4
+	score(t, liveMatch, games(1, 2, 1, 2, 1, 2, 1, 2, 1, 1)...)
+	assert.Equal(t, []GameSet{{GamesCouple1: 6, GamesCouple2: 4}, {}}, liveMatch.Score().Sets)
+
+	// 5-5, 6-6 then tiebreak
+	score(t, liveMatch, games(1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2)...)
+	score(t, liveMatch, Couple1, Couple2, Couple2)
+	current := liveMatch.Score()
+	assert.Equal(t, &LiveGame{PointsCouple1: "1", PointsCouple2: "2", Tiebreak: true}, current.Game)
+	assert.Equal(t, GameSet{GamesCouple1: 6, GamesCouple2: 6, Tiebreak: &Tiebreak{PointsCouple1: 1, PointsCouple2: 2}}, current.Sets[1])
+
+	// Tiebreaks are won by 2 points.
+	score(t, liveMatch, repeat(Couple1, 5)...)
+	score(t, liveMatch, repeat(Couple2, 4)...)
+	assert.False(t, liveMatch.Score().Finished)
+	assert.Equal(t, &Tiebreak{PointsCouple1: 6, PointsCouple2: 6}, liveMatch.Score().Sets[1].Tiebreak)
+	score(t, liveMatch, Couple2, Couple2)
+	assert.Equal(t, []GameSet{
+		{GamesCouple1: 6, GamesCouple2: 4},
+		{GamesCouple1: 6, GamesCouple2: 7, Tiebreak: &Tiebreak{PointsCouple1: 6, PointsCouple2: 8}},
+		{},
+	}, liveMatch.Score().Sets)
+}
+
+func TestLiveMatch_FinishAndUndo(t *testing.T) {
+	liveMatch := newTestLiveMatch(t, false)
+	score(t, liveMatch, games(repeat(1, 6)...)...)
+	score(t, liveMatch, games(1, 1, 1, 1, 1)...)
+	score(t, liveMatch, repeat(Couple1, 3)...)
+
+	_, err := liveMatch.Score().FinalScore()
+	assert.Error(t, err, "Expected no final score before match point")
+
+	score(t, liveMatch, Couple1)
+	final := liveMatch.Score()
+	assert.True(t, final.Finished)
+	assert.Equal(t, Couple1, final.Winner)
+	assert.Nil(t, final.Game)
+	score1, err := final.FinalScore()
+	assert.NoError(t, err)
+	assert.Equal(t, &Score{Set1: GameSet{GamesCouple1: 6}, Set2: GameSet{GamesCouple1: 6}}, score1)
+	assert.NoError(t, ValidateScore(*score1))
+	assert.ErrorIs(t, liveMatch.ScorePoint(Couple2, liveAt), ErrMatchFinished)
+
+	// Undoing the match point reopens the match.
+	assert.NoError(t, liveMatch.UndoPoint(liveAt.Add(time.Minute)))
+	reopened := liveMatch.Score()
+	assert.False(t, reopened.Finished)
+	assert.Equal(t, &LiveGame{PointsCouple1: "40", PointsCouple2: "0"}, reopened.Game)
+	assert.Equal(t, liveAt.Add(time.Minute), reopened.UpdatedAt)
+}
+
+func TestLiveMatch_ThirdSet(t *testing.T) {
+	liveMatch := newTestLiveMatch(t, true)
+	score(t, liveMatch, games(repeat(1, 6)...)...)
+	score(t, liveMatch, games(repeat(2, 6)...)...)
+	score(t, liveMatch, games(2, 2, 2, 2, 2, 1, 2)...)
+
+	final, err := liveMatch.Score().FinalScore()
+	assert.NoError(t, err)
+	assert.Equal(t, &GameSet{GamesCouple1: 1, GamesCouple2: 6}, final.Set3)
+	assert.Equal(t, Couple2, liveMatch.Score().Winner)
+}
+
+func TestLiveMatch_InvalidPoints(t *testing.T) {
+	liveMatch := newTestLiveMatch(t, false)
+
+	assert.Error(t, liveMatch.ScorePoint(3, liveAt))
+	assert.ErrorIs(t, liveMatch.UndoPoint(liveAt), ErrNoPointToUndo)
+}
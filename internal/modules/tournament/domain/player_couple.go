@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 // Adapted copy similar to player_couple module.
 // (not imported as its a different module so it must be a copy for tournaments).
 // Used to retrieve registered players domain objects from tournament (repository).
@@ -9,7 +11,24 @@ type Player struct {
 	SocialSecurityNumber *string `bson:"socialSecurityNumber,omitempty" json:"socialSecurityNumber,omitempty"`
 	FirstName            string  `bson:"firstName" json:"firstName"`
 	LastName             string  `bson:"lastName" json:"lastName"`
-	Age                  *int    `bson:"age,omitempty" json:"age,omitempty"`
+	// BirthDate (2006-01-02) is only read to check the age limits of categories, it isn't part of brackets.
+	BirthDate string `bson:"birthDate,omitempty" json:"-"`
+	// Gender is only known once set in the player profile.
+	Gender Gender `bson:"gender,omitempty" json:"gender,omitempty"`
+}
+
+// AgeOn returns the age in years on the date, nil when the birth date is unknown (or invalid).
+func (p Player) AgeOn(date time.Time) *int {
+	birthDate, err := time.Parse(time.DateOnly, p.BirthDate)
+	if err != nil {
+		return nil
+	}
+	date = date.UTC()
+	age := date.Year() - birthDate.Year()
+	if date.Month() < birthDate.Month() || (date.Month() == birthDate.Month() && date.Day() < birthDate.Day()) {
+		age--
+	}
+	return &age
 }
 
 type PlayerCouple struct {
@@ -0,0 +1,452 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Outcome tells how a match ended.
+type Outcome string
+
+const (
+	// OutcomeCompleted is a match played until the end, its winner is the one of the score.
+	OutcomeCompleted Outcome = "completed"
+	// OutcomeRetired is a match the loser couldn't finish (e.g. an injury), the score is partial.
+	OutcomeRetired Outcome = "retired"
+	// OutcomeWalkover is a match not played because the loser didn't show up.
+	OutcomeWalkover Outcome = "walkover"
+	// OutcomeDisqualified is a match lost by disqualification, the score is partial.
+	OutcomeDisqualified Outcome = "disqualified"
+	// OutcomeCancelled is a match that won't be played, e.g. rained off, it has no winner.
+	OutcomeCancelled Outcome = "cancelled"
+)
+
+// CancelledAdvancement tells who advances in the bracket after a cancelled match.
+type CancelledAdvancement string
+
+const (
+	// AdvanceNone eliminates both couples.
+	AdvanceNone CancelledAdvancement = "none"
+	// AdvanceBetterSeed lets the couple with the best ranking advance (couple 1 on ties).
+	AdvanceBetterSeed CancelledAdvancement = "better-seed"
+)
+
+var (
+	ErrInvalidResult    = errors.New("invalid result")
+	ErrRoundNotFinished = errors.New("round not finished")
+	ErrBracketDecided   = errors.New("no couple left to advance")
+)
+
+// Result is the outcome of a match, its (partial) score being Match.Score.
+type Result struct {
+	Outcome Outcome `bson:"outcome" json:"outcome"`
+	// Winner is 1 or 2 (the couple), 0 for cancelled matches.
+	Winner int    `bson:"winner,omitempty" json:"winner,omitempty"`
+	Reason string `bson:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// ResultRules configure the standings and the bracket advancement of a tournament, see DefaultResultRules.
+type ResultRules struct {
+	WinPoints  int `bson:"winPoints" json:"winPoints"`
+	LossPoints int `bson:"lossPoints" json:"lossPoints"`
+	// RetiredLossPoints, WalkoverLossPoints and DisqualifiedLossPoints replace LossPoints for these outcomes,
+	// they can be negative to penalize the loser.
+	RetiredLossPoints      int `bson:"retiredLossPoints" json:"retiredLossPoints"`
+	WalkoverLossPoints     int `bson:"walkoverLossPoints" json:"walkoverLossPoints"`
+	DisqualifiedLossPoints int `bson:"disqualifiedLossPoints" json:"disqualifiedLossPoints"`
+	// CountPartialScores counts the sets and games of retired and disqualified matches.
+	CountPartialScores bool `bson:"countPartialScores" json:"countPartialScores"`
+	// WalkoverSets credits the winner of a walkover with 6-0 sets, i.e. the sets needed to win a match.
+	WalkoverSets bool `bson:"walkoverSets" json:"walkoverSets"`
+	// DisqualifiedLast ranks the disqualified couples last whatever their points.
+	DisqualifiedLast     bool                 `bson:"disqualifiedLast" json:"disqualifiedLast"`
+	CancelledAdvancement CancelledAdvancement `bson:"cancelledAdvancement" json:"cancelledAdvancement"`
+}
+
+// DefaultResultRules are used until the tournament configures its own: 3 points a win, 1 a loss on court, none
+// a walkover or disqualification.
+func DefaultResultRules() ResultRules {
+	return ResultRules{WinPoints: 3, LossPoints: 1, RetiredLossPoints: 1, CountPartialScores: true, WalkoverSets: true,
+		DisqualifiedLast: true, CancelledAdvancement: AdvanceNone}
+}
+
+// Validate checks the rules built without DefaultResultRules (e.g. decoded from requests).
+func (r ResultRules) Validate() error {
+	if r.WinPoints <= max(r.LossPoints, r.RetiredLossPoints, r.WalkoverLossPoints, r.DisqualifiedLossPoints) {
+		return fmt.Errorf("winPoints must be greater than loss points: %d", r.WinPoints)
+	}
+	switch r.CancelledAdvancement {
+	case AdvanceNone, AdvanceBetterSeed:
+		return nil
+	default:
+		return fmt.Errorf("invalid cancelledAdvancement: %q", r.CancelledAdvancement)
+	}
+}
+
+// Rules returns the result rules of the tournament, DefaultResultRules unless configured.
+func (t *Tournament) Rules() ResultRules {
+	if t.ResultRules == nil {
+		return DefaultResultRules()
+	}
+	return *t.ResultRules
+}
+
+// ConfigureResultRules sets the rules of the tournament, they can't change once it's in progress.
+func (t *Tournament) ConfigureResultRules(rules ResultRules) error {
+	if err := t.Allows(OperationConfigureRules); err != nil {
+		return err
+	}
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+	t.ResultRules = &rules
+	return nil
+}
+
+// winner returns the couple (1 or 2) who won the set, 0 while it isn't finished. A set is won with 6 games and
+// 2 ahead or with 7 games, a tiebreak (or a super tiebreak scored with 0-0 games) with 7 points and 2 ahead.
+func (s GameSet) winner() int {
+	games1, games2 := s.GamesCouple1, s.GamesCouple2
+	switch {
+	case games1 == games2 && s.Tiebreak != nil:
+		return ahead(s.Tiebreak.PointsCouple1, s.Tiebreak.PointsCouple2, tiebreakPoints, 2)
+	case max(games1, games2) == gamesPerSet+1:
+		// 7-5, or 7-6 after a tiebreak.
+		return ahead(games1, games2, gamesPerSet+1, 1)
+	default:
+		return ahead(games1, games2, gamesPerSet, 2)
+	}
+}
+
+// ahead returns the couple reaching target with the margin, 0 when none does.
+func ahead(couple1, couple2, target, margin int) int {
+	switch {
+	case couple1 >= target && couple1-couple2 >= margin:
+		return Couple1
+	case couple2 >= target && couple2-couple1 >= margin:
+		return Couple2
+	default:
+		return 0
+	}
+}
+
+func (s Score) sets() []GameSet {
+	if s.Set3 == nil {
+		return []GameSet{s.Set1, s.Set2}
+	}
+	return []GameSet{s.Set1, s.Set2, *s.Set3}
+}
+
+// Winner returns the couple (1 or 2) who won the sets needed to win the match, 0 when the score is undecided.
+func (s Score) Winner() int {
+	won := [3]int{}
+	for _, set := range s.sets() {
+		won[set.winner()]++
+	}
+	switch {
+	case won[Couple1] >= setsToWin:
+		return Couple1
+	case won[Couple2] >= setsToWin:
+		return Couple2
+	default:
+		return 0
+	}
+}
+
+// NewResult validates the outcome against the (partial) score: completed matches need a decided score whose
+// winner is the one of the result (derived when 0), walkovers and cancellations have no score.
+func NewResult(outcome Outcome, winner int, reason string, score *Score) (*Result, error) {
+	if score != nil {
+		if err := ValidateScore(*score); err != nil {
+			return nil, err
+		}
+	}
+	switch outcome {
+	case OutcomeCompleted:
+		if score == nil || score.Winner() == 0 {
+			return nil, fmt.Errorf("%w: completed matches need a decided score", ErrInvalidResult)
+		}
+		if winner != 0 && winner != score.Winner() {
+			return nil, fmt.Errorf("%w: winner %d doesn't match the score", ErrInvalidResult, winner)
+		}
+		winner = score.Winner()
+	case OutcomeRetired, OutcomeDisqualified:
+		if winner != Couple1 && winner != Couple2 {
+			return nil, fmt.Errorf("%w: winner must be 1 or 2", ErrInvalidResult)
+		}
+	case OutcomeWalkover:
+		if winner != Couple1 && winner != Couple2 {
+			return nil, fmt.Errorf("%w: winner must be 1 or 2", ErrInvalidResult)
+		}
+		if score != nil {
+			return nil, fmt.Errorf("%w: walkovers have no score", ErrInvalidResult)
+		}
+	case OutcomeCancelled:
+		if winner != 0 || score != nil {
+			return nil, fmt.Errorf("%w: cancelled matches have no winner nor score", ErrInvalidResult)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown outcome %q", ErrInvalidResult, outcome)
+	}
+	return &Result{Outcome: outcome, Winner: winner, Reason: reason}, nil
+}
+
+// Decided returns the result of the match, matches only scored (see RecordScore) being completed once their
+// score is decided.
+func (m *Match) Decided() (Result, bool) {
+	if m.Result != nil {
+		return *m.Result, true
+	}
+	if m.Score != nil && m.Score.Winner() != 0 {
+		return Result{Outcome: OutcomeCompleted, Winner: m.Score.Winner()}, true
+	}
+	return Result{}, false
+}
+
+// RecordResult sets (or corrects) the outcome of the match with its (partial) score, see NewResult.
+func (t *Tournament) RecordResult(matchID string, result Result, score *Score) (*Match, error) {
+	if err := t.Allows(OperationScoreMatches); err != nil {
+		return nil, err
+	}
+	validated, err := NewResult(result.Outcome, result.Winner, result.Reason, score)
+	if err != nil {
+		return nil, err
+	}
+	match, err := t.FindMatch(matchID)
+	if err != nil {
+		return nil, err
+	}
+	match.Result, match.Score = validated, score
+	return match, nil
+}
+
+// Standing is the record of a couple in the decided matches of a tournament (or category).
+type Standing struct {
+	Position     int    `json:"position"`
+	CoupleID     string `json:"coupleId"`
+	Played       int    `json:"played"`
+	Won          int    `json:"won"`
+	Lost         int    `json:"lost"`
+	Walkovers    int    `json:"walkovers"`
+	Disqualified bool   `json:"disqualified"`
+	SetsWon      int    `json:"setsWon"`
+	SetsLost     int    `json:"setsLost"`
+	GamesWon     int    `json:"gamesWon"`
+	GamesLost    int    `json:"gamesLost"`
+	Points       int    `json:"points"`
+}
+
+// Standings ranks the couples of the category ("" for every match) by points, set difference and game
+// difference, according to the result rules of the tournament.
+func (t *Tournament) Standings(categoryID string) []Standing {
+	rules := t.Rules()
+	var order []string
+	byCouple := map[string]*Standing{}
+	standing := func(coupleID string) *Standing {
+		if _, ok := byCouple[coupleID]; !ok {
+			order = append(order, coupleID)
+			byCouple[coupleID] = &Standing{CoupleID: coupleID}
+		}
+		return byCouple[coupleID]
+	}
+	for _, match := range t.matches(categoryID) {
+		result, ok := match.Decided()
+		if !ok || result.Outcome == OutcomeCancelled {
+			continue
+		}
+		couples := [2]*Standing{standing(match.Couple1.ID), standing(match.Couple2.ID)}
+		winner, loser := couples[result.Winner-1], couples[2-result.Winner]
+		winner.Won++
+		winner.Points += rules.WinPoints
+		loser.Lost++
+		switch result.Outcome {
+		case OutcomeCompleted:
+			loser.Points += rules.LossPoints
+		case OutcomeRetired:
+			loser.Points += rules.RetiredLossPoints
+		case OutcomeWalkover:
+			loser.Walkovers++
+			loser.Points += rules.WalkoverLossPoints
+		case OutcomeDisqualified:
+			loser.Disqualified = true
+			loser.Points += rules.DisqualifiedLossPoints
+		}
+		if result.Outcome != OutcomeWalkover {
+			winner.Played++
+			loser.Played++
+		}
+		countScore := match.Score != nil && (result.Outcome == OutcomeCompleted || rules.CountPartialScores)
+		if countScore {
+			for _, set := range match.Score.sets() {
+				couples[0].addSet(set.GamesCouple1, set.GamesCouple2, set.winner(), Couple1)
+				couples[1].addSet(set.GamesCouple2, set.GamesCouple1, set.winner(), Couple2)
+			}
+		}
+		if result.Outcome == OutcomeWalkover && rules.WalkoverSets {
+			for range setsToWin {
+				winner.addSet(gamesPerSet, 0, Couple1, Couple1)
+				loser.addSet(0, gamesPerSet, Couple1, Couple2)
+			}
+		}
+	}
+
+	standings := make([]Standing, 0, len(order))
+	for _, coupleID := range order {
+		standings = append(standings, *byCouple[coupleID])
+	}
+	slices.SortStableFunc(standings, func(a, b Standing) int {
+		if rules.DisqualifiedLast && a.Disqualified != b.Disqualified {
+			if a.Disqualified {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Or(
+			cmp.Compare(b.Points, a.Points),
+			cmp.Compare(b.SetsWon-b.SetsLost, a.SetsWon-a.SetsLost),
+			cmp.Compare(b.GamesWon-b.GamesLost, a.GamesWon-a.GamesLost),
+		)
+	})
+	for s := range standings {
+		standings[s].Position = s + 1
+	}
+	return standings
+}
+
+// addSet counts the games of the set, and the set itself once it has a winner (1 or 2), for the couple (1 or 2).
+func (s *Standing) addSet(gamesWon, gamesLost, winner, couple int) {
+	s.GamesWon += gamesWon
+	s.GamesLost += gamesLost
+	switch winner {
+	case 0:
+	case couple:
+		s.SetsWon++
+	default:
+		s.SetsLost++
+	}
+}
+
+// matches returns the matches of the category, or every match when categoryID is empty.
+func (t *Tournament) matches(categoryID string) []*Match {
+	var matches []*Match
+	for r := range t.Rounds {
+		for m := range t.Rounds[r].Matches {
+			if match := &t.Rounds[r].Matches[m]; categoryID == "" || match.CategoryID == categoryID {
+				matches = append(matches, match)
+			}
+		}
+	}
+	return matches
+}
+
+// Advancing returns the couple advancing in the bracket, none after a cancelled match unless the rules let
+// the better seed advance.
+func (m *Match) Advancing(rules ResultRules) (PlayerCouple, bool) {
+	result, ok := m.Decided()
+	if !ok {
+		return PlayerCouple{}, false
+	}
+	switch {
+	case result.Winner == Couple1:
+		return m.Couple1, true
+	case result.Winner == Couple2:
+		return m.Couple2, true
+	case rules.CancelledAdvancement == AdvanceBetterSeed && rankingOrder(m.Couple2) < rankingOrder(m.Couple1):
+		return m.Couple2, true
+	case rules.CancelledAdvancement == AdvanceBetterSeed:
+		return m.Couple1, true
+	default:
+		return PlayerCouple{}, false
+	}
+}
+
+// AdvanceRound adds the next round of the bracket once every match of the last round is decided. Couples
+// advancing from consecutive matches play each other, the last one being exempt (bye) when the count is odd.
+// Every category has its own bracket, match IDs following the ones of the draw (e.g. "R2-M01").
+func (t *Tournament) AdvanceRound() (*Round, error) {
+	if err := t.Allows(OperationAdvanceRounds); err != nil {
+		return nil, err
+	}
+	if len(t.Rounds) == 0 {
+		return nil, ErrNothingToDraw
+	}
+	last := t.Rounds[len(t.Rounds)-1]
+	for m := range last.Matches {
+		if _, ok := last.Matches[m].Decided(); !ok {
+			return nil, fmt.Errorf("%w: match %s", ErrRoundNotFinished, last.Matches[m].ID)
+		}
+	}
+	number := last.Number + 1
+	var matches []Match
+	for _, bracket := range t.brackets() {
+		alive := t.aliveCouples(bracket)
+		prefix := ""
+		if bracket.categoryID != "" {
+			prefix = bracket.categoryID + "-"
+		}
+		for i := 0; i+1 < len(alive); i += 2 {
+			matches = append(matches, Match{
+				ID:         prefix + MatchCode(number, i/2+1),
+				Timestamp:  t.Timestamp,
+				CategoryID: bracket.categoryID,
+				Couple1:    alive[i],
+				Couple2:    alive[i+1],
+			})
+		}
+	}
+	if len(matches) == 0 {
+		return nil, ErrBracketDecided
+	}
+	t.Rounds = append(t.Rounds, Round{Number: number, Matches: matches})
+	return &t.Rounds[len(t.Rounds)-1], nil
+}
+
+type bracket struct {
+	categoryID string
+	entries    []PlayerCouple
+}
+
+// brackets are the categories of the tournament, or its couples without categories.
+func (t *Tournament) brackets() []bracket {
+	if len(t.Categories) == 0 {
+		return []bracket{{entries: t.PlayerCouples}}
+	}
+	brackets := make([]bracket, 0, len(t.Categories))
+	for _, category := range t.Categories {
+		brackets = append(brackets, bracket{categoryID: category.ID, entries: category.Entries})
+	}
+	return brackets
+}
+
+// aliveCouples replays the rounds of the bracket: couples advancing from the matches of a round, in match order,
+// are followed by the ones exempt from it.
+func (t *Tournament) aliveCouples(b bracket) []PlayerCouple {
+	alive := slices.Clone(b.entries)
+	rules := t.Rules()
+	for _, round := range t.Rounds {
+		var advancing []PlayerCouple
+		played := map[string]bool{}
+		for m := range round.Matches {
+			match := &round.Matches[m]
+			if match.CategoryID != b.categoryID {
+				continue
+			}
+			played[match.Couple1.ID], played[match.Couple2.ID] = true, true
+			if couple, ok := match.Advancing(rules); ok {
+				advancing = append(advancing, couple)
+			}
+		}
+		if len(played) == 0 {
+			continue
+		}
+		for _, couple := range alive {
+			if !played[couple.ID] {
+				advancing = append(advancing, couple)
+			}
+		}
+		alive = advancing
+	}
+	return alive
+}
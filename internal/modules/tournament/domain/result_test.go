@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setsScore(sets ...GameSet) *Score {
+	s := &Score{Set1: sets[0], Set2: sets[1]}
+	if len(sets) > 2 {
+		s.Set3 = &sets[2]
+	}
+	return s
+}
+
+func TestNewResult(t *testing.T) {
+	won := setsScore(GameSet{GamesCouple1: 6, GamesCouple2: 4}, GameSet{GamesCouple1: 3, GamesCouple2: 6}, GameSet{GamesCouple1: 7, GamesCouple2: 6})
+	partial := setsScore(GameSet{GamesCouple1: 6, GamesCouple2: 4}, GameSet{GamesCouple1: 2, GamesCouple2: 1})
+
+	result, err := NewResult(OutcomeCompleted, 0, "", won)
+	assert.NoError(t, err)
+	assert.Equal(t, Couple1, result.Winner, "Expected the winner to be derived from the score")
+	_, err = NewResult(OutcomeCompleted, Couple2, "", won)
+	assert.ErrorIs(t, err, ErrInvalidResult)
+	_, err = NewResult(OutcomeCompleted, Couple1, "", partial)
+	assert.ErrorIs(t, err, ErrInvalidResult, "Expected completed matches to need a decided score")
+
+	result, err = NewResult(OutcomeRetired, Couple2, "injury", partial)
+	assert.NoError(t, err)
+	assert.Equal(t, Result{Outcome: OutcomeRetired, Winner: Couple2, Reason: "injury"}, *result)
+	_, err = NewResult(OutcomeWalkover, Couple1, "", partial)
+	assert.ErrorIs(t, err, ErrInvalidResult)
+	_, err = NewResult(OutcomeDisqualified, 0, "", nil)
+	assert.ErrorIs(t, err, ErrInvalidResult)
+	_, err = NewResult(OutcomeCancelled, Couple1, "", nil)
+	assert.ErrorIs(t, err, ErrInvalidResult)
+	_, err = NewResult("abandoned", Couple1, "", nil)
+	assert.ErrorIs(t, err, ErrInvalidResult)
+}
+
+// resultsTournament has couple-1 winning on court, couple-2 retiring, couple-3 not showing up and couple-4 being
+// disqualified.
+func resultsTournament(rules *ResultRules) Tournament {
+	tournament := Tournament{ID: "tournament-1", Status: StatusInProgress, ResultRules: rules, Rounds: []Round{{Number: 1, Matches: []Match{
+		{ID: "match-1", Couple1: couple("couple-1"), Couple2: couple("couple-2")},
+		{ID: "match-2", Couple1: couple("couple-3"), Couple2: couple("couple-4")},
+		{ID: "match-3", Couple1: couple("couple-2"), Couple2: couple("couple-3")},
+		{ID: "match-4", Couple1: couple("couple-4"), Couple2: couple("couple-1")},
+	}}}}
+	_, _ = tournament.RecordScore("match-1", *setsScore(GameSet{GamesCouple1: 6, GamesCouple2: 4}, GameSet{GamesCouple1: 6, GamesCouple2: 4}))
+	_, _ = tournament.RecordResult("match-2", Result{Outcome: OutcomeWalkover, Winner: Couple2}, nil)
+	_, _ = tournament.RecordResult("match-3", Result{Outcome: OutcomeRetired, Winner: Couple2},
+		setsScore(GameSet{GamesCouple1: 6, GamesCouple2: 2}, GameSet{GamesCouple1: 1, GamesCouple2: 0}))
+	_, _ = tournament.RecordResult("match-4", Result{Outcome: OutcomeDisqualified, Winner: Couple2}, nil)
+	return tournament
+}
+
+func TestTournament_Standings(t *testing.T) {
+	tournament := resultsTournament(nil)
+	standings := tournament.Standings("")
+
+	assert.Equal(t, []Standing{
+		{Position: 1, CoupleID: "couple-1", Played: 2, Won: 2, SetsWon: 2, GamesWon: 12, GamesLost: 8, Points: 6},
+		{Position: 2, CoupleID: "couple-3", Played: 1, Won: 1, Lost: 1, Walkovers: 1, SetsLost: 3, GamesWon: 2, GamesLost: 19, Points: 3},
+		{Position: 3, CoupleID: "couple-2", Played: 2, Lost: 2, SetsWon: 1, SetsLost: 2, GamesWon: 15, GamesLost: 14, Points: 2},
+		{Position: 4, CoupleID: "couple-4", Played: 1, Won: 1, Lost: 1, Disqualified: true, SetsWon: 2, GamesWon: 12, Points: 3},
+	}, standings)
+}
+
+func TestTournament_Standings_ConfiguredRules(t *testing.T) {
+	rules := ResultRules{WinPoints: 2, LossPoints: 1, RetiredLossPoints: 0, WalkoverLossPoints: -1, CancelledAdvancement: AdvanceNone}
+
+	tournament := resultsTournament(&rules)
+	standings := tournament.Standings("")
+
+	points := map[string]int{}
+	for _, standing := range standings {
+		points[standing.CoupleID] = standing.Points
+	}
+	assert.Equal(t, map[string]int{"couple-1": 4, "couple-2": 1, "couple-3": 1, "couple-4": 2}, points)
+	assert.Equal(t, "couple-4", standings[1].CoupleID, "Expected disqualified couples to keep their rank")
+	assert.Zero(t, standings[1].SetsWon, "Expected walkovers not to be credited with sets")
+}
+
+func TestTournament_ConfigureResultRules(t *testing.T) {
+	tournament := Tournament{Status: StatusRegistrationOpen}
+	assert.Error(t, tournament.ConfigureResultRules(ResultRules{WinPoints: 1, LossPoints: 1, CancelledAdvancement: AdvanceNone}))
+	assert.Error(t, tournament.ConfigureResultRules(ResultRules{WinPoints: 2, CancelledAdvancement: "coin-toss"}))
+	assert.NoError(t, tournament.ConfigureResultRules(ResultRules{WinPoints: 2, CancelledAdvancement: AdvanceBetterSeed}))
+	assert.Equal(t, 2, tournament.Rules().WinPoints)
+
+	tournament.Status = StatusInProgress
+	assert.ErrorIs(t, tournament.ConfigureResultRules(DefaultResultRules()), ErrOperationNotAllowed)
+}
+
+func TestTournament_AdvanceRound(t *testing.T) {
+	couples := []PlayerCouple{
+		categoryCouple("couple-1", 1, Player{ID: "p1"}, Player{ID: "p2"}),
+		categoryCouple("couple-2", 2, Player{ID: "p3"}, Player{ID: "p4"}),
+		categoryCouple("couple-3", 3, Player{ID: "p5"}, Player{ID: "p6"}),
+		categoryCouple("couple-4", 4, Player{ID: "p7"}, Player{ID: "p8"}),
+		categoryCouple("couple-5", 5, Player{ID: "p9"}, Player{ID: "p10"}),
+	}
+	tournament := Tournament{ID: "tournament-1", Status: StatusRegistrationClosed, PlayerCouples: couples}
+	_, _ = tournament.Apply(TransitionPublishDraw, tournament.Timestamp)
+	_, _ = tournament.Apply(TransitionStart, tournament.Timestamp)
+	// Draw: couple-1 vs couple-5, couple-2 vs couple-4, couple-3 exempt.
+
+	_, err := tournament.AdvanceRound()
+	assert.ErrorIs(t, err, ErrRoundNotFinished)
+
+	_, _ = tournament.RecordResult("R1-M01", Result{Outcome: OutcomeWalkover, Winner: Couple2}, nil)
+	_, _ = tournament.RecordResult("R1-M02", Result{Outcome: OutcomeRetired, Winner: Couple1}, nil)
+	round, err := tournament.AdvanceRound()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, round.Number)
+	assert.Len(t, round.Matches, 1)
+	assert.Equal(t, "R2-M01", round.Matches[0].ID)
+	assert.Equal(t, []string{"couple-5", "couple-2"}, []string{round.Matches[0].Couple1.ID, round.Matches[0].Couple2.ID},
+		"Expected couple-3 to stay exempt while the count is odd")
+
+	_, _ = tournament.RecordResult("R2-M01", Result{Outcome: OutcomeCancelled}, nil)
+	_, err = tournament.AdvanceRound()
+	assert.ErrorIs(t, err, ErrBracketDecided, "Expected the cancelled match to eliminate both couples")
+	assert.Len(t, tournament.Rounds, 2)
+}
@@ -0,0 +1,387 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	minMatchMinutes = 15
+	maxMatchMinutes = 300
+	maxRestMinutes  = 24 * 60
+)
+
+var (
+	ErrSchedulingNotConfigured = errors.New("scheduling not configured")
+	ErrCourtNotFound           = errors.New("court not found")
+	ErrNoCourtAvailable        = errors.New("no court available")
+)
+
+// Scheduling holds the venues of a tournament and the estimates used to schedule its matches.
+type Scheduling struct {
+	Venues []Venue `bson:"venues" json:"venues"`
+	// MatchMinutes is the estimated duration of a match.
+	MatchMinutes int `bson:"matchMinutes" json:"matchMinutes"`
+	// RestMinutes is the minimum time between the end of a match of a couple and its next one.
+	RestMinutes int `bson:"restMinutes" json:"restMinutes"`
+}
+
+type Venue struct {
+	ID     string  `bson:"_id" json:"id"`
+	Name   string  `bson:"name" json:"name"`
+	Courts []Court `bson:"courts" json:"courts"`
+}
+
+type Court struct {
+	ID   string `bson:"_id" json:"id"`
+	Name string `bson:"name" json:"name"`
+	// Availability are the time windows where matches can be played on the court.
+	Availability []TimeWindow `bson:"availability" json:"availability"`
+}
+
+type TimeWindow struct {
+	Start time.Time `bson:"start" json:"start"`
+	End   time.Time `bson:"end" json:"end"`
+}
+
+func (w TimeWindow) contains(start, end time.Time) bool {
+	return !start.Before(w.Start) && !end.After(w.End)
+}
+
+func (w TimeWindow) overlaps(start, end time.Time) bool {
+	return start.Before(w.End) && w.Start.Before(end)
+}
+
+// NewScheduling validates venues (court IDs are unique across venues) and estimates.
+func NewScheduling(venues []Venue, matchMinutes, restMinutes int) (*Scheduling, error) {
+	if matchMinutes < minMatchMinutes || matchMinutes > maxMatchMinutes {
+		return nil, fmt.Errorf("invalid matchMinutes: %d", matchMinutes)
+	}
+	if restMinutes < 0 || restMinutes > maxRestMinutes {
+		return nil, fmt.Errorf("invalid restMinutes: %d", restMinutes)
+	}
+	if len(venues) == 0 {
+		return nil, errors.New("venues cannot be empty")
+	}
+	courtIDs := map[string]bool{}
+	for _, venue := range venues {
+		if err := ValidateID(venue.ID); err != nil {
+			return nil, err
+		}
+		if len(venue.Courts) == 0 {
+			return nil, fmt.Errorf("venue %s has no courts", venue.ID)
+		}
+		for _, court := range venue.Courts {
+			if err := ValidateID(court.ID); err != nil {
+				return nil, err
+			}
+			if courtIDs[court.ID] {
+				return nil, fmt.Errorf("duplicated court: %s", court.ID)
+			}
+			courtIDs[court.ID] = true
+			for _, window := range court.Availability {
+				if !window.Start.Before(window.End) {
+					return nil, fmt.Errorf("invalid availability of court %s: start must be before end", court.ID)
+				}
+			}
+		}
+	}
+	return &Scheduling{Venues: venues, MatchMinutes: matchMinutes, RestMinutes: restMinutes}, nil
+}
+
+// ConfigureScheduling sets the venues and estimates of the tournament, see NewScheduling.
+func (t *Tournament) ConfigureScheduling(scheduling *Scheduling) error {
+	if err := t.Allows(OperationConfigureVenues); err != nil {
+		return err
+	}
+	t.Scheduling = scheduling
+	return nil
+}
+
+func (s *Scheduling) findCourt(courtID string) (*Court, bool) {
+	for v := range s.Venues {
+		for c := range s.Venues[v].Courts {
+			if s.Venues[v].Courts[c].ID == courtID {
+				return &s.Venues[v].Courts[c], true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (s *Scheduling) matchDuration() time.Duration {
+	return time.Duration(s.MatchMinutes) * time.Minute
+}
+
+func (s *Scheduling) rest() time.Duration {
+	return time.Duration(s.RestMinutes) * time.Minute
+}
+
+// ConflictKind tells why scheduled matches conflict.
+type ConflictKind string
+
+const (
+	// ConflictCourt is a court booked for overlapping matches.
+	ConflictCourt ConflictKind = "court"
+	// ConflictCouple is a couple with overlapping matches or without enough rest between them.
+	ConflictCouple ConflictKind = "couple"
+	// ConflictAvailability is a match outside the availability of its court (or on an unknown court).
+	ConflictAvailability ConflictKind = "availability"
+)
+
+type ScheduleConflict struct {
+	Kind     ConflictKind `json:"kind"`
+	MatchIDs []string     `json:"matchIds"`
+	CourtID  string       `json:"courtId,omitempty"`
+	CoupleID string       `json:"coupleId,omitempty"`
+}
+
+// ScheduledMatch is a match of the schedule with its estimated (or reported) end.
+type ScheduledMatch struct {
+	MatchID     string    `json:"matchId"`
+	RoundNumber int       `json:"roundNumber"`
+	CourtID     string    `json:"courtId"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+}
+
+// Schedule is the court allocation of the matches of a tournament, sorted by start.
+type Schedule struct {
+	TournamentID string             `json:"tournamentId"`
+	Matches      []ScheduledMatch   `json:"matches"`
+	Conflicts    []ScheduleConflict `json:"conflicts"`
+}
+
+// matchEnd is the reported end of the match (e.g. an overrun) or else its estimated end.
+func (t *Tournament) matchEnd(match *Match) time.Time {
+	if match.EndsAt != nil {
+		return *match.EndsAt
+	}
+	return match.Timestamp.Add(t.Scheduling.matchDuration())
+}
+
+// Schedule returns the matches allocated to courts and their conflicts.
+func (t *Tournament) Schedule() (Schedule, error) {
+	if t.Scheduling == nil {
+		return Schedule{}, ErrSchedulingNotConfigured
+	}
+	schedule := Schedule{TournamentID: t.ID, Matches: []ScheduledMatch{}, Conflicts: t.ScheduleConflicts()}
+	for _, round := range t.Rounds {
+		for m := range round.Matches {
+			match := &round.Matches[m]
+			if match.CourtID == "" {
+				continue
+			}
+			schedule.Matches = append(schedule.Matches, ScheduledMatch{MatchID: match.ID, RoundNumber: round.Number,
+				CourtID: match.CourtID, Start: match.Timestamp, End: t.matchEnd(match)})
+		}
+	}
+	sort.SliceStable(schedule.Matches, func(i, j int) bool {
+		return schedule.Matches[i].Start.Before(schedule.Matches[j].Start)
+	})
+	return schedule, nil
+}
+
+// ScheduleConflicts detects double booked courts and couples, missing rest and matches outside court availability.
+func (t *Tournament) ScheduleConflicts() []ScheduleConflict {
+	conflicts := []ScheduleConflict{}
+	if t.Scheduling == nil {
+		return conflicts
+	}
+	var scheduled []*Match
+	for r := range t.Rounds {
+		for m := range t.Rounds[r].Matches {
+			if match := &t.Rounds[r].Matches[m]; match.CourtID != "" {
+				scheduled = append(scheduled, match)
+			}
+		}
+	}
+	for i, match := range scheduled {
+		start, end := match.Timestamp, t.matchEnd(match)
+		if court, ok := t.Scheduling.findCourt(match.CourtID); !ok || !court.available(start, end) {
+			conflicts = append(conflicts, ScheduleConflict{Kind: ConflictAvailability, MatchIDs: []string{match.ID}, CourtID: match.CourtID})
+		}
+		for _, other := range scheduled[i+1:] {
+			otherStart, otherEnd := other.Timestamp, t.matchEnd(other)
+			matchIDs := []string{match.ID, other.ID}
+			if match.CourtID == other.CourtID && (TimeWindow{start, end}).overlaps(otherStart, otherEnd) {
+				conflicts = append(conflicts, ScheduleConflict{Kind: ConflictCourt, MatchIDs: matchIDs, CourtID: match.CourtID})
+			}
+			rest := t.Scheduling.rest()
+			for _, coupleID := range sharedCouples(match, other) {
+				if (TimeWindow{start.Add(-rest), end.Add(rest)}).overlaps(otherStart, otherEnd) {
+					conflicts = append(conflicts, ScheduleConflict{Kind: ConflictCouple, MatchIDs: matchIDs, CoupleID: coupleID})
+				}
+			}
+		}
+	}
+	return conflicts
+}
+
+func sharedCouples(match, other *Match) []string {
+	var coupleIDs []string
+	for _, couple := range []PlayerCouple{match.Couple1, match.Couple2} {
+		if couple.ID != "" && (couple.ID == other.Couple1.ID || couple.ID == other.Couple2.ID) {
+			coupleIDs = append(coupleIDs, couple.ID)
+		}
+	}
+	return coupleIDs
+}
+
+func (c *Court) available(start, end time.Time) bool {
+	for _, window := range c.Availability {
+		if window.contains(start, end) {
+			return true
+		}
+	}
+	return false
+}
+
+// AssignMatch books the court for the match at start (manual scheduling), conflicts are detected afterward.
+func (t *Tournament) AssignMatch(matchID, courtID string, start time.Time) (*Match, error) {
+	if err := t.Allows(OperationScheduleMatches); err != nil {
+		return nil, err
+	}
+	if t.Scheduling == nil {
+		return nil, ErrSchedulingNotConfigured
+	}
+	if _, ok := t.Scheduling.findCourt(courtID); !ok {
+		return nil, ErrCourtNotFound
+	}
+	match, err := t.FindMatch(matchID)
+	if err != nil {
+		return nil, err
+	}
+	match.CourtID, match.Timestamp, match.EndsAt = courtID, start, nil
+	return match, nil
+}
+
+// ReportMatchEnd sets the actual (or newly expected) end of the match, e.g. when it overruns.
+func (t *Tournament) ReportMatchEnd(matchID string, endsAt time.Time) (*Match, error) {
+	if err := t.Allows(OperationScheduleMatches); err != nil {
+		return nil, err
+	}
+	match, err := t.FindMatch(matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.CourtID == "" {
+		return nil, fmt.Errorf("match %s is not scheduled", matchID)
+	}
+	if !endsAt.After(match.Timestamp) {
+		return nil, errors.New("endsAt must be after the start of the match")
+	}
+	match.EndsAt = &endsAt
+	return match, nil
+}
+
+// ScheduleMatches allocates courts to the matches starting from "from", keeping the matches already played or
+// started (scored, decided or scheduled before from). Matches are scheduled round after round on the earliest available
+// court: a round starts once the previous one ends and couples rest between their matches. The tournament is
+// left unchanged on errors.
+func (t *Tournament) ScheduleMatches(from time.Time) error {
+	if err := t.Allows(OperationScheduleMatches); err != nil {
+		return err
+	}
+	if t.Scheduling == nil {
+		return ErrSchedulingNotConfigured
+	}
+	rounds := make([]Round, len(t.Rounds))
+	for r, round := range t.Rounds {
+		rounds[r] = Round{Number: round.Number, Matches: append([]Match{}, round.Matches...)}
+	}
+	bookings := map[string][]TimeWindow{}
+	coupleReady := map[string]time.Time{}
+	book := func(match *Match) {
+		if match.CourtID == "" {
+			return
+		}
+		end := t.matchEnd(match)
+		bookings[match.CourtID] = append(bookings[match.CourtID], TimeWindow{match.Timestamp, end})
+		for _, couple := range []PlayerCouple{match.Couple1, match.Couple2} {
+			if ready := end.Add(t.Scheduling.rest()); couple.ID != "" && ready.After(coupleReady[couple.ID]) {
+				coupleReady[couple.ID] = ready
+			}
+		}
+	}
+	kept := func(match *Match) bool {
+		return match.Score != nil || match.Result != nil || (match.CourtID != "" && match.Timestamp.Before(from))
+	}
+	for r := range rounds {
+		for m := range rounds[r].Matches {
+			if match := &rounds[r].Matches[m]; kept(match) {
+				book(match)
+			}
+		}
+	}
+
+	roundStart := from
+	for r := range rounds {
+		roundEnd := roundStart
+		for m := range rounds[r].Matches {
+			match := &rounds[r].Matches[m]
+			if !kept(match) {
+				earliest := latest(roundStart, coupleReady[match.Couple1.ID], coupleReady[match.Couple2.ID])
+				courtID, start, ok := t.Scheduling.earliestSlot(bookings, earliest)
+				if !ok {
+					return fmt.Errorf("%w for match %s", ErrNoCourtAvailable, match.ID)
+				}
+				match.CourtID, match.Timestamp, match.EndsAt = courtID, start, nil
+				book(match)
+			}
+			if end := t.matchEnd(match); end.After(roundEnd) {
+				roundEnd = end
+			}
+		}
+		roundStart = roundEnd
+	}
+	t.Rounds = rounds
+	return nil
+}
+
+// earliestSlot returns the court (first one on ties) where a match can start the earliest from "from".
+func (s *Scheduling) earliestSlot(bookings map[string][]TimeWindow, from time.Time) (string, time.Time, bool) {
+	var courtID string
+	var best time.Time
+	for _, venue := range s.Venues {
+		for _, court := range venue.Courts {
+			if start, ok := court.earliestStart(bookings[court.ID], from, s.matchDuration()); ok && (courtID == "" || start.Before(best)) {
+				courtID, best = court.ID, start
+			}
+		}
+	}
+	return courtID, best, courtID != ""
+}
+
+func (c *Court) earliestStart(bookings []TimeWindow, from time.Time, duration time.Duration) (time.Time, bool) {
+	windows := append([]TimeWindow{}, c.Availability...)
+	sort.Slice(windows, func(i, j int) bool { return windows[i].Start.Before(windows[j].Start) })
+	for _, window := range windows {
+		start := latest(from, window.Start)
+		// Moves past bookings until the match fits, every move starts after a booking so the loop ends.
+		for moved := true; moved; {
+			moved = false
+			for _, booking := range bookings {
+				if booking.overlaps(start, start.Add(duration)) {
+					start, moved = booking.End, true
+				}
+			}
+		}
+		if window.contains(start, start.Add(duration)) {
+			return start, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func latest(times ...time.Time) time.Time {
+	var max time.Time
+	for _, t := range times {
+		if t.After(max) {
+			max = t
+		}
+	}
+	return max
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var scheduleDay = time.Date(2026, time.November, 1, 9, 0, 0, 0, time.UTC)
+
+func at(hour, minute int) time.Time {
+	return scheduleDay.Add(time.Duration(hour-9)*time.Hour + time.Duration(minute)*time.Minute)
+}
+
+func couple(id string) PlayerCouple {
+	return PlayerCouple{ID: id}
+}
+
+// scheduledTournament has 2 courts available from 9:00 to 14:00, 60 minutes matches and 30 minutes of rest.
+func scheduledTournament(rounds ...Round) Tournament {
+	day := []TimeWindow{{Start: at(9, 0), End: at(14, 0)}}
+	scheduling, _ := NewScheduling([]Venue{{ID: "venue-1", Name: "Central", Courts: []Court{
+		{ID: "court-1", Name: "Court 1", Availability: day},
+		{ID: "court-2", Name: "Court 2", Availability: day},
+	}}}, 60, 30)
+	return Tournament{ID: "tournament-1", Status: StatusInProgress, Rounds: rounds, Scheduling: scheduling}
+}
+
+func TestNewScheduling(t *testing.T) {
+	court := Court{ID: "court-1", Availability: []TimeWindow{{Start: at(9, 0), End: at(14, 0)}}}
+
+	_, err := NewScheduling([]Venue{{ID: "venue-1", Courts: []Court{court}}}, 90, 0)
+	assert.NoError(t, err)
+
+	_, err = NewScheduling([]Venue{{ID: "venue-1", Courts: []Court{court}}}, 5, 0)
+	assert.Error(t, err, "Expected error for too short matches")
+	_, err = NewScheduling([]Venue{{ID: "venue-1", Courts: []Court{court}}}, 60, -1)
+	assert.Error(t, err, "Expected error for negative rest")
+	_, err = NewScheduling(nil, 60, 0)
+	assert.Error(t, err, "Expected error without venues")
+	_, err = NewScheduling([]Venue{{ID: "venue-1"}}, 60, 0)
+	assert.Error(t, err, "Expected error for venue without courts")
+	_, err = NewScheduling([]Venue{{ID: "venue-1", Courts: []Court{court}}, {ID: "venue-2", Courts: []Court{court}}}, 60, 0)
+	assert.Error(t, err, "Expected error for duplicated court")
+	_, err = NewScheduling([]Venue{{ID: "venue-1", Courts: []Court{{ID: "court-1", Availability: []TimeWindow{{Start: at(14, 0), End: at(9, 0)}}}}}}, 60, 0)
+	assert.Error(t, err, "Expected error for inverted availability")
+}
+
+func TestTournament_ScheduleMatches(t *testing.T) {
+	tournament := scheduledTournament(
+		Round{Number: 1, Matches: []Match{
+			{ID: "match-1", Couple1: couple("couple-1"), Couple2: couple("couple-2")},
+			{ID: "match-2", Couple1: couple("couple-3"), Couple2: couple("couple-4")},
+			{ID: "match-3", Couple1: couple("couple-1"), Couple2: couple("couple-3")},
+		}},
+		Round{Number: 2, Matches: []Match{
+			{ID: "match-4", Couple1: couple("couple-2"), Couple2: couple("couple-4")},
+		}},
+	)
+
+	assert.NoError(t, tournament.ScheduleMatches(at(9, 0)))
+
+	schedule, err := tournament.Schedule()
+	assert.NoError(t, err)
+	assert.Equal(t, []ScheduledMatch{
+		{MatchID: "match-1", RoundNumber: 1, CourtID: "court-1", Start: at(9, 0), End: at(10, 0)},
+		{MatchID: "match-2", RoundNumber: 1, CourtID: "court-2", Start: at(9, 0), End: at(10, 0)},
+		// Couples 1 and 3 rest 30 minutes.
+		{MatchID: "match-3", RoundNumber: 1, CourtID: "court-1", Start: at(10, 30), End: at(11, 30)},
+		// Round 2 starts once round 1 ends.
+		{MatchID: "match-4", RoundNumber: 2, CourtID: "court-1", Start: at(11, 30), End: at(12, 30)},
+	}, schedule.Matches)
+	assert.Empty(t, schedule.Conflicts)
+}
+
+func TestTournament_ScheduleMatches_Overrun(t *testing.T) {
+	tournament := scheduledTournament(Round{Number: 1, Matches: []Match{
+		{ID: "match-1", Couple1: couple("couple-1"), Couple2: couple("couple-2")},
+		{ID: "match-2", Couple1: couple("couple-3"), Couple2: couple("couple-4")},
+		{ID: "match-3", Couple1: couple("couple-5"), Couple2: couple("couple-6")},
+		{ID: "match-4", Couple1: couple("couple-1"), Couple2: couple("couple-3")},
+	}})
+	assert.NoError(t, tournament.ScheduleMatches(at(9, 0)))
+	match4, _ := tournament.FindMatch("match-4")
+	assert.Equal(t, at(10, 30), match4.Timestamp)
+
+	_, err := tournament.ReportMatchEnd("match-1", at(10, 45))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tournament.ScheduleConflicts(), "Expected couple 1 to miss its rest")
+
+	// Matches started before the overrun keep their court.
+	assert.NoError(t, tournament.ScheduleMatches(at(9, 50)))
+	match1, _ := tournament.FindMatch("match-1")
+	assert.Equal(t, at(9, 0), match1.Timestamp)
+	match3, _ := tournament.FindMatch("match-3")
+	assert.Equal(t, "court-2", match3.CourtID)
+	assert.Equal(t, at(10, 0), match3.Timestamp)
+	match4, _ = tournament.FindMatch("match-4")
+	assert.Equal(t, "court-1", match4.CourtID)
+	assert.Equal(t, at(11, 15), match4.Timestamp)
+	assert.Empty(t, tournament.ScheduleConflicts())
+}
+
+func TestTournament_ScheduleMatches_NoCourtAvailable(t *testing.T) {
+	tournament := scheduledTournament(Round{Number: 1, Matches: []Match{
+		{ID: "match-1", Couple1: couple("couple-1"), Couple2: couple("couple-2")},
+	}})
+
+	assert.ErrorIs(t, tournament.ScheduleMatches(at(13, 30)), ErrNoCourtAvailable)
+	assert.Empty(t, tournament.Rounds[0].Matches[0].CourtID, "Expected the tournament unchanged")
+	assert.ErrorIs(t, (&Tournament{Status: StatusInProgress}).ScheduleMatches(at(9, 0)), ErrSchedulingNotConfigured)
+}
+
+func TestTournament_ScheduleConflicts(t *testing.T) {
+	tournament := scheduledTournament(Round{Number: 1, Matches: []Match{
+		{ID: "match-1", Couple1: couple("couple-1"), Couple2: couple("couple-2")},
+		{ID: "match-2", Couple1: couple("couple-1"), Couple2: couple("couple-3")},
+		{ID: "match-3", Couple1: couple("couple-4"), Couple2: couple("couple-5")},
+	}})
+	for _, assignment := range []struct {
+		matchID, courtID string
+		start            time.Time
+	}{{"match-1", "court-1", at(9, 0)}, {"match-2", "court-2", at(10, 15)}, {"match-3", "court-1", at(13, 30)}} {
+		_, err := tournament.AssignMatch(assignment.matchID, assignment.courtID, assignment.start)
+		assert.NoError(t, err)
+	}
+	_, err := tournament.AssignMatch("match-3", "court-9", at(9, 0))
+	assert.ErrorIs(t, err, ErrCourtNotFound)
+
+	assert.Equal(t, []ScheduleConflict{
+		{Kind: ConflictCouple, MatchIDs: []string{"match-1", "match-2"}, CoupleID: "couple-1"},
+		{Kind: ConflictAvailability, MatchIDs: []string{"match-3"}, CourtID: "court-1"},
+	}, tournament.ScheduleConflicts())
+
+	_, err = tournament.AssignMatch("match-2", "court-1", at(9, 30))
+	assert.NoError(t, err)
+	assert.Contains(t, tournament.ScheduleConflicts(),
+		ScheduleConflict{Kind: ConflictCourt, MatchIDs: []string{"match-1", "match-2"}, CourtID: "court-1"})
+}
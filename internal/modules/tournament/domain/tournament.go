@@ -2,6 +2,7 @@ package domain
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 )
@@ -22,10 +23,22 @@ type Tournament struct {
 	ID        string    `bson:"_id" json:"id"`
 	Title     string    `bson:"title" json:"title"`
 	Timestamp time.Time `bson:"timestamp" json:"timestamp"`
+	// Status is only changed by transitions (see Apply).
+	Status TournamentStatus `bson:"status,omitempty" json:"status,omitempty"`
 	// Pre-requisite: Players creation is done in player-couple module.
 	// Tournament registration is done here:
 	PlayerCouples []PlayerCouple `bson:"player_couples,omitempty" json:"player_couples,omitempty"`
 	Rounds        []Round        `bson:"rounds,omitempty" json:"rounds,omitempty"`
+	Scheduling    *Scheduling    `bson:"scheduling,omitempty" json:"scheduling,omitempty"`
+	// Categories hold their own entries, PlayerCouples being the couples of tournaments without categories.
+	Categories []Category `bson:"categories,omitempty" json:"categories,omitempty"`
+	// ResultRules are DefaultResultRules unless configured (see Rules).
+	ResultRules *ResultRules `bson:"resultRules,omitempty" json:"resultRules,omitempty"`
+	// HostClub is copied from the club module when assigned (see AssignHostClub).
+	HostClub *HostClub `bson:"hostClub,omitempty" json:"hostClub,omitempty"`
+	// Version is incremented on each save, which fails with ErrTournamentConflict when another one happened
+	// meanwhile (see TournamentRepository).
+	Version int `bson:"version" json:"-"`
 }
 
 // Custom JSON marshalling to format time without seconds:
@@ -40,6 +53,19 @@ func (t Tournament) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// UnmarshalJSON reads the timestamps written by MarshalJSON, e.g. by API clients.
+func (t *Tournament) UnmarshalJSON(data []byte) error {
+	type Alias Tournament
+	value := struct {
+		Timestamp string `json:"timestamp"`
+		*Alias
+	}{Alias: (*Alias)(t)}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return parseTimestamp(value.Timestamp, &t.Timestamp)
+}
+
 type Round struct {
 	Number  int     `bson:"number" json:"number"`
 	Matches []Match `bson:"matches" json:"matches"`
@@ -51,6 +77,20 @@ type Match struct {
 	Couple1   PlayerCouple `bson:"couple1" json:"couple1"`
 	Couple2   PlayerCouple `bson:"couple2" json:"couple2"`
 	Score     *Score       `bson:"score,omitempty" json:"score,omitempty"`
+	// Result is set for matches not completed (e.g. walkovers), Score being partial (see Decided).
+	Result *Result `bson:"result,omitempty" json:"result,omitempty"`
+	// CategoryID is set for matches of tournaments with categories.
+	CategoryID string `bson:"categoryId,omitempty" json:"categoryId,omitempty"`
+	// CourtID is the court booked for the match (see Tournament.Scheduling), Timestamp being its start.
+	CourtID string `bson:"courtId,omitempty" json:"courtId,omitempty"`
+	// EndsAt is the reported end of the match, e.g. after an overrun, otherwise it's estimated.
+	EndsAt *time.Time `bson:"endsAt,omitempty" json:"endsAt,omitempty"`
+}
+
+// MatchCode is the short human friendly ID of a match, e.g. R2-M05 for the 5th match of the 2nd round. It's unique
+// within a bracket, so matches of categories are prefixed with the category ID.
+func MatchCode(round, match int) string {
+	return fmt.Sprintf("R%d-M%02d", round, match)
 }
 
 // Custom JSON marshalling to format time without seconds:
@@ -65,6 +105,33 @@ func (m Match) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (m *Match) UnmarshalJSON(data []byte) error {
+	type Alias Match
+	value := struct {
+		Timestamp string `json:"timestamp"`
+		*Alias
+	}{Alias: (*Alias)(m)}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return parseTimestamp(value.Timestamp, &m.Timestamp)
+}
+
+// parseTimestamp parses timestamps without seconds (UTC) or RFC 3339 ones, an empty one being the zero time.
+func parseTimestamp(value string, timestamp *time.Time) error {
+	if len(value) == 0 {
+		return nil
+	}
+	parsed, err := time.Parse(noSecondsFormat, value)
+	if err != nil {
+		if parsed, err = time.Parse(time.RFC3339, value); err != nil {
+			return fmt.Errorf("invalid timestamp: %s", value)
+		}
+	}
+	*timestamp = parsed
+	return nil
+}
+
 type Score struct {
 	Set1 GameSet  `bson:"set1" json:"set1"`
 	Set2 GameSet  `bson:"set2" json:"set2"`
@@ -94,6 +161,7 @@ func NewTournament(title string, timestamp time.Time,
 		//ID:          auto generated ID set in the repository.
 		Title:         title,
 		Timestamp:     timestamp,
+		Status:        StatusDraft,
 		PlayerCouples: playerCouples,
 		Rounds:        rounds,
 	}, nil
@@ -166,3 +234,83 @@ func NewTiebreak(pointsCouple1, pointsCouple2 int) (*Tiebreak, error) {
 		PointsCouple2: pointsCouple2,
 	}, nil
 }
+
+func ValidateID(id string) error {
+	if len(id) < minIdDigits {
+		return fmt.Errorf("invalid id: %s", id)
+	}
+	return nil
+}
+
+var (
+	// ErrMatchNotFound is returned when the match isn't part of any round of the tournament.
+	ErrMatchNotFound = errors.New("match not found")
+	// ErrTournamentConflict is returned when the tournament was saved by someone else since it was read.
+	ErrTournamentConflict = errors.New("tournament updated concurrently")
+)
+
+// FindMatch returns the match of any round of the tournament.
+func (t *Tournament) FindMatch(matchID string) (*Match, error) {
+	for r := range t.Rounds {
+		for m := range t.Rounds[r].Matches {
+			if t.Rounds[r].Matches[m].ID == matchID {
+				return &t.Rounds[r].Matches[m], nil
+			}
+		}
+	}
+	return nil, ErrMatchNotFound
+}
+
+// RecordScore sets (or corrects) the score of the match, i.e. the match is completed.
+func (t *Tournament) RecordScore(matchID string, score Score) (*Match, error) {
+	if err := t.Allows(OperationScoreMatches); err != nil {
+		return nil, err
+	}
+	match, err := t.FindMatch(matchID)
+	if err != nil {
+		return nil, err
+	}
+	match.Score, match.Result = &score, nil
+	return match, nil
+}
+
+// ValidateScore validates every set of a score built without the "New" functions (e.g. decoded from requests).
+func ValidateScore(score Score) error {
+	for _, set := range []*GameSet{&score.Set1, &score.Set2, score.Set3} {
+		if set == nil {
+			continue
+		}
+		if _, err := NewGameSet(set.GamesCouple1, set.GamesCouple2, set.Tiebreak); err != nil {
+			return err
+		}
+		if set.Tiebreak != nil {
+			if _, err := NewTiebreak(set.Tiebreak.PointsCouple1, set.Tiebreak.PointsCouple2); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ScoreUpdate is published every time the score of a match changes, e.g. for live score subscriptions.
+type ScoreUpdate struct {
+	TournamentID string `json:"tournamentId"`
+	MatchID      string `json:"matchId"`
+	Score        Score  `json:"score"`
+	// Result is set when the match isn't completed, e.g. a walkover (with an empty score).
+	Result    *Result   `json:"result,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ClearScore removes the score of the match, e.g. once its live scoring is reopened.
+func (t *Tournament) ClearScore(matchID string) error {
+	if err := t.Allows(OperationScoreMatches); err != nil {
+		return err
+	}
+	match, err := t.FindMatch(matchID)
+	if err != nil {
+		return err
+	}
+	match.Score, match.Result = nil, nil
+	return nil
+}
@@ -40,6 +40,24 @@ func TestMatch_MarshalJSON_Success(t *testing.T) {
 		string(jsonData), "Expected JSON to match")
 }
 
+func TestTournament_UnmarshalJSON_Success(t *testing.T) {
+	var tournament Tournament
+	err := json.Unmarshal([]byte(`{"id":"t1","title":"Grand Slam","timestamp":"2024-09-18T12:00",
+		"rounds":[{"number":1,"matches":[{"id":"m1","timestamp":"2024-09-18T13:30:00Z"}]}]}`), &tournament)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Grand Slam", tournament.Title)
+	assert.Equal(t, time.Date(2024, time.September, 18, 12, 0, 0, 0, time.UTC), tournament.Timestamp)
+	assert.Equal(t, time.Date(2024, time.September, 18, 13, 30, 0, 0, time.UTC), tournament.Rounds[0].Matches[0].Timestamp, "Expected RFC 3339 accepted")
+
+	roundTrip, _ := json.Marshal(tournament)
+	var again Tournament
+	assert.NoError(t, json.Unmarshal(roundTrip, &again))
+	assert.Equal(t, tournament, again)
+
+	assert.EqualError(t, json.Unmarshal([]byte(`{"timestamp":"18/09/2024"}`), &tournament), "invalid timestamp: 18/09/2024")
+}
+
 func TestNewTournament_Success(t *testing.T) {
 	timestamp := time.Now()
 	playerCouples := []PlayerCouple{}
@@ -194,3 +212,38 @@ func TestNewTiebreak_Fail_InvalidPointsCouple2(t *testing.T) {
 	assert.Error(t, err, "Expected error when pointsCouple2 is invalid")
 	assert.Nil(t, tiebreak, "Expected Tiebreak to be nil when pointsCouple2 is invalid")
 }
+
+func TestTournament_RecordScore(t *testing.T) {
+	tournament := Tournament{ID: "t1", Status: StatusInProgress, Rounds: []Round{
+		{Number: 1, Matches: []Match{{ID: "m1"}}},
+		{Number: 2, Matches: []Match{{ID: "m2"}, {ID: "m3"}}},
+	}}
+	score := Score{Set1: GameSet{GamesCouple1: 6, GamesCouple2: 3}, Set2: GameSet{GamesCouple1: 6, GamesCouple2: 4}}
+
+	match, err := tournament.RecordScore("m3", score)
+	assert.NoError(t, err)
+	assert.Equal(t, "m3", match.ID)
+	// The match of the aggregate is updated, not a copy.
+	assert.Equal(t, &score, tournament.Rounds[1].Matches[1].Score)
+	assert.Nil(t, tournament.Rounds[1].Matches[0].Score)
+
+	_, err = tournament.RecordScore("m9", score)
+	assert.ErrorIs(t, err, ErrMatchNotFound)
+
+	assert.NoError(t, tournament.ClearScore("m3"))
+	assert.Nil(t, tournament.Rounds[1].Matches[1].Score)
+	assert.ErrorIs(t, tournament.ClearScore("m9"), ErrMatchNotFound)
+}
+
+func TestValidateScore(t *testing.T) {
+	valid := Score{Set1: GameSet{GamesCouple1: 6, GamesCouple2: 3}, Set2: GameSet{GamesCouple1: 7, GamesCouple2: 6, Tiebreak: &Tiebreak{PointsCouple1: 7, PointsCouple2: 4}}}
+	assert.NoError(t, ValidateScore(valid))
+
+	invalidGames := valid
+	invalidGames.Set3 = &GameSet{GamesCouple1: 20}
+	assert.Error(t, ValidateScore(invalidGames))
+
+	invalidTiebreak := valid
+	invalidTiebreak.Set2.Tiebreak = &Tiebreak{PointsCouple1: -1}
+	assert.Error(t, ValidateScore(invalidTiebreak))
+}
@@ -0,0 +1,37 @@
+package mongo
+
+import (
+	"context"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection owned by the club module, only read here.
+const clubsColName = "clubs"
+
+// Venues of the club aren't read, tournaments configure their own courts.
+var clubProjection = bson.M{"_id": 1, "name": 1, "city": 1}
+
+type mongoClubReader struct {
+	collection *common.TenantCollection
+}
+
+func NewMongoClubReader(client common.MongoClient) domain.ClubReader {
+	return &mongoClubReader{collection: common.NewTenantCollection(client, clubsColName)}
+}
+
+func (r *mongoClubReader) FindByID(ctx context.Context, id string) (domain.HostClub, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var club domain.HostClub
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}, options.FindOne().SetProjection(clubProjection)).Decode(&club)
+	if mongo.ErrNoDocuments == err {
+		return domain.HostClub{}, nil
+	}
+	return club, err
+}
@@ -0,0 +1,38 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestMongoClubReader_FindByID(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("success", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testDbName+"."+clubsColName, mtest.FirstBatch,
+			bson.D{{Key: "_id", Value: "club-1"}, {Key: "name", Value: "Club Norte"}, {Key: "city", Value: "Buenos Aires"}},
+		))
+
+		reader := NewMongoClubReader(newMongoClientMock(mt.Client))
+		club, err := reader.FindByID(context.Background(), "club-1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.HostClub{ID: "club-1", Name: "Club Norte", City: "Buenos Aires"}, club)
+		projection := mt.GetStartedEvent().Command.Lookup("projection").Document()
+		_, err = projection.LookupErr("venues")
+		assert.Error(t, err, "Expected venues not read")
+	})
+
+	mt.Run("not found", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testDbName+"."+clubsColName, mtest.FirstBatch))
+
+		reader := NewMongoClubReader(newMongoClientMock(mt.Client))
+		club, err := reader.FindByID(context.Background(), "club-2")
+		assert.NoError(t, err)
+		assert.Empty(t, club.ID)
+	})
+}
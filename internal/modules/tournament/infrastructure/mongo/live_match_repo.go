@@ -0,0 +1,96 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const liveMatchesColName = "live_matches"
+
+type mongoLiveMatchRepository struct {
+	idGen      utils.IDGenerator
+	collection *common.TenantCollection
+}
+
+func NewMongoLiveMatchRepository(idGen utils.IDGenerator, client common.MongoClient) domain.LiveMatchRepository {
+	return &mongoLiveMatchRepository{
+		idGen:      idGen,
+		collection: common.NewTenantCollection(client, liveMatchesColName),
+	}
+}
+
+func (r *mongoLiveMatchRepository) Upsert(ctx context.Context, liveMatch *domain.LiveMatch) error {
+	if liveMatch == nil {
+		return errors.New("liveMatch is nil")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// DDD repository principle.
+	if len(liveMatch.ID) > 0 {
+		_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": liveMatch.ID}, liveMatch)
+		return err
+	}
+	liveMatch.ID = r.idGen.GenerateID()
+	_, err := r.collection.InsertOne(ctx, liveMatch)
+	if err != nil {
+		liveMatch.ID = ""
+	}
+	return err
+}
+
+func (r *mongoLiveMatchRepository) FindByMatchID(ctx context.Context, tournamentID, matchID string) (domain.LiveMatch, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var liveMatch domain.LiveMatch
+	err := r.collection.FindOne(ctx, bson.M{"tournamentId": tournamentID, "matchId": matchID}).Decode(&liveMatch)
+	if mongo.ErrNoDocuments == err {
+		return domain.LiveMatch{}, nil
+	}
+	return liveMatch, err
+}
+
+// AppendPoint pushes the last point of the match as long as the stored log holds the previous points only.
+func (r *mongoLiveMatchRepository) AppendPoint(ctx context.Context, liveMatch *domain.LiveMatch) error {
+	if liveMatch == nil || len(liveMatch.Points) == 0 {
+		return errors.New("liveMatch has no point to append")
+	}
+	last := len(liveMatch.Points) - 1
+	return r.update(ctx, liveMatch.ID, last, bson.M{
+		"$push": bson.M{"points": liveMatch.Points[last]},
+		"$set":  bson.M{"updatedAt": liveMatch.UpdatedAt},
+	})
+}
+
+// RemoveLastPoint pops the last point of the stored log as long as it holds one point more than the match.
+func (r *mongoLiveMatchRepository) RemoveLastPoint(ctx context.Context, liveMatch *domain.LiveMatch) error {
+	if liveMatch == nil {
+		return errors.New("liveMatch is nil")
+	}
+	return r.update(ctx, liveMatch.ID, len(liveMatch.Points)+1, bson.M{
+		"$pop": bson.M{"points": 1},
+		"$set": bson.M{"updatedAt": liveMatch.UpdatedAt},
+	})
+}
+
+// update applies the change when the stored log has the expected number of points (optimistic concurrency).
+func (r *mongoLiveMatchRepository) update(ctx context.Context, id string, storedPoints int, change bson.M) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "points": bson.M{"$size": storedPoints}}, change)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrLiveMatchConflict
+	}
+	return nil
+}
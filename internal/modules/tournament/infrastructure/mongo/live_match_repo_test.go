@@ -0,0 +1,101 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+const testLiveMatchesNs = testDbName + "." + liveMatchesColName
+
+func TestMongoLiveMatchRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	startedAt := time.Date(2026, time.November, 1, 10, 0, 0, 0, time.UTC)
+
+	mt.Run("Save live match", func(mt *mtest.T) {
+		repo := NewMongoLiveMatchRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		liveMatch, _ := domain.NewLiveMatch("tournament-1", "match-1", true, startedAt)
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, repo.Upsert(context.Background(), liveMatch))
+		assert.Equal(t, mockId, liveMatch.ID)
+	})
+
+	mt.Run("Save live match failure", func(mt *mtest.T) {
+		repo := NewMongoLiveMatchRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		liveMatch, _ := domain.NewLiveMatch("tournament-1", "match-1", true, startedAt)
+
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "insert error"}))
+		assert.Error(t, repo.Upsert(context.Background(), liveMatch))
+		assert.Empty(t, liveMatch.ID)
+	})
+
+	mt.Run("Find live match by match ID", func(mt *mtest.T) {
+		repo := NewMongoLiveMatchRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testLiveMatchesNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "live-1"},
+			{Key: "tournamentId", Value: "tournament-1"},
+			{Key: "matchId", Value: "match-1"},
+			{Key: "goldenPoint", Value: true},
+			{Key: "points", Value: bson.A{1, 2, 2}},
+			{Key: "startedAt", Value: startedAt},
+			{Key: "updatedAt", Value: startedAt},
+		}))
+
+		liveMatch, err := repo.FindByMatchID(context.Background(), "tournament-1", "match-1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.LiveMatch{ID: "live-1", TournamentID: "tournament-1", MatchID: "match-1", GoldenPoint: true,
+			Points: []int{1, 2, 2}, StartedAt: startedAt, UpdatedAt: startedAt}, liveMatch)
+	})
+
+	mt.Run("Find live match not found", func(mt *mtest.T) {
+		repo := NewMongoLiveMatchRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testLiveMatchesNs, mtest.FirstBatch))
+
+		liveMatch, err := repo.FindByMatchID(context.Background(), "tournament-1", "match-9")
+		assert.NoError(t, err)
+		assert.Empty(t, liveMatch.ID)
+	})
+
+	mt.Run("Append point", func(mt *mtest.T) {
+		repo := NewMongoLiveMatchRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		liveMatch := &domain.LiveMatch{ID: "live-1", Points: []int{1, 2}, UpdatedAt: startedAt}
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+		assert.NoError(t, repo.AppendPoint(context.Background(), liveMatch))
+
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, int32(1), update.Lookup("q", "points", "$size").Int32(), "Expected the previous points only")
+		assert.Equal(t, int32(2), update.Lookup("u", "$push", "points").Int32())
+	})
+
+	mt.Run("Append point conflict", func(mt *mtest.T) {
+		repo := NewMongoLiveMatchRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		liveMatch := &domain.LiveMatch{ID: "live-1", Points: []int{1, 2}, UpdatedAt: startedAt}
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+		assert.ErrorIs(t, repo.AppendPoint(context.Background(), liveMatch), domain.ErrLiveMatchConflict)
+	})
+
+	mt.Run("Append without points", func(mt *mtest.T) {
+		repo := NewMongoLiveMatchRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		assert.Error(t, repo.AppendPoint(context.Background(), &domain.LiveMatch{ID: "live-1"}))
+	})
+
+	mt.Run("Remove last point", func(mt *mtest.T) {
+		repo := NewMongoLiveMatchRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		liveMatch := &domain.LiveMatch{ID: "live-1", Points: []int{1}, UpdatedAt: startedAt}
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+		assert.NoError(t, repo.RemoveLastPoint(context.Background(), liveMatch))
+
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, int32(2), update.Lookup("q", "points", "$size").Int32(), "Expected the undone point stored")
+		assert.Equal(t, int32(1), update.Lookup("u", "$pop", "points").Int32())
+	})
+}
@@ -0,0 +1,35 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/paguerre3/goddd/internal/modules/common/migration"
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Migrations of the tournaments and live_matches collections.
+func Migrations() []migration.Migration {
+	return []migration.Migration{
+		{
+			Version:     2026101909,
+			Description: "Assign tournaments and live_matches to the default tenant",
+			Up:          migration.AssignTenant(tournamentsColName, liveMatchesColName),
+		},
+		{
+			// Upsert only replaces the version it read, i.e. tournaments stored before versions couldn't be saved.
+			Version:     2026101912,
+			Description: "Version tournaments",
+			Up: func(ctx context.Context, client common.MongoClient) error {
+				_, err := client.GetCollection(ctx, tournamentsColName).UpdateMany(ctx,
+					bson.M{"version": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"version": 0}})
+				return err
+			},
+			Down: func(ctx context.Context, client common.MongoClient) error {
+				_, err := client.GetCollection(ctx, tournamentsColName).UpdateMany(ctx,
+					bson.M{}, bson.M{"$unset": bson.M{"version": ""}})
+				return err
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package mongo
+
+import (
+	"context"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collections owned by the player-couple module, only read here.
+const (
+	playersColName       = "players"
+	playerCouplesColName = "player_couples"
+)
+
+// Only the public profile and the data checked by category eligibility (birth date, gender) are read, i.e. SSNs
+// (encrypted by the player-couple module) never reach tournaments.
+var (
+	playerProjection = bson.M{"_id": 1, "email": 1, "firstName": 1, "lastName": 1, "birthDate": 1, "gender": 1}
+	coupleProjection = bson.M{
+		"_id": 1, "ranking": 1,
+		"player1._id": 1, "player1.email": 1, "player1.firstName": 1, "player1.lastName": 1, "player1.birthDate": 1, "player1.gender": 1,
+		"player2._id": 1, "player2.email": 1, "player2.firstName": 1, "player2.lastName": 1, "player2.birthDate": 1, "player2.gender": 1,
+	}
+)
+
+type mongoPlayerReader struct {
+	collection *common.TenantCollection
+}
+
+type mongoPlayerCoupleReader struct {
+	collection *common.TenantCollection
+}
+
+func NewMongoPlayerReader(client common.MongoClient) domain.PlayerReader {
+	return &mongoPlayerReader{collection: common.NewTenantCollection(client, playersColName)}
+}
+
+func NewMongoPlayerCoupleReader(client common.MongoClient) domain.PlayerCoupleReader {
+	return &mongoPlayerCoupleReader{collection: common.NewTenantCollection(client, playerCouplesColName)}
+}
+
+func (r *mongoPlayerReader) FindByIDs(ctx context.Context, ids []string) ([]domain.Player, error) {
+	return findByIDs[domain.Player](ctx, r.collection, ids, playerProjection)
+}
+
+func (r *mongoPlayerCoupleReader) FindByIDs(ctx context.Context, ids []string) ([]domain.PlayerCouple, error) {
+	return findByIDs[domain.PlayerCouple](ctx, r.collection, ids, coupleProjection)
+}
+
+func findByIDs[T any](ctx context.Context, collection *common.TenantCollection, ids []string, projection bson.M) ([]T, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cursor, err := collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}}, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+	var documents []T
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, err
+	}
+	return documents, nil
+}
@@ -0,0 +1,54 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestMongoPlayerReader_FindByIDs(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("success", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testDbName+"."+playersColName, mtest.FirstBatch,
+			bson.D{{Key: "_id", Value: "p1"}, {Key: "firstName", Value: "Agustin"}, {Key: "lastName", Value: "Tapia"}},
+			bson.D{{Key: "_id", Value: "p2"}, {Key: "firstName", Value: "Arturo"}, {Key: "lastName", Value: "Coello"}},
+		), mtest.CreateCursorResponse(0, testDbName+"."+playersColName, mtest.NextBatch))
+
+		reader := NewMongoPlayerReader(newMongoClientMock(mt.Client))
+		players, err := reader.FindByIDs(context.Background(), []string{"p1", "p2"})
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Player{{ID: "p1", FirstName: "Agustin", LastName: "Tapia"}, {ID: "p2", FirstName: "Arturo", LastName: "Coello"}}, players)
+	})
+
+	mt.Run("failure", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "find error"}))
+
+		reader := NewMongoPlayerReader(newMongoClientMock(mt.Client))
+		_, err := reader.FindByIDs(context.Background(), []string{"p1"})
+		assert.Error(t, err)
+	})
+}
+
+func TestMongoPlayerCoupleReader_FindByIDs(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("success", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testDbName+"."+playerCouplesColName, mtest.FirstBatch,
+			bson.D{
+				{Key: "_id", Value: "c1"},
+				{Key: "player1", Value: bson.D{{Key: "_id", Value: "p1"}}},
+				{Key: "player2", Value: bson.D{{Key: "_id", Value: "p2"}}},
+			},
+		))
+
+		reader := NewMongoPlayerCoupleReader(newMongoClientMock(mt.Client))
+		couples, err := reader.FindByIDs(context.Background(), []string{"c1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.PlayerCouple{{ID: "c1", Player1: domain.Player{ID: "p1"}, Player2: domain.Player{ID: "p2"}}}, couples)
+	})
+}
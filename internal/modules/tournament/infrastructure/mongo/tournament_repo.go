@@ -0,0 +1,118 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	timeout            = 5 * time.Second
+	tournamentsColName = "tournaments"
+)
+
+type mongoTournamentRepository struct {
+	idGen      utils.IDGenerator
+	collection *common.TenantCollection
+}
+
+func NewMongoTournamentRepository(idGen utils.IDGenerator, client common.MongoClient) domain.TournamentRepository {
+	return &mongoTournamentRepository{
+		idGen:      idGen,
+		collection: common.NewTenantCollection(client, tournamentsColName),
+	}
+}
+
+func (r *mongoTournamentRepository) Upsert(ctx context.Context, tournament *domain.Tournament) error {
+	if tournament == nil {
+		return errors.New("tournament is nil")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// DDD repository principle.
+	if len(tournament.ID) > 0 {
+		// The tournament is the root aggregate, i.e. couples and rounds are replaced with it as long as nobody
+		// saved it since it was read (optimistic concurrency).
+		replacement := *tournament
+		replacement.Version++
+		result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": tournament.ID, "version": tournament.Version}, replacement)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return domain.ErrTournamentConflict
+		}
+		tournament.Version = replacement.Version
+		return nil
+	}
+	tournament.ID = r.idGen.GenerateID()
+	_, err := r.collection.InsertOne(ctx, tournament)
+	if err != nil {
+		tournament.ID = ""
+	}
+	return err
+}
+
+func (r *mongoTournamentRepository) FindByID(ctx context.Context, id string) (domain.Tournament, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var tournament domain.Tournament
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&tournament)
+	if mongo.ErrNoDocuments == err {
+		return domain.Tournament{}, nil
+	}
+	return tournament, err
+}
+
+// ForEach has no timeout of its own as exports outlast other queries, ctx bounds it.
+func (r *mongoTournamentRepository) ForEach(ctx context.Context, filter domain.TournamentFilter, fn func(domain.Tournament) error) error {
+	query := bson.M{}
+	timestamp := bson.M{}
+	if filter.From != nil {
+		timestamp["$gte"] = *filter.From
+	}
+	if filter.To != nil {
+		timestamp["$lt"] = *filter.To
+	}
+	if len(timestamp) > 0 {
+		query["timestamp"] = timestamp
+	}
+	if len(filter.TournamentID) > 0 {
+		query["_id"] = filter.TournamentID
+	}
+	if len(filter.CategoryID) > 0 {
+		query["categories._id"] = filter.CategoryID
+	}
+	cursor, err := r.collection.Find(ctx, query, options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var tournament domain.Tournament
+		if err := cursor.Decode(&tournament); err != nil {
+			return err
+		}
+		if err := fn(tournament); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+func (r *mongoTournamentRepository) Delete(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
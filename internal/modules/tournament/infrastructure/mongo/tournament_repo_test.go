@@ -0,0 +1,211 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	common "github.com/paguerre3/goddd/internal/modules/common/mongo"
+	"github.com/paguerre3/goddd/internal/modules/common/tenant"
+	"github.com/paguerre3/goddd/internal/modules/common/utils"
+	"github.com/paguerre3/goddd/internal/modules/tournament/domain"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+const (
+	testDbName        = "testdb"
+	testTournamentsNs = testDbName + "." + tournamentsColName
+	mockId            = "mock-id"
+)
+
+type idGenMock struct {
+}
+
+func (i *idGenMock) GenerateID() string {
+	return mockId
+}
+
+func (i *idGenMock) GenerateIDWithPrefixes(prefix1 string, prefix2 string) string {
+	return prefix1 + "-" + prefix2 + "-" + mockId
+}
+
+func newIdGenMock() utils.IDGenerator {
+	return &idGenMock{}
+}
+
+type mongoClientMock struct {
+	database *mongo.Database
+}
+
+func (m *mongoClientMock) GetCollection(_ context.Context, collectionName string) *mongo.Collection {
+	return m.database.Collection(collectionName)
+}
+
+func (m *mongoClientMock) Close() error {
+	return nil
+}
+
+func newMongoClientMock(client *mongo.Client) common.MongoClient {
+	return &mongoClientMock{database: client.Database(testDbName)}
+}
+
+func TestMongoTournamentRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	timestamp := time.Date(2024, time.October, 1, 10, 0, 0, 0, time.UTC)
+
+	mt.Run("Save tournament", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		tournament := &domain.Tournament{Title: "Premier Padel", Timestamp: timestamp}
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		err := repo.Upsert(context.Background(), tournament)
+		assert.NoError(t, err)
+		assert.Equal(t, mockId, tournament.ID)
+	})
+
+	mt.Run("Save tournament failure", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		tournament := &domain.Tournament{Title: "Premier Padel", Timestamp: timestamp}
+
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "insert error"}))
+		err := repo.Upsert(context.Background(), tournament)
+		assert.Error(t, err)
+		assert.Empty(t, tournament.ID)
+	})
+
+	mt.Run("Update tournament", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		tournament := &domain.Tournament{ID: "t1", Title: "Premier Padel", Timestamp: timestamp, Version: 3}
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+		err := repo.Upsert(context.Background(), tournament)
+		assert.NoError(t, err)
+		assert.Equal(t, "t1", tournament.ID)
+		assert.Equal(t, 4, tournament.Version)
+
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, int64(3), update.Lookup("q", "version").AsInt64(), "Expected the version read to be replaced")
+		assert.Equal(t, int64(4), update.Lookup("u", "version").AsInt64())
+	})
+
+	mt.Run("Update tournament saved meanwhile", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		tournament := &domain.Tournament{ID: "t1", Title: "Premier Padel", Timestamp: timestamp, Version: 3}
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+		err := repo.Upsert(context.Background(), tournament)
+		assert.ErrorIs(t, err, domain.ErrTournamentConflict)
+		assert.Equal(t, 3, tournament.Version)
+	})
+
+	mt.Run("Nil tournament", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		assert.Error(t, repo.Upsert(context.Background(), nil))
+	})
+
+	mt.Run("Find tournament by ID", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testTournamentsNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "t1"},
+			{Key: "title", Value: "Premier Padel"},
+			{Key: "timestamp", Value: timestamp},
+		}))
+
+		tournament, err := repo.FindByID(context.Background(), "t1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Tournament{ID: "t1", Title: "Premier Padel", Timestamp: timestamp}, tournament)
+	})
+
+	mt.Run("Find tournament by ID not found", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testTournamentsNs, mtest.FirstBatch))
+
+		tournament, err := repo.FindByID(context.Background(), "t9")
+		assert.NoError(t, err)
+		assert.Empty(t, tournament.ID)
+	})
+
+	mt.Run("Stream tournaments", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, testTournamentsNs, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "t1"}, {Key: "title", Value: "Premier Padel"}, {Key: "timestamp", Value: timestamp},
+		}), mtest.CreateCursorResponse(1, testTournamentsNs, mtest.NextBatch, bson.D{
+			{Key: "_id", Value: "t2"}, {Key: "title", Value: "World Padel"}, {Key: "timestamp", Value: timestamp},
+		}), mtest.CreateCursorResponse(0, testTournamentsNs, mtest.NextBatch))
+
+		var ids []string
+		err := repo.ForEach(context.Background(), domain.TournamentFilter{From: &timestamp, CategoryID: "men-40"}, func(tournament domain.Tournament) error {
+			ids = append(ids, tournament.ID)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"t1", "t2"}, ids)
+	})
+
+	mt.Run("Stream tournaments failure", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "find error"}))
+
+		assert.Error(t, repo.ForEach(context.Background(), domain.TournamentFilter{}, func(domain.Tournament) error { return nil }))
+	})
+
+	mt.Run("Delete tournament", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+
+		assert.NoError(t, repo.Delete(context.Background(), "t1"))
+	})
+
+	mt.Run("Tournaments of other tenants are never read nor replaced", func(mt *mtest.T) {
+		repo := NewMongoTournamentRepository(newIdGenMock(), newMongoClientMock(mt.Client))
+		ctx := tenant.WithID(context.Background(), "fep")
+
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testTournamentsNs, mtest.FirstBatch))
+		tournament, err := repo.FindByID(ctx, "t1")
+		assert.NoError(t, err)
+		assert.Empty(t, tournament.ID)
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, "fep", filter.Lookup(common.TenantField).StringValue())
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+		assert.NoError(t, repo.Upsert(ctx, &domain.Tournament{ID: "t1", Title: "Premier Padel", Timestamp: timestamp}))
+		update := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, "fep", update.Lookup("q", common.TenantField).StringValue())
+		assert.Equal(t, "fep", update.Lookup("u", common.TenantField).StringValue())
+
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, testTournamentsNs, mtest.FirstBatch))
+		assert.NoError(t, repo.ForEach(ctx, domain.TournamentFilter{}, func(domain.Tournament) error { return nil }))
+		filter = mt.GetStartedEvent().Command.Lookup("filter").Document()
+		assert.Equal(t, "fep", filter.Lookup(common.TenantField).StringValue(), "Expected exports of the tenant only")
+	})
+}
+
+func TestMigrations(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("Tournaments and live matches stored before tenants are of the default tenant", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+		assert.NoError(t, Migrations()[0].Up(context.Background(), newMongoClientMock(mt.Client)))
+
+		for _, colName := range []string{tournamentsColName, liveMatchesColName} {
+			event := mt.GetStartedEvent()
+			assert.Equal(t, colName, event.Command.Lookup("update").StringValue())
+			update := event.Command.Lookup("updates").Array().Index(0).Value().Document()
+			assert.Equal(t, tenant.Default, update.Lookup("u", "$set", common.TenantField).StringValue())
+		}
+	})
+
+	mt.Run("Tournaments stored before versions are of version 0", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		assert.NoError(t, Migrations()[1].Up(context.Background(), newMongoClientMock(mt.Client)))
+
+		event := mt.GetStartedEvent()
+		assert.Equal(t, tournamentsColName, event.Command.Lookup("update").StringValue())
+		update := event.Command.Lookup("updates").Array().Index(0).Value().Document()
+		assert.Equal(t, int32(0), update.Lookup("u", "$set", "version").Int32())
+		assert.False(t, update.Lookup("q", "version", "$exists").Boolean())
+	})
+}